@@ -0,0 +1,68 @@
+package badge
+
+import (
+	"fmt"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+// Badge is the JSON accepted by the shields.io endpoint badge (see https://shields.io/badges/endpoint-badge).
+type Badge struct {
+	SchemaVersion int    `json:"schemaVersion"` // always 1
+	Label         string `json:"label"`
+	Message       string `json:"message"`
+	Color         string `json:"color,omitempty"`
+	IsError       bool   `json:"isError,omitempty"`
+	CacheSeconds  int    `json:"cacheSeconds,omitempty"` // how long shields.io may cache the badge for
+}
+
+// Stats are the release statistics shown by the badges.
+type Stats struct {
+	LatestRelease     string // the version of the latest release ("" when nothing has been released yet)
+	UnreleasedChanges int    // the number of changes since the latest release
+}
+
+// NewStats finds the latest release and counts the changes made since then.
+func NewStats(summer release.Summarizer) (*Stats, error) {
+	last, err := summer.LastRelease()
+	if err != nil {
+		return nil, fmt.Errorf("unable to find the latest release: %w", err)
+	}
+
+	var stats Stats
+	var since string
+	if last != nil {
+		stats.LatestRelease = last.Version
+		since = last.Version
+	}
+
+	changes, err := summer.Changes(since, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to summarize changes: %w", err)
+	}
+	stats.UnreleasedChanges = len(changes)
+
+	return &stats, nil
+}
+
+// LatestRelease is a badge showing the version of the latest release.
+func LatestRelease(stats Stats) Badge {
+	if stats.LatestRelease == "" {
+		return Badge{SchemaVersion: 1, Label: "release", Message: "none", Color: "lightgrey"}
+	}
+	return Badge{SchemaVersion: 1, Label: "release", Message: stats.LatestRelease, Color: "blue"}
+}
+
+// UnreleasedChanges is a badge showing the number of changes since the latest release.
+func UnreleasedChanges(stats Stats) Badge {
+	b := Badge{SchemaVersion: 1, Label: "unreleased changes", Message: fmt.Sprintf("%d", stats.UnreleasedChanges), Color: "brightgreen"}
+	if stats.UnreleasedChanges > 0 {
+		b.Color = "orange"
+	}
+	return b
+}
+
+// Unavailable is the badge shown when the statistics could not be found.
+func Unavailable(label string) Badge {
+	return Badge{SchemaVersion: 1, Label: label, Message: "unavailable", Color: "lightgrey", IsError: true}
+}
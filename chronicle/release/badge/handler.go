@@ -0,0 +1,110 @@
+package badge
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// the badges served (by path, relative to the handler prefix) along with the label shown when the stats are unavailable
+var badges = map[string]struct {
+	label  string
+	render func(Stats) Badge
+}{
+	"latest-release":     {label: "release", render: LatestRelease},
+	"unreleased-changes": {label: "unreleased changes", render: UnreleasedChanges},
+}
+
+// Handler serves shields.io endpoint badges at "<prefix>/latest-release" and "<prefix>/unreleased-changes". The stats
+// are cached for the given TTL to avoid querying the summarizer for every request, and each response carries an ETag
+// (a hash of the badge content) so that unchanged badges are not sent again.
+type Handler struct {
+	summer    release.Summarizer
+	prefix    string
+	ttl       time.Duration
+	now       func() time.Time
+	lock      sync.Mutex
+	stats     *Stats
+	fetchedAt time.Time
+}
+
+func NewHandler(summer release.Summarizer, prefix string, ttl time.Duration) *Handler {
+	return &Handler{
+		summer: summer,
+		prefix: strings.TrimSuffix(prefix, "/"),
+		ttl:    ttl,
+		now:    time.Now,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := strings.Trim(strings.TrimPrefix(r.URL.Path, h.prefix), "/")
+	b, ok := badges[name]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	// note: shields.io renders any non-200 response as an "invalid" badge, so failures are reported within the badge
+	var badge Badge
+	stats, err := h.currentStats()
+	if err != nil {
+		log.Errorf("unable to get release stats for badge %q: %+v", name, err)
+		badge = Unavailable(b.label)
+	} else {
+		badge = b.render(*stats)
+		badge.CacheSeconds = int(h.ttl.Seconds())
+	}
+
+	body, err := json.Marshal(badge)
+	if err != nil {
+		log.Errorf("unable to encode badge %q: %+v", name, err)
+		http.Error(w, "unable to encode badge", http.StatusInternalServerError)
+		return
+	}
+
+	digest := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(digest[:8]) + `"`
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(body); err != nil {
+		log.Errorf("unable to write badge %q: %+v", name, err)
+	}
+}
+
+func (h *Handler) currentStats() (*Stats, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.stats != nil && h.now().Sub(h.fetchedAt) < h.ttl {
+		return h.stats, nil
+	}
+
+	stats, err := NewStats(h.summer)
+	if err != nil {
+		return nil, err
+	}
+
+	h.stats = stats
+	h.fetchedAt = h.now()
+	return stats, nil
+}
@@ -0,0 +1,98 @@
+package badge
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+type mockSummarizer struct {
+	release.MockSummarizer
+	calls int
+	err   error
+}
+
+func (m *mockSummarizer) Changes(since, until string) ([]change.Change, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.MockSummarizer.Changes(since, until)
+}
+
+func TestHandler(t *testing.T) {
+	summer := &mockSummarizer{
+		MockSummarizer: release.MockSummarizer{
+			MockLastRelease: "v0.4.1",
+			MockChanges:     []change.Change{{Text: "fix"}, {Text: "feature"}},
+		},
+	}
+
+	now := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	h := NewHandler(summer, "/badge/", time.Minute)
+	h.now = func() time.Time { return now }
+
+	get := func(path, etag string) (*httptest.ResponseRecorder, Badge) {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		var b Badge
+		if rec.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &b))
+		}
+		return rec, b
+	}
+
+	rec, b := get("/badge/latest-release", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Equal(t, Badge{SchemaVersion: 1, Label: "release", Message: "v0.4.1", Color: "blue", CacheSeconds: 60}, b)
+
+	rec, b = get("/badge/unreleased-changes", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, Badge{SchemaVersion: 1, Label: "unreleased changes", Message: "2", Color: "orange", CacheSeconds: 60}, b)
+
+	// the badge is not sent again while its content is unchanged
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	rec, _ = get("/badge/unreleased-changes", etag)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+
+	// stats are cached until the TTL expires
+	assert.Equal(t, 1, summer.calls)
+	now = now.Add(2 * time.Minute)
+	get("/badge/latest-release", "")
+	assert.Equal(t, 2, summer.calls)
+
+	// failures are reported within the badge, since shields.io shows any other response as invalid
+	summer.err = fmt.Errorf("bad things")
+	now = now.Add(2 * time.Minute)
+	rec, b = get("/badge/unreleased-changes", etag)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, Badge{SchemaVersion: 1, Label: "unreleased changes", Message: "unavailable", Color: "lightgrey", IsError: true}, b)
+
+	rec, _ = get("/badge/stars", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/badge/latest-release", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestBadges(t *testing.T) {
+	assert.Equal(t, Badge{SchemaVersion: 1, Label: "release", Message: "none", Color: "lightgrey"}, LatestRelease(Stats{}))
+	assert.Equal(t, Badge{SchemaVersion: 1, Label: "unreleased changes", Message: "0", Color: "brightgreen"}, UnreleasedChanges(Stats{LatestRelease: "v0.4.1"}))
+}
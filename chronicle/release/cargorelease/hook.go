@@ -0,0 +1,55 @@
+package cargorelease
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Hook is the release being made by cargo-release, as given to the pre-release hook via environment variables.
+type Hook struct {
+	Version       string // the version being released (NEW_VERSION)
+	PrevVersion   string // the version before the release (PREV_VERSION)
+	CrateName     string // the name of the crate being released (CRATE_NAME)
+	WorkspaceRoot string // the root of the workspace (WORKSPACE_ROOT)
+	CrateRoot     string // the root of the crate being released (CRATE_ROOT)
+	DryRun        bool   // true when cargo-release is run without --execute (DRY_RUN)
+	Date          time.Time
+}
+
+// HookFromEnv returns the release being made from the environment of a cargo-release pre-release hook.
+func HookFromEnv(getenv func(string) string, now time.Time) (Hook, error) {
+	hook := Hook{
+		Version:       getenv("NEW_VERSION"),
+		PrevVersion:   getenv("PREV_VERSION"),
+		CrateName:     getenv("CRATE_NAME"),
+		WorkspaceRoot: getenv("WORKSPACE_ROOT"),
+		CrateRoot:     getenv("CRATE_ROOT"),
+		DryRun:        getenv("DRY_RUN") == "true",
+		Date:          now,
+	}
+	if hook.Version == "" {
+		return Hook{}, fmt.Errorf("no NEW_VERSION given (must be run as a cargo-release pre-release-hook)")
+	}
+	return hook, nil
+}
+
+// Render replaces the cargo-release replacement tokens ({{version}}, {{prev_version}}, {{crate_name}}, and {{date}})
+// within the given value.
+func (h Hook) Render(value string) string {
+	return h.render(value, h.Version)
+}
+
+// RenderPrevious is the same as Render, but for the previous release (the {{version}} token is the previous version).
+func (h Hook) RenderPrevious(value string) string {
+	return h.render(value, h.PrevVersion)
+}
+
+func (h Hook) render(value, version string) string {
+	return strings.NewReplacer(
+		"{{version}}", version,
+		"{{prev_version}}", h.PrevVersion,
+		"{{crate_name}}", h.CrateName,
+		"{{date}}", h.Date.Format("2006-01-02"),
+	).Replace(value)
+}
@@ -0,0 +1,52 @@
+package cargorelease
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHookFromEnv(t *testing.T) {
+	env := map[string]string{
+		"NEW_VERSION":    "0.2.0",
+		"PREV_VERSION":   "0.1.0",
+		"CRATE_NAME":     "chronicle-core",
+		"WORKSPACE_ROOT": "/work",
+		"CRATE_ROOT":     "/work/core",
+		"DRY_RUN":        "true",
+	}
+	now := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	hook, err := HookFromEnv(func(key string) string { return env[key] }, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, Hook{
+		Version:       "0.2.0",
+		PrevVersion:   "0.1.0",
+		CrateName:     "chronicle-core",
+		WorkspaceRoot: "/work",
+		CrateRoot:     "/work/core",
+		DryRun:        true,
+		Date:          now,
+	}, hook)
+}
+
+func TestHookFromEnv_notAHook(t *testing.T) {
+	_, err := HookFromEnv(func(string) string { return "" }, time.Now())
+	require.ErrorContains(t, err, "NEW_VERSION")
+}
+
+func TestHook_Render(t *testing.T) {
+	hook := Hook{
+		Version:     "0.2.0",
+		PrevVersion: "0.1.0",
+		CrateName:   "chronicle-core",
+		Date:        time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, "notes/chronicle-core-v0.2.0-2023-03-01.md", hook.Render("notes/{{crate_name}}-v{{version}}-{{date}}.md"))
+	assert.Equal(t, "chronicle-core-v0.1.0", hook.RenderPrevious("{{crate_name}}-v{{version}}"))
+	assert.Equal(t, "0.1.0...0.2.0", hook.Render("{{prev_version}}...{{version}}"))
+}
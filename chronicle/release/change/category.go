@@ -0,0 +1,58 @@
+package change
+
+import "strings"
+
+// Category is one of the conventional categories of change, as used by keepachangelog.com and Artifact Hub.
+type Category string
+
+const (
+	AddedCategory      Category = "added"
+	ChangedCategory    Category = "changed"
+	DeprecatedCategory Category = "deprecated"
+	RemovedCategory    Category = "removed"
+	FixedCategory      Category = "fixed"
+	SecurityCategory   Category = "security"
+)
+
+// Categories are all categories of change, in the order used by keepachangelog.com.
+var Categories = []Category{
+	AddedCategory,
+	ChangedCategory,
+	DeprecatedCategory,
+	RemovedCategory,
+	FixedCategory,
+	SecurityCategory,
+}
+
+// Title is the display title of the category (e.g. "Added").
+func (c Category) Title() string {
+	if c == "" {
+		return ""
+	}
+	return strings.ToUpper(string(c[:1])) + string(c[1:])
+}
+
+// CategoryOf maps a change type onto a category of change, by the name of the change type (e.g. "bug-fix" is fixed)
+// or otherwise by the semver field it bumps. Breaking change types are "changed" (as in keepachangelog.com).
+func CategoryOf(t Type) Category {
+	name := strings.ToLower(t.Name)
+	switch {
+	case strings.Contains(name, "security") || strings.Contains(name, "vulnerab"):
+		return SecurityCategory
+	case strings.Contains(name, "deprecat"):
+		return DeprecatedCategory
+	case strings.Contains(name, "remov"):
+		return RemovedCategory
+	case strings.Contains(name, "fix") || strings.Contains(name, "bug"):
+		return FixedCategory
+	case strings.Contains(name, "break"):
+		return ChangedCategory
+	case strings.Contains(name, "add") || strings.Contains(name, "feat") || strings.Contains(name, "enhance"):
+		return AddedCategory
+	}
+
+	if t.Kind == SemVerMinor {
+		return AddedCategory
+	}
+	return ChangedCategory
+}
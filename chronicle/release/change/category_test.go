@@ -0,0 +1,33 @@
+package change
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategoryOf(t *testing.T) {
+	tests := []struct {
+		changeType Type
+		want       Category
+	}{
+		{changeType: NewType("security-fixes", SemVerPatch), want: SecurityCategory},
+		{changeType: NewType("deprecated-feature", SemVerMinor), want: DeprecatedCategory},
+		{changeType: NewType("removed-feature", SemVerMajor), want: RemovedCategory},
+		{changeType: NewType("bug-fix", SemVerPatch), want: FixedCategory},
+		{changeType: NewType("added-feature", SemVerMinor), want: AddedCategory},
+		{changeType: NewType("breaking-feature", SemVerMajor), want: ChangedCategory},
+		{changeType: NewType("improvement", SemVerMinor), want: AddedCategory},
+		{changeType: NewType("unknown", SemVerUnknown), want: ChangedCategory},
+	}
+	for _, tt := range tests {
+		t.Run(tt.changeType.Name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CategoryOf(tt.changeType))
+		})
+	}
+}
+
+func TestCategory_Title(t *testing.T) {
+	assert.Equal(t, "Added", AddedCategory.Title())
+	assert.Equal(t, "Security", SecurityCategory.Title())
+}
@@ -11,12 +11,16 @@ type Changes []Change
 
 // Change represents the smallest unit within a release that can be summarized.
 type Change struct {
-	Text        string      // title or short summary describing the change (e.g. GitHub issue or PR title)
-	ChangeTypes []Type      // the kind(s) of change(s) this specific change description represents (e.g. breaking, enhancement, patch, etc.)
-	Timestamp   time.Time   // the timestamp best representing when the change was committed to the VCS baseline (e.g. GitHub PR merged).
-	References  []Reference // any URLs that relate to the change
-	EntryType   string      // a free-form helper string that indicates where the change came from (e.g. a "github-issue"). This can be useful for parsing the `Entry` field.
-	Entry       interface{} // the original data entry from the source that represents the change. The `EntryType` field should be used to help indicate how the shape should be interpreted.
+	Text        string                 // title or short summary describing the change (e.g. GitHub issue or PR title)
+	ChangeTypes []Type                 // the kind(s) of change(s) this specific change description represents (e.g. breaking, enhancement, patch, etc.)
+	Timestamp   time.Time              // the timestamp best representing when the change was committed to the VCS baseline (e.g. GitHub PR merged).
+	References  []Reference            // any URLs that relate to the change
+	EntryType   string                 // a free-form helper string that indicates where the change came from (e.g. a "github-issue"). This can be useful for parsing the `Entry` field.
+	Entry       interface{}            // the original data entry from the source that represents the change. The `EntryType` field should be used to help indicate how the shape should be interpreted.
+	Children    Changes                // the changes clustered under this change (e.g. all PRs that implement a single tracking issue)
+	Identities  []string               // stable identifiers for the entities this change was derived from (e.g. "github-pr:123" or "commit:<sha>"), used to recognize the same change reported by multiple sources
+	Authors     []Reference            `json:",omitempty"` // the people who made the change (e.g. the PR author), whether or not they are credited within the references
+	Raw         map[string]interface{} `json:",omitempty"` // the unmodified API payload of the entry (only populated with --expose-raw). The shape is owned by the upstream API and may change without notice.
 }
 
 // Reference indicates where you can find additional information about a particular change.
@@ -25,6 +29,18 @@ type Reference struct {
 	URL  string
 }
 
+// SharesIdentity indicates if both changes were derived from at least one common entity.
+func (c Change) SharesIdentity(other Change) bool {
+	for _, a := range c.Identities {
+		for _, b := range other.Identities {
+			if a == b {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // ByChangeType returns the set of changes that match one of the given change types.
 func (s Changes) ByChangeType(types ...Type) (result Changes) {
 	for _, summary := range s {
@@ -0,0 +1,43 @@
+package change
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Component extracts the component of a change from its text using the "component" capture group of the given
+// pattern (e.g. "api" from "api: add the thing"), returning the component and the text without the matched portion.
+// When there is no pattern (or it does not match) the component is empty and the text is returned unchanged.
+func Component(pattern *regexp.Regexp, text string) (string, string) {
+	if pattern == nil {
+		return "", text
+	}
+	idx := pattern.SubexpIndex("component")
+	if idx < 0 {
+		return "", text
+	}
+	match := pattern.FindStringSubmatchIndex(text)
+	if match == nil || match[2*idx] < 0 {
+		return "", text
+	}
+	component := text[match[2*idx]:match[2*idx+1]]
+	return component, strings.TrimSpace(text[:match[0]] + text[match[1]:])
+}
+
+// ByComponent returns the set of changes whose component (see Component) is one of the given components (ignoring
+// case).
+func (s Changes) ByComponent(pattern *regexp.Regexp, components ...string) (result Changes) {
+	for _, c := range s {
+		component, _ := Component(pattern, c.Text)
+		if component == "" {
+			continue
+		}
+		for _, target := range components {
+			if strings.EqualFold(component, target) {
+				result = append(result, c)
+				break
+			}
+		}
+	}
+	return result
+}
@@ -1,5 +1,10 @@
 package change
 
+import (
+	"sort"
+	"strings"
+)
+
 // TypeSet is a unique set of types indexed by their name
 type TypeSet map[string]Type
 
@@ -10,11 +15,38 @@ func (l TypeSet) Names() (results []string) {
 	return results
 }
 
+// ChangeTypes returns the types for the given labels. Labels are matched exactly first, falling back to ignoring
+// case (as github does). When several names differ only by case, the fallback resolves to the first name in sorted
+// order, so the result is the same on every run.
 func (l TypeSet) ChangeTypes(labels ...string) (results []Type) {
+	var folded map[string]Type
 	for _, label := range labels {
 		if ct, exists := l[label]; exists {
 			results = append(results, ct)
+			continue
+		}
+		if folded == nil {
+			folded = l.foldedIndex()
+		}
+		if ct, exists := folded[strings.ToLower(label)]; exists {
+			results = append(results, ct)
 		}
 	}
 	return results
 }
+
+// foldedIndex returns the types indexed by their lowercased name, with the first name (in sorted order) taking
+// precedence over any others that differ only by case.
+func (l TypeSet) foldedIndex() map[string]Type {
+	names := l.Names()
+	sort.Strings(names)
+
+	index := make(map[string]Type, len(names))
+	for _, name := range names {
+		key := strings.ToLower(name)
+		if _, exists := index[key]; !exists {
+			index[key] = l[name]
+		}
+	}
+	return index
+}
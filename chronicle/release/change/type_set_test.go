@@ -0,0 +1,59 @@
+package change
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeSet_ChangeTypes(t *testing.T) {
+	bug := NewType("bug", SemVerPatch)
+	fix := NewType("fix", SemVerPatch)
+	feature := NewType("feature", SemVerMinor)
+
+	tests := []struct {
+		name   string
+		set    TypeSet
+		labels []string
+		want   []Type
+	}{
+		{
+			name:   "exact match",
+			set:    TypeSet{"bug": bug, "enhancement": feature},
+			labels: []string{"bug", "enhancement"},
+			want:   []Type{bug, feature},
+		},
+		{
+			name:   "case-insensitive match",
+			set:    TypeSet{"bug": bug, "enhancement": feature},
+			labels: []string{"BUG", "Enhancement"},
+			want:   []Type{bug, feature},
+		},
+		{
+			name:   "unknown labels are ignored",
+			set:    TypeSet{"bug": bug},
+			labels: []string{"question", "Bug"},
+			want:   []Type{bug},
+		},
+		{
+			name:   "exact match takes precedence over names differing by case",
+			set:    TypeSet{"Bug": fix, "bug": bug},
+			labels: []string{"bug", "Bug"},
+			want:   []Type{bug, fix},
+		},
+		{
+			name:   "names differing by case resolve to the first in sorted order",
+			set:    TypeSet{"bug": bug, "Bug": fix, "BUG": feature},
+			labels: []string{"bUg"},
+			want:   []Type{feature},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// note: repeated since map iteration order differs between runs
+			for i := 0; i < 20; i++ {
+				assert.Equal(t, tt.want, tt.set.ChangeTypes(tt.labels...))
+			}
+		})
+	}
+}
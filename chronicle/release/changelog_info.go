@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"sort"
-	"time"
 
 	"github.com/scylladb/go-set/strset"
 
@@ -13,17 +12,29 @@ import (
 	"github.com/anchore/chronicle/internal/log"
 )
 
+// UnreleasedVersion is the version shown for a changelog that does not describe a specific release.
+const UnreleasedVersion = "(Unreleased)"
+
 type ChangelogInfoConfig struct {
 	VersionSpeculator
 	RepoPath         string
 	SinceTag         string
 	UntilTag         string
+	UntilDraft       bool   // the release being described is a draft, whose notes are to be replaced (see Release.Draft)
+	PrereleaseMode   string // whether pre-releases are considered as the last release (see StableReleasesOnly, the default)
+	UntilVersion     string // the version of the release when it has not been tagged yet (e.g. a draft release), which ends at the current revision
 	ChangeTypeTitles []change.TypeTitle
+	NewContributors  bool      // find the people who contributed for the first time within the release (see ContributorSummarizer)
+	Warnings         *Warnings // collects the warnings raised while describing the release (typically shared with the summarizer)
+	Clock            Clock     // the source of the current time, used as the date of the release being described (the system clock when nil)
 }
 
 // ChangelogInfo identifies the last release (the start of the changelog) and returns a description of the current (potentially speculative) release.
 func ChangelogInfo(summer Summarizer, config ChangelogInfoConfig) (*Release, *Description, error) {
-	startRelease, err := getChangelogStartingRelease(summer, config.SinceTag)
+	// note: the releases are only listed once for both the start of the changelog and the timeline
+	listed := listOnce(summer)
+
+	startRelease, err := getChangelogStartingRelease(listed, config)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -41,21 +52,38 @@ func ChangelogInfo(summer Summarizer, config ChangelogInfoConfig) (*Release, *De
 
 	var releaseDisplayVersion = releaseVersion
 	if releaseVersion == "" {
-		releaseDisplayVersion = "(Unreleased)"
+		releaseDisplayVersion = UnreleasedVersion
 	}
 
 	logChanges(changes)
 
+	var newContributorList []Contributor
+	if config.NewContributors {
+		newContributorList, err = newContributors(summer, startRelease.Version, config.UntilTag)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	releaseDate := config.Clock.Now()
+
+	timeline := newTimeline(listed, *startRelease, releaseVersion, releaseDate, config.Warnings)
+
 	return startRelease, &Description{
 		Release: Release{
 			Version: releaseDisplayVersion,
-			Date:    time.Now(),
+			Date:    releaseDate,
+			Draft:   config.UntilDraft,
 		},
+		Timeline:         timeline,
 		VCSReferenceURL:  summer.ReferenceURL(releaseVersion),
 		VCSChangesURL:    summer.ChangesURL(startRelease.Version, releaseVersion),
 		Changes:          changes,
 		SupportedChanges: config.ChangeTypeTitles,
 		Notice:           "", // TODO...
+		NewContributors:  newContributorList,
+		Contributors:     contributors(changes),
+		Warnings:         config.Warnings.List(),
 	}, nil
 }
 
@@ -67,11 +95,16 @@ func changelogChanges(startReleaseVersion string, summer Summarizer, config Chan
 		return "", nil, fmt.Errorf("unable to summarize changes: %w", err)
 	}
 
+	if endReleaseVersion == "" && config.UntilVersion != "" {
+		log.Infof("using the version of the untagged release=%q", config.UntilVersion)
+		return config.UntilVersion, changes, nil
+	}
+
 	if config.VersionSpeculator != nil {
 		if endReleaseVersion == "" {
 			specEndReleaseVersion, err := speculateNextVersion(config.VersionSpeculator, startReleaseVersion, changes)
 			if err != nil {
-				log.Warnf("unable to speculate next release version: %+v", err)
+				config.Warnings.Add(UnresolvedTagWarning, "unable to speculate the next release version: %+v", err)
 			} else {
 				endReleaseVersion = specEndReleaseVersion
 			}
@@ -100,18 +133,18 @@ func speculateNextVersion(speculator VersionSpeculator, startReleaseVersion stri
 	return nextUniqueVersion, nil
 }
 
-func getChangelogStartingRelease(summer Summarizer, sinceTag string) (*Release, error) {
+func getChangelogStartingRelease(summer Summarizer, config ChangelogInfoConfig) (*Release, error) {
 	var lastRelease *Release
 	var err error
-	if sinceTag != "" {
-		lastRelease, err = summer.Release(sinceTag)
+	if config.SinceTag != "" {
+		lastRelease, err = summer.Release(config.SinceTag)
 		if err != nil {
 			return nil, fmt.Errorf("unable to fetch specific release: %w", err)
 		} else if lastRelease == nil {
 			return nil, errors.New("unable to fetch release")
 		}
 	} else {
-		lastRelease, err = summer.LastRelease()
+		lastRelease, err = lastReleaseOf(summer, config)
 		if err != nil {
 			return nil, fmt.Errorf("unable to determine last release: %w", err)
 		} else if lastRelease == nil {
@@ -122,6 +155,29 @@ func getChangelogStartingRelease(summer Summarizer, sinceTag string) (*Release,
 	return lastRelease, nil
 }
 
+// lastReleaseOf returns the last release of the summarizer, selected per the pre-release mode when the summarizer can
+// list its releases.
+func lastReleaseOf(summer Summarizer, config ChangelogInfoConfig) (*Release, error) {
+	lister, ok := summer.(ReleaseLister)
+	if !ok {
+		return summer.LastRelease()
+	}
+
+	releases, err := lister.Releases()
+	if err != nil {
+		return nil, err
+	}
+	if releases == nil {
+		return summer.LastRelease()
+	}
+
+	untilVersion := config.UntilTag
+	if untilVersion == "" {
+		untilVersion = config.UntilVersion
+	}
+	return selectLastRelease(releases, config.PrereleaseMode, untilVersion), nil
+}
+
 func logChanges(changes change.Changes) {
 	log.Infof("discovered changes: %d", len(changes))
 
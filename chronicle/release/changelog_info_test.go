@@ -2,6 +2,7 @@ package release
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,6 +34,20 @@ func Test_getChangelogStartingRelease(t *testing.T) {
 			},
 			wantErr: require.Error,
 		},
+		{
+			name:     "use the last stable release when the releases can be listed",
+			sinceTag: "",
+			summer: MockSummarizer{
+				MockLastRelease: "v0.2.0-rc.1",
+				MockReleases: []Release{
+					{Version: "v0.1.0"},
+					{Version: "v0.2.0-rc.1"},
+				},
+			},
+			want: &Release{
+				Version: "v0.1.0",
+			},
+		},
 		{
 			name:     "use given release (which exists)",
 			sinceTag: "v0.1.0",
@@ -55,7 +70,7 @@ func Test_getChangelogStartingRelease(t *testing.T) {
 			if tt.wantErr == nil {
 				tt.wantErr = require.NoError
 			}
-			got, err := getChangelogStartingRelease(tt.summer, tt.sinceTag)
+			got, err := getChangelogStartingRelease(tt.summer, ChangelogInfoConfig{SinceTag: tt.sinceTag})
 			tt.wantErr(t, err)
 			assert.Equal(t, tt.want, got)
 		})
@@ -98,6 +113,20 @@ func Test_changelogChanges(t *testing.T) {
 			endReleaseVersion: "v0.2.1",
 			endReleaseDisplay: "v0.2.1",
 		},
+		{
+			name:                "untagged draft release - use its version",
+			startReleaseVersion: "v0.1.0",
+			summer:              MockSummarizer{},
+			config: ChangelogInfoConfig{
+				UntilVersion: "v0.3.0",
+				VersionSpeculator: MockVersionSpeculator{
+					MockNextIdealVersion:  "v0.2.0",
+					MockNextUniqueVersion: "v0.2.0",
+				},
+			},
+			endReleaseVersion: "v0.3.0",
+			endReleaseDisplay: "v0.3.0",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -111,3 +140,18 @@ func Test_changelogChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestChangelogInfo_Clock(t *testing.T) {
+	now := time.Date(2024, time.March, 14, 15, 9, 26, 0, time.UTC)
+
+	_, description, err := ChangelogInfo(MockSummarizer{
+		MockLastRelease: "v0.1.0",
+	}, ChangelogInfoConfig{
+		UntilVersion: "v0.2.0",
+		Clock:        FixedClock(now),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "v0.2.0", description.Version)
+	assert.Equal(t, now, description.Date)
+}
@@ -0,0 +1,229 @@
+package changelogfile
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+)
+
+// unreleasedKey identifies the "Unreleased" section of a changelog, regardless of how its heading is written (e.g.
+// "## [Unreleased]" or "## (Unreleased)").
+const unreleasedKey = "unreleased"
+
+// linkDefinitionPattern matches a markdown link reference definition (e.g. "[0.19.1]: https://...") as found at the
+// bottom of changelogs following the keepachangelog.com conventions.
+var linkDefinitionPattern = regexp.MustCompile(`^\[([^\]]+)\]:\s*\S+`)
+
+// Update inserts the rendered changelog of a release into the changelog file at the given path (creating the file
+// when it does not exist). See Insert for how the release is positioned.
+func Update(path, rendered, version string) error {
+	existing, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("unable to read changelog %q: %w", path, err)
+	}
+
+	info, err := os.Stat(path)
+	mode := os.FileMode(0644)
+	if err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	if err := os.WriteFile(path, []byte(Insert(string(existing), rendered, version)), mode); err != nil {
+		return fmt.Errorf("unable to write changelog %q: %w", path, err)
+	}
+	return nil
+}
+
+// Insert returns the existing changelog with the release sections of the rendered changelog (everything from the first
+// "## " heading, without the title) inserted for the given version. Prior releases, the "Unreleased" section, and
+// anything above the first release (e.g. the title and preamble) are kept as-is:
+//   - a section for the same version is replaced, so re-running for a release is idempotent
+//   - otherwise the release is placed after the "Unreleased" section, above the first release with a lower version
+//     (e.g. a patch release of an older line goes below newer releases)
+//   - link reference definitions at the bottom of the rendered changelog are merged into those of the existing
+//     changelog
+func Insert(existing, rendered, version string) string {
+	if strings.TrimSpace(existing) == "" {
+		return strings.TrimRight(rendered, "\n") + "\n"
+	}
+
+	_, newSections, newLinks := parse(rendered)
+	var release []string
+	for _, s := range newSections {
+		release = append(release, s.lines...)
+	}
+	release = withTrailingBlank(release)
+
+	preamble, sections, links := parse(existing)
+
+	key := versionKey(version)
+	at := -1
+	replace := false
+	for i, s := range sections {
+		if s.key == key {
+			at, replace = i, true
+			break
+		}
+	}
+	if at < 0 {
+		at = position(sections, key)
+	}
+
+	var lines []string
+	lines = append(lines, withTrailingBlank(preamble)...)
+	for i, s := range sections {
+		if i == at {
+			lines = append(lines, release...)
+			if replace {
+				continue
+			}
+		}
+		lines = append(lines, withTrailingBlank(s.lines)...)
+	}
+	if at == len(sections) {
+		lines = append(lines, release...)
+	}
+
+	if merged := mergeLinks(links, newLinks); len(merged) > 0 {
+		lines = append(withTrailingBlank(lines), merged...)
+	}
+
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n") + "\n"
+}
+
+type section struct {
+	key   string // the normalized version of the section (see versionKey)
+	lines []string
+}
+
+// parse splits a markdown changelog into what precedes the first "## " heading, a section per "## " heading, and the
+// trailing link reference definitions.
+func parse(contents string) ([]string, []section, []string) {
+	lines := strings.Split(strings.TrimRight(contents, "\n"), "\n")
+
+	// the link reference definitions at the bottom of the document, ignoring blank lines between them
+	end := len(lines)
+	var links []string
+	for i := len(lines) - 1; i >= 0; i-- {
+		if strings.TrimSpace(lines[i]) == "" {
+			continue
+		}
+		if !linkDefinitionPattern.MatchString(lines[i]) {
+			break
+		}
+		links = append([]string{lines[i]}, links...)
+		end = i
+	}
+	lines = lines[:end]
+
+	var preamble []string
+	var sections []section
+	fenced := false
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			fenced = !fenced
+		}
+		if !fenced && strings.HasPrefix(line, "## ") {
+			sections = append(sections, section{key: headingKey(line)})
+		}
+		if len(sections) == 0 {
+			preamble = append(preamble, line)
+			continue
+		}
+		last := &sections[len(sections)-1]
+		last.lines = append(last.lines, line)
+	}
+
+	return preamble, sections, links
+}
+
+// headingKey returns the normalized version of the release for a "## " heading, e.g. "0.19.1" for
+// "## [v0.19.1](https://github.com/anchore/syft/tree/v0.19.1) (2021-09-16)" or "## [0.19.1] - 2021-09-16".
+func headingKey(heading string) string {
+	text := strings.TrimSpace(strings.TrimPrefix(heading, "## "))
+	if strings.Contains(strings.ToLower(text), unreleasedKey) {
+		return unreleasedKey
+	}
+	text = strings.TrimPrefix(text, "[")
+	if i := strings.IndexAny(text, "] ("); i >= 0 {
+		text = text[:i]
+	}
+	return versionKey(text)
+}
+
+func versionKey(version string) string {
+	if strings.Contains(strings.ToLower(version), unreleasedKey) {
+		return unreleasedKey
+	}
+	return strings.TrimPrefix(version, "v")
+}
+
+// position returns the index of the section that a new release (by key) should be placed before.
+func position(sections []section, key string) int {
+	newVersion, err := semver.NewVersion(key)
+	for i, s := range sections {
+		if s.key == unreleasedKey {
+			continue
+		}
+		if key == unreleasedKey || err != nil {
+			return i
+		}
+		v, vErr := semver.NewVersion(s.key)
+		if vErr != nil || v.LessThan(*newVersion) {
+			return i
+		}
+	}
+	return len(sections)
+}
+
+// mergeLinks adds the new link reference definitions to the existing ones, replacing any with the same label in
+// place. Other new definitions go after the one for the "Unreleased" section (which conventionally comes first).
+func mergeLinks(existing, added []string) []string {
+	label := func(line string) string {
+		return strings.ToLower(linkDefinitionPattern.FindStringSubmatch(line)[1])
+	}
+
+	replacements := make(map[string]string)
+	for _, a := range added {
+		replacements[label(a)] = a
+	}
+
+	var result []string
+	for _, e := range existing {
+		if a, ok := replacements[label(e)]; ok {
+			result = append(result, a)
+			delete(replacements, label(e))
+			continue
+		}
+		result = append(result, e)
+	}
+
+	var remaining []string
+	for _, a := range added {
+		if _, ok := replacements[label(a)]; ok {
+			remaining = append(remaining, a)
+		}
+	}
+
+	at := 0
+	if len(result) > 0 && label(result[0]) == unreleasedKey {
+		at = 1
+	}
+	return append(result[:at], append(remaining, result[at:]...)...)
+}
+
+// withTrailingBlank returns the lines ending with exactly one blank line, separating them from what follows.
+func withTrailingBlank(lines []string) []string {
+	end := len(lines)
+	for end > 0 && strings.TrimSpace(lines[end-1]) == "" {
+		end--
+	}
+	if end == 0 {
+		return nil
+	}
+	return append(append([]string{}, lines[:end]...), "")
+}
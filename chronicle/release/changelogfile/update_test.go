@@ -0,0 +1,233 @@
+package changelogfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const markdownRelease = `# Changelog
+
+## [v0.3.0](https://github.com/anchore/chronicle/tree/v0.3.0) (2023-03-01)
+
+[Full Changelog](https://github.com/anchore/chronicle/compare/v0.2.0...v0.3.0)
+
+### Added Features
+
+- a new feature
+`
+
+const keepAChangelogRelease = `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+## [0.3.0] - 2023-03-01
+
+### Added
+
+- a new feature
+
+[0.3.0]: https://github.com/anchore/chronicle/compare/v0.2.0...v0.3.0
+`
+
+func TestInsert(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		rendered string
+		version  string
+		want     string
+	}{
+		{
+			name:     "new changelog",
+			rendered: markdownRelease,
+			version:  "v0.3.0",
+			want:     markdownRelease,
+		},
+		{
+			name: "above the previous releases",
+			existing: `# Changelog
+
+Some words about this changelog.
+
+## [v0.2.0](https://github.com/anchore/chronicle/tree/v0.2.0) (2023-02-01)
+
+- an older feature
+`,
+			rendered: markdownRelease,
+			version:  "v0.3.0",
+			want: `# Changelog
+
+Some words about this changelog.
+
+## [v0.3.0](https://github.com/anchore/chronicle/tree/v0.3.0) (2023-03-01)
+
+[Full Changelog](https://github.com/anchore/chronicle/compare/v0.2.0...v0.3.0)
+
+### Added Features
+
+- a new feature
+
+## [v0.2.0](https://github.com/anchore/chronicle/tree/v0.2.0) (2023-02-01)
+
+- an older feature
+`,
+		},
+		{
+			name: "replaces the same version",
+			existing: `# Changelog
+
+## [v0.3.0](https://github.com/anchore/chronicle/tree/v0.3.0) (2023-02-28)
+
+- a stale entry
+
+## [v0.2.0](https://github.com/anchore/chronicle/tree/v0.2.0) (2023-02-01)
+
+- an older feature
+`,
+			rendered: markdownRelease,
+			version:  "v0.3.0",
+			want: `# Changelog
+
+## [v0.3.0](https://github.com/anchore/chronicle/tree/v0.3.0) (2023-03-01)
+
+[Full Changelog](https://github.com/anchore/chronicle/compare/v0.2.0...v0.3.0)
+
+### Added Features
+
+- a new feature
+
+## [v0.2.0](https://github.com/anchore/chronicle/tree/v0.2.0) (2023-02-01)
+
+- an older feature
+`,
+		},
+		{
+			name: "below newer release lines",
+			existing: `# Changelog
+
+## v1.0.0
+
+- the next major release
+
+## v0.2.0
+
+- an older feature
+`,
+			rendered: markdownRelease,
+			version:  "v0.3.0",
+			want: `# Changelog
+
+## v1.0.0
+
+- the next major release
+
+## [v0.3.0](https://github.com/anchore/chronicle/tree/v0.3.0) (2023-03-01)
+
+[Full Changelog](https://github.com/anchore/chronicle/compare/v0.2.0...v0.3.0)
+
+### Added Features
+
+- a new feature
+
+## v0.2.0
+
+- an older feature
+`,
+		},
+		{
+			name: "keep a changelog with an unreleased section and links",
+			existing: `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+## [Unreleased]
+
+## [0.2.0] - 2023-02-01
+
+### Added
+
+- an older feature
+
+` + "```" + `
+## not a heading
+` + "```" + `
+
+[unreleased]: https://github.com/anchore/chronicle/compare/v0.2.0...HEAD
+[0.2.0]: https://github.com/anchore/chronicle/compare/v0.1.0...v0.2.0
+`,
+			rendered: keepAChangelogRelease,
+			version:  "v0.3.0",
+			want: `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+## [Unreleased]
+
+## [0.3.0] - 2023-03-01
+
+### Added
+
+- a new feature
+
+## [0.2.0] - 2023-02-01
+
+### Added
+
+- an older feature
+
+` + "```" + `
+## not a heading
+` + "```" + `
+
+[unreleased]: https://github.com/anchore/chronicle/compare/v0.2.0...HEAD
+[0.3.0]: https://github.com/anchore/chronicle/compare/v0.2.0...v0.3.0
+[0.2.0]: https://github.com/anchore/chronicle/compare/v0.1.0...v0.2.0
+`,
+		},
+		{
+			name: "at the end when there are no older releases",
+			existing: `# Changelog
+
+## [Unreleased]
+`,
+			rendered: markdownRelease,
+			version:  "v0.3.0",
+			want: `# Changelog
+
+## [Unreleased]
+
+## [v0.3.0](https://github.com/anchore/chronicle/tree/v0.3.0) (2023-03-01)
+
+[Full Changelog](https://github.com/anchore/chronicle/compare/v0.2.0...v0.3.0)
+
+### Added Features
+
+- a new feature
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Insert(tt.existing, tt.rendered, tt.version)
+			assert.Equal(t, tt.want, got)
+
+			// re-running for the same release changes nothing
+			assert.Equal(t, got, Insert(got, tt.rendered, tt.version))
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "CHANGELOG.md")
+
+	require.NoError(t, Update(path, markdownRelease, "v0.3.0"))
+	require.NoError(t, Update(path, markdownRelease, "v0.3.0"))
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, markdownRelease, string(contents))
+}
@@ -0,0 +1,23 @@
+package release
+
+import "time"
+
+// Clock returns the current time. Everything within a changelog derived from the current time (e.g. the date of a
+// release that has not been tagged yet) is taken from the clock, so library users and tests can provide a fixed clock
+// for deterministic results. A nil clock is the system clock.
+type Clock func() time.Time
+
+// FixedClock returns a clock that always returns the given time.
+func FixedClock(t time.Time) Clock {
+	return func() time.Time {
+		return t
+	}
+}
+
+// Now returns the current time according to the clock.
+func (c Clock) Now() time.Time {
+	if c == nil {
+		return time.Now()
+	}
+	return c()
+}
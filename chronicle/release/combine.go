@@ -0,0 +1,98 @@
+package release
+
+import (
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+// RepoDescription is the description of a release of one repo, among the repos that together make up a product.
+type RepoDescription struct {
+	Name        string // the display name of the repo (e.g. "api"), used as the component of each of its changes
+	Description Description
+}
+
+// CombineDescriptions merges the descriptions of several repos into a single description for a release that spans all
+// of them. Each change is prefixed with the name of its repo (e.g. "api: add the thing"), so the changes can be
+// grouped by repo in the same way as by component (see change.Component), and the changes of each repo are kept
+// together (in the given order) within each section. The version is used for the combined release (when not given
+// the release is described as unreleased). Since no single repo describes the combined release, the VCS URLs are left
+// empty.
+func CombineDescriptions(version string, repos []RepoDescription) Description {
+	if version == "" {
+		version = UnreleasedVersion
+	}
+
+	combined := Description{
+		Release: Release{
+			Version: version,
+		},
+	}
+
+	seenContributors := make(map[string]bool)
+	seenWarnings := make(map[Warning]bool)
+	for _, r := range repos {
+		d := r.Description
+		if d.Date.After(combined.Date) {
+			combined.Date = d.Date
+		}
+
+		for _, c := range d.Changes {
+			combined.Changes = append(combined.Changes, repoChange(r.Name, c))
+		}
+
+		combined.SupportedChanges = mergeTypeTitles(combined.SupportedChanges, d.SupportedChanges)
+		combined.NewContributors = append(combined.NewContributors, d.NewContributors...)
+
+		for _, c := range d.Contributors {
+			if seenContributors[c.Text] {
+				continue
+			}
+			seenContributors[c.Text] = true
+			combined.Contributors = append(combined.Contributors, c)
+		}
+
+		// note: the repos may share a warnings collection, in which case each repeats the warnings of the repos before it
+		for _, w := range d.Warnings {
+			if seenWarnings[w] {
+				continue
+			}
+			seenWarnings[w] = true
+			combined.Warnings = append(combined.Warnings, w)
+		}
+	}
+	return combined
+}
+
+// repoChange attributes the change to the given repo. The identities are scoped to the repo too, since identifiers such
+// as PR numbers are only unique within a single repo.
+func repoChange(name string, c change.Change) change.Change {
+	if name == "" {
+		return c
+	}
+	c.Text = name + ": " + c.Text
+
+	identities := make([]string, 0, len(c.Identities))
+	for _, id := range c.Identities {
+		identities = append(identities, name+"/"+id)
+	}
+	c.Identities = identities
+	return c
+}
+
+// mergeTypeTitles appends the titles for any change types that are not already present (keeping the titles and order
+// of the sections seen first).
+func mergeTypeTitles(existing, titles []change.TypeTitle) []change.TypeTitle {
+	results := existing
+	for _, t := range titles {
+		var found bool
+		for _, e := range results {
+			if e.ChangeType.Name == t.ChangeType.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			results = append(results, t)
+		}
+	}
+	return results
+}
@@ -0,0 +1,76 @@
+package release
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func TestCombineDescriptions(t *testing.T) {
+	bug := change.NewType("bug", change.SemVerPatch)
+	feature := change.NewType("feature", change.SemVerMinor)
+
+	earlier := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+	later := earlier.Add(24 * time.Hour)
+
+	warning := Warning{Kind: SkippedChangeWarning, Message: "skipped PR #3"}
+
+	repos := []RepoDescription{
+		{
+			Name: "api",
+			Description: Description{
+				Release: Release{Version: "v1.2.0", Date: later},
+				Changes: []change.Change{
+					{Text: "fix the thing", ChangeTypes: []change.Type{bug}, Identities: []string{"github-pr:1"}},
+				},
+				SupportedChanges: []change.TypeTitle{{ChangeType: bug, Title: "Bug Fixes"}},
+				Contributors:     []change.Reference{{Text: "@alice"}},
+				Warnings:         []Warning{warning},
+			},
+		},
+		{
+			Name: "web",
+			Description: Description{
+				Release: Release{Version: "v0.9.0", Date: earlier},
+				Changes: []change.Change{
+					{Text: "add the page", ChangeTypes: []change.Type{feature}, Identities: []string{"github-pr:1"}},
+				},
+				SupportedChanges: []change.TypeTitle{
+					{ChangeType: feature, Title: "Added Features"},
+					{ChangeType: bug, Title: "Fixes"},
+				},
+				Contributors: []change.Reference{{Text: "@alice"}, {Text: "@bob"}},
+				Warnings:     []Warning{warning, {Kind: TruncatedWarning, Message: "too many PRs"}},
+			},
+		},
+	}
+
+	got := CombineDescriptions("2023.03", repos)
+
+	assert.Equal(t, "2023.03", got.Version)
+	assert.Equal(t, later, got.Date)
+	assert.Empty(t, got.VCSReferenceURL)
+	assert.Empty(t, got.VCSChangesURL)
+
+	assert.Equal(t, change.Changes{
+		{Text: "api: fix the thing", ChangeTypes: []change.Type{bug}, Identities: []string{"api/github-pr:1"}},
+		{Text: "web: add the page", ChangeTypes: []change.Type{feature}, Identities: []string{"web/github-pr:1"}},
+	}, got.Changes)
+
+	assert.Equal(t, []change.TypeTitle{
+		{ChangeType: bug, Title: "Bug Fixes"},
+		{ChangeType: feature, Title: "Added Features"},
+	}, got.SupportedChanges)
+
+	assert.Equal(t, []change.Reference{{Text: "@alice"}, {Text: "@bob"}}, got.Contributors)
+	assert.Equal(t, []Warning{warning, {Kind: TruncatedWarning, Message: "too many PRs"}}, got.Warnings)
+}
+
+func TestCombineDescriptions_Unreleased(t *testing.T) {
+	got := CombineDescriptions("", nil)
+	assert.Equal(t, UnreleasedVersion, got.Version)
+	assert.Empty(t, got.Changes)
+}
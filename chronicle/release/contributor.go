@@ -0,0 +1,68 @@
+package release
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// Contributor is a person who contributed to a release.
+type Contributor struct {
+	Name              string           // the name or handle of the contributor (e.g. a github login)
+	URL               string           // the profile of the contributor
+	FirstContribution change.Reference // the first change the contributor made (e.g. their first merged PR)
+}
+
+// ContributorSummarizer is implemented by summarizers that can identify the people who contributed to the project for
+// the first time within a release.
+type ContributorSummarizer interface {
+	// NewContributors returns the contributors whose first contribution was made between the two given references (e.g.
+	// tag or commits), ordered by their first contribution. If `untilRef` is not provided then the latest VCS change
+	// found will be used.
+	NewContributors(sinceRef, untilRef string) ([]Contributor, error)
+}
+
+func newContributors(summer Summarizer, sinceRef, untilRef string) ([]Contributor, error) {
+	cs, ok := summer.(ContributorSummarizer)
+	if !ok {
+		log.Warn("the configured source does not support finding new contributors")
+		return nil, nil
+	}
+
+	contributors, err := cs.NewContributors(sinceRef, untilRef)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find new contributors: %w", err)
+	}
+
+	log.Infof("new contributors: %d", len(contributors))
+	return contributors, nil
+}
+
+// contributors returns everyone who made one of the given changes (including clustered changes), ordered by name.
+func contributors(changes change.Changes) []change.Reference {
+	seen := make(map[string]struct{})
+	var results []change.Reference
+	var visit func(changes change.Changes)
+	visit = func(changes change.Changes) {
+		for _, c := range changes {
+			for _, author := range c.Authors {
+				key := strings.ToLower(author.Text)
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				results = append(results, author)
+			}
+			visit(c.Children)
+		}
+	}
+	visit(changes)
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return strings.ToLower(results[i].Text) < strings.ToLower(results[j].Text)
+	})
+	return results
+}
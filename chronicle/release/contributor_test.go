@@ -0,0 +1,38 @@
+package release
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func Test_contributors(t *testing.T) {
+	alice := change.Reference{Text: "alice", URL: "https://github.com/alice"}
+	bob := change.Reference{Text: "Bob", URL: "https://github.com/Bob"}
+	carol := change.Reference{Text: "carol", URL: "https://github.com/carol"}
+
+	changes := change.Changes{
+		{
+			Text:    "first",
+			Authors: []change.Reference{carol},
+		},
+		{
+			Text:    "tracking issue",
+			Authors: []change.Reference{alice, carol},
+			Children: change.Changes{
+				{
+					Text:    "part of the issue",
+					Authors: []change.Reference{bob},
+				},
+			},
+		},
+		{
+			Text: "no authors",
+		},
+	}
+
+	assert.Equal(t, []change.Reference{alice, bob, carol}, contributors(changes))
+	assert.Empty(t, contributors(nil))
+}
@@ -0,0 +1,10 @@
+package release
+
+// Deprecation is a feature deprecated by a previous release that is scheduled for removal in a later release.
+type Deprecation struct {
+	Text         string // the text of the change that deprecated the feature
+	URL          string `json:",omitempty"` // where to find more information about the deprecation (e.g. the PR)
+	DeprecatedIn string // the version of the release that deprecated the feature
+	RemovalIn    string `json:",omitempty"` // the version of the release that the feature is scheduled to be removed in
+	Countdown    string `json:",omitempty"` // how far away the removal is from the release being described (e.g. "in 2 minor releases")
+}
@@ -0,0 +1,217 @@
+package deprecation
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-semver/semver"
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+// Bump is the version field bumped from the deprecating release to schedule the removal of a feature.
+type Bump string
+
+const (
+	MajorBump Bump = "major"
+	MinorBump Bump = "minor"
+	PatchBump Bump = "patch"
+)
+
+var bumps = []Bump{MajorBump, MinorBump, PatchBump}
+
+func ParseBump(value string) (Bump, error) {
+	for _, b := range bumps {
+		if strings.EqualFold(value, string(b)) {
+			return b, nil
+		}
+	}
+	return "", fmt.Errorf("invalid removal bump %q (allowable: major, minor, patch)", value)
+}
+
+// Entry is a single deprecation within the ledger.
+type Entry struct {
+	Text         string    `yaml:"text"`
+	URL          string    `yaml:"url,omitempty"`
+	DeprecatedIn string    `yaml:"deprecated-in"`
+	Date         time.Time `yaml:"date"`
+	RemovalIn    string    `yaml:"removal-in,omitempty"`
+}
+
+// Ledger is the record of every deprecation shipped by a release, oldest first. The ledger is meant to be committed
+// alongside the changelog, so the removal version of an entry can be edited by hand (and an entry removed once the
+// feature has been removed).
+type Ledger struct {
+	Deprecations []Entry `yaml:"deprecations"`
+}
+
+// Load reads the ledger at the given path. A missing ledger results in an empty ledger.
+func Load(path string) (*Ledger, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &Ledger{}, nil
+		}
+		return nil, fmt.Errorf("unable to read deprecations ledger %q: %w", path, err)
+	}
+
+	var l Ledger
+	if err := yaml.Unmarshal(contents, &l); err != nil {
+		return nil, fmt.Errorf("unable to parse deprecations ledger %q: %w", path, err)
+	}
+	return &l, nil
+}
+
+// Save writes the ledger to the given path.
+func (l Ledger) Save(path string) error {
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("unable to create deprecations ledger directory %q: %w", dir, err)
+		}
+	}
+
+	contents, err := yaml.Marshal(l)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, contents, 0644); err != nil {
+		return fmt.Errorf("unable to write deprecations ledger %q: %w", path, err)
+	}
+	return nil
+}
+
+// Record adds an entry for each change of the release with a deprecation change type (see change.CategoryOf), with the
+// removal scheduled by bumping the given version field of the release. Changes already recorded for the release are
+// skipped, so re-running for a release is safe. The added entries are returned.
+func (l *Ledger) Record(description release.Description, bump Bump) []Entry {
+	var added []Entry
+	for _, c := range description.Changes {
+		if !isDeprecation(c) || l.contains(c.Text, description.Version) {
+			continue
+		}
+
+		entry := Entry{
+			Text:         c.Text,
+			DeprecatedIn: description.Version,
+			Date:         description.Date,
+			RemovalIn:    bumpVersion(description.Version, bump),
+		}
+		for _, ref := range c.References {
+			if ref.URL != "" {
+				entry.URL = ref.URL
+				break
+			}
+		}
+
+		l.Deprecations = append(l.Deprecations, entry)
+		added = append(added, entry)
+	}
+	return added
+}
+
+// Pending returns the deprecations made before the given release, with how far away each removal is from the release.
+func (l Ledger) Pending(version string) []release.Deprecation {
+	var results []release.Deprecation
+	for _, e := range l.Deprecations {
+		if e.DeprecatedIn == version {
+			// these are already listed within the changes of the release
+			continue
+		}
+		results = append(results, release.Deprecation{
+			Text:         e.Text,
+			URL:          e.URL,
+			DeprecatedIn: e.DeprecatedIn,
+			RemovalIn:    e.RemovalIn,
+			Countdown:    countdown(version, e.RemovalIn),
+		})
+	}
+	return results
+}
+
+func (l Ledger) contains(text, version string) bool {
+	for _, e := range l.Deprecations {
+		if e.Text == text && e.DeprecatedIn == version {
+			return true
+		}
+	}
+	return false
+}
+
+func isDeprecation(c change.Change) bool {
+	for _, t := range c.ChangeTypes {
+		if change.CategoryOf(t) == change.DeprecatedCategory {
+			return true
+		}
+	}
+	return false
+}
+
+func parseVersion(version string) (*semver.Version, bool) {
+	v, err := semver.NewVersion(strings.TrimPrefix(version, "v"))
+	if err != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+// bumpVersion returns the given version with the given field bumped (keeping any "v" prefix), or nothing when the
+// version is not semver.
+func bumpVersion(version string, bump Bump) string {
+	v, ok := parseVersion(version)
+	if !ok {
+		return ""
+	}
+
+	switch bump {
+	case MajorBump:
+		v.BumpMajor()
+	case MinorBump:
+		v.BumpMinor()
+	default:
+		v.BumpPatch()
+	}
+
+	if strings.HasPrefix(version, "v") {
+		return "v" + v.String()
+	}
+	return v.String()
+}
+
+// countdown describes how far away the removal version is from the given version (e.g. "in the next major release"),
+// or nothing when either version is not semver.
+func countdown(version, removal string) string {
+	current, ok := parseVersion(version)
+	if !ok {
+		return ""
+	}
+	target, ok := parseVersion(removal)
+	if !ok {
+		return ""
+	}
+
+	switch {
+	case target.Major > current.Major:
+		return releasesAway(target.Major-current.Major, "major")
+	case target.Major == current.Major && target.Minor > current.Minor:
+		return releasesAway(target.Minor-current.Minor, "minor")
+	case target.Major == current.Major && target.Minor == current.Minor && target.Patch > current.Patch:
+		return releasesAway(target.Patch-current.Patch, "patch")
+	case target.Equal(*current):
+		return "due in this release"
+	default:
+		return "overdue"
+	}
+}
+
+func releasesAway(n int64, field string) string {
+	if n == 1 {
+		return fmt.Sprintf("in the next %s release", field)
+	}
+	return fmt.Sprintf("in %d %s releases", n, field)
+}
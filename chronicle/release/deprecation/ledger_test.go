@@ -0,0 +1,126 @@
+package deprecation
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func TestLedger_Record(t *testing.T) {
+	deprecated := change.NewType("deprecated-feature", change.SemVerMinor)
+	bug := change.NewType("bug", change.SemVerPatch)
+	date := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	description := release.Description{
+		Release: release.Release{Version: "v1.2.0", Date: date},
+		Changes: change.Changes{
+			{
+				ChangeTypes: []change.Type{deprecated},
+				Text:        "Deprecate the --legacy flag",
+				References: []change.Reference{
+					{Text: "wagoodman"},
+					{Text: "#12", URL: "https://github.com/anchore/chronicle/pull/12"},
+				},
+			},
+			{
+				ChangeTypes: []change.Type{bug},
+				Text:        "Fix a bug",
+			},
+		},
+	}
+
+	l := Ledger{}
+	added := l.Record(description, MajorBump)
+
+	expected := []Entry{
+		{
+			Text:         "Deprecate the --legacy flag",
+			URL:          "https://github.com/anchore/chronicle/pull/12",
+			DeprecatedIn: "v1.2.0",
+			Date:         date,
+			RemovalIn:    "v2.0.0",
+		},
+	}
+	assert.Equal(t, expected, added)
+	assert.Equal(t, expected, l.Deprecations)
+
+	// re-running for the same release does not record the deprecation twice
+	assert.Empty(t, l.Record(description, MajorBump))
+	assert.Len(t, l.Deprecations, 1)
+}
+
+func TestLedger_Pending(t *testing.T) {
+	l := Ledger{
+		Deprecations: []Entry{
+			{Text: "Deprecate the --legacy flag", DeprecatedIn: "v1.2.0", RemovalIn: "v2.0.0"},
+			{Text: "Deprecate the old config", DeprecatedIn: "v1.3.0", RemovalIn: "v1.5.0"},
+			{Text: "Deprecate the json output", DeprecatedIn: "v1.4.0", RemovalIn: "v1.4.0"},
+		},
+	}
+
+	assert.Equal(t, []release.Deprecation{
+		{Text: "Deprecate the --legacy flag", DeprecatedIn: "v1.2.0", RemovalIn: "v2.0.0", Countdown: "in the next major release"},
+		{Text: "Deprecate the old config", DeprecatedIn: "v1.3.0", RemovalIn: "v1.5.0", Countdown: "in the next minor release"},
+	}, l.Pending("v1.4.0"))
+}
+
+func TestLedger_SaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "deprecations.yaml")
+
+	l, err := Load(path)
+	require.NoError(t, err)
+	assert.Empty(t, l.Deprecations)
+
+	l.Deprecations = []Entry{
+		{Text: "Deprecate the --legacy flag", DeprecatedIn: "v1.2.0", Date: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC), RemovalIn: "v2.0.0"},
+	}
+	require.NoError(t, l.Save(path))
+
+	loaded, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, l, loaded)
+}
+
+func Test_bumpVersion(t *testing.T) {
+	assert.Equal(t, "v2.0.0", bumpVersion("v1.2.3", MajorBump))
+	assert.Equal(t, "1.3.0", bumpVersion("1.2.3", MinorBump))
+	assert.Equal(t, "v1.2.4", bumpVersion("v1.2.3", PatchBump))
+	assert.Empty(t, bumpVersion(release.UnreleasedVersion, MajorBump))
+}
+
+func Test_countdown(t *testing.T) {
+	tests := []struct {
+		version string
+		removal string
+		want    string
+	}{
+		{version: "v1.2.0", removal: "v2.0.0", want: "in the next major release"},
+		{version: "v1.2.0", removal: "v3.0.0", want: "in 2 major releases"},
+		{version: "v1.2.0", removal: "v1.5.0", want: "in 3 minor releases"},
+		{version: "v1.2.0", removal: "v1.2.1", want: "in the next patch release"},
+		{version: "v1.2.0", removal: "v1.2.0", want: "due in this release"},
+		{version: "v2.1.0", removal: "v2.0.0", want: "overdue"},
+		{version: release.UnreleasedVersion, removal: "v2.0.0", want: ""},
+		{version: "v1.2.0", removal: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version+"->"+tt.removal, func(t *testing.T) {
+			assert.Equal(t, tt.want, countdown(tt.version, tt.removal))
+		})
+	}
+}
+
+func TestParseBump(t *testing.T) {
+	b, err := ParseBump("Minor")
+	require.NoError(t, err)
+	assert.Equal(t, MinorBump, b)
+
+	_, err = ParseBump("epoch")
+	assert.Error(t, err)
+}
@@ -10,4 +10,11 @@ type Description struct {
 	Notice           string             // manual note or summary that describes the changelog at a high level
 	Changes          change.Changes     // all issues and PRs that makeup this release
 	SupportedChanges []change.TypeTitle // the sections of the changelog and their display titles
+	NewContributors  []Contributor      `json:",omitempty"` // the people who contributed for the first time within this release (when enabled)
+	Contributors     []change.Reference `json:",omitempty"` // everyone who made a change within this release (for sources that report the authors of each change)
+	Warnings         []Warning          `json:",omitempty"` // the problems found while describing this release that likely need attention
+	Timeline         *Timeline          `json:",omitempty"` // where this release falls within the sequence of releases (e.g. the days since the previous release)
+	Deprecations     []Deprecation      `json:",omitempty"` // the features deprecated by previous releases that are still scheduled for removal (from the deprecations ledger)
+	Support          []SupportLine      `json:",omitempty"` // the supported lines of releases and when each reaches its end of life
+	FeatureFlags     *FeatureFlags      `json:",omitempty"` // the feature flags added, removed, or with a changed default since the previous release
 }
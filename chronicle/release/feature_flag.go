@@ -0,0 +1,24 @@
+package release
+
+// FeatureFlagChangeKind is how a feature flag changed between releases.
+type FeatureFlagChangeKind string
+
+const (
+	FeatureFlagAdded          FeatureFlagChangeKind = "added"
+	FeatureFlagRemoved        FeatureFlagChangeKind = "removed"
+	FeatureFlagDefaultChanged FeatureFlagChangeKind = "default-changed"
+)
+
+// FeatureFlags are the changes to the feature flags of the project within a release.
+type FeatureFlags struct {
+	Title   string // the display title of the section (e.g. "Feature Flags")
+	Changes []FeatureFlagChange
+}
+
+// FeatureFlagChange is a feature flag that was added, removed, or had its default value changed within a release.
+type FeatureFlagChange struct {
+	Name            string
+	Kind            FeatureFlagChangeKind
+	Default         string `json:",omitempty"` // the default value of the flag as of the release (empty when removed or without a default)
+	PreviousDefault string `json:",omitempty"` // the default value of the flag as of the previous release (empty when added or without a default)
+}
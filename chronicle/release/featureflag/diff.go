@@ -0,0 +1,141 @@
+package featureflag
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+// Config describes where the feature flags are defined and how to read them.
+type Config struct {
+	Path       string // the path of the flag definition file within the repo (YAML or JSON)
+	Key        string // the dot-separated path to the flag definitions within the file (the root of the document when empty)
+	NameKey    string // for flags defined as a list: the field of each flag holding its name (for a map the keys are the names)
+	DefaultKey string // the field of each flag holding its default value (for flags defined as a plain value, the value itself)
+}
+
+// Parse reads the flags (name to default value) from the contents of the flag definition file. Empty contents (e.g.
+// the file did not exist yet) yield no flags.
+func Parse(contents string, config Config) (map[string]string, error) {
+	flags := make(map[string]string)
+	if strings.TrimSpace(contents) == "" {
+		return flags, nil
+	}
+
+	// note: JSON is a subset of YAML, so both formats are handled by the same parser
+	var doc interface{}
+	if err := yaml.Unmarshal([]byte(contents), &doc); err != nil {
+		return nil, fmt.Errorf("unable to parse feature flags %q: %w", config.Path, err)
+	}
+
+	definitions, err := lookup(doc, config.Key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find feature flags in %q: %w", config.Path, err)
+	}
+
+	switch d := definitions.(type) {
+	case nil:
+		return flags, nil
+	case map[string]interface{}:
+		for name, value := range d {
+			flags[name] = defaultValue(value, config.DefaultKey)
+		}
+	case []interface{}:
+		for idx, item := range d {
+			fields, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("feature flag %d in %q is not an object", idx, config.Path)
+			}
+			name := formatValue(fields[config.NameKey])
+			if name == "" {
+				return nil, fmt.Errorf("feature flag %d in %q has no %q field", idx, config.Path, config.NameKey)
+			}
+			flags[name] = defaultValue(fields, config.DefaultKey)
+		}
+	default:
+		return nil, fmt.Errorf("feature flags at %q in %q are not a map or list", config.Key, config.Path)
+	}
+	return flags, nil
+}
+
+// Diff returns the flags that were added, removed, or had their default value changed between the previous and the
+// current flags (ordered by kind, then by name).
+func Diff(previous, current map[string]string) []release.FeatureFlagChange {
+	var changes []release.FeatureFlagChange
+	for name, value := range current {
+		old, ok := previous[name]
+		switch {
+		case !ok:
+			changes = append(changes, release.FeatureFlagChange{Name: name, Kind: release.FeatureFlagAdded, Default: value})
+		case old != value:
+			changes = append(changes, release.FeatureFlagChange{Name: name, Kind: release.FeatureFlagDefaultChanged, Default: value, PreviousDefault: old})
+		}
+	}
+	for name, value := range previous {
+		if _, ok := current[name]; !ok {
+			changes = append(changes, release.FeatureFlagChange{Name: name, Kind: release.FeatureFlagRemoved, PreviousDefault: value})
+		}
+	}
+
+	order := map[release.FeatureFlagChangeKind]int{
+		release.FeatureFlagAdded:          0,
+		release.FeatureFlagDefaultChanged: 1,
+		release.FeatureFlagRemoved:        2,
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Kind != changes[j].Kind {
+			return order[changes[i].Kind] < order[changes[j].Kind]
+		}
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+func lookup(doc interface{}, key string) (interface{}, error) {
+	if key == "" {
+		return doc, nil
+	}
+	current := doc
+	for _, field := range strings.Split(key, ".") {
+		fields, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%q is not within an object", field)
+		}
+		current, ok = fields[field]
+		if !ok {
+			return nil, fmt.Errorf("no %q field", field)
+		}
+	}
+	return current, nil
+}
+
+// defaultValue returns the default of the given flag definition, which is either the value of the default field or
+// the definition itself when it is a plain value.
+func defaultValue(definition interface{}, key string) string {
+	if fields, ok := definition.(map[string]interface{}); ok {
+		return formatValue(fields[key])
+	}
+	return formatValue(definition)
+}
+
+// formatValue renders a flag value as a string that is stable between reads (so values can be compared).
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case map[string]interface{}, []interface{}:
+		// note: map keys are sorted when encoded
+		contents, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(contents)
+	default:
+		return fmt.Sprint(v)
+	}
+}
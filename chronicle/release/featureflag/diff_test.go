@@ -0,0 +1,102 @@
+package featureflag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		config   Config
+		want     map[string]string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name: "map of plain values",
+			contents: `
+flags:
+  dark-mode: false
+  new-billing: true
+`,
+			config: Config{Key: "flags"},
+			want:   map[string]string{"dark-mode": "false", "new-billing": "true"},
+		},
+		{
+			name: "map of objects",
+			contents: `
+features:
+  flags:
+    dark-mode:
+      description: the dark theme
+      default: false
+    rollout:
+      default: {percent: 10}
+`,
+			config: Config{Key: "features.flags", DefaultKey: "default"},
+			want:   map[string]string{"dark-mode": "false", "rollout": `{"percent":10}`},
+		},
+		{
+			name:     "json list of objects",
+			contents: `[{"key": "dark-mode", "enabled": false}, {"key": "beta"}]`,
+			config:   Config{NameKey: "key", DefaultKey: "enabled"},
+			want:     map[string]string{"dark-mode": "false", "beta": ""},
+		},
+		{
+			name:     "empty file",
+			contents: "",
+			want:     map[string]string{},
+		},
+		{
+			name:     "missing key",
+			contents: "flags: {}",
+			config:   Config{Key: "features"},
+			wantErr:  require.Error,
+		},
+		{
+			name:     "list without names",
+			contents: `[{"enabled": false}]`,
+			config:   Config{NameKey: "key", DefaultKey: "enabled"},
+			wantErr:  require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			got, err := Parse(tt.contents, tt.config)
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	previous := map[string]string{
+		"dark-mode":   "false",
+		"legacy-ui":   "true",
+		"new-billing": "false",
+	}
+	current := map[string]string{
+		"dark-mode":   "true",
+		"new-billing": "false",
+		"search-v2":   "false",
+		"beta":        "",
+	}
+
+	assert.Equal(t, []release.FeatureFlagChange{
+		{Name: "beta", Kind: release.FeatureFlagAdded},
+		{Name: "search-v2", Kind: release.FeatureFlagAdded, Default: "false"},
+		{Name: "dark-mode", Kind: release.FeatureFlagDefaultChanged, Default: "true", PreviousDefault: "false"},
+		{Name: "legacy-ui", Kind: release.FeatureFlagRemoved, PreviousDefault: "true"},
+	}, Diff(previous, current))
+}
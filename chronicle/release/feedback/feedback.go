@@ -0,0 +1,42 @@
+package feedback
+
+// Reaction content names, as reported by the GitHub API.
+const (
+	ThumbsUp   = "THUMBS_UP"
+	ThumbsDown = "THUMBS_DOWN"
+)
+
+// Report is the reader feedback for the published release notes of a single release.
+type Report struct {
+	Version   string         `json:"version"`
+	URL       string         `json:"url,omitempty"`
+	Reactions map[string]int `json:"reactions"` // the count of each reaction (e.g. "THUMBS_UP" or "HEART")
+	Up        int            `json:"up"`
+	Down      int            `json:"down"`
+	Score     float64        `json:"score"` // the share of up votes out of all up and down votes (0 when there are no votes)
+}
+
+// Source provides the feedback for a release (e.g. from the reactions on the GitHub release).
+type Source interface {
+	// ReleaseFeedback returns the feedback for the given release version, or the latest release when no version is given.
+	ReleaseFeedback(version string) (*Report, error)
+}
+
+// NewReport summarizes the given reaction counts for a release.
+func NewReport(version, url string, reactions map[string]int) Report {
+	if reactions == nil {
+		reactions = make(map[string]int)
+	}
+
+	r := Report{
+		Version:   version,
+		URL:       url,
+		Reactions: reactions,
+		Up:        reactions[ThumbsUp],
+		Down:      reactions[ThumbsDown],
+	}
+	if total := r.Up + r.Down; total > 0 {
+		r.Score = float64(r.Up) / float64(total)
+	}
+	return r
+}
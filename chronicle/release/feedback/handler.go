@@ -0,0 +1,89 @@
+package feedback
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// ErrReleaseNotFound is returned by a Source when there is no published release for the requested version.
+var ErrReleaseNotFound = errors.New("release not found")
+
+type cacheEntry struct {
+	report    *Report
+	fetchedAt time.Time
+}
+
+// Handler serves the feedback report for a release as JSON at "<prefix>/<version>" (or the latest release at
+// "<prefix>"). Reports are cached for the given TTL to avoid querying the source for every request.
+type Handler struct {
+	source Source
+	prefix string
+	ttl    time.Duration
+	now    func() time.Time
+	lock   sync.Mutex
+	cache  map[string]cacheEntry
+}
+
+func NewHandler(source Source, prefix string, ttl time.Duration) *Handler {
+	return &Handler{
+		source: source,
+		prefix: strings.TrimSuffix(prefix, "/"),
+		ttl:    ttl,
+		now:    time.Now,
+		cache:  make(map[string]cacheEntry),
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	version := strings.Trim(strings.TrimPrefix(r.URL.Path, h.prefix), "/")
+
+	report, err := h.report(version)
+	if err != nil {
+		if errors.Is(err, ErrReleaseNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		log.Errorf("unable to get feedback for release %q: %+v", version, err)
+		http.Error(w, "unable to get release feedback", http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		log.Errorf("unable to write feedback report: %+v", err)
+	}
+}
+
+func (h *Handler) report(version string) (*Report, error) {
+	h.lock.Lock()
+	entry, ok := h.cache[version]
+	h.lock.Unlock()
+
+	if ok && h.now().Sub(entry.fetchedAt) < h.ttl {
+		return entry.report, nil
+	}
+
+	report, err := h.source.ReleaseFeedback(version)
+	if err != nil {
+		return nil, err
+	}
+
+	h.lock.Lock()
+	h.cache[version] = cacheEntry{report: report, fetchedAt: h.now()}
+	h.lock.Unlock()
+
+	return report, nil
+}
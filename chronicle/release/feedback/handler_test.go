@@ -0,0 +1,92 @@
+package feedback
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSource struct {
+	calls     map[string]int
+	reactions map[string]map[string]int
+}
+
+func (m *mockSource) ReleaseFeedback(version string) (*Report, error) {
+	m.calls[version]++
+	if version == "" {
+		version = "v0.2.0"
+	}
+	if version == "broken" {
+		return nil, fmt.Errorf("bad things")
+	}
+	reactions, ok := m.reactions[version]
+	if !ok {
+		return nil, ErrReleaseNotFound
+	}
+	r := NewReport(version, "", reactions)
+	return &r, nil
+}
+
+func TestHandler(t *testing.T) {
+	source := &mockSource{
+		calls: make(map[string]int),
+		reactions: map[string]map[string]int{
+			"v0.1.0": {ThumbsUp: 3, ThumbsDown: 1, "HEART": 2},
+			"v0.2.0": {},
+		},
+	}
+
+	now := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	h := NewHandler(source, "/feedback/", time.Minute)
+	h.now = func() time.Time { return now }
+
+	get := func(path string) (*httptest.ResponseRecorder, Report) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		var report Report
+		if rec.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+		}
+		return rec, report
+	}
+
+	rec, report := get("/feedback/v0.1.0")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Equal(t, Report{
+		Version:   "v0.1.0",
+		Reactions: map[string]int{ThumbsUp: 3, ThumbsDown: 1, "HEART": 2},
+		Up:        3,
+		Down:      1,
+		Score:     0.75,
+	}, report)
+
+	// the latest release
+	rec, report = get("/feedback")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "v0.2.0", report.Version)
+	assert.Equal(t, 0.0, report.Score)
+
+	rec, _ = get("/feedback/v9.9.9")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec, _ = get("/feedback/broken")
+	assert.Equal(t, http.StatusBadGateway, rec.Code)
+
+	// reports are cached until the TTL expires
+	get("/feedback/v0.1.0")
+	assert.Equal(t, 1, source.calls["v0.1.0"])
+	now = now.Add(2 * time.Minute)
+	get("/feedback/v0.1.0")
+	assert.Equal(t, 2, source.calls["v0.1.0"])
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/feedback/v0.1.0", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -0,0 +1,177 @@
+package blockkit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+// limits imposed by slack on messages (see https://api.slack.com/reference/block-kit/blocks)
+const (
+	maxBlocks      = 50
+	maxHeaderText  = 150
+	maxSectionText = 3000
+	maxFieldText   = 2000
+)
+
+var _ presenter.Presenter = (*Presenter)(nil)
+
+// Presenter renders the release as a slack message payload using Block Kit: a header with the version, the release
+// details as fields, and a section listing the changes of each change type. Text is truncated to the slack limits, so
+// the payload can be posted as-is (e.g. to an incoming webhook, or via chat.postMessage with a channel added).
+type Presenter struct {
+	config Config
+}
+
+type Config struct {
+	release.Description
+	Title string
+}
+
+func NewBlockKitPresenter(config Config) (*Presenter, error) {
+	return &Presenter{
+		config: config,
+	}, nil
+}
+
+type Message struct {
+	Text   string  `json:"text"` // shown in notifications and by clients that cannot render blocks
+	Blocks []Block `json:"blocks"`
+}
+
+type Block struct {
+	Type     string `json:"type"`
+	Text     *Text  `json:"text,omitempty"`
+	Fields   []Text `json:"fields,omitempty"`
+	Elements []Text `json:"elements,omitempty"`
+}
+
+type Text struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+func (p Presenter) Present(writer io.Writer) error {
+	enc := json.NewEncoder(writer)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(p.message())
+}
+
+func (p Presenter) message() Message {
+	title := strings.TrimSpace(fmt.Sprintf("%s %s", p.config.Title, p.config.Version))
+
+	blocks := []Block{
+		{
+			Type: "header",
+			Text: &Text{Type: "plain_text", Text: truncate(title, maxHeaderText)},
+		},
+		{
+			Type:   "section",
+			Fields: p.detailFields(),
+		},
+	}
+
+	sections := p.changeSections()
+	if len(blocks)+len(sections) > maxBlocks {
+		// leave room for the note about the omitted sections
+		omitted := len(blocks) + len(sections) - (maxBlocks - 1)
+		blocks = append(blocks, sections[:len(sections)-omitted]...)
+		blocks = append(blocks, Block{
+			Type:     "context",
+			Elements: []Text{{Type: "mrkdwn", Text: fmt.Sprintf("_%d more change types are not shown_", omitted)}},
+		})
+	} else {
+		blocks = append(blocks, sections...)
+	}
+
+	return Message{
+		Text:   title,
+		Blocks: blocks,
+	}
+}
+
+func (p Presenter) detailFields() []Text {
+	fields := []Text{
+		{Type: "mrkdwn", Text: "*Version*\n" + escape(p.config.Version)},
+		{Type: "mrkdwn", Text: "*Date*\n" + p.config.Date.Format("2006-01-02")},
+	}
+	if p.config.VCSChangesURL != "" {
+		fields = append(fields, Text{Type: "mrkdwn", Text: fmt.Sprintf("*Changes*\n<%s|Full Changelog>", p.config.VCSChangesURL)})
+	}
+	for i := range fields {
+		fields[i].Text = truncate(fields[i].Text, maxFieldText)
+	}
+	return fields
+}
+
+// changeSections returns a section block for each change type with changes, listing the changes as bullets. Changes
+// that do not fit within the section are counted instead.
+func (p Presenter) changeSections() []Block {
+	var blocks []Block
+	for _, section := range p.config.SupportedChanges {
+		summaries := p.config.Changes.ByChangeType(section.ChangeType)
+		if len(summaries) == 0 {
+			continue
+		}
+
+		text := fmt.Sprintf("*%s*", escape(section.Title))
+		for idx, summary := range summaries {
+			// note: a single change is capped so that at least one change always fits within the section
+			entry := truncate(entryLines(summary, ""), maxFieldText)
+			omittedNote := fmt.Sprintf("\n_…and %d more_", len(summaries)-idx)
+			if len([]rune(text+entry+omittedNote)) > maxSectionText {
+				text += omittedNote
+				break
+			}
+			text += entry
+		}
+
+		blocks = append(blocks, Block{
+			Type: "section",
+			Text: &Text{Type: "mrkdwn", Text: text},
+		})
+	}
+	return blocks
+}
+
+func entryLines(c change.Change, indent string) string {
+	bullet := "•"
+	if indent != "" {
+		bullet = "◦"
+	}
+	line := fmt.Sprintf("\n%s%s %s", indent, bullet, escape(c.Text))
+	for _, ref := range c.References {
+		if ref.URL == "" {
+			line += " " + escape(ref.Text)
+		} else {
+			line += fmt.Sprintf(" <%s|%s>", ref.URL, escape(ref.Text))
+		}
+	}
+
+	// clustered changes are listed as nested bullets of the parent change
+	for _, child := range c.Children {
+		line += entryLines(child, indent+"    ")
+	}
+	return line
+}
+
+// escape escapes the control characters of slack mrkdwn (see https://api.slack.com/reference/surfaces/formatting).
+func escape(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
+
+// truncate shortens the text to the given number of characters (with an ellipsis when shortened).
+func truncate(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit-1]) + "…"
+}
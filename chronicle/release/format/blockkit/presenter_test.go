@@ -0,0 +1,115 @@
+package blockkit
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/go-testutils"
+)
+
+var updateBlockKitPresenterGoldenFiles = flag.Bool("update-blockkit", false, "update the *.golden files for block kit presenters")
+
+func TestBlockKitPresenter_Present(t *testing.T) {
+	bug := change.NewType("bug", change.SemVerPatch)
+	added := change.NewType("added", change.SemVerMinor)
+
+	p, err := NewBlockKitPresenter(Config{
+		Title: "Changelog",
+		Description: release.Description{
+			SupportedChanges: []change.TypeTitle{
+				{ChangeType: bug, Title: "Bug Fixes"},
+				{ChangeType: added, Title: "Added Features"},
+			},
+			Release: release.Release{
+				Version: "v0.19.1",
+				Date:    time.Date(2021, time.September, 16, 19, 34, 0, 0, time.UTC),
+			},
+			VCSChangesURL: "https://github.com/anchore/syft/compare/v0.19.0...v0.19.1",
+			Changes: []change.Change{
+				{
+					ChangeTypes: []change.Type{bug},
+					Text:        "Redirect cursor <hide/show> to stderr & stdout",
+					References: []change.Reference{
+						{Text: "#456", URL: "https://github.com/anchore/syft/pull/456"},
+						{Text: "wagoodman"},
+					},
+				},
+				{
+					ChangeTypes: []change.Type{added},
+					Text:        "Support a new config format",
+					Children: change.Changes{
+						{Text: "the first part"},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	require.NoError(t, p.Present(&buffer))
+	actual := buffer.Bytes()
+
+	if *updateBlockKitPresenterGoldenFiles {
+		testutils.UpdateGoldenFileContents(t, actual)
+	}
+
+	expected := testutils.GetGoldenFileContents(t)
+
+	if !bytes.Equal(expected, actual) {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(string(expected), string(actual), true)
+		t.Errorf("mismatched output:\n%s", dmp.DiffPrettyText(diffs))
+	}
+}
+
+func TestBlockKitPresenter_limits(t *testing.T) {
+	var description release.Description
+	description.Version = strings.Repeat("v", 200)
+
+	// more change types than there are blocks, with more changes than fit within a section
+	for i := 0; i < 60; i++ {
+		changeType := change.NewType(fmt.Sprintf("type-%d", i), change.SemVerPatch)
+		description.SupportedChanges = append(description.SupportedChanges, change.TypeTitle{ChangeType: changeType, Title: changeType.Name})
+		for j := 0; j < 100; j++ {
+			description.Changes = append(description.Changes, change.Change{
+				ChangeTypes: []change.Type{changeType},
+				Text:        strings.Repeat("x", 50),
+			})
+		}
+	}
+	description.Changes = append(description.Changes, change.Change{
+		ChangeTypes: []change.Type{description.SupportedChanges[0].ChangeType},
+		Text:        strings.Repeat("y", 5000),
+	})
+
+	p, err := NewBlockKitPresenter(Config{Title: "Changelog", Description: description})
+	require.NoError(t, err)
+
+	msg := p.message()
+	require.Len(t, msg.Blocks, maxBlocks)
+
+	assert.Len(t, []rune(msg.Blocks[0].Text.Text), maxHeaderText)
+	assert.Equal(t, "_13 more change types are not shown_", msg.Blocks[maxBlocks-1].Elements[0].Text)
+
+	for _, block := range msg.Blocks[2 : maxBlocks-1] {
+		assert.LessOrEqual(t, len([]rune(block.Text.Text)), maxSectionText)
+		assert.True(t, strings.HasSuffix(block.Text.Text, "more_"), "section text should note the omitted changes")
+	}
+}
+
+func Test_truncate(t *testing.T) {
+	assert.Equal(t, "abc", truncate("abc", 3))
+	assert.Equal(t, "ab…", truncate("abcd", 3))
+	assert.Equal(t, "✓✓…", truncate("✓✓✓✓", 3))
+}
@@ -0,0 +1,210 @@
+package discord
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+// limits imposed by discord on embeds (see https://discord.com/developers/docs/resources/message#embed-object-embed-limits)
+const (
+	maxTitle       = 256
+	maxDescription = 4096
+	maxFields      = 25
+	maxFieldName   = 256
+	maxFieldValue  = 1024
+	maxFooterText  = 2048
+	maxEmbedText   = 6000 // the combined length of the title, description, field names and values, and footer
+
+	maxOmittedNote = 50 // the room reserved for the footer noting the omitted change types
+)
+
+// embed colors by the significance of the release (the most significant semver field bumped by the changes)
+const (
+	majorColor   = 0xED4245 // red
+	minorColor   = 0x57F287 // green
+	patchColor   = 0x5865F2 // blurple
+	unknownColor = 0x99AAB5 // grey
+)
+
+var _ presenter.Presenter = (*Presenter)(nil)
+
+// Presenter renders the release as a discord webhook payload with a single embed: the version as the title (linked to
+// the release), a field listing the changes of each change type, and a color by how significant the release is. Text
+// is truncated to the discord limits, so the payload can be posted to a webhook as-is.
+type Presenter struct {
+	config Config
+}
+
+type Config struct {
+	release.Description
+	Title string
+}
+
+func NewDiscordPresenter(config Config) (*Presenter, error) {
+	return &Presenter{
+		config: config,
+	}, nil
+}
+
+type Payload struct {
+	Embeds []Embed `json:"embeds"`
+}
+
+type Embed struct {
+	Title       string     `json:"title"`
+	Description string     `json:"description,omitempty"`
+	URL         string     `json:"url,omitempty"`
+	Color       int        `json:"color"`
+	Timestamp   *time.Time `json:"timestamp,omitempty"`
+	Fields      []Field    `json:"fields,omitempty"`
+	Footer      *Footer    `json:"footer,omitempty"`
+}
+
+type Field struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type Footer struct {
+	Text string `json:"text"`
+}
+
+func (p Presenter) Present(writer io.Writer) error {
+	enc := json.NewEncoder(writer)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(p.payload())
+}
+
+func (p Presenter) payload() Payload {
+	embed := Embed{
+		Title:       truncate(strings.TrimSpace(fmt.Sprintf("%s %s", p.config.Title, p.config.Version)), maxTitle),
+		Description: truncate(p.description(), maxDescription),
+		URL:         p.config.VCSReferenceURL,
+		Color:       color(change.Significance(p.config.Changes)),
+	}
+	if !p.config.Date.IsZero() {
+		date := p.config.Date
+		embed.Timestamp = &date
+	}
+
+	// note: the fields are added last, so they get whatever room is left within the combined limit
+	remaining := maxEmbedText - len([]rune(embed.Title)) - len([]rune(embed.Description))
+
+	fields := p.changeFields()
+	omitted := 0
+	for idx, f := range fields {
+		// leave room for the footer noting the omitted change types
+		if idx == maxFields || len([]rune(f.Name))+len([]rune(f.Value)) > remaining-maxOmittedNote {
+			omitted = len(fields) - idx
+			break
+		}
+		embed.Fields = append(embed.Fields, f)
+		remaining -= len([]rune(f.Name)) + len([]rune(f.Value))
+	}
+
+	if omitted > 0 {
+		embed.Footer = &Footer{Text: omittedNote(omitted)}
+	}
+
+	return Payload{Embeds: []Embed{embed}}
+}
+
+func omittedNote(count int) string {
+	return fmt.Sprintf("%d more change types are not shown", count)
+}
+
+func (p Presenter) description() string {
+	var lines []string
+	if p.config.Notice != "" {
+		lines = append(lines, p.config.Notice)
+	}
+	if p.config.VCSChangesURL != "" {
+		lines = append(lines, fmt.Sprintf("[Full Changelog](%s)", p.config.VCSChangesURL))
+	}
+	return strings.Join(lines, "\n\n")
+}
+
+// changeFields returns a field for each change type with changes, listing the changes as bullets. Changes that do not
+// fit within the field are counted instead.
+func (p Presenter) changeFields() []Field {
+	var fields []Field
+	for _, section := range p.config.SupportedChanges {
+		summaries := p.config.Changes.ByChangeType(section.ChangeType)
+		if len(summaries) == 0 {
+			continue
+		}
+
+		var value string
+		for idx, summary := range summaries {
+			// note: a single change is capped so that at least one change always fits within the field
+			entry := truncate(entryLines(summary, ""), maxFieldValue/2)
+			if value != "" {
+				entry = "\n" + entry
+			}
+			omittedNote := fmt.Sprintf("\n*…and %d more*", len(summaries)-idx)
+			if len([]rune(value+entry+omittedNote)) > maxFieldValue {
+				value += omittedNote
+				break
+			}
+			value += entry
+		}
+
+		fields = append(fields, Field{
+			Name:  truncate(section.Title, maxFieldName),
+			Value: value,
+		})
+	}
+	return fields
+}
+
+func entryLines(c change.Change, indent string) string {
+	line := fmt.Sprintf("%s- %s", indent, escape(c.Text))
+	for _, ref := range c.References {
+		if ref.URL == "" {
+			line += " " + escape(ref.Text)
+		} else {
+			line += fmt.Sprintf(" [%s](%s)", escape(ref.Text), ref.URL)
+		}
+	}
+
+	// clustered changes are listed as nested bullets of the parent change
+	for _, child := range c.Children {
+		line += "\n" + entryLines(child, indent+"  ")
+	}
+	return line
+}
+
+func color(kind change.SemVerKind) int {
+	switch kind {
+	case change.SemVerMajor:
+		return majorColor
+	case change.SemVerMinor:
+		return minorColor
+	case change.SemVerPatch:
+		return patchColor
+	}
+	return unknownColor
+}
+
+// escape escapes the characters of discord markdown that would otherwise change the formatting of the text.
+func escape(text string) string {
+	return strings.NewReplacer("\\", "\\\\", "*", "\\*", "_", "\\_", "~", "\\~", "`", "\\`", "|", "\\|", "[", "\\[", "]", "\\]").Replace(text)
+}
+
+// truncate shortens the text to the given number of characters (with an ellipsis when shortened).
+func truncate(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit-1]) + "…"
+}
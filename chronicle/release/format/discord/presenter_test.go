@@ -0,0 +1,122 @@
+package discord
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/go-testutils"
+)
+
+var updateDiscordPresenterGoldenFiles = flag.Bool("update-discord", false, "update the *.golden files for discord presenters")
+
+func TestDiscordPresenter_Present(t *testing.T) {
+	bug := change.NewType("bug", change.SemVerPatch)
+	added := change.NewType("added", change.SemVerMinor)
+
+	p, err := NewDiscordPresenter(Config{
+		Title: "Changelog",
+		Description: release.Description{
+			SupportedChanges: []change.TypeTitle{
+				{ChangeType: bug, Title: "Bug Fixes"},
+				{ChangeType: added, Title: "Added Features"},
+			},
+			Release: release.Release{
+				Version: "v0.19.1",
+				Date:    time.Date(2021, time.September, 16, 19, 34, 0, 0, time.UTC),
+			},
+			VCSReferenceURL: "https://github.com/anchore/syft/tree/v0.19.1",
+			VCSChangesURL:   "https://github.com/anchore/syft/compare/v0.19.0...v0.19.1",
+			Changes: []change.Change{
+				{
+					ChangeTypes: []change.Type{bug},
+					Text:        "Redirect cursor **hide/show** to stderr",
+					References: []change.Reference{
+						{Text: "#456", URL: "https://github.com/anchore/syft/pull/456"},
+						{Text: "wagoodman"},
+					},
+				},
+				{
+					ChangeTypes: []change.Type{added},
+					Text:        "Support a new config format",
+					Children: change.Changes{
+						{Text: "the first part"},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	require.NoError(t, p.Present(&buffer))
+	actual := buffer.Bytes()
+
+	if *updateDiscordPresenterGoldenFiles {
+		testutils.UpdateGoldenFileContents(t, actual)
+	}
+
+	expected := testutils.GetGoldenFileContents(t)
+
+	if !bytes.Equal(expected, actual) {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(string(expected), string(actual), true)
+		t.Errorf("mismatched output:\n%s", dmp.DiffPrettyText(diffs))
+	}
+}
+
+func TestDiscordPresenter_limits(t *testing.T) {
+	var description release.Description
+	description.Version = strings.Repeat("v", 300)
+
+	// more change types than there are fields, with more changes than fit within a field
+	for i := 0; i < 30; i++ {
+		changeType := change.NewType(fmt.Sprintf("type-%d", i), change.SemVerMajor)
+		description.SupportedChanges = append(description.SupportedChanges, change.TypeTitle{ChangeType: changeType, Title: changeType.Name})
+		for j := 0; j < 100; j++ {
+			description.Changes = append(description.Changes, change.Change{
+				ChangeTypes: []change.Type{changeType},
+				Text:        strings.Repeat("x", 50),
+			})
+		}
+	}
+
+	p, err := NewDiscordPresenter(Config{Title: "Changelog", Description: description})
+	require.NoError(t, err)
+
+	embed := p.payload().Embeds[0]
+	assert.Equal(t, majorColor, embed.Color)
+	assert.Len(t, []rune(embed.Title), maxTitle)
+	assert.LessOrEqual(t, len(embed.Fields), maxFields)
+	require.NotNil(t, embed.Footer)
+	assert.Equal(t, omittedNote(30-len(embed.Fields)), embed.Footer.Text)
+
+	total := len([]rune(embed.Title)) + len([]rune(embed.Description)) + len([]rune(embed.Footer.Text))
+	for _, f := range embed.Fields {
+		assert.LessOrEqual(t, len([]rune(f.Value)), maxFieldValue)
+		assert.True(t, strings.HasSuffix(f.Value, "more*"), "field value should note the omitted changes")
+		total += len([]rune(f.Name)) + len([]rune(f.Value))
+	}
+	assert.LessOrEqual(t, total, maxEmbedText)
+}
+
+func Test_color(t *testing.T) {
+	assert.Equal(t, minorColor, color(change.Significance([]change.Change{
+		{ChangeTypes: []change.Type{change.NewType("bug", change.SemVerPatch)}},
+		{ChangeTypes: []change.Type{change.NewType("added", change.SemVerMinor)}},
+	})))
+	assert.Equal(t, unknownColor, color(change.Significance(nil)))
+}
+
+func Test_escape(t *testing.T) {
+	assert.Equal(t, `\*\*bold\*\* \_a\_ \[link\]`, escape("**bold** _a_ [link]"))
+}
@@ -0,0 +1,176 @@
+package feed
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+const atomNamespace = "http://www.w3.org/2005/Atom"
+
+// Feed is a feed of releases, newest first.
+type Feed struct {
+	Title string
+	ID    string // the permanent identifier of the feed (an IRI, such as the URL the feed is served at)
+	Link  string // the URL the feed is served at (optional)
+	Items []Item
+}
+
+// Item is a single release within a feed.
+type Item struct {
+	Title   string
+	ID      string // the permanent identifier of the release (an IRI)
+	Link    string // the URL of the release notes (optional)
+	Updated time.Time
+	Content string // the release notes as an HTML fragment
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    *atomLink   `xml:"link,omitempty"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	XMLName xml.Name    `xml:"entry"`
+	Xmlns   string      `xml:"xmlns,attr,omitempty"` // only set for a standalone entry
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    *atomLink   `xml:"link,omitempty"`
+	Content atomContent `xml:"content"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Link        string    `xml:"link"`
+	Description string    `xml:"description"`
+	Items       []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	XMLName     xml.Name `xml:"item"`
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link,omitempty"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// WriteAtom writes the feed as an Atom (RFC 4287) document.
+func WriteAtom(writer io.Writer, f Feed) error {
+	doc := atomFeed{
+		Xmlns: atomNamespace,
+		Title: f.Title,
+		ID:    f.ID,
+	}
+	if f.Link != "" {
+		doc.Link = &atomLink{Href: f.Link, Rel: "self"}
+	}
+	for _, item := range f.Items {
+		doc.Entries = append(doc.Entries, newAtomEntry(item))
+		if updated := formatAtomDate(item.Updated); updated > doc.Updated {
+			doc.Updated = updated
+		}
+	}
+	if doc.Updated == "" {
+		doc.Updated = formatAtomDate(time.Time{})
+	}
+	return encode(writer, doc)
+}
+
+// WriteAtomEntry writes a single standalone Atom entry (e.g. to be added to an existing feed).
+func WriteAtomEntry(writer io.Writer, item Item) error {
+	entry := newAtomEntry(item)
+	entry.Xmlns = atomNamespace
+	return encode(writer, entry)
+}
+
+// WriteRSS writes the feed as an RSS 2.0 document.
+func WriteRSS(writer io.Writer, f Feed) error {
+	doc := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       f.Title,
+			Link:        f.Link,
+			Description: f.Title,
+		},
+	}
+	for _, item := range f.Items {
+		doc.Channel.Items = append(doc.Channel.Items, newRSSItem(item))
+	}
+	return encode(writer, doc)
+}
+
+// WriteRSSItem writes a single standalone RSS item (e.g. to be added to an existing channel).
+func WriteRSSItem(writer io.Writer, item Item) error {
+	return encode(writer, newRSSItem(item))
+}
+
+func newAtomEntry(item Item) atomEntry {
+	entry := atomEntry{
+		Title:   item.Title,
+		ID:      item.ID,
+		Updated: formatAtomDate(item.Updated),
+		Content: atomContent{Type: "html", Body: item.Content},
+	}
+	if item.Link != "" {
+		entry.Link = &atomLink{Href: item.Link, Rel: "alternate"}
+	}
+	return entry
+}
+
+func newRSSItem(item Item) rssItem {
+	return rssItem{
+		Title: item.Title,
+		Link:  item.Link,
+		GUID: rssGUID{
+			IsPermaLink: item.ID == item.Link,
+			Value:       item.ID,
+		},
+		PubDate:     item.Updated.Format(time.RFC1123Z),
+		Description: item.Content,
+	}
+}
+
+func formatAtomDate(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func encode(writer io.Writer, value interface{}) error {
+	if _, err := io.WriteString(writer, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(writer)
+	enc.Indent("", "  ")
+	if err := enc.Encode(value); err != nil {
+		return err
+	}
+	_, err := io.WriteString(writer, "\n")
+	return err
+}
@@ -0,0 +1,124 @@
+package feed
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+// Kind is the syndication format of a feed.
+type Kind string
+
+const (
+	Atom Kind = "atom"
+	RSS  Kind = "rss"
+)
+
+const contentTemplate = `{{- range .Sections }}<h3>{{ .Title }}</h3>
+<ul>
+{{- template "entries" .Changes }}
+</ul>
+{{ end -}}
+{{- if .ChangesURL }}<p><a href="{{ .ChangesURL }}">Full Changelog</a></p>
+{{ end -}}
+{{ define "entries" }}
+{{- range . }}
+<li>{{ .Text }}{{ range .References }} {{ if .URL }}<a href="{{ .URL }}">{{ .Text }}</a>{{ else }}{{ .Text }}{{ end }}{{ end }}
+{{- if .Children }}<ul>{{ template "entries" .Children }}</ul>{{ end }}</li>
+{{- end }}
+{{- end }}`
+
+var contentTemplater = template.Must(template.New("content").Parse(contentTemplate))
+
+var _ presenter.Presenter = (*Presenter)(nil)
+
+// Presenter renders the release as a single feed entry (an Atom <entry> or an RSS <item>), to be added to the feed
+// that release notes are syndicated with. See index.Update for maintaining the whole feed.
+type Presenter struct {
+	kind Kind
+	item Item
+}
+
+func NewFeedPresenter(kind Kind, description release.Description) (*Presenter, error) {
+	if kind != Atom && kind != RSS {
+		return nil, fmt.Errorf("unsupported feed kind: %q", kind)
+	}
+
+	item, err := NewItem(description)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Presenter{
+		kind: kind,
+		item: item,
+	}, nil
+}
+
+func (p Presenter) Present(writer io.Writer) error {
+	if p.kind == RSS {
+		return WriteRSSItem(writer, p.item)
+	}
+	return WriteAtomEntry(writer, p.item)
+}
+
+// NewItem describes the release as a feed item, with the changes of the release rendered as an HTML fragment.
+func NewItem(description release.Description) (Item, error) {
+	content, err := ContentHTML(description)
+	if err != nil {
+		return Item{}, err
+	}
+
+	id := description.VCSReferenceURL
+	if id == "" {
+		// an identifier is required, and must not change when the feed is regenerated
+		id = "urn:chronicle:release:" + description.Version
+	}
+
+	return Item{
+		Title:   description.Version,
+		ID:      id,
+		Link:    description.VCSReferenceURL,
+		Updated: description.Date,
+		Content: content,
+	}, nil
+}
+
+type contentSection struct {
+	Title   string
+	Changes change.Changes
+}
+
+// ContentHTML renders the changes of the release as an HTML fragment, with a heading and list for each change type.
+func ContentHTML(description release.Description) (string, error) {
+	var sections []contentSection
+	for _, section := range description.SupportedChanges {
+		summaries := description.Changes.ByChangeType(section.ChangeType)
+		if len(summaries) == 0 {
+			continue
+		}
+		sections = append(sections, contentSection{
+			Title:   section.Title,
+			Changes: summaries,
+		})
+	}
+
+	var sb strings.Builder
+	err := contentTemplater.Execute(&sb, struct {
+		Sections   []contentSection
+		ChangesURL string
+	}{
+		Sections:   sections,
+		ChangesURL: description.VCSChangesURL,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to render feed content: %w", err)
+	}
+	return sb.String(), nil
+}
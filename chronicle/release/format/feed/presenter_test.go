@@ -0,0 +1,145 @@
+package feed
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/go-testutils"
+)
+
+var updateFeedPresenterGoldenFiles = flag.Bool("update-feed", false, "update the *.golden files for feed presenters")
+
+func testDescription() release.Description {
+	bug := change.NewType("bug", change.SemVerPatch)
+	added := change.NewType("added", change.SemVerMinor)
+	return release.Description{
+		SupportedChanges: []change.TypeTitle{
+			{ChangeType: bug, Title: "Bug Fixes"},
+			{ChangeType: added, Title: "Added Features"},
+		},
+		Release: release.Release{
+			Version: "v0.19.1",
+			Date:    time.Date(2021, time.September, 16, 19, 34, 0, 0, time.UTC),
+		},
+		VCSReferenceURL: "https://github.com/anchore/syft/tree/v0.19.1",
+		VCSChangesURL:   "https://github.com/anchore/syft/compare/v0.19.0...v0.19.1",
+		Changes: []change.Change{
+			{
+				ChangeTypes: []change.Type{bug},
+				Text:        "Redirect cursor <hide/show> to stderr",
+				References: []change.Reference{
+					{Text: "#456", URL: "https://github.com/anchore/syft/pull/456"},
+					{Text: "wagoodman"},
+				},
+			},
+			{
+				ChangeTypes: []change.Type{added},
+				Text:        "Support a new config format",
+				Children: change.Changes{
+					{Text: "the first part"},
+				},
+			},
+		},
+	}
+}
+
+func TestFeedPresenter_Present_atom(t *testing.T) {
+	p, err := NewFeedPresenter(Atom, testDescription())
+	require.NoError(t, err)
+
+	assertPresenterAgainstGoldenSnapshot(t, p, *updateFeedPresenterGoldenFiles)
+}
+
+func TestFeedPresenter_Present_rss(t *testing.T) {
+	p, err := NewFeedPresenter(RSS, testDescription())
+	require.NoError(t, err)
+
+	assertPresenterAgainstGoldenSnapshot(t, p, *updateFeedPresenterGoldenFiles)
+}
+
+func TestNewFeedPresenter_unsupported(t *testing.T) {
+	_, err := NewFeedPresenter("json", testDescription())
+	assert.Error(t, err)
+}
+
+func TestNewItem_noReferenceURL(t *testing.T) {
+	description := testDescription()
+	description.VCSReferenceURL = ""
+
+	item, err := NewItem(description)
+	require.NoError(t, err)
+
+	assert.Equal(t, "urn:chronicle:release:v0.19.1", item.ID)
+	assert.Empty(t, item.Link)
+}
+
+func TestWriteAtom(t *testing.T) {
+	item, err := NewItem(testDescription())
+	require.NoError(t, err)
+
+	older := Item{
+		Title:   "v0.19.0",
+		ID:      "https://github.com/anchore/syft/tree/v0.19.0",
+		Link:    "https://github.com/anchore/syft/tree/v0.19.0",
+		Updated: time.Date(2021, time.September, 1, 12, 0, 0, 0, time.UTC),
+		Content: "<p>the notes</p>",
+	}
+
+	var buffer bytes.Buffer
+	require.NoError(t, WriteAtom(&buffer, Feed{
+		Title: "Releases",
+		ID:    "https://example.com/releases.atom",
+		Link:  "https://example.com/releases.atom",
+		Items: []Item{item, older},
+	}))
+
+	assertGoldenSnapshot(t, buffer.Bytes(), *updateFeedPresenterGoldenFiles)
+}
+
+func TestWriteRSS(t *testing.T) {
+	item, err := NewItem(testDescription())
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	require.NoError(t, WriteRSS(&buffer, Feed{
+		Title: "Releases",
+		Link:  "https://example.com/releases.rss",
+		Items: []Item{item},
+	}))
+
+	assertGoldenSnapshot(t, buffer.Bytes(), *updateFeedPresenterGoldenFiles)
+}
+
+func assertPresenterAgainstGoldenSnapshot(t *testing.T, pres presenter.Presenter, updateSnapshot bool) {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	require.NoError(t, pres.Present(&buffer))
+
+	assertGoldenSnapshot(t, buffer.Bytes(), updateSnapshot)
+}
+
+func assertGoldenSnapshot(t *testing.T, actual []byte, updateSnapshot bool) {
+	t.Helper()
+
+	if updateSnapshot {
+		testutils.UpdateGoldenFileContents(t, actual)
+	}
+
+	expected := testutils.GetGoldenFileContents(t)
+
+	if !bytes.Equal(expected, actual) {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(string(expected), string(actual), true)
+		t.Errorf("mismatched output:\n%s", dmp.DiffPrettyText(diffs))
+	}
+}
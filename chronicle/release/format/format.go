@@ -5,8 +5,26 @@ import "strings"
 type Format string
 
 var (
-	MarkdownFormat Format = "md"
-	JSONFormat     Format = "json"
+	MarkdownFormat       Format = "md"
+	JSONFormat           Format = "json"
+	HomebrewFormat       Format = "homebrew"
+	ScoopFormat          Format = "scoop"
+	TemplateFormat       Format = "template"
+	HTMLFormat           Format = "html"
+	ArtifactHubFormat    Format = "artifacthub"
+	KeepAChangelogFormat Format = "keepachangelog"
+	TerraformFormat      Format = "terraform"
+	AtomFormat           Format = "atom"
+	RSSFormat            Format = "rss"
+	SlackFormat          Format = "slack"
+	DiscordFormat        Format = "discord"
+	TerminalFormat       Format = "terminal"
+	CSVFormat            Format = "csv"
+	TSVFormat            Format = "tsv"
+	DebianFormat         Format = "debian"
+	RPMFormat            Format = "rpm"
+	KrewFormat           Format = "krew"
+	OperatorHubFormat    Format = "operatorhub"
 )
 
 func FromString(option string) *Format {
@@ -16,6 +34,42 @@ func FromString(option string) *Format {
 		return &MarkdownFormat
 	case "j", "json", "jason":
 		return &JSONFormat
+	case "brew", "homebrew":
+		return &HomebrewFormat
+	case "scoop":
+		return &ScoopFormat
+	case "template", "tmpl":
+		return &TemplateFormat
+	case "html", "htm":
+		return &HTMLFormat
+	case "artifacthub", "artifact-hub":
+		return &ArtifactHubFormat
+	case "keepachangelog", "keep-a-changelog", "kac":
+		return &KeepAChangelogFormat
+	case "terraform", "tf":
+		return &TerraformFormat
+	case "atom":
+		return &AtomFormat
+	case "rss":
+		return &RSSFormat
+	case "slack", "blockkit", "block-kit":
+		return &SlackFormat
+	case "discord":
+		return &DiscordFormat
+	case "terminal", "term", "ansi":
+		return &TerminalFormat
+	case "csv":
+		return &CSVFormat
+	case "tsv":
+		return &TSVFormat
+	case "debian", "deb":
+		return &DebianFormat
+	case "rpm", "spec":
+		return &RPMFormat
+	case "krew":
+		return &KrewFormat
+	case "operatorhub", "olm":
+		return &OperatorHubFormat
 	default:
 		return nil
 	}
@@ -25,6 +79,23 @@ func All() []Format {
 	return []Format{
 		MarkdownFormat,
 		JSONFormat,
+		HomebrewFormat,
+		ScoopFormat,
+		TemplateFormat,
+		HTMLFormat,
+		ArtifactHubFormat,
+		KeepAChangelogFormat,
+		TerraformFormat,
+		AtomFormat,
+		RSSFormat,
+		SlackFormat,
+		DiscordFormat,
+		TerminalFormat,
+		CSVFormat,
+		TSVFormat,
+		DebianFormat,
+		KrewFormat,
+		OperatorHubFormat,
 	}
 }
 
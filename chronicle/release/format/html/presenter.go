@@ -0,0 +1,186 @@
+package html
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+const (
+	htmlTemplate = `{{ if .EmbedCSS }}<style>
+{{ .CSS }}</style>
+{{ end }}<article class="changelog">
+  <header>
+    <h1>{{ .Title }}</h1>
+    <h2 id="{{ .VersionAnchor }}">{{ if .VCSReferenceURL }}<a href="{{ .VCSReferenceURL }}">{{ .Version }}</a>{{ else }}{{ .Version }}{{ end }} <time datetime="{{ .Date.Format "2006-01-02" }}">{{ .Date.Format "2006-01-02" }}</time></h2>
+{{- if .VCSChangesURL }}
+    <p class="full-changelog"><a href="{{ .VCSChangesURL }}">Full Changelog</a></p>
+{{- end }}
+  </header>
+{{- range .Sections }}
+  <section id="{{ .Anchor }}" class="change-type-{{ .ChangeType }}">
+    <h3>{{ .Title }}</h3>
+    <ul>
+{{- template "entries" .Entries }}
+    </ul>
+  </section>
+{{- end }}
+{{- if .NewContributors }}
+  <section id="new-contributors">
+    <h3>New Contributors</h3>
+    <ul>
+{{- range .NewContributors }}
+      <li>{{ if .URL }}<a href="{{ .URL }}">@{{ .Name }}</a>{{ else }}@{{ .Name }}{{ end }} made their first contribution in {{ template "reference" .FirstContribution }}</li>
+{{- end }}
+    </ul>
+  </section>
+{{- end }}
+</article>
+{{ define "entries" }}
+{{- range . }}
+      <li id="{{ .Anchor }}">{{ .Text }}{{ range .References }} {{ template "reference" . }}{{ end }} <a class="anchor" href="#{{ .Anchor }}" aria-label="Link to this change">#</a>
+{{- if .Children }}
+        <ul>
+{{- template "entries" .Children }}
+        </ul>
+{{- end }}</li>
+{{- end }}
+{{- end }}{{ define "reference" }}{{ if .URL }}<a href="{{ .URL }}">{{ .Text }}</a>{{ else }}{{ .Text }}{{ end }}{{ end }}`
+
+	// defaultCSS is a minimal stylesheet that keeps the changelog readable when it is not styled by the page it is
+	// dropped into (e.g. within an email).
+	defaultCSS = `.changelog { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; line-height: 1.5; max-width: 48em; }
+.changelog time { color: #57606a; font-size: 0.8em; font-weight: normal; }
+.changelog h3 { border-bottom: 1px solid #d0d7de; padding-bottom: 0.3em; }
+.changelog a { color: #0969da; text-decoration: none; }
+.changelog a:hover { text-decoration: underline; }
+.changelog .anchor { color: #8c959f; visibility: hidden; }
+.changelog li:hover > .anchor { visibility: visible; }
+`
+)
+
+var _ presenter.Presenter = (*Presenter)(nil)
+
+var nonAnchorPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Presenter renders the release as an HTML fragment (an <article>), with a <section> for each change type and an
+// anchor for each change, so it can be dropped into a docs site or an email.
+type Presenter struct {
+	config    Config
+	templater *template.Template
+}
+
+type Config struct {
+	release.Description
+	Title    string
+	EmbedCSS bool // include a minimal stylesheet, for when the changelog is not styled by the page it is included in
+}
+
+type view struct {
+	Config
+	CSS             template.CSS
+	VersionAnchor   string
+	Sections        []sectionView
+	NewContributors []release.Contributor
+}
+
+type sectionView struct {
+	ChangeType string
+	Title      string
+	Anchor     string
+	Entries    []entryView
+}
+
+type entryView struct {
+	Text       string
+	Anchor     string
+	References []change.Reference
+	Children   []entryView
+}
+
+func NewHTMLPresenter(config Config) (*Presenter, error) {
+	templater, err := template.New("html").Parse(htmlTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse html presenter template: %w", err)
+	}
+
+	return &Presenter{
+		config:    config,
+		templater: templater,
+	}, nil
+}
+
+func (m Presenter) Present(writer io.Writer) error {
+	return m.templater.Execute(writer, m.view())
+}
+
+func (m Presenter) view() view {
+	anchors := anchorSet{}
+	v := view{
+		Config:          m.config,
+		CSS:             template.CSS(defaultCSS),
+		VersionAnchor:   anchors.unique(m.config.Version),
+		NewContributors: m.config.NewContributors,
+	}
+
+	for _, section := range m.config.SupportedChanges {
+		summaries := m.config.Changes.ByChangeType(section.ChangeType)
+		if len(summaries) == 0 {
+			continue
+		}
+		v.Sections = append(v.Sections, sectionView{
+			ChangeType: section.ChangeType.Name,
+			Title:      section.Title,
+			Anchor:     anchors.unique(section.ChangeType.Name),
+			Entries:    newEntries(summaries, anchors),
+		})
+	}
+	return v
+}
+
+func newEntries(changes change.Changes, anchors anchorSet) []entryView {
+	var entries []entryView
+	for _, c := range changes {
+		entries = append(entries, entryView{
+			Text:       c.Text,
+			Anchor:     anchors.unique(entryAnchor(c)),
+			References: c.References,
+			Children:   newEntries(c.Children, anchors),
+		})
+	}
+	return entries
+}
+
+// entryAnchor is the anchor of a change, taken from its identity (e.g. "github-pr-123") when known so the link to a
+// change remains the same even when its title is edited.
+func entryAnchor(c change.Change) string {
+	if len(c.Identities) > 0 {
+		return c.Identities[0]
+	}
+	return c.Text
+}
+
+// anchorSet hands out the anchors (element IDs) within the page, ensuring each is unique.
+type anchorSet map[string]bool
+
+func (s anchorSet) unique(text string) string {
+	base := strings.Trim(nonAnchorPattern.ReplaceAllString(strings.ToLower(text), "-"), "-")
+	if base == "" {
+		base = "change"
+	}
+
+	anchor := base
+	for i := 2; s[anchor]; i++ {
+		anchor = base + "-" + strconv.Itoa(i)
+	}
+	s[anchor] = true
+	return anchor
+}
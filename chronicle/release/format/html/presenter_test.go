@@ -0,0 +1,113 @@
+package html
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/go-testutils"
+)
+
+var updateHTMLPresenterGoldenFiles = flag.Bool("update-html", false, "update the *.golden files for html presenters")
+
+func TestHTMLPresenter_Present(t *testing.T) {
+	bug := change.NewType("bug", change.SemVerPatch)
+	added := change.NewType("added", change.SemVerMinor)
+	removed := change.NewType("removed", change.SemVerMajor)
+
+	tests := []struct {
+		name     string
+		embedCSS bool
+	}{
+		{name: "fragment"},
+		{name: "embedded css", embedCSS: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewHTMLPresenter(Config{
+				Title:    "Changelog",
+				EmbedCSS: tt.embedCSS,
+				Description: release.Description{
+					Release: release.Release{
+						Version: "v0.19.1",
+						Date:    time.Date(2021, time.September, 16, 19, 34, 0, 0, time.UTC),
+					},
+					VCSReferenceURL: "https://github.com/anchore/syft/releases/tag/v0.19.1",
+					VCSChangesURL:   "https://github.com/anchore/syft/compare/v0.19.0...v0.19.1",
+					SupportedChanges: []change.TypeTitle{
+						{ChangeType: bug, Title: "Bug Fixes"},
+						{ChangeType: added, Title: "Added Features"},
+						{ChangeType: removed, Title: "Removed Features"},
+					},
+					Changes: []change.Change{
+						{
+							ChangeTypes: []change.Type{bug},
+							Text:        "Redirect cursor hide/show to stderr",
+							Identities:  []string{"github-pr:456"},
+							References: []change.Reference{
+								{Text: "#456", URL: "https://github.com/anchore/syft/pull/456"},
+							},
+						},
+						{
+							ChangeTypes: []change.Type{added},
+							Text:        "Added feature <b>escaped</b> & linked",
+							Identities:  []string{"github-issue:457"},
+							References: []change.Reference{
+								{Text: "#457", URL: "https://github.com/anchore/syft/issues/457"},
+								{Text: "@wagoodman", URL: "https://github.com/wagoodman"},
+							},
+							Children: change.Changes{
+								{Text: "the first part", Identities: []string{"github-pr:458"}},
+								{Text: "the second part"},
+							},
+						},
+						{
+							ChangeTypes: []change.Type{added},
+							Text:        "the second part",
+						},
+					},
+					NewContributors: []release.Contributor{
+						{
+							Name:              "wagoodman",
+							URL:               "https://github.com/wagoodman",
+							FirstContribution: change.Reference{Text: "#456", URL: "https://github.com/anchore/syft/pull/456"},
+						},
+					},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buffer bytes.Buffer
+			assert.NoError(t, p.Present(&buffer))
+			actual := buffer.Bytes()
+
+			if *updateHTMLPresenterGoldenFiles {
+				testutils.UpdateGoldenFileContents(t, actual)
+			}
+
+			expected := testutils.GetGoldenFileContents(t)
+
+			if !bytes.Equal(expected, actual) {
+				dmp := diffmatchpatch.New()
+				diffs := dmp.DiffMain(string(expected), string(actual), true)
+				t.Errorf("mismatched output:\n%s", dmp.DiffPrettyText(diffs))
+			}
+		})
+	}
+}
+
+func TestAnchorSet_unique(t *testing.T) {
+	anchors := anchorSet{}
+	assert.Equal(t, "github-pr-123", anchors.unique("github-pr:123"))
+	assert.Equal(t, "github-pr-123-2", anchors.unique("github-pr:123"))
+	assert.Equal(t, "fix-the-thing", anchors.unique("Fix the thing!"))
+	assert.Equal(t, "change", anchors.unique("???"))
+}
@@ -0,0 +1,230 @@
+package json
+
+import (
+	"time"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+// SchemaVersion is the version of the JSON document schema. Fields may be added within a version, but are never
+// renamed or removed (that would be a new version).
+const SchemaVersion = "1"
+
+// Document is the JSON representation of a release description. Unlike the description itself, the shape of the
+// document is stable, so it can be consumed by release pipelines and bots.
+type Document struct {
+	SchemaVersion   string           `json:"schemaVersion"`
+	Release         DocumentRelease  `json:"release"`
+	Notice          string           `json:"notice,omitempty"`
+	Sections        []Section        `json:"sections"`                  // the sections of the changelog (in order), including sections without entries
+	Contributors    []Reference      `json:"contributors,omitempty"`    // everyone who made a change within the release
+	NewContributors []NewContributor `json:"newContributors,omitempty"` // the people who contributed for the first time within the release
+	FeatureFlags    []FeatureFlag    `json:"featureFlags,omitempty"`    // the feature flags added, removed, or with a changed default since the previous release
+	Deprecations    []Deprecation    `json:"deprecations,omitempty"`    // the features deprecated by previous releases that are still scheduled for removal
+	Support         []SupportLine    `json:"support,omitempty"`         // the supported lines of releases and when each reaches its end of life
+	Warnings        []Warning        `json:"warnings,omitempty"`        // the problems found while describing the release
+}
+
+// DocumentRelease is the metadata of the release being described.
+type DocumentRelease struct {
+	Version      string           `json:"version"`
+	Date         time.Time        `json:"date"`
+	Draft        bool             `json:"draft,omitempty"`
+	Prerelease   bool             `json:"prerelease,omitempty"`
+	ReferenceURL string           `json:"referenceUrl,omitempty"` // where to find more information about the release
+	ChangesURL   string           `json:"changesUrl,omitempty"`   // where to find the source changes that make up the release
+	Number       int              `json:"number,omitempty"`       // the position of the release among all releases, starting at 1
+	Previous     *PreviousRelease `json:"previous,omitempty"`     // the release the changelog starts from
+}
+
+// PreviousRelease is the release the changelog starts from.
+type PreviousRelease struct {
+	Version   string    `json:"version"`
+	Date      time.Time `json:"date"`
+	DaysSince int       `json:"daysSince"` // the number of whole days between the previous release and this release
+}
+
+// Section is a section of the changelog, holding every entry with the change type of the section.
+type Section struct {
+	Name    string  `json:"name"`             // the change type (e.g. "bug")
+	Title   string  `json:"title"`            // the display title (e.g. "Bug Fixes")
+	SemVer  string  `json:"semver,omitempty"` // the semver field bumped by the change type: "major", "minor", or "patch"
+	Entries []Entry `json:"entries"`
+}
+
+// Entry is a single change within a section. A change with several change types is an entry within each section.
+type Entry struct {
+	Text        string                 `json:"text"`
+	ChangeTypes []string               `json:"changeTypes"`
+	Timestamp   *time.Time             `json:"timestamp,omitempty"`
+	References  []Reference            `json:"references,omitempty"`
+	Authors     []Reference            `json:"authors,omitempty"`
+	Source      string                 `json:"source,omitempty"`     // where the change came from (e.g. "github-pr")
+	Identities  []string               `json:"identities,omitempty"` // stable identifiers for the entities the change was derived from (e.g. "github-pr:123")
+	Children    []Entry                `json:"children,omitempty"`   // the changes clustered under this change
+	Raw         map[string]interface{} `json:"raw,omitempty"`        // the unmodified API payload (only with --expose-raw), which is not part of the stable schema
+}
+
+type Reference struct {
+	Text string `json:"text"`
+	URL  string `json:"url,omitempty"`
+}
+
+type NewContributor struct {
+	Name              string    `json:"name"`
+	URL               string    `json:"url,omitempty"`
+	FirstContribution Reference `json:"firstContribution"`
+}
+
+type FeatureFlag struct {
+	Name            string `json:"name"`
+	Change          string `json:"change"` // "added", "removed", or "default-changed"
+	Default         string `json:"default,omitempty"`
+	PreviousDefault string `json:"previousDefault,omitempty"`
+}
+
+type Deprecation struct {
+	Text         string `json:"text"`
+	URL          string `json:"url,omitempty"`
+	DeprecatedIn string `json:"deprecatedIn"`
+	RemovalIn    string `json:"removalIn,omitempty"`
+	Countdown    string `json:"countdown,omitempty"` // how far away the removal is from the release (e.g. "in the next major release")
+}
+
+type SupportLine struct {
+	Version   string     `json:"version"`
+	EOL       *time.Time `json:"eol,omitempty"`
+	EndOfLife bool       `json:"endOfLife"`
+	Current   bool       `json:"current,omitempty"` // whether the release is within the line
+}
+
+type Warning struct {
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+}
+
+// NewDocument converts the release description to the JSON document.
+func NewDocument(description release.Description) Document {
+	doc := Document{
+		SchemaVersion: SchemaVersion,
+		Release: DocumentRelease{
+			Version:      description.Version,
+			Date:         description.Date,
+			Draft:        description.Draft,
+			Prerelease:   description.Prerelease,
+			ReferenceURL: description.VCSReferenceURL,
+			ChangesURL:   description.VCSChangesURL,
+		},
+		Notice:       description.Notice,
+		Sections:     []Section{},
+		Contributors: newReferences(description.Contributors),
+	}
+
+	if t := description.Timeline; t != nil {
+		doc.Release.Number = t.Number
+		if t.PreviousVersion != "" {
+			doc.Release.Previous = &PreviousRelease{
+				Version:   t.PreviousVersion,
+				Date:      t.PreviousDate,
+				DaysSince: t.DaysSincePrevious,
+			}
+		}
+	}
+
+	for _, section := range description.SupportedChanges {
+		entries := []Entry{}
+		for _, c := range description.Changes.ByChangeType(section.ChangeType) {
+			entries = append(entries, newEntry(c))
+		}
+		doc.Sections = append(doc.Sections, Section{
+			Name:    section.ChangeType.Name,
+			Title:   section.Title,
+			SemVer:  section.ChangeType.Kind.String(),
+			Entries: entries,
+		})
+	}
+
+	for _, c := range description.NewContributors {
+		doc.NewContributors = append(doc.NewContributors, NewContributor{
+			Name:              c.Name,
+			URL:               c.URL,
+			FirstContribution: newReference(c.FirstContribution),
+		})
+	}
+
+	if flags := description.FeatureFlags; flags != nil {
+		for _, c := range flags.Changes {
+			doc.FeatureFlags = append(doc.FeatureFlags, FeatureFlag{
+				Name:            c.Name,
+				Change:          string(c.Kind),
+				Default:         c.Default,
+				PreviousDefault: c.PreviousDefault,
+			})
+		}
+	}
+
+	for _, d := range description.Deprecations {
+		doc.Deprecations = append(doc.Deprecations, Deprecation{
+			Text:         d.Text,
+			URL:          d.URL,
+			DeprecatedIn: d.DeprecatedIn,
+			RemovalIn:    d.RemovalIn,
+			Countdown:    d.Countdown,
+		})
+	}
+
+	for _, l := range description.Support {
+		line := SupportLine{Version: l.Version, EndOfLife: l.EndOfLife, Current: l.Current}
+		if !l.EOL.IsZero() {
+			eol := l.EOL
+			line.EOL = &eol
+		}
+		doc.Support = append(doc.Support, line)
+	}
+
+	for _, w := range description.Warnings {
+		doc.Warnings = append(doc.Warnings, Warning{Kind: string(w.Kind), Message: w.Message})
+	}
+
+	return doc
+}
+
+func newEntry(c change.Change) Entry {
+	entry := Entry{
+		Text:        c.Text,
+		ChangeTypes: []string{},
+		References:  newReferences(c.References),
+		Authors:     newReferences(c.Authors),
+		Source:      c.EntryType,
+		Identities:  c.Identities,
+		Raw:         c.Raw,
+	}
+
+	if !c.Timestamp.IsZero() {
+		timestamp := c.Timestamp
+		entry.Timestamp = &timestamp
+	}
+
+	for _, t := range c.ChangeTypes {
+		entry.ChangeTypes = append(entry.ChangeTypes, t.Name)
+	}
+
+	for _, child := range c.Children {
+		entry.Children = append(entry.Children, newEntry(child))
+	}
+
+	return entry
+}
+
+func newReferences(refs []change.Reference) []Reference {
+	var results []Reference
+	for _, r := range refs {
+		results = append(results, newReference(r))
+	}
+	return results
+}
+
+func newReference(r change.Reference) Reference {
+	return Reference{Text: r.Text, URL: r.URL}
+}
@@ -0,0 +1,87 @@
+package json
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/go-testutils"
+)
+
+var updateJSONPresenterGoldenFiles = flag.Bool("update-json", false, "update the *.golden files for json presenters")
+
+func TestJSONPresenter_Present(t *testing.T) {
+	bug := change.NewType("bug", change.SemVerPatch)
+	added := change.NewType("added", change.SemVerMinor)
+	removed := change.NewType("removed", change.SemVerMajor)
+
+	p, err := NewJSONPresenter(release.Description{
+		Release: release.Release{
+			Version: "v0.4.1",
+			Date:    time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		VCSReferenceURL: "https://github.com/anchore/chronicle/releases/tag/v0.4.1",
+		VCSChangesURL:   "https://github.com/anchore/chronicle/compare/v0.3.0...v0.4.1",
+		SupportedChanges: []change.TypeTitle{
+			{ChangeType: bug, Title: "Bug Fixes"},
+			{ChangeType: added, Title: "Added Features"},
+			{ChangeType: removed, Title: "Removed Features"},
+		},
+		Changes: []change.Change{
+			{
+				Text:        "fix the thing",
+				ChangeTypes: []change.Type{bug, added},
+				Timestamp:   time.Date(2023, time.February, 20, 0, 0, 0, 0, time.UTC),
+				References: []change.Reference{
+					{Text: "#12", URL: "https://github.com/anchore/chronicle/pull/12"},
+					{Text: "@alice", URL: "https://github.com/alice"},
+				},
+				Authors:    []change.Reference{{Text: "@alice", URL: "https://github.com/alice"}},
+				EntryType:  "github-pr",
+				Identities: []string{"github-pr:12"},
+				Children: change.Changes{
+					{Text: "a clustered change", ChangeTypes: []change.Type{bug}},
+				},
+			},
+		},
+		Contributors: []change.Reference{{Text: "@alice", URL: "https://github.com/alice"}},
+		NewContributors: []release.Contributor{
+			{
+				Name:              "alice",
+				URL:               "https://github.com/alice",
+				FirstContribution: change.Reference{Text: "#12", URL: "https://github.com/anchore/chronicle/pull/12"},
+			},
+		},
+		Warnings: []release.Warning{{Kind: release.SkippedChangeWarning, Message: "skipped PR #13"}},
+		Timeline: &release.Timeline{
+			Number:            5,
+			PreviousVersion:   "v0.3.0",
+			PreviousDate:      time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC),
+			DaysSincePrevious: 28,
+		},
+	})
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	assert.NoError(t, p.Present(&buffer))
+	actual := buffer.Bytes()
+
+	if *updateJSONPresenterGoldenFiles {
+		testutils.UpdateGoldenFileContents(t, actual)
+	}
+
+	expected := testutils.GetGoldenFileContents(t)
+
+	if !bytes.Equal(expected, actual) {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(string(expected), string(actual), true)
+		t.Errorf("mismatched output:\n%s", dmp.DiffPrettyText(diffs))
+	}
+}
@@ -8,12 +8,39 @@ import (
 )
 
 type Presenter struct {
-	description release.Description
+	value interface{}
 }
 
+// NewJSONPresenter renders the release description as a JSON document (see Document).
 func NewJSONPresenter(description release.Description) (*Presenter, error) {
 	return &Presenter{
-		description: description,
+		value: NewDocument(description),
+	}, nil
+}
+
+// NewJSONPresenterWithProvenance includes how the release notes were produced (see Provenance) alongside the release
+// document.
+func NewJSONPresenterWithProvenance(description release.Description, provenance Provenance) (*Presenter, error) {
+	return &Presenter{
+		value: struct {
+			Document
+			Provenance Provenance `json:"provenance"`
+		}{
+			Document:   NewDocument(description),
+			Provenance: provenance,
+		},
+	}, nil
+}
+
+func NewJSONReportPresenter(report release.Report) (*Presenter, error) {
+	return &Presenter{
+		value: report,
+	}, nil
+}
+
+func NewJSONRetroPresenter(retro release.Retro) (*Presenter, error) {
+	return &Presenter{
+		value: retro,
 	}, nil
 }
 
@@ -21,5 +48,5 @@ func (m Presenter) Present(writer io.Writer) error {
 	enc := json.NewEncoder(writer)
 	enc.SetEscapeHTML(false)
 	enc.SetIndent("", "  ")
-	return enc.Encode(m.description)
+	return enc.Encode(m.value)
 }
@@ -0,0 +1,71 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+func TestPresenter_provenance(t *testing.T) {
+	description := release.Description{
+		Release: release.Release{
+			Version: "v0.4.1",
+			Date:    time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	finished := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	p, err := NewJSONPresenterWithProvenance(description, Provenance{
+		BuildDefinition: BuildDefinition{
+			BuildType: BuildType,
+			ResolvedDependencies: []ResourceDescriptor{
+				{
+					URI:    "git+https://github.com/anchore/chronicle@refs/tags/v0.4.1",
+					Digest: map[string]string{"gitCommit": "abc123"},
+				},
+			},
+		},
+		RunDetails: RunDetails{
+			Builder:  Builder{ID: "https://github.com/anchore/chronicle/.github/workflows/release.yaml@refs/tags/v0.4.1"},
+			Metadata: BuildMetadata{FinishedOn: &finished},
+		},
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, p.Present(&buf))
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+
+	// the release description keeps the same shape as without provenance
+	assert.Equal(t, SchemaVersion, doc["schemaVersion"])
+	assert.Equal(t, "v0.4.1", doc["release"].(map[string]interface{})["version"])
+	assert.Contains(t, doc, "sections")
+
+	assert.Equal(t, map[string]interface{}{
+		"buildDefinition": map[string]interface{}{
+			"buildType": BuildType,
+			"resolvedDependencies": []interface{}{
+				map[string]interface{}{
+					"uri":    "git+https://github.com/anchore/chronicle@refs/tags/v0.4.1",
+					"digest": map[string]interface{}{"gitCommit": "abc123"},
+				},
+			},
+		},
+		"runDetails": map[string]interface{}{
+			"builder": map[string]interface{}{
+				"id": "https://github.com/anchore/chronicle/.github/workflows/release.yaml@refs/tags/v0.4.1",
+			},
+			"metadata": map[string]interface{}{
+				"finishedOn": "2023-03-01T12:00:00Z",
+			},
+		},
+	}, doc["provenance"])
+}
@@ -0,0 +1,42 @@
+package json
+
+import "time"
+
+// BuildType identifies how chronicle produced the release notes, per the SLSA provenance conventions.
+const BuildType = "https://github.com/anchore/chronicle/release-notes@v1"
+
+// Provenance describes how the release notes were produced, following the shape of the SLSA v1 provenance predicate
+// (https://slsa.dev/spec/v1.0/provenance) so that the JSON output can be used within supply-chain attestations.
+type Provenance struct {
+	BuildDefinition BuildDefinition `json:"buildDefinition"`
+	RunDetails      RunDetails      `json:"runDetails"`
+}
+
+type BuildDefinition struct {
+	BuildType            string               `json:"buildType"`
+	ExternalParameters   map[string]string    `json:"externalParameters,omitempty"`   // the user-provided inputs (e.g. the since and until tags)
+	ResolvedDependencies []ResourceDescriptor `json:"resolvedDependencies,omitempty"` // the source the release notes were derived from
+}
+
+// ResourceDescriptor identifies an artifact by URI and digest (e.g. "git+https://github.com/anchore/chronicle@refs/tags/v0.4.1"
+// with a "gitCommit" digest).
+type ResourceDescriptor struct {
+	URI    string            `json:"uri"`
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+type RunDetails struct {
+	Builder  Builder       `json:"builder"`
+	Metadata BuildMetadata `json:"metadata"`
+}
+
+type Builder struct {
+	ID      string            `json:"id"`                // the identity of the platform that ran chronicle (e.g. a CI workflow)
+	Version map[string]string `json:"version,omitempty"` // e.g. the chronicle version
+}
+
+type BuildMetadata struct {
+	InvocationID string     `json:"invocationId,omitempty"` // e.g. the URL of the CI run
+	StartedOn    *time.Time `json:"startedOn,omitempty"`
+	FinishedOn   *time.Time `json:"finishedOn,omitempty"`
+}
@@ -0,0 +1,198 @@
+package json
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// the published JSON schema of each version of the document, which downstream consumers can validate against
+//
+//go:embed schema/schema-*.json
+var schemas embed.FS
+
+// Schema returns the JSON schema of the given version of the document (see SchemaVersion).
+func Schema(version string) ([]byte, error) {
+	contents, err := schemas.ReadFile(fmt.Sprintf("schema/schema-%s.json", version))
+	if err != nil {
+		return nil, fmt.Errorf("no JSON schema for document version %q", version)
+	}
+	return contents, nil
+}
+
+// ValidationError lists every way in which a document does not conform to its schema.
+type ValidationError struct {
+	Problems []string // each is prefixed with the path of the offending value (e.g. "$.sections[0].name: ...")
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("document does not conform to the JSON schema:\n  - %s", strings.Join(e.Problems, "\n  - "))
+}
+
+// Validate checks the given JSON document against the schema of the version the document declares. Only the subset
+// of JSON schema used by the published schemas is supported.
+func Validate(contents []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(contents))
+	dec.UseNumber()
+
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return fmt.Errorf("unable to parse document: %w", err)
+	}
+
+	fields, ok := doc.(map[string]interface{})
+	if !ok {
+		return ValidationError{Problems: []string{"$: expected an object"}}
+	}
+	version, ok := fields["schemaVersion"].(string)
+	if !ok {
+		return ValidationError{Problems: []string{"$.schemaVersion: missing (unable to select a schema)"}}
+	}
+
+	raw, err := Schema(version)
+	if err != nil {
+		return err
+	}
+
+	var root schemaNode
+	if err := json.Unmarshal(raw, &root); err != nil {
+		return fmt.Errorf("unable to parse JSON schema %q: %w", version, err)
+	}
+
+	v := validator{defs: root.Defs}
+	v.validate("$", &root, doc)
+	if len(v.problems) > 0 {
+		return ValidationError{Problems: v.problems}
+	}
+	return nil
+}
+
+// schemaNode is the subset of JSON schema used by the published schemas.
+type schemaNode struct {
+	Ref                  string                 `json:"$ref"`
+	Defs                 map[string]*schemaNode `json:"$defs"`
+	Type                 string                 `json:"type"`
+	Enum                 []string               `json:"enum"`
+	Format               string                 `json:"format"`
+	Minimum              *float64               `json:"minimum"`
+	Required             []string               `json:"required"`
+	Properties           map[string]*schemaNode `json:"properties"`
+	AdditionalProperties *schemaNode            `json:"additionalProperties"`
+	Items                *schemaNode            `json:"items"`
+}
+
+type validator struct {
+	defs     map[string]*schemaNode
+	problems []string
+}
+
+func (v *validator) fail(path, format string, args ...interface{}) {
+	v.problems = append(v.problems, path+": "+fmt.Sprintf(format, args...))
+}
+
+func (v *validator) validate(path string, node *schemaNode, value interface{}) {
+	if node.Ref != "" {
+		def, ok := v.defs[strings.TrimPrefix(node.Ref, "#/$defs/")]
+		if !ok {
+			v.fail(path, "unresolvable schema reference %q", node.Ref)
+			return
+		}
+		node = def
+	}
+
+	switch node.Type {
+	case "object":
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			v.fail(path, "expected an object")
+			return
+		}
+		v.validateObject(path, node, fields)
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			v.fail(path, "expected an array")
+			return
+		}
+		if node.Items != nil {
+			for i, item := range items {
+				v.validate(fmt.Sprintf("%s[%d]", path, i), node.Items, item)
+			}
+		}
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			v.fail(path, "expected a string")
+			return
+		}
+		v.validateString(path, node, s)
+	case "integer":
+		n, ok := value.(json.Number)
+		if !ok {
+			v.fail(path, "expected an integer")
+			return
+		}
+		i, err := n.Int64()
+		if err != nil {
+			v.fail(path, "expected an integer (got %s)", n)
+			return
+		}
+		if node.Minimum != nil && float64(i) < *node.Minimum {
+			v.fail(path, "must be at least %v (got %d)", *node.Minimum, i)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			v.fail(path, "expected a boolean")
+		}
+	}
+}
+
+func (v *validator) validateObject(path string, node *schemaNode, fields map[string]interface{}) {
+	for _, name := range node.Required {
+		if _, ok := fields[name]; !ok {
+			v.fail(path, "missing required field %q", name)
+		}
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		// note: fields without a schema are allowed, since fields may be added within a schema version
+		property, ok := node.Properties[name]
+		if !ok {
+			property = node.AdditionalProperties
+		}
+		if property != nil {
+			v.validate(path+"."+name, property, fields[name])
+		}
+	}
+}
+
+func (v *validator) validateString(path string, node *schemaNode, s string) {
+	if len(node.Enum) > 0 {
+		found := false
+		for _, e := range node.Enum {
+			if s == e {
+				found = true
+				break
+			}
+		}
+		if !found {
+			v.fail(path, "must be one of %s (got %q)", strings.Join(node.Enum, ", "), s)
+		}
+	}
+
+	if node.Format == "date-time" {
+		if _, err := time.Parse(time.RFC3339, s); err != nil {
+			v.fail(path, "expected an RFC 3339 date-time (got %q)", s)
+		}
+	}
+}
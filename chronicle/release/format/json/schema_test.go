@@ -0,0 +1,141 @@
+package json
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate_golden(t *testing.T) {
+	contents, err := os.ReadFile("test-fixtures/snapshot/TestJSONPresenter_Present.golden")
+	require.NoError(t, err)
+
+	assert.NoError(t, Validate(contents))
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		want     []string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "minimal document",
+			document: `{"schemaVersion": "1", "release": {"version": "v0.1.0", "date": "2023-03-01T00:00:00Z"}, "sections": []}`,
+		},
+		{
+			name:     "unknown fields are allowed",
+			document: `{"schemaVersion": "1", "release": {"version": "v0.1.0", "date": "2023-03-01T00:00:00Z", "future": 1}, "sections": [], "future": {}}`,
+		},
+		{
+			name:     "missing required fields",
+			document: `{"schemaVersion": "1", "release": {"version": "v0.1.0"}}`,
+			want: []string{
+				`$: missing required field "sections"`,
+				`$.release: missing required field "date"`,
+			},
+		},
+		{
+			name:     "invalid values",
+			document: `{"schemaVersion": "1", "release": {"version": 1, "date": "yesterday", "number": 0}, "sections": [{"name": "bug", "title": "Bug Fixes", "semver": "micro", "entries": [{"text": "fix", "changeTypes": "bug"}]}]}`,
+			want: []string{
+				`$.release.date: expected an RFC 3339 date-time (got "yesterday")`,
+				`$.release.number: must be at least 1 (got 0)`,
+				`$.release.version: expected a string`,
+				`$.sections[0].entries[0].changeTypes: expected an array`,
+				`$.sections[0].semver: must be one of major, minor, patch (got "micro")`,
+			},
+		},
+		{
+			name:     "missing schema version",
+			document: `{"release": {}}`,
+			want:     []string{"$.schemaVersion: missing (unable to select a schema)"},
+		},
+		{
+			name:     "unknown schema version",
+			document: `{"schemaVersion": "0"}`,
+			wantErr:  require.Error,
+		},
+		{
+			name:     "not JSON",
+			document: `# Changelog`,
+			wantErr:  require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate([]byte(tt.document))
+			if tt.wantErr != nil {
+				tt.wantErr(t, err)
+				return
+			}
+			if len(tt.want) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			var validationErr ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.want, validationErr.Problems)
+		})
+	}
+}
+
+// the schema must describe every field of the document, otherwise consumers would not know about new fields
+func TestSchema_coversDocument(t *testing.T) {
+	contents, err := Schema(SchemaVersion)
+	require.NoError(t, err)
+
+	var root schemaNode
+	require.NoError(t, json.Unmarshal(contents, &root))
+
+	doc := struct {
+		Document
+		Provenance Provenance `json:"provenance"`
+	}{}
+	assertSchemaCovers(t, root.Defs, "$", &root, reflect.TypeOf(doc), map[reflect.Type]bool{})
+}
+
+func assertSchemaCovers(t *testing.T, defs map[string]*schemaNode, path string, node *schemaNode, typ reflect.Type, seen map[reflect.Type]bool) {
+	t.Helper()
+	if node.Ref != "" {
+		node = defs[strings.TrimPrefix(node.Ref, "#/$defs/")]
+		require.NotNil(t, node, path)
+	}
+
+	for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice {
+		if typ.Kind() == reflect.Slice {
+			require.NotNil(t, node.Items, path)
+			node = node.Items
+			if node.Ref != "" {
+				node = defs[strings.TrimPrefix(node.Ref, "#/$defs/")]
+				require.NotNil(t, node, path)
+			}
+		}
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct || typ.PkgPath() == "time" || seen[typ] {
+		return
+	}
+	seen[typ] = true
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.Anonymous {
+			assertSchemaCovers(t, defs, path, node, field.Type, seen)
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		property, ok := node.Properties[name]
+		if !assert.True(t, ok, "%s.%s is not described by the schema", path, name) {
+			continue
+		}
+		assertSchemaCovers(t, defs, path+"."+name, property, field.Type, seen)
+	}
+}
@@ -0,0 +1,120 @@
+package keepachangelog
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+const preamble = `All notable changes to this project will be documented in this file.
+
+The format is based on [Keep a Changelog](https://keepachangelog.com/en/1.1.0/),
+and this project adheres to [Semantic Versioning](https://semver.org/spec/v2.0.0.html).
+`
+
+var _ presenter.Presenter = (*Presenter)(nil)
+
+// Presenter renders the release following the keepachangelog.com conventions: the changes are listed under the
+// Added, Changed, Deprecated, Removed, Fixed, and Security headings (mapped from the change types, see
+// change.CategoryOf), beneath a "## [x.y.z] - YYYY-MM-DD" version header with the link to the changes of the release at
+// the bottom.
+type Presenter struct {
+	config Config
+}
+
+type Config struct {
+	release.Description
+	Title string
+}
+
+func NewKeepAChangelogPresenter(config Config) (*Presenter, error) {
+	return &Presenter{
+		config: config,
+	}, nil
+}
+
+func (m Presenter) Present(writer io.Writer) error {
+	_, err := io.WriteString(writer, m.render())
+	return err
+}
+
+func (m Presenter) render() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s\n\n%s\n", m.config.Title, preamble)
+
+	label := versionLabel(m.config.Version)
+	if label == "Unreleased" {
+		fmt.Fprintf(&sb, "## [%s]\n\n", label)
+	} else {
+		fmt.Fprintf(&sb, "## [%s] - %s\n\n", label, m.config.Date.Format("2006-01-02"))
+	}
+
+	byCategory := m.changesByCategory()
+	for _, category := range change.Categories {
+		changes := byCategory[category]
+		if len(changes) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "### %s\n\n", category.Title())
+		for _, c := range changes {
+			writeEntry(&sb, c, "")
+		}
+		sb.WriteString("\n")
+	}
+
+	if m.config.VCSChangesURL != "" {
+		fmt.Fprintf(&sb, "[%s]: %s\n", label, m.config.VCSChangesURL)
+	}
+
+	return strings.TrimRight(sb.String(), "\n") + "\n"
+}
+
+// changesByCategory groups the changes by category. Each change is listed once, within the category of the first
+// section it belongs to.
+func (m Presenter) changesByCategory() map[change.Category]change.Changes {
+	results := make(map[change.Category]change.Changes)
+	seen := make(map[int]bool)
+	for _, section := range m.config.SupportedChanges {
+		category := change.CategoryOf(section.ChangeType)
+		for idx, c := range m.config.Changes {
+			if seen[idx] || !change.ContainsAny([]change.Type{section.ChangeType}, c.ChangeTypes) {
+				continue
+			}
+			seen[idx] = true
+			results[category] = append(results[category], c)
+		}
+	}
+	return results
+}
+
+func writeEntry(sb *strings.Builder, c change.Change, indent string) {
+	fmt.Fprintf(sb, "%s- %s", indent, c.Text)
+	for _, ref := range c.References {
+		if ref.URL == "" {
+			fmt.Fprintf(sb, " %s", ref.Text)
+		} else {
+			fmt.Fprintf(sb, " [%s](%s)", ref.Text, ref.URL)
+		}
+	}
+	sb.WriteString("\n")
+
+	for _, child := range c.Children {
+		writeEntry(sb, child, indent+"  ")
+	}
+}
+
+// versionLabel is the version as shown by keepachangelog.com (without a "v" prefix, e.g. "1.0.0").
+func versionLabel(version string) string {
+	if version == "" || version == release.UnreleasedVersion {
+		return "Unreleased"
+	}
+	if len(version) > 1 && version[0] == 'v' && version[1] >= '0' && version[1] <= '9' {
+		return version[1:]
+	}
+	return version
+}
@@ -0,0 +1,94 @@
+package keepachangelog
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/go-testutils"
+)
+
+var updateKeepAChangelogPresenterGoldenFiles = flag.Bool("update-keepachangelog", false, "update the *.golden files for keep a changelog presenters")
+
+func TestKeepAChangelogPresenter_Present(t *testing.T) {
+	bug := change.NewType("bug-fix", change.SemVerPatch)
+	added := change.NewType("added-feature", change.SemVerMinor)
+	breaking := change.NewType("breaking-feature", change.SemVerMajor)
+	security := change.NewType("security-fixes", change.SemVerPatch)
+	unknown := change.NewType("unknown", change.SemVerUnknown)
+
+	p, err := NewKeepAChangelogPresenter(Config{
+		Title: "Changelog",
+		Description: release.Description{
+			Release: release.Release{
+				Version: "v0.19.1",
+				Date:    time.Date(2021, time.September, 16, 19, 34, 0, 0, time.UTC),
+			},
+			VCSChangesURL: "https://github.com/anchore/syft/compare/v0.19.0...v0.19.1",
+			SupportedChanges: []change.TypeTitle{
+				{ChangeType: security, Title: "Security Fixes"},
+				{ChangeType: added, Title: "Added Features"},
+				{ChangeType: bug, Title: "Bug Fixes"},
+				{ChangeType: breaking, Title: "Breaking Changes"},
+				{ChangeType: unknown, Title: "Additional Changes"},
+			},
+			Changes: []change.Change{
+				{
+					ChangeTypes: []change.Type{bug},
+					Text:        "Redirect cursor hide/show to stderr",
+					References: []change.Reference{
+						{Text: "#456", URL: "https://github.com/anchore/syft/pull/456"},
+						{Text: "wagoodman"},
+					},
+				},
+				{
+					ChangeTypes: []change.Type{added, breaking},
+					Text:        "Support a new config format",
+					Children: change.Changes{
+						{Text: "the first part"},
+					},
+				},
+				{
+					ChangeTypes: []change.Type{security},
+					Text:        "Bump a vulnerable dependency",
+				},
+				{
+					ChangeTypes: []change.Type{unknown},
+					Text:        "Update the docs",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	assert.NoError(t, p.Present(&buffer))
+	actual := buffer.Bytes()
+
+	if *updateKeepAChangelogPresenterGoldenFiles {
+		testutils.UpdateGoldenFileContents(t, actual)
+	}
+
+	expected := testutils.GetGoldenFileContents(t)
+
+	if !bytes.Equal(expected, actual) {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(string(expected), string(actual), true)
+		t.Errorf("mismatched output:\n%s", dmp.DiffPrettyText(diffs))
+	}
+}
+
+func Test_versionLabel(t *testing.T) {
+	assert.Equal(t, "1.2.3", versionLabel("v1.2.3"))
+	assert.Equal(t, "1.2.3", versionLabel("1.2.3"))
+	assert.Equal(t, "version-one", versionLabel("version-one"))
+	assert.Equal(t, "Unreleased", versionLabel(release.UnreleasedVersion))
+	assert.Equal(t, "Unreleased", versionLabel(""))
+}
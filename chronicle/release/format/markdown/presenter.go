@@ -3,6 +3,7 @@ package markdown
 import (
 	"fmt"
 	"io"
+	"strings"
 	"text/template"
 
 	"github.com/wagoodman/go-presenter"
@@ -16,9 +17,9 @@ const (
 
 ## [{{.Version}}]({{.VCSReferenceURL}}) ({{ .Date.Format "2006-01-02" }})
 
-[Full Changelog]({{.VCSChangesURL}})
+{{ if not .Footer }}[Full Changelog]({{.VCSChangesURL}})
 
-{{ formatChangeSections .Changes }}
+{{ end }}{{ formatChangeSections .Changes }}{{ formatFeatureFlags .FeatureFlags }}{{ formatNewContributors .NewContributors }}{{ formatDeprecations .Deprecations }}{{ formatSupport .Support }}{{ if .Footer }}{{ formatFooter .Contributors .VCSChangesURL }}{{ end }}
 `
 )
 
@@ -38,7 +39,10 @@ type Sections []ChangeSection
 
 type Config struct {
 	release.Description
-	Title string
+	Title   string
+	Footer  bool // end with the contributors and the full changelog link (as github generated release notes do), instead of starting with the link
+	Details bool // list the changes grouped beneath an entry (e.g. dependency updates) within a collapsed <details> block
+	Wrap    int  // hard-wrap the entries and contributors at this column, so changelog diffs stay reviewable (disabled when 0)
 }
 
 func NewMarkdownPresenter(config Config) (*Presenter, error) {
@@ -47,7 +51,12 @@ func NewMarkdownPresenter(config Config) (*Presenter, error) {
 	}
 
 	funcMap := template.FuncMap{
-		"formatChangeSections": p.formatChangeSections,
+		"formatChangeSections":  p.formatChangeSections,
+		"formatNewContributors": formatNewContributors,
+		"formatFeatureFlags":    formatFeatureFlags,
+		"formatDeprecations":    formatDeprecations,
+		"formatSupport":         formatSupport,
+		"formatFooter":          p.formatFooter,
 	}
 	templater, err := template.New("markdown").Funcs(funcMap).Parse(markdownHeaderTemplate)
 	if err != nil {
@@ -68,29 +77,207 @@ func (m Presenter) formatChangeSections(changes change.Changes) string {
 	for _, section := range m.config.SupportedChanges {
 		summaries := changes.ByChangeType(section.ChangeType)
 		if len(summaries) > 0 {
-			result += formatChangeSection(section.Title, summaries) + "\n"
+			result += formatChangeSection(section.Title, summaries, m.config.Details, m.config.Wrap) + "\n"
 		}
 	}
 	return result
 }
 
-func formatChangeSection(title string, summaries []change.Change) string {
+func formatChangeSection(title string, summaries []change.Change, details bool, wrap int) string {
 	result := fmt.Sprintf("### %s\n\n", title)
 	for _, summary := range summaries {
-		result += formatSummary(summary)
+		result += formatSummary(summary, details, wrap)
 	}
 	return result
 }
 
-func formatSummary(summary change.Change) string {
-	result := fmt.Sprintf("- %s", summary.Text)
+// formatNewContributors lists the people who contributed for the first time within the release (in the style of the
+// github generated release notes).
+func formatNewContributors(contributors []release.Contributor) string {
+	if len(contributors) == 0 {
+		return ""
+	}
+	result := "### New Contributors\n\n"
+	for _, c := range contributors {
+		name := "@" + c.Name
+		if c.URL != "" {
+			name = fmt.Sprintf("[%s](%s)", name, c.URL)
+		}
+		first := c.FirstContribution.Text
+		if c.FirstContribution.URL != "" {
+			first = fmt.Sprintf("[%s](%s)", first, c.FirstContribution.URL)
+		}
+		result += fmt.Sprintf("- %s made their first contribution in %s\n", name, first)
+	}
+	return result + "\n"
+}
+
+// formatFeatureFlags lists the feature flags that were added, removed, or had their default value changed.
+func formatFeatureFlags(flags *release.FeatureFlags) string {
+	if flags == nil || len(flags.Changes) == 0 {
+		return ""
+	}
+	result := fmt.Sprintf("### %s\n\n", flags.Title)
+	for _, c := range flags.Changes {
+		switch c.Kind {
+		case release.FeatureFlagAdded:
+			if c.Default != "" {
+				result += fmt.Sprintf("- Added `%s` (default: `%s`)\n", c.Name, c.Default)
+			} else {
+				result += fmt.Sprintf("- Added `%s`\n", c.Name)
+			}
+		case release.FeatureFlagRemoved:
+			result += fmt.Sprintf("- Removed `%s`\n", c.Name)
+		case release.FeatureFlagDefaultChanged:
+			result += fmt.Sprintf("- Changed the default of `%s` from `%s` to `%s`\n", c.Name, orNone(c.PreviousDefault), orNone(c.Default))
+		}
+	}
+	return result + "\n"
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "none"
+	}
+	return value
+}
+
+// formatDeprecations lists the features deprecated by previous releases that are still scheduled for removal, noting
+// how far away each removal is.
+func formatDeprecations(deprecations []release.Deprecation) string {
+	if len(deprecations) == 0 {
+		return ""
+	}
+	result := "### Scheduled Removals\n\n"
+	for _, d := range deprecations {
+		text := d.Text
+		if d.URL != "" {
+			text = fmt.Sprintf("%s [[%s]](%s)", text, d.DeprecatedIn, d.URL)
+		} else {
+			text = fmt.Sprintf("%s [%s]", text, d.DeprecatedIn)
+		}
+		switch {
+		case d.RemovalIn == "":
+			result += fmt.Sprintf("- %s: removal not yet scheduled\n", text)
+		case d.Countdown != "":
+			result += fmt.Sprintf("- %s: removal scheduled for %s (%s)\n", text, d.RemovalIn, d.Countdown)
+		default:
+			result += fmt.Sprintf("- %s: removal scheduled for %s\n", text, d.RemovalIn)
+		}
+	}
+	return result + "\n"
+}
+
+// formatSupport renders the support matrix as a table, marking the line of the release being described.
+func formatSupport(lines []release.SupportLine) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	result := "### Support Matrix\n\n| Version | End of Life | Status |\n| --- | --- | --- |\n"
+	for _, l := range lines {
+		version := l.Version
+		if l.Current {
+			version = fmt.Sprintf("**%s** (this release)", version)
+		}
+		eol := "-"
+		if !l.EOL.IsZero() {
+			eol = l.EOL.Format("2006-01-02")
+		}
+		status := "supported"
+		if l.EndOfLife {
+			status = "end of life"
+		}
+		result += fmt.Sprintf("| %s | %s | %s |\n", version, eol, status)
+	}
+	return result + "\n"
+}
+
+func (m Presenter) formatFooter(contributors []change.Reference, changesURL string) string {
+	return formatFooter(contributors, changesURL, m.config.Wrap)
+}
+
+// formatFooter lists everyone who made a change within the release followed by the link to the full set of source
+// changes (in the style of the github generated release notes).
+func formatFooter(contributors []change.Reference, changesURL string, wrap int) string {
+	var result string
+	if len(contributors) > 0 {
+		var names []string
+		for _, c := range contributors {
+			name := "@" + c.Text
+			if c.URL != "" {
+				name = fmt.Sprintf("[%s](%s)", name, c.URL)
+			}
+			names = append(names, name+",")
+		}
+		// note: the separators are kept with each name, so a name is never wrapped apart from its comma
+		names[len(names)-1] = strings.TrimSuffix(names[len(names)-1], ",")
+		result += fmt.Sprintf("### Contributors\n\n%s\n\n", wrapWords(names, wrap, "", ""))
+	}
+	if changesURL != "" {
+		result += fmt.Sprintf("**Full Changelog**: %s\n", changesURL)
+	}
+	return result
+}
+
+func formatSummary(summary change.Change, details bool, wrap int) string {
+	return formatIndentedSummary(summary, "", details, wrap)
+}
+
+func formatIndentedSummary(summary change.Change, indent string, details bool, wrap int) string {
+	words := []string{summary.Text}
+	if wrap > 0 {
+		words = strings.Fields(summary.Text)
+	}
 	for _, ref := range summary.References {
+		// note: each reference is kept whole (even when the text has spaces) so the link markup is never wrapped
 		if ref.URL == "" {
-			result += fmt.Sprintf(" [%s]", ref.Text)
+			words = append(words, fmt.Sprintf("[%s]", ref.Text))
 		} else {
-			result += fmt.Sprintf(" [[%s](%s)]", ref.Text, ref.URL)
+			words = append(words, fmt.Sprintf("[[%s](%s)]", ref.Text, ref.URL))
 		}
 	}
+	result := wrapWords(words, wrap, indent+"- ", indent+"  ") + "\n"
 
-	return result + "\n"
+	if details && len(summary.Children) > 0 {
+		// the blank lines are needed for the markdown within the block to be rendered
+		result += fmt.Sprintf("%s  <details>\n%s  <summary>%d changes</summary>\n\n", indent, indent, len(summary.Children))
+		for _, child := range summary.Children {
+			result += formatIndentedSummary(child, indent+"  ", details, wrap)
+		}
+		return result + fmt.Sprintf("\n%s  </details>\n", indent)
+	}
+
+	// clustered changes are listed as sub-bullets of the parent change
+	for _, child := range summary.Children {
+		result += formatIndentedSummary(child, indent+"  ", details, wrap)
+	}
+
+	return result
+}
+
+// wrapWords joins the words with spaces, starting with the prefix. When a wrap column is given, the words are wrapped
+// onto lines no longer than the column (beginning with the indent), breaking only between words (a word that does not
+// fit on its own is given a line of its own). The wrap points only depend on the words being wrapped, so when a
+// changelog is regenerated only the entries that changed are wrapped differently.
+func wrapWords(words []string, wrap int, prefix, indent string) string {
+	if wrap <= 0 {
+		return prefix + strings.Join(words, " ")
+	}
+
+	var result strings.Builder
+	line := prefix
+	lineHasWords := false
+	for _, word := range words {
+		if lineHasWords && len(line)+1+len(word) > wrap {
+			result.WriteString(line + "\n")
+			line, lineHasWords = indent, false
+		}
+		if lineHasWords {
+			line += " "
+		}
+		line += word
+		lineHasWords = true
+	}
+	result.WriteString(line)
+	return result.String()
 }
@@ -86,6 +86,105 @@ func TestMarkdownPresenter_Present(t *testing.T) {
 	)
 }
 
+func TestMarkdownPresenter_Present_footer(t *testing.T) {
+	m, err := NewMarkdownPresenter(Config{
+		Title:  "Changelog",
+		Footer: true,
+		Description: release.Description{
+			SupportedChanges: []change.TypeTitle{
+				{
+					ChangeType: change.NewType("bug", change.SemVerPatch),
+					Title:      "Bug Fixes",
+				},
+			},
+			Release: release.Release{
+				Version: "v0.19.1",
+				Date:    time.Date(2021, time.September, 16, 19, 34, 0, 0, time.UTC),
+			},
+			VCSReferenceURL: "https://github.com/anchore/syft/tree/v0.19.1",
+			VCSChangesURL:   "https://github.com/anchore/syft/compare/v0.19.0...v0.19.1",
+			Changes: []change.Change{
+				{
+					ChangeTypes: []change.Type{change.NewType("bug", change.SemVerPatch)},
+					Text:        "Redirect cursor hide/show to stderr",
+					References: []change.Reference{
+						{
+							Text: "PR #456",
+							URL:  "https://github.com/anchore/syft/pull/456",
+						},
+					},
+				},
+			},
+			Contributors: []change.Reference{
+				{
+					Text: "alice",
+					URL:  "https://github.com/alice",
+				},
+				{
+					Text: "dependabot[bot]",
+					URL:  "https://github.com/apps/dependabot",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertPresenterAgainstGoldenSnapshot(t, m, *updateMarkdownPresenterGoldenFiles)
+}
+
+func TestMarkdownPresenter_Present_clustered(t *testing.T) {
+	m, err := NewMarkdownPresenter(Config{
+		Title: "Changelog",
+		Description: release.Description{
+			SupportedChanges: []change.TypeTitle{
+				{
+					ChangeType: change.NewType("added", change.SemVerMinor),
+					Title:      "Added Features",
+				},
+			},
+			Release: release.Release{
+				Version: "v0.19.1",
+				Date:    time.Date(2021, time.September, 16, 19, 34, 0, 0, time.UTC),
+			},
+			VCSReferenceURL: "https://github.com/anchore/syft/tree/v0.19.1",
+			VCSChangesURL:   "https://github.com/anchore/syft/compare/v0.19.0...v0.19.1",
+			Changes: []change.Change{
+				{
+					ChangeTypes: []change.Type{change.NewType("added", change.SemVerMinor)},
+					Text:        "added feature",
+					References: []change.Reference{
+						{
+							Text: "Issue #450",
+							URL:  "https://github.com/anchore/syft/issues/450",
+						},
+					},
+					Children: []change.Change{
+						{
+							ChangeTypes: []change.Type{change.NewType("added", change.SemVerMinor)},
+							Text:        "first part of the feature",
+							References: []change.Reference{
+								{
+									Text: "PR #457",
+									URL:  "https://github.com/anchore/syft/pull/457",
+								},
+							},
+						},
+						{
+							ChangeTypes: []change.Type{change.NewType("added", change.SemVerMinor)},
+							Text:        "second part of the feature",
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertPresenterAgainstGoldenSnapshot(t, m, *updateMarkdownPresenterGoldenFiles)
+}
+
 type redactor func(s []byte) []byte
 
 func assertPresenterAgainstGoldenSnapshot(t *testing.T, pres presenter.Presenter, updateSnapshot bool, redactors ...redactor) {
@@ -115,3 +214,198 @@ func assertPresenterAgainstGoldenSnapshot(t *testing.T, pres presenter.Presenter
 		t.Errorf("mismatched output:\n%s", dmp.DiffPrettyText(diffs))
 	}
 }
+
+func Test_formatNewContributors(t *testing.T) {
+	assert.Empty(t, formatNewContributors(nil))
+
+	got := formatNewContributors([]release.Contributor{
+		{
+			Name:              "alice",
+			URL:               "https://github.com/alice",
+			FirstContribution: change.Reference{Text: "PR #123", URL: "https://github.com/anchore/syft/pull/123"},
+		},
+		{
+			Name:              "bob",
+			FirstContribution: change.Reference{Text: "PR #124"},
+		},
+	})
+
+	assert.Equal(t, `### New Contributors
+
+- [@alice](https://github.com/alice) made their first contribution in [PR #123](https://github.com/anchore/syft/pull/123)
+- @bob made their first contribution in PR #124
+
+`, got)
+}
+
+func Test_formatFeatureFlags(t *testing.T) {
+	assert.Empty(t, formatFeatureFlags(nil))
+
+	got := formatFeatureFlags(&release.FeatureFlags{
+		Title: "Feature Flags",
+		Changes: []release.FeatureFlagChange{
+			{Name: "search-v2", Kind: release.FeatureFlagAdded, Default: "false"},
+			{Name: "beta", Kind: release.FeatureFlagAdded},
+			{Name: "dark-mode", Kind: release.FeatureFlagDefaultChanged, Default: "true", PreviousDefault: "false"},
+			{Name: "legacy-ui", Kind: release.FeatureFlagRemoved, PreviousDefault: "true"},
+		},
+	})
+
+	assert.Equal(t, "### Feature Flags\n\n"+
+		"- Added `search-v2` (default: `false`)\n"+
+		"- Added `beta`\n"+
+		"- Changed the default of `dark-mode` from `false` to `true`\n"+
+		"- Removed `legacy-ui`\n\n", got)
+}
+
+func Test_formatDeprecations(t *testing.T) {
+	assert.Empty(t, formatDeprecations(nil))
+
+	got := formatDeprecations([]release.Deprecation{
+		{
+			Text:         "Deprecate the --legacy flag",
+			URL:          "https://github.com/anchore/syft/pull/12",
+			DeprecatedIn: "v0.18.0",
+			RemovalIn:    "v1.0.0",
+			Countdown:    "in the next major release",
+		},
+		{
+			Text:         "Deprecate the old config",
+			DeprecatedIn: "v0.19.0",
+		},
+	})
+
+	assert.Equal(t, `### Scheduled Removals
+
+- Deprecate the --legacy flag [[v0.18.0]](https://github.com/anchore/syft/pull/12): removal scheduled for v1.0.0 (in the next major release)
+- Deprecate the old config [v0.19.0]: removal not yet scheduled
+
+`, got)
+}
+
+func Test_formatSupport(t *testing.T) {
+	assert.Empty(t, formatSupport(nil))
+
+	got := formatSupport([]release.SupportLine{
+		{Version: "v0.20"},
+		{Version: "v0.19", EOL: time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC), Current: true},
+		{Version: "v0.18", EOL: time.Date(2021, time.June, 30, 0, 0, 0, 0, time.UTC), EndOfLife: true},
+	})
+
+	assert.Equal(t, `### Support Matrix
+
+| Version | End of Life | Status |
+| --- | --- | --- |
+| v0.20 | - | supported |
+| **v0.19** (this release) | 2021-12-31 | supported |
+| v0.18 | 2021-06-30 | end of life |
+
+`, got)
+}
+
+func Test_formatFooter(t *testing.T) {
+	assert.Empty(t, formatFooter(nil, "", 0))
+	assert.Equal(t, "**Full Changelog**: https://github.com/anchore/syft/compare/v0.19.0...v0.19.1\n", formatFooter(nil, "https://github.com/anchore/syft/compare/v0.19.0...v0.19.1", 0))
+	assert.Equal(t, "### Contributors\n\n[@alice](https://github.com/alice), @bob\n\n", formatFooter([]change.Reference{
+		{Text: "alice", URL: "https://github.com/alice"},
+		{Text: "bob"},
+	}, "", 0))
+	assert.Equal(t, "### Contributors\n\n@alice, @bob,\n@carol\n\n", formatFooter([]change.Reference{
+		{Text: "alice"},
+		{Text: "bob"},
+		{Text: "carol"},
+	}, "", 14))
+}
+
+func Test_formatSummary_details(t *testing.T) {
+	group := change.Change{
+		Text: "Dependency updates",
+		Children: []change.Change{
+			{Text: "Bump golang.org/x/net", References: []change.Reference{{Text: "PR #1", URL: "https://github.com/anchore/syft/pull/1"}}},
+			{Text: "Bump github.com/spf13/cobra", References: []change.Reference{{Text: "PR #2"}}},
+		},
+	}
+
+	assert.Equal(t, `- Dependency updates
+  - Bump golang.org/x/net [[PR #1](https://github.com/anchore/syft/pull/1)]
+  - Bump github.com/spf13/cobra [PR #2]
+`, formatSummary(group, false, 0))
+
+	assert.Equal(t, `- Dependency updates
+  <details>
+  <summary>2 changes</summary>
+
+  - Bump golang.org/x/net [[PR #1](https://github.com/anchore/syft/pull/1)]
+  - Bump github.com/spf13/cobra [PR #2]
+
+  </details>
+`, formatSummary(group, true, 0))
+}
+
+func Test_formatSummary_wrap(t *testing.T) {
+	summary := change.Change{
+		Text:       "Add support for reading the configuration from the environment",
+		References: []change.Reference{{Text: "PR #12", URL: "https://github.com/anchore/syft/pull/12"}, {Text: "Issue #3"}},
+		Children: []change.Change{
+			{Text: "Document the environment variables for each of the options"},
+		},
+	}
+
+	assert.Equal(t, `- Add support for reading the configuration from
+  the environment
+  [[PR #12](https://github.com/anchore/syft/pull/12)]
+  [Issue #3]
+  - Document the environment variables for each of
+    the options
+`, formatSummary(summary, false, 50))
+
+	// without a wrap column the entry is left on a single line (including the spacing of the text)
+	assert.Equal(t, "- a  b [Issue #3]\n", formatSummary(change.Change{Text: "a  b", References: []change.Reference{{Text: "Issue #3"}}}, false, 0))
+}
+
+func Test_wrapWords(t *testing.T) {
+	tests := []struct {
+		name   string
+		words  []string
+		wrap   int
+		prefix string
+		indent string
+		want   string
+	}{
+		{
+			name:   "no wrap",
+			words:  []string{"one", "two", "three"},
+			prefix: "- ",
+			want:   "- one two three",
+		},
+		{
+			name:   "fits exactly",
+			words:  []string{"one", "two", "three"},
+			wrap:   15,
+			prefix: "- ",
+			indent: "  ",
+			want:   "- one two three",
+		},
+		{
+			name:   "wraps between words",
+			words:  []string{"one", "two", "three"},
+			wrap:   14,
+			prefix: "- ",
+			indent: "  ",
+			want:   "- one two\n  three",
+		},
+		{
+			name:   "long words are not split",
+			words:  []string{"https://example.com/a/very/long/url", "end"},
+			wrap:   10,
+			prefix: "- ",
+			indent: "  ",
+			want:   "- https://example.com/a/very/long/url\n  end",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, wrapWords(tt.words, tt.wrap, tt.prefix, tt.indent))
+		})
+	}
+}
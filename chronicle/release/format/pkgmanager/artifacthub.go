@@ -0,0 +1,172 @@
+package pkgmanager
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wagoodman/go-presenter"
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+// ArtifactHubChangesAnnotation is the Helm chart annotation that Artifact Hub shows the changes of a chart version from.
+const ArtifactHubChangesAnnotation = "artifacthub.io/changes"
+
+var _ presenter.Presenter = (*ArtifactHubPresenter)(nil)
+
+// ArtifactHubPresenter renders the release notes as the value of the "artifacthub.io/changes" annotation of a Helm
+// chart: a YAML list of changes, each with a kind (added, changed, deprecated, removed, fixed, or security), a
+// description, and links (see https://artifacthub.io/docs/topics/annotations/helm/).
+type ArtifactHubPresenter struct {
+	description release.Description
+}
+
+type artifactHubChange struct {
+	Kind        string            `yaml:"kind"`
+	Description string            `yaml:"description"`
+	Links       []artifactHubLink `yaml:"links,omitempty"`
+}
+
+type artifactHubLink struct {
+	Name string `yaml:"name"`
+	URL  string `yaml:"url"`
+}
+
+func NewArtifactHubPresenter(description release.Description) (*ArtifactHubPresenter, error) {
+	return &ArtifactHubPresenter{
+		description: description,
+	}, nil
+}
+
+func (p ArtifactHubPresenter) Present(writer io.Writer) error {
+	changes, err := ArtifactHubChanges(p.description)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(writer, changes)
+	return err
+}
+
+// ArtifactHubChanges renders the changes of the release as the value of the "artifacthub.io/changes" annotation. Each
+// change is listed once, with the kind of the first section it belongs to.
+func ArtifactHubChanges(description release.Description) (string, error) {
+	changes := []artifactHubChange{}
+	seen := make(map[int]bool)
+	for _, section := range description.SupportedChanges {
+		kind := artifactHubKind(section.ChangeType)
+		for idx, c := range description.Changes {
+			if seen[idx] || !change.ContainsAny([]change.Type{section.ChangeType}, c.ChangeTypes) {
+				continue
+			}
+			seen[idx] = true
+
+			entry := artifactHubChange{
+				Kind:        kind,
+				Description: c.Text,
+			}
+			for _, ref := range c.References {
+				if ref.URL != "" {
+					entry.Links = append(entry.Links, artifactHubLink{Name: ref.Text, URL: ref.URL})
+				}
+			}
+			changes = append(changes, entry)
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(changes); err != nil {
+		return "", fmt.Errorf("unable to encode artifact hub changes: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// InjectArtifactHubChanges sets the "artifacthub.io/changes" annotation of the given Chart.yaml contents (adding the
+// annotations when missing). The rest of the chart is kept as is (including comments), though it is re-indented.
+func InjectArtifactHubChanges(chart []byte, changes string) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(chart, &doc); err != nil {
+		return nil, fmt.Errorf("unable to parse chart: %w", err)
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("unable to parse chart: not a YAML mapping")
+	}
+
+	annotations := mappingValue(doc.Content[0], "annotations")
+	if annotations == nil {
+		annotations = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		setMappingValue(doc.Content[0], "annotations", annotations)
+	}
+	if annotations.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("the chart annotations are not a YAML mapping")
+	}
+
+	setMappingValue(annotations, ArtifactHubChangesAnnotation, &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Tag:   "!!str",
+		Style: yaml.LiteralStyle,
+		Value: changes,
+	})
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, fmt.Errorf("unable to encode chart: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func setMappingValue(mapping *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content[i+1] = value
+			return
+		}
+	}
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+}
+
+// artifactHubKind maps a change type onto one of the kinds of change known to Artifact Hub, by the name of the change
+// type (e.g. "bug-fix" is "fixed") or otherwise by the semver field it bumps. Unlike change.CategoryOf, breaking change
+// types are not singled out as "changed" (e.g. "breaking-feature" is "added").
+func artifactHubKind(t change.Type) string {
+	name := strings.ToLower(t.Name)
+	switch {
+	case strings.Contains(name, "security") || strings.Contains(name, "vulnerab"):
+		return "security"
+	case strings.Contains(name, "deprecat"):
+		return "deprecated"
+	case strings.Contains(name, "remov"):
+		return "removed"
+	case strings.Contains(name, "fix") || strings.Contains(name, "bug"):
+		return "fixed"
+	case strings.Contains(name, "add") || strings.Contains(name, "feat") || strings.Contains(name, "enhance"):
+		return "added"
+	}
+
+	if t.Kind == change.SemVerMinor {
+		return "added"
+	}
+	return "changed"
+}
@@ -0,0 +1,102 @@
+package pkgmanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func Test_artifactHubKind(t *testing.T) {
+	tests := []struct {
+		changeType change.Type
+		want       string
+	}{
+		{changeType: change.NewType("security-fixes", change.SemVerPatch), want: "security"},
+		{changeType: change.NewType("deprecated-feature", change.SemVerMinor), want: "deprecated"},
+		{changeType: change.NewType("removed-feature", change.SemVerMajor), want: "removed"},
+		{changeType: change.NewType("bug-fix", change.SemVerPatch), want: "fixed"},
+		{changeType: change.NewType("added-feature", change.SemVerMinor), want: "added"},
+		{changeType: change.NewType("breaking-feature", change.SemVerMajor), want: "added"},
+		{changeType: change.NewType("improvement", change.SemVerMinor), want: "added"},
+		{changeType: change.NewType("unknown", change.SemVerUnknown), want: "changed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.changeType.Name, func(t *testing.T) {
+			assert.Equal(t, tt.want, artifactHubKind(tt.changeType))
+		})
+	}
+}
+
+func TestInjectArtifactHubChanges(t *testing.T) {
+	changes := "- kind: fixed\n  description: fix the thing\n"
+
+	tests := []struct {
+		name    string
+		chart   string
+		want    string
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "existing annotation",
+			chart: `apiVersion: v2
+name: thing # the chart name
+version: 1.2.3
+annotations:
+  artifacthub.io/license: Apache-2.0
+  artifacthub.io/changes: |
+    - kind: added
+      description: an old change
+`,
+			want: `apiVersion: v2
+name: thing # the chart name
+version: 1.2.3
+annotations:
+  artifacthub.io/license: Apache-2.0
+  artifacthub.io/changes: |
+    - kind: fixed
+      description: fix the thing
+`,
+		},
+		{
+			name: "without annotations",
+			chart: `apiVersion: v2
+name: thing
+version: 1.2.3
+`,
+			want: `apiVersion: v2
+name: thing
+version: 1.2.3
+annotations:
+  artifacthub.io/changes: |
+    - kind: fixed
+      description: fix the thing
+`,
+		},
+		{
+			name:    "not a mapping",
+			chart:   "- a\n- b\n",
+			wantErr: require.Error,
+		},
+		{
+			name:    "annotations not a mapping",
+			chart:   "annotations: nope\n",
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			got, err := InjectArtifactHubChanges([]byte(tt.chart), changes)
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package pkgmanager
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+// changelogWrap is the column that the entries of a debian/changelog stanza (per Debian policy) and an RPM %changelog
+// entry are wrapped at.
+const changelogWrap = 80
+
+var _ presenter.Presenter = (*DebianPresenter)(nil)
+
+// DebianPresenter renders the release notes as a debian/changelog stanza (see the deb-changelog man page), ready to be
+// prepended to the changelog of the package.
+type DebianPresenter struct {
+	config DebianConfig
+}
+
+type DebianConfig struct {
+	release.Description
+	Package      string // the source package name
+	Revision     string // the Debian revision appended to the upstream version (e.g. "1" for "1.2.3-1"), none when empty
+	Distribution string // the distribution the package is uploaded to (e.g. "unstable")
+	Urgency      string // the urgency of the upload (e.g. "medium")
+	Maintainer   string // the name and email of the maintainer (e.g. "Jane Doe <jane@example.com>")
+}
+
+func NewDebianPresenter(config DebianConfig) (*DebianPresenter, error) {
+	if config.Package == "" {
+		return nil, fmt.Errorf("no package name given for the debian changelog")
+	}
+	if config.Maintainer == "" {
+		return nil, fmt.Errorf("no maintainer given for the debian changelog")
+	}
+	return &DebianPresenter{
+		config: config,
+	}, nil
+}
+
+func (p DebianPresenter) Present(writer io.Writer) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s (%s) %s; urgency=%s\n\n", p.config.Package, debianVersion(p.config.Version, p.config.Revision), p.config.Distribution, p.config.Urgency)
+
+	var entries int
+	seen := make(map[int]bool)
+	for _, section := range p.config.SupportedChanges {
+		for idx, c := range p.config.Changes {
+			if seen[idx] || !change.ContainsAny([]change.Type{section.ChangeType}, c.ChangeTypes) {
+				continue
+			}
+			seen[idx] = true
+			writeWrappedEntry(&sb, c, "  * ", "    ")
+			entries++
+		}
+	}
+
+	if entries == 0 {
+		// a stanza must have at least one entry
+		sb.WriteString("  * New upstream release.\n")
+	}
+
+	fmt.Fprintf(&sb, "\n -- %s  %s\n", p.config.Maintainer, p.config.Date.Format("Mon, 02 Jan 2006 15:04:05 -0700"))
+
+	_, err := io.WriteString(writer, sb.String())
+	return err
+}
+
+func writeWrappedEntry(sb *strings.Builder, c change.Change, prefix, indent string) {
+	words := strings.Fields(c.Text)
+
+	var refs []string
+	for _, ref := range c.References {
+		refs = append(refs, ref.Text)
+	}
+	if len(refs) > 0 {
+		words = append(words, strings.Fields(fmt.Sprintf("(%s)", strings.Join(refs, ", ")))...)
+	}
+
+	sb.WriteString(wrapLine(words, prefix, indent) + "\n")
+
+	// clustered changes are listed as nested items of the parent change
+	for _, child := range c.Children {
+		writeWrappedEntry(sb, child, indent+"- ", indent+"  ")
+	}
+}
+
+// wrapLine joins the words onto lines no longer than the changelog wrap limit, breaking only between words.
+func wrapLine(words []string, prefix, indent string) string {
+	var lines []string
+	line := prefix
+	lineHasWords := false
+	for _, word := range words {
+		if lineHasWords && len(line)+1+len(word) > changelogWrap {
+			lines = append(lines, line)
+			line, lineHasWords = indent, false
+		}
+		if lineHasWords {
+			line += " "
+		}
+		line += word
+		lineHasWords = true
+	}
+	lines = append(lines, line)
+	return strings.Join(lines, "\n")
+}
+
+// debianVersion is the version of the package: the upstream version (without a "v" prefix, since Debian versions must
+// start with a digit) followed by the Debian revision.
+func debianVersion(version, revision string) string {
+	if version == release.UnreleasedVersion {
+		version = "UNRELEASED"
+	}
+	version = semverOf(version)
+	if revision != "" {
+		version += "-" + revision
+	}
+	return version
+}
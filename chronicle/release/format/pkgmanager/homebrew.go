@@ -0,0 +1,49 @@
+package pkgmanager
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+var _ presenter.Presenter = (*HomebrewPresenter)(nil)
+
+// HomebrewPresenter renders the release notes as the "caveats" method of a Homebrew formula (or cask), ready to be
+// pasted into the formula definition.
+type HomebrewPresenter struct {
+	description release.Description
+}
+
+func NewHomebrewPresenter(description release.Description) (*HomebrewPresenter, error) {
+	return &HomebrewPresenter{
+		description: description,
+	}, nil
+}
+
+func (p HomebrewPresenter) Present(writer io.Writer) error {
+	var sb strings.Builder
+	sb.WriteString("  def caveats\n")
+	sb.WriteString("    <<~EOS\n")
+	for _, line := range noteLines(p.description) {
+		if line == "" {
+			sb.WriteString("\n")
+			continue
+		}
+		sb.WriteString("      " + escapeRubyHeredoc(line) + "\n")
+	}
+	sb.WriteString("    EOS\n")
+	sb.WriteString("  end\n")
+
+	_, err := fmt.Fprint(writer, sb.String())
+	return err
+}
+
+// escapeRubyHeredoc escapes the characters that would otherwise be interpreted within an interpolating heredoc.
+func escapeRubyHeredoc(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, "#{", `\#{`)
+}
@@ -0,0 +1,54 @@
+package pkgmanager
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wagoodman/go-presenter"
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+var _ presenter.Presenter = (*KrewPresenter)(nil)
+
+// KrewPresenter renders the release as a fragment of a Krew plugin manifest: the version of the plugin and the release
+// notes as the caveats shown once the plugin is installed or upgraded. The fragment can be merged into the manifest
+// (e.g. `yq '. *= load("notes.yaml")' plugin.yaml`).
+type KrewPresenter struct {
+	description release.Description
+}
+
+func NewKrewPresenter(description release.Description) (*KrewPresenter, error) {
+	return &KrewPresenter{
+		description: description,
+	}, nil
+}
+
+func (p KrewPresenter) Present(writer io.Writer) error {
+	version := p.description.Version
+	if version != release.UnreleasedVersion && !strings.HasPrefix(version, "v") {
+		// note: krew requires the version to be prefixed with "v"
+		version = "v" + version
+	}
+
+	return encodeYAML(writer, map[string]interface{}{
+		"spec": struct {
+			Version string `yaml:"version"`
+			Caveats string `yaml:"caveats"`
+		}{
+			Version: version,
+			Caveats: strings.Join(noteLines(p.description), "\n") + "\n",
+		},
+	})
+}
+
+func encodeYAML(writer io.Writer, value interface{}) error {
+	enc := yaml.NewEncoder(writer)
+	enc.SetIndent(2)
+	if err := enc.Encode(value); err != nil {
+		return fmt.Errorf("unable to encode yaml: %w", err)
+	}
+	return enc.Close()
+}
@@ -0,0 +1,54 @@
+package pkgmanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+// noteLines renders the release description as plain text lines (package manager notes are shown in a terminal, so
+// there is no markup), with one change section per supported change type.
+func noteLines(description release.Description) []string {
+	lines := []string{
+		fmt.Sprintf("Release notes for %s (%s):", description.Version, description.Date.Format("2006-01-02")),
+	}
+
+	for _, section := range description.SupportedChanges {
+		summaries := description.Changes.ByChangeType(section.ChangeType)
+		if len(summaries) == 0 {
+			continue
+		}
+		lines = append(lines, "", section.Title+":")
+		for _, summary := range summaries {
+			lines = append(lines, summaryLines(summary, "  ")...)
+		}
+	}
+
+	if description.VCSChangesURL != "" {
+		lines = append(lines, "", "Full changelog: "+description.VCSChangesURL)
+	}
+
+	return lines
+}
+
+func summaryLines(summary change.Change, indent string) []string {
+	line := fmt.Sprintf("%s- %s", indent, summary.Text)
+
+	var refs []string
+	for _, ref := range summary.References {
+		refs = append(refs, ref.Text)
+	}
+	if len(refs) > 0 {
+		line += fmt.Sprintf(" (%s)", strings.Join(refs, ", "))
+	}
+
+	lines := []string{line}
+
+	// clustered changes are listed as nested bullets of the parent change
+	for _, child := range summary.Children {
+		lines = append(lines, summaryLines(child, indent+"  ")...)
+	}
+	return lines
+}
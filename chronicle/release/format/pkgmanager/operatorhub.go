@@ -0,0 +1,73 @@
+package pkgmanager
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+var _ presenter.Presenter = (*OperatorHubPresenter)(nil)
+
+// OperatorHubPresenter renders the release as a fragment of the ClusterServiceVersion (CSV) of an operator published
+// to OperatorHub: the name and version of the CSV, and the CSV it replaces (the previous release), which together
+// form the upgrade graph of the operator. The fragment can be merged into the CSV (e.g. `yq '. *= load("csv.yaml")'
+// thing.clusterserviceversion.yaml`).
+type OperatorHubPresenter struct {
+	config OperatorHubConfig
+}
+
+type OperatorHubConfig struct {
+	release.Description
+	Operator string // the name of the operator (the CSV names are "<operator>.v<version>")
+}
+
+func NewOperatorHubPresenter(config OperatorHubConfig) (*OperatorHubPresenter, error) {
+	if config.Operator == "" {
+		return nil, fmt.Errorf("no operator name given for the operatorhub CSV")
+	}
+	if config.Version == release.UnreleasedVersion {
+		return nil, fmt.Errorf("the version of the release is required for the operatorhub CSV (see --speculate-next-version)")
+	}
+	return &OperatorHubPresenter{
+		config: config,
+	}, nil
+}
+
+type operatorHubCSV struct {
+	Metadata struct {
+		Name string `yaml:"name"`
+	} `yaml:"metadata"`
+	Spec struct {
+		Version  string `yaml:"version"`
+		Replaces string `yaml:"replaces,omitempty"`
+	} `yaml:"spec"`
+}
+
+func (p OperatorHubPresenter) Present(writer io.Writer) error {
+	var csv operatorHubCSV
+	csv.Metadata.Name = p.csvName(p.config.Version)
+	csv.Spec.Version = semverOf(p.config.Version)
+
+	// note: the first release of an operator does not replace another CSV
+	if t := p.config.Timeline; t != nil && t.PreviousVersion != "" {
+		csv.Spec.Replaces = p.csvName(t.PreviousVersion)
+	}
+
+	return encodeYAML(writer, csv)
+}
+
+func (p OperatorHubPresenter) csvName(version string) string {
+	return fmt.Sprintf("%s.v%s", p.config.Operator, semverOf(version))
+}
+
+// semverOf is the version without a "v" prefix (as required by the CSV version field).
+func semverOf(version string) string {
+	if len(version) > 1 && version[0] == 'v' && version[1] >= '0' && version[1] <= '9' {
+		return strings.TrimPrefix(version, "v")
+	}
+	return version
+}
@@ -0,0 +1,208 @@
+package pkgmanager
+
+import (
+	"bytes"
+	"flag"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/go-testutils"
+)
+
+var updatePkgManagerPresenterGoldenFiles = flag.Bool("update-pkgmanager", false, "update the *.golden files for package manager presenters")
+
+func testDescription() release.Description {
+	return release.Description{
+		SupportedChanges: []change.TypeTitle{
+			{
+				ChangeType: change.NewType("bug", change.SemVerPatch),
+				Title:      "Bug Fixes",
+			},
+			{
+				ChangeType: change.NewType("added", change.SemVerMinor),
+				Title:      "Added Features",
+			},
+			{
+				ChangeType: change.NewType("removed", change.SemVerMajor),
+				Title:      "Removed Features",
+			},
+		},
+		Release: release.Release{
+			Version: "v0.19.1",
+			Date:    time.Date(2021, time.September, 16, 19, 34, 0, 0, time.UTC),
+		},
+		VCSReferenceURL: "https://github.com/anchore/syft/tree/v0.19.1",
+		VCSChangesURL:   "https://github.com/anchore/syft/compare/v0.19.0...v0.19.1",
+		Changes: []change.Change{
+			{
+				ChangeTypes: []change.Type{change.NewType("bug", change.SemVerPatch)},
+				Text:        `Handle "quoted" paths with a \ and #{braces}`,
+				References: []change.Reference{
+					{
+						Text: "PR #456",
+						URL:  "https://github.com/anchore/syft/pull/456",
+					},
+					{
+						Text: "wagoodman",
+						URL:  "https://github.com/wagoodman",
+					},
+				},
+			},
+			{
+				ChangeTypes: []change.Type{change.NewType("added", change.SemVerMinor)},
+				Text:        "another added feature",
+				Children: []change.Change{
+					{
+						ChangeTypes: []change.Type{change.NewType("added", change.SemVerMinor)},
+						Text:        "first part of the feature",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestHomebrewPresenter_Present(t *testing.T) {
+	p, err := NewHomebrewPresenter(testDescription())
+	require.NoError(t, err)
+
+	assertPresenterAgainstGoldenSnapshot(t, p, *updatePkgManagerPresenterGoldenFiles)
+}
+
+func TestScoopPresenter_Present(t *testing.T) {
+	p, err := NewScoopPresenter(testDescription())
+	require.NoError(t, err)
+
+	assertPresenterAgainstGoldenSnapshot(t, p, *updatePkgManagerPresenterGoldenFiles)
+}
+
+func TestArtifactHubPresenter_Present(t *testing.T) {
+	p, err := NewArtifactHubPresenter(testDescription())
+	require.NoError(t, err)
+
+	assertPresenterAgainstGoldenSnapshot(t, p, *updatePkgManagerPresenterGoldenFiles)
+}
+
+func TestDebianPresenter_Present(t *testing.T) {
+	p, err := NewDebianPresenter(DebianConfig{
+		Description:  testDescription(),
+		Package:      "syft",
+		Revision:     "1",
+		Distribution: "unstable",
+		Urgency:      "medium",
+		Maintainer:   "Jane Doe <jane@example.com>",
+	})
+	require.NoError(t, err)
+
+	assertPresenterAgainstGoldenSnapshot(t, p, *updatePkgManagerPresenterGoldenFiles)
+}
+
+func TestNewDebianPresenter_missingFields(t *testing.T) {
+	_, err := NewDebianPresenter(DebianConfig{Maintainer: "Jane Doe <jane@example.com>"})
+	assert.Error(t, err)
+
+	_, err = NewDebianPresenter(DebianConfig{Package: "syft"})
+	assert.Error(t, err)
+}
+
+func Test_debianVersion(t *testing.T) {
+	assert.Equal(t, "1.2.3-1", debianVersion("v1.2.3", "1"))
+	assert.Equal(t, "1.2.3", debianVersion("1.2.3", ""))
+	assert.Equal(t, "UNRELEASED-1", debianVersion(release.UnreleasedVersion, "1"))
+}
+
+func Test_wrapLine(t *testing.T) {
+	words := strings.Fields(strings.Repeat("word ", 20))
+	assert.Equal(t, "  * word word word word word word word word word word word word word word word\n    word word word word word", wrapLine(words, "  * ", "    "))
+}
+
+func TestRPMPresenter_Present(t *testing.T) {
+	description := testDescription()
+	description.Changes[1].Text = "report 100% of the feature"
+
+	p, err := NewRPMPresenter(RPMConfig{
+		Description: description,
+		Release:     "1",
+		Packager:    "Jane Doe <jane@example.com>",
+	})
+	require.NoError(t, err)
+
+	assertPresenterAgainstGoldenSnapshot(t, p, *updatePkgManagerPresenterGoldenFiles)
+}
+
+func TestNewRPMPresenter_missingPackager(t *testing.T) {
+	_, err := NewRPMPresenter(RPMConfig{})
+	assert.Error(t, err)
+}
+
+func TestKrewPresenter_Present(t *testing.T) {
+	p, err := NewKrewPresenter(testDescription())
+	require.NoError(t, err)
+
+	assertPresenterAgainstGoldenSnapshot(t, p, *updatePkgManagerPresenterGoldenFiles)
+}
+
+func TestOperatorHubPresenter_Present(t *testing.T) {
+	description := testDescription()
+	description.Timeline = &release.Timeline{PreviousVersion: "v0.19.0"}
+
+	p, err := NewOperatorHubPresenter(OperatorHubConfig{
+		Description: description,
+		Operator:    "syft-operator",
+	})
+	require.NoError(t, err)
+
+	assertPresenterAgainstGoldenSnapshot(t, p, *updatePkgManagerPresenterGoldenFiles)
+}
+
+func TestOperatorHubPresenter_firstRelease(t *testing.T) {
+	p, err := NewOperatorHubPresenter(OperatorHubConfig{
+		Description: testDescription(),
+		Operator:    "syft-operator",
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, p.Present(&buf))
+	assert.Equal(t, "metadata:\n  name: syft-operator.v0.19.1\nspec:\n  version: 0.19.1\n", buf.String())
+}
+
+func TestNewOperatorHubPresenter_invalid(t *testing.T) {
+	_, err := NewOperatorHubPresenter(OperatorHubConfig{Description: testDescription()})
+	assert.Error(t, err)
+
+	description := testDescription()
+	description.Version = release.UnreleasedVersion
+	_, err = NewOperatorHubPresenter(OperatorHubConfig{Description: description, Operator: "syft-operator"})
+	assert.Error(t, err)
+}
+
+func assertPresenterAgainstGoldenSnapshot(t *testing.T, pres presenter.Presenter, updateSnapshot bool) {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	err := pres.Present(&buffer)
+	assert.NoError(t, err)
+	actual := buffer.Bytes()
+
+	// replace the expected snapshot contents with the current presenter contents
+	if updateSnapshot {
+		testutils.UpdateGoldenFileContents(t, actual)
+	}
+
+	var expected = testutils.GetGoldenFileContents(t)
+
+	if !bytes.Equal(expected, actual) {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(string(expected), string(actual), true)
+		t.Errorf("mismatched output:\n%s", dmp.DiffPrettyText(diffs))
+	}
+}
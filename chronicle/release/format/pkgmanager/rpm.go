@@ -0,0 +1,73 @@
+package pkgmanager
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+var _ presenter.Presenter = (*RPMPresenter)(nil)
+
+// RPMPresenter renders the release notes as an entry of the %changelog section of an RPM spec file, ready to be
+// prepended to the existing entries.
+type RPMPresenter struct {
+	config RPMConfig
+}
+
+type RPMConfig struct {
+	release.Description
+	Release  string // the RPM release appended to the version (e.g. "1" for "1.2.3-1"), none when empty
+	Packager string // the name and email of the packager (e.g. "Jane Doe <jane@example.com>")
+}
+
+func NewRPMPresenter(config RPMConfig) (*RPMPresenter, error) {
+	if config.Packager == "" {
+		return nil, fmt.Errorf("no packager given for the rpm changelog")
+	}
+	return &RPMPresenter{
+		config: config,
+	}, nil
+}
+
+func (p RPMPresenter) Present(writer io.Writer) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "* %s %s - %s\n", p.config.Date.Format("Mon Jan 02 2006"), p.config.Packager, rpmVersion(p.config.Version, p.config.Release))
+
+	var entries int
+	seen := make(map[int]bool)
+	for _, section := range p.config.SupportedChanges {
+		for idx, c := range p.config.Changes {
+			if seen[idx] || !change.ContainsAny([]change.Type{section.ChangeType}, c.ChangeTypes) {
+				continue
+			}
+			seen[idx] = true
+			writeWrappedEntry(&sb, c, "- ", "  ")
+			entries++
+		}
+	}
+
+	if entries == 0 {
+		sb.WriteString("- New upstream release\n")
+	}
+
+	// macros are expanded within the %changelog section, so any literal percent signs must be escaped
+	_, err := io.WriteString(writer, strings.ReplaceAll(sb.String(), "%", "%%"))
+	return err
+}
+
+// rpmVersion is the version-release of the package: the version (without a "v" prefix) followed by the RPM release.
+func rpmVersion(version, rel string) string {
+	if version == release.UnreleasedVersion {
+		version = "UNRELEASED"
+	}
+	version = semverOf(version)
+	if rel != "" {
+		version += "-" + rel
+	}
+	return version
+}
@@ -0,0 +1,35 @@
+package pkgmanager
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+var _ presenter.Presenter = (*ScoopPresenter)(nil)
+
+// ScoopPresenter renders the release notes as the "notes" field of a Scoop manifest. The output is a JSON object with
+// only this field, so it can be merged into an existing manifest (e.g. `jq -s '.[0] * .[1]' app.json notes.json`).
+type ScoopPresenter struct {
+	description release.Description
+}
+
+func NewScoopPresenter(description release.Description) (*ScoopPresenter, error) {
+	return &ScoopPresenter{
+		description: description,
+	}, nil
+}
+
+func (p ScoopPresenter) Present(writer io.Writer) error {
+	enc := json.NewEncoder(writer)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "    ")
+	return enc.Encode(struct {
+		Notes []string `json:"notes"`
+	}{
+		Notes: noteLines(p.description),
+	})
+}
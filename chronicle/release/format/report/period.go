@@ -0,0 +1,77 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Period is the span of time that the changes of a report are grouped by.
+type Period string
+
+const (
+	NoPeriod      Period = ""
+	WeekPeriod    Period = "week"    // ISO 8601 weeks (e.g. "2023-W09"), starting on Monday
+	MonthPeriod   Period = "month"   // calendar months (e.g. "March 2023")
+	QuarterPeriod Period = "quarter" // fiscal quarters (e.g. "2023 Q1", or "FY2024 Q1" when the fiscal year does not start in January)
+)
+
+func ParsePeriod(value string) (Period, error) {
+	switch p := Period(strings.ToLower(strings.TrimSpace(value))); p {
+	case NoPeriod, WeekPeriod, MonthPeriod, QuarterPeriod:
+		return p, nil
+	}
+	return NoPeriod, fmt.Errorf("unsupported report grouping %q (options: week, month, quarter)", value)
+}
+
+// ParseMonth parses a month by name (e.g. "october" or "oct") or number (e.g. "10").
+func ParseMonth(value string) (time.Month, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for m := time.January; m <= time.December; m++ {
+		name := strings.ToLower(m.String())
+		if value == name || value == name[:3] || value == fmt.Sprintf("%d", int(m)) || value == fmt.Sprintf("%02d", int(m)) {
+			return m, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid month %q", value)
+}
+
+// periodOf returns the start of the period that the given time falls within along with the display label for the
+// period. Times are grouped in UTC so that the grouping does not depend on where the report is generated.
+func periodOf(t time.Time, p Period, fiscalYearStart time.Month) (time.Time, string) {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch p {
+	case WeekPeriod:
+		// ISO weeks start on Monday (and the week-numbering year may differ from the calendar year)
+		offset := (int(day.Weekday()) + 6) % 7
+		start := day.AddDate(0, 0, -offset)
+		year, week := t.ISOWeek()
+		return start, fmt.Sprintf("%d-W%02d (%s)", year, week, start.Format("Jan 2"))
+
+	case MonthPeriod:
+		start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.Format("January 2006")
+
+	case QuarterPeriod:
+		if fiscalYearStart < time.January || fiscalYearStart > time.December {
+			fiscalYearStart = time.January
+		}
+		offset := (int(t.Month()) - int(fiscalYearStart) + 12) % 12
+		quarter := offset/3 + 1
+		start := time.Date(t.Year(), t.Month()-time.Month(offset%3), 1, 0, 0, 0, 0, time.UTC)
+
+		if fiscalYearStart == time.January {
+			return start, fmt.Sprintf("%d Q%d", t.Year(), quarter)
+		}
+		// fiscal years are named by the calendar year that they end in
+		fiscalYear := t.Year()
+		if t.Month() >= fiscalYearStart {
+			fiscalYear++
+		}
+		return start, fmt.Sprintf("FY%d Q%d", fiscalYear, quarter)
+	}
+
+	return time.Time{}, ""
+}
@@ -0,0 +1,111 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_periodOf(t *testing.T) {
+	tests := []struct {
+		name            string
+		time            time.Time
+		period          Period
+		fiscalYearStart time.Month
+		wantStart       time.Time
+		wantLabel       string
+	}{
+		{
+			name:      "ISO week mid-week",
+			time:      time.Date(2023, time.March, 2, 15, 0, 0, 0, time.UTC),
+			period:    WeekPeriod,
+			wantStart: time.Date(2023, time.February, 27, 0, 0, 0, 0, time.UTC),
+			wantLabel: "2023-W09 (Feb 27)",
+		},
+		{
+			name:      "ISO week on sunday belongs to the previous monday",
+			time:      time.Date(2023, time.March, 5, 23, 0, 0, 0, time.UTC),
+			period:    WeekPeriod,
+			wantStart: time.Date(2023, time.February, 27, 0, 0, 0, 0, time.UTC),
+			wantLabel: "2023-W09 (Feb 27)",
+		},
+		{
+			name:      "ISO week year differs from calendar year",
+			time:      time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+			period:    WeekPeriod,
+			wantStart: time.Date(2020, time.December, 28, 0, 0, 0, 0, time.UTC),
+			wantLabel: "2020-W53 (Dec 28)",
+		},
+		{
+			name:      "grouped in UTC",
+			time:      time.Date(2023, time.March, 31, 22, 0, 0, 0, time.FixedZone("UTC-5", -5*60*60)),
+			period:    MonthPeriod,
+			wantStart: time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC),
+			wantLabel: "April 2023",
+		},
+		{
+			name:            "calendar quarter",
+			time:            time.Date(2023, time.May, 10, 0, 0, 0, 0, time.UTC),
+			period:          QuarterPeriod,
+			fiscalYearStart: time.January,
+			wantStart:       time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC),
+			wantLabel:       "2023 Q2",
+		},
+		{
+			name:      "unset fiscal year start is a calendar quarter",
+			time:      time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC),
+			period:    QuarterPeriod,
+			wantStart: time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC),
+			wantLabel: "2023 Q4",
+		},
+		{
+			name:            "fiscal quarter named by the year the fiscal year ends",
+			time:            time.Date(2023, time.November, 10, 0, 0, 0, 0, time.UTC),
+			period:          QuarterPeriod,
+			fiscalYearStart: time.October,
+			wantStart:       time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC),
+			wantLabel:       "FY2024 Q1",
+		},
+		{
+			name:            "fiscal quarter before the fiscal year start month",
+			time:            time.Date(2023, time.March, 10, 0, 0, 0, 0, time.UTC),
+			period:          QuarterPeriod,
+			fiscalYearStart: time.April,
+			wantStart:       time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+			wantLabel:       "FY2023 Q4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, label := periodOf(tt.time, tt.period, tt.fiscalYearStart)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantLabel, label)
+		})
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	p, err := ParsePeriod(" Quarter ")
+	require.NoError(t, err)
+	assert.Equal(t, QuarterPeriod, p)
+
+	p, err = ParsePeriod("")
+	require.NoError(t, err)
+	assert.Equal(t, NoPeriod, p)
+
+	_, err = ParsePeriod("fortnight")
+	assert.Error(t, err)
+}
+
+func TestParseMonth(t *testing.T) {
+	for _, value := range []string{"april", "Apr", "4", "04"} {
+		m, err := ParseMonth(value)
+		require.NoError(t, err)
+		assert.Equal(t, time.April, m, value)
+	}
+
+	_, err := ParseMonth("13")
+	assert.Error(t, err)
+}
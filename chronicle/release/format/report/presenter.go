@@ -0,0 +1,161 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+const (
+	reportTemplate = `# {{.Title}}
+
+{{ .Since.Format "2006-01-02" }} to {{ .Until.Format "2006-01-02" }} ({{ len .Changes }} changes)
+
+{{ formatPeriods .Changes }}
+`
+)
+
+var _ presenter.Presenter = (*Presenter)(nil)
+
+// Presenter renders a report as markdown, with a section for each change type that is further grouped by component.
+type Presenter struct {
+	config    Config
+	templater *template.Template
+}
+
+type Config struct {
+	release.Report
+	Title string
+	// ComponentPattern extracts the component from the text of a change via the "component" named capture group
+	// (e.g. "api" from "api: add the thing"). The matched text is removed from the change text. Changes that do not
+	// match are listed before any component.
+	ComponentPattern *regexp.Regexp
+	// GroupBy groups the changes by the period they were made in (e.g. by week), with the change type sections
+	// nested within each period.
+	GroupBy Period
+	// FiscalYearStart is the first month of the fiscal year, used when grouping by quarter (defaults to January).
+	FiscalYearStart time.Month
+}
+
+func NewReportPresenter(config Config) (*Presenter, error) {
+	p := Presenter{
+		config: config,
+	}
+
+	funcMap := template.FuncMap{
+		"formatPeriods": p.formatPeriods,
+	}
+	templater, err := template.New("report").Funcs(funcMap).Parse(reportTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse report presenter template: %w", err)
+	}
+
+	p.templater = templater
+
+	return &p, nil
+}
+
+func (m Presenter) Present(writer io.Writer) error {
+	return m.templater.Execute(writer, m.config)
+}
+
+// formatPeriods renders the change type sections for each period (oldest first), or for all changes when not
+// grouping by period.
+func (m Presenter) formatPeriods(changes change.Changes) string {
+	if m.config.GroupBy == NoPeriod {
+		return m.formatChangeSections(changes, "##")
+	}
+
+	var starts []time.Time
+	labels := make(map[time.Time]string)
+	byPeriod := make(map[time.Time]change.Changes)
+	for _, c := range changes {
+		start, label := periodOf(c.Timestamp, m.config.GroupBy, m.config.FiscalYearStart)
+		if _, ok := byPeriod[start]; !ok {
+			starts = append(starts, start)
+			labels[start] = label
+		}
+		byPeriod[start] = append(byPeriod[start], c)
+	}
+	sort.Slice(starts, func(i, j int) bool {
+		return starts[i].Before(starts[j])
+	})
+
+	var result string
+	for _, start := range starts {
+		result += fmt.Sprintf("## %s\n\n", labels[start])
+		result += m.formatChangeSections(byPeriod[start], "###")
+	}
+	return result
+}
+
+func (m Presenter) formatChangeSections(changes change.Changes, heading string) string {
+	var result string
+	for _, section := range m.config.SupportedChanges {
+		summaries := changes.ByChangeType(section.ChangeType)
+		if len(summaries) > 0 {
+			result += m.formatChangeSection(heading, section.Title, summaries) + "\n"
+		}
+	}
+	return result
+}
+
+func (m Presenter) formatChangeSection(heading, title string, summaries []change.Change) string {
+	result := fmt.Sprintf("%s %s\n\n", heading, title)
+
+	byComponent := make(map[string][]string)
+	for _, summary := range summaries {
+		component, text := m.component(summary.Text)
+		byComponent[component] = append(byComponent[component], formatSummary(text, summary.References))
+	}
+
+	// changes without a component are listed first
+	for _, line := range byComponent[""] {
+		result += line
+	}
+
+	var components []string
+	for component := range byComponent {
+		if component != "" {
+			components = append(components, component)
+		}
+	}
+	sort.Strings(components)
+
+	for _, component := range components {
+		if !strings.HasSuffix(result, "\n\n") {
+			result += "\n"
+		}
+		result += fmt.Sprintf("%s# %s\n\n", heading, component)
+		for _, line := range byComponent[component] {
+			result += line
+		}
+	}
+	return result
+}
+
+// component returns the component of a change (if any) and the change text without the component.
+func (m Presenter) component(text string) (string, string) {
+	return change.Component(m.config.ComponentPattern, text)
+}
+
+func formatSummary(text string, references []change.Reference) string {
+	result := fmt.Sprintf("- %s", text)
+	for _, ref := range references {
+		if ref.URL == "" {
+			result += fmt.Sprintf(" [%s]", ref.Text)
+		} else {
+			result += fmt.Sprintf(" [[%s](%s)]", ref.Text, ref.URL)
+		}
+	}
+	return result + "\n"
+}
@@ -0,0 +1,207 @@
+package report
+
+import (
+	"bytes"
+	"flag"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/go-testutils"
+)
+
+var updateReportPresenterGoldenFiles = flag.Bool("update-report", false, "update the *.golden files for report presenters")
+
+func TestReportPresenter_Present(t *testing.T) {
+	bug := change.NewType("bug", change.SemVerPatch)
+	added := change.NewType("added", change.SemVerMinor)
+	removed := change.NewType("removed", change.SemVerMajor)
+
+	p, err := NewReportPresenter(Config{
+		Title:            "What Changed",
+		ComponentPattern: regexp.MustCompile(`^(?P<component>[\w./-]+):\s+`),
+		Report: release.Report{
+			Since: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+			Until: time.Date(2023, time.March, 8, 0, 0, 0, 0, time.UTC),
+			SupportedChanges: []change.TypeTitle{
+				{
+					ChangeType: bug,
+					Title:      "Bug Fixes",
+				},
+				{
+					ChangeType: added,
+					Title:      "Added Features",
+				},
+				{
+					ChangeType: removed,
+					Title:      "Removed Features",
+				},
+			},
+			Changes: []change.Change{
+				{
+					ChangeTypes: []change.Type{bug},
+					Text:        "ui: redirect cursor hide/show to stderr",
+					References: []change.Reference{
+						{
+							Text: "PR #456",
+							URL:  "https://github.com/anchore/syft/pull/456",
+						},
+					},
+				},
+				{
+					ChangeTypes: []change.Type{bug},
+					Text:        "fix a thing without a component",
+				},
+				{
+					ChangeTypes: []change.Type{added},
+					Text:        "api: added feature",
+				},
+				{
+					ChangeTypes: []change.Type{bug},
+					Text:        "api: fix the api",
+					References: []change.Reference{
+						{
+							Text: "wagoodman",
+						},
+					},
+				},
+				{
+					ChangeTypes: []change.Type{added},
+					Text:        "api: another added feature",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buffer bytes.Buffer
+	assert.NoError(t, p.Present(&buffer))
+	actual := buffer.Bytes()
+
+	if *updateReportPresenterGoldenFiles {
+		testutils.UpdateGoldenFileContents(t, actual)
+	}
+
+	expected := testutils.GetGoldenFileContents(t)
+
+	if !bytes.Equal(expected, actual) {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(string(expected), string(actual), true)
+		t.Errorf("mismatched output:\n%s", dmp.DiffPrettyText(diffs))
+	}
+}
+
+func TestPresenter_component(t *testing.T) {
+	tests := []struct {
+		name          string
+		pattern       *regexp.Regexp
+		text          string
+		wantComponent string
+		wantText      string
+	}{
+		{
+			name:          "no pattern",
+			text:          "api: add the thing",
+			wantComponent: "",
+			wantText:      "api: add the thing",
+		},
+		{
+			name:          "prefix component",
+			pattern:       regexp.MustCompile(`^(?P<component>[\w./-]+):\s+`),
+			text:          "api: add the thing",
+			wantComponent: "api",
+			wantText:      "add the thing",
+		},
+		{
+			name:          "no match",
+			pattern:       regexp.MustCompile(`^(?P<component>[\w./-]+):\s+`),
+			text:          "add the thing",
+			wantComponent: "",
+			wantText:      "add the thing",
+		},
+		{
+			name:          "pattern without component group",
+			pattern:       regexp.MustCompile(`^([\w./-]+):\s+`),
+			text:          "api: add the thing",
+			wantComponent: "",
+			wantText:      "api: add the thing",
+		},
+		{
+			name:          "suffix component",
+			pattern:       regexp.MustCompile(`\s+\((?P<component>\w+)\)$`),
+			text:          "add the thing (cli)",
+			wantComponent: "cli",
+			wantText:      "add the thing",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Presenter{config: Config{ComponentPattern: tt.pattern}}
+			component, text := p.component(tt.text)
+			assert.Equal(t, tt.wantComponent, component)
+			assert.Equal(t, tt.wantText, text)
+		})
+	}
+}
+
+func TestPresenter_formatPeriods(t *testing.T) {
+	bug := change.NewType("bug", change.SemVerPatch)
+	added := change.NewType("added", change.SemVerMinor)
+
+	p := Presenter{config: Config{
+		GroupBy:          MonthPeriod,
+		ComponentPattern: regexp.MustCompile(`^(?P<component>[\w./-]+):\s+`),
+		Report: release.Report{
+			SupportedChanges: []change.TypeTitle{
+				{ChangeType: bug, Title: "Bug Fixes"},
+				{ChangeType: added, Title: "Added Features"},
+			},
+		},
+	}}
+
+	changes := change.Changes{
+		{
+			ChangeTypes: []change.Type{bug},
+			Text:        "api: fix the api",
+			Timestamp:   time.Date(2023, time.April, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ChangeTypes: []change.Type{added},
+			Text:        "add a thing",
+			Timestamp:   time.Date(2023, time.March, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ChangeTypes: []change.Type{bug},
+			Text:        "fix a thing",
+			Timestamp:   time.Date(2023, time.March, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	expected := `## March 2023
+
+### Bug Fixes
+
+- fix a thing
+
+### Added Features
+
+- add a thing
+
+## April 2023
+
+### Bug Fixes
+
+#### api
+
+- fix the api
+
+`
+	assert.Equal(t, expected, p.formatPeriods(changes))
+}
@@ -0,0 +1,106 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"text/template"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+const (
+	retroTemplate = `# {{.Title}}
+
+{{ .Since.Format "2006-01-02" }} to {{ .Until.Format "2006-01-02" }}: {{ len .Releases }} releases with {{ len .Changes }} changes{{ if .Unreleased }} ({{ .Unreleased }} not yet released){{ end }}
+
+{{ formatReleases .Releases }}{{ formatChangesByType .ChangesByType }}{{ formatTopContributors .TopContributors }}`
+)
+
+var _ presenter.Presenter = (*RetroPresenter)(nil)
+
+// RetroPresenter renders a retrospective as markdown, with the releases, the number of changes of each change type,
+// and the top contributors within the period.
+type RetroPresenter struct {
+	config    RetroConfig
+	templater *template.Template
+}
+
+type RetroConfig struct {
+	release.Retro
+	Title string
+}
+
+func NewRetroPresenter(config RetroConfig) (*RetroPresenter, error) {
+	p := RetroPresenter{
+		config: config,
+	}
+
+	funcMap := template.FuncMap{
+		"formatReleases":        p.formatReleases,
+		"formatChangesByType":   formatChangesByType,
+		"formatTopContributors": formatTopContributors,
+	}
+	templater, err := template.New("retro").Funcs(funcMap).Parse(retroTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse retro presenter template: %w", err)
+	}
+
+	p.templater = templater
+
+	return &p, nil
+}
+
+func (m RetroPresenter) Present(writer io.Writer) error {
+	return m.templater.Execute(writer, m.config)
+}
+
+// formatReleases lists the releases (oldest first), marking the biggest release.
+func (m RetroPresenter) formatReleases(releases []release.RetroRelease) string {
+	if len(releases) == 0 {
+		return ""
+	}
+
+	result := "## Releases\n\n| Version | Date | Changes |\n| --- | --- | ---: |\n"
+	for _, r := range releases {
+		version := r.Version
+		if m.config.BiggestRelease != nil && r.Version == m.config.BiggestRelease.Version {
+			version = fmt.Sprintf("**%s** (biggest release)", r.Version)
+		}
+		result += fmt.Sprintf("| %s | %s | %d |\n", version, r.Date.Format("2006-01-02"), r.Changes)
+	}
+	return result + "\n"
+}
+
+func formatChangesByType(counts []release.TypeCount) string {
+	if len(counts) == 0 {
+		return ""
+	}
+
+	result := "## Changes by Type\n\n"
+	for _, c := range counts {
+		result += fmt.Sprintf("- %s: %d\n", c.Title, c.Count)
+	}
+	return result + "\n"
+}
+
+func formatTopContributors(contributors []release.ContributorCount) string {
+	if len(contributors) == 0 {
+		return ""
+	}
+
+	result := "## Top Contributors\n\n"
+	for i, c := range contributors {
+		result += fmt.Sprintf("%d. %s (%d changes)\n", i+1, formatReference(c.Reference), c.Count)
+	}
+	return result + "\n"
+}
+
+func formatReference(ref change.Reference) string {
+	if ref.URL == "" {
+		return ref.Text
+	}
+	return fmt.Sprintf("[%s](%s)", ref.Text, ref.URL)
+}
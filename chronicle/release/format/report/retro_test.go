@@ -0,0 +1,75 @@
+package report
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/go-testutils"
+)
+
+func TestRetroPresenter_Present(t *testing.T) {
+	bug := change.NewType("bug", change.SemVerPatch)
+	added := change.NewType("added", change.SemVerMinor)
+
+	biggest := release.RetroRelease{
+		Release: release.Release{Version: "v1.0.0", Date: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		Changes: 3,
+	}
+
+	p, err := NewRetroPresenter(RetroConfig{
+		Title: "Year in Review",
+		Retro: release.Retro{
+			Since: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Until: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Releases: []release.RetroRelease{
+				biggest,
+				{
+					Release: release.Release{Version: "v1.1.0", Date: time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)},
+					Changes: 1,
+				},
+			},
+			BiggestRelease: &biggest,
+			Changes: []change.Change{
+				{ChangeTypes: []change.Type{bug}, Text: "fix one"},
+				{ChangeTypes: []change.Type{added}, Text: "add one"},
+				{ChangeTypes: []change.Type{bug}, Text: "fix two"},
+				{ChangeTypes: []change.Type{bug}, Text: "fix three"},
+				{ChangeTypes: []change.Type{added}, Text: "add two"},
+			},
+			ChangesByType: []release.TypeCount{
+				{TypeTitle: change.TypeTitle{ChangeType: bug, Title: "Bug Fixes"}, Count: 3},
+				{TypeTitle: change.TypeTitle{ChangeType: added, Title: "Added Features"}, Count: 2},
+			},
+			TopContributors: []release.ContributorCount{
+				{Reference: change.Reference{Text: "@alice", URL: "https://github.com/alice"}, Count: 3},
+				{Reference: change.Reference{Text: "bob"}, Count: 2},
+			},
+			Unreleased: 1,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buffer bytes.Buffer
+	assert.NoError(t, p.Present(&buffer))
+	actual := buffer.Bytes()
+
+	if *updateReportPresenterGoldenFiles {
+		testutils.UpdateGoldenFileContents(t, actual)
+	}
+
+	expected := testutils.GetGoldenFileContents(t)
+
+	if !bytes.Equal(expected, actual) {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(string(expected), string(actual), true)
+		t.Errorf("mismatched output:\n%s", dmp.DiffPrettyText(diffs))
+	}
+}
@@ -0,0 +1,105 @@
+package summary
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+const (
+	// SignificanceSelection selects the changes that would bump the most significant semver field first (breaking
+	// changes, then features, then fixes), keeping the changelog order otherwise (the default).
+	SignificanceSelection = "significance"
+	// SectionSelection selects the changes in the order they appear in the changelog sections.
+	SectionSelection = "sections"
+
+	ellipsis = "…"
+)
+
+// ParseSelection validates the heuristic used to select the highlights of a release.
+func ParseSelection(value string) (string, error) {
+	switch v := strings.ToLower(strings.TrimSpace(value)); v {
+	case "", SignificanceSelection:
+		return SignificanceSelection, nil
+	case SectionSelection:
+		return SectionSelection, nil
+	}
+	return "", fmt.Errorf("unsupported summary selection %q (options: %s, %s)", value, SignificanceSelection, SectionSelection)
+}
+
+var _ presenter.Presenter = (*Presenter)(nil)
+
+// Presenter renders a short excerpt of a release: the text of the top changes, one per line (without any markup or
+// references), for use in release PR titles, commit messages, and announcements.
+type Presenter struct {
+	config Config
+}
+
+type Config struct {
+	release.Description
+	Lines     int    // the maximum number of changes to include
+	Select    string // the heuristic used to pick the changes (see SignificanceSelection and SectionSelection)
+	MaxLength int    // truncate each line to this many characters (0 for no limit)
+}
+
+func NewSummaryPresenter(config Config) (*Presenter, error) {
+	if _, err := ParseSelection(config.Select); err != nil {
+		return nil, err
+	}
+	return &Presenter{
+		config: config,
+	}, nil
+}
+
+func (p Presenter) Present(writer io.Writer) error {
+	for _, c := range Highlights(p.config.Description, p.config.Lines, p.config.Select) {
+		if _, err := fmt.Fprintln(writer, truncate(c.Text, p.config.MaxLength)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Highlights returns (up to) the top N changes of the release, per the given selection heuristic. Only the changes
+// listed within the changelog sections are considered.
+func Highlights(description release.Description, n int, selection string) []change.Change {
+	var candidates []change.Change
+	seen := make(map[int]bool)
+	for _, section := range description.SupportedChanges {
+		for idx, c := range description.Changes {
+			if seen[idx] || !change.ContainsAny([]change.Type{section.ChangeType}, c.ChangeTypes) {
+				continue
+			}
+			seen[idx] = true
+			candidates = append(candidates, c)
+		}
+	}
+
+	if selection != SectionSelection {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return change.Significance(candidates[i:i+1]) > change.Significance(candidates[j:j+1])
+		})
+	}
+
+	if n >= 0 && len(candidates) > n {
+		candidates = candidates[:n]
+	}
+	return candidates
+}
+
+func truncate(text string, maxLength int) string {
+	runes := []rune(strings.TrimSpace(text))
+	if maxLength <= 0 || len(runes) <= maxLength {
+		return string(runes)
+	}
+	if maxLength <= len([]rune(ellipsis)) {
+		return string(runes[:maxLength])
+	}
+	return strings.TrimSpace(string(runes[:maxLength-1])) + ellipsis
+}
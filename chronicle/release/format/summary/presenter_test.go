@@ -0,0 +1,107 @@
+package summary
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+var (
+	bugType      = change.NewType("bug", change.SemVerPatch)
+	addedType    = change.NewType("added", change.SemVerMinor)
+	breakingType = change.NewType("breaking", change.SemVerMajor)
+)
+
+func testDescription() release.Description {
+	return release.Description{
+		SupportedChanges: []change.TypeTitle{
+			{ChangeType: bugType, Title: "Bug Fixes"},
+			{ChangeType: addedType, Title: "Added Features"},
+			{ChangeType: breakingType, Title: "Breaking Changes"},
+		},
+		Changes: []change.Change{
+			{Text: "fix the first thing", ChangeTypes: []change.Type{bugType}},
+			{Text: "add a feature", ChangeTypes: []change.Type{addedType}},
+			{Text: "fix the second thing", ChangeTypes: []change.Type{bugType}},
+			{Text: "remove the old flag", ChangeTypes: []change.Type{breakingType, addedType}},
+			{Text: "not in any section", ChangeTypes: []change.Type{change.UnknownType}},
+		},
+	}
+}
+
+func texts(changes []change.Change) (results []string) {
+	for _, c := range changes {
+		results = append(results, c.Text)
+	}
+	return results
+}
+
+func TestHighlights(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         int
+		selection string
+		want      []string
+	}{
+		{
+			name:      "most significant first",
+			n:         3,
+			selection: SignificanceSelection,
+			want:      []string{"remove the old flag", "add a feature", "fix the first thing"},
+		},
+		{
+			name:      "section order",
+			n:         3,
+			selection: SectionSelection,
+			want:      []string{"fix the first thing", "fix the second thing", "add a feature"},
+		},
+		{
+			name:      "more lines than changes",
+			n:         10,
+			selection: SectionSelection,
+			want:      []string{"fix the first thing", "fix the second thing", "add a feature", "remove the old flag"},
+		},
+		{
+			name:      "no lines",
+			n:         0,
+			selection: SignificanceSelection,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, texts(Highlights(testDescription(), tt.n, tt.selection)))
+		})
+	}
+}
+
+func TestPresenter_Present(t *testing.T) {
+	p, err := NewSummaryPresenter(Config{
+		Description: testDescription(),
+		Lines:       2,
+		Select:      SignificanceSelection,
+		MaxLength:   13,
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, p.Present(&buf))
+	assert.Equal(t, "remove the o…\nadd a feature\n", buf.String())
+}
+
+func TestParseSelection(t *testing.T) {
+	got, err := ParseSelection("")
+	require.NoError(t, err)
+	assert.Equal(t, SignificanceSelection, got)
+
+	got, err = ParseSelection("Sections")
+	require.NoError(t, err)
+	assert.Equal(t, SectionSelection, got)
+
+	_, err = ParseSelection("reactions")
+	require.Error(t, err)
+}
@@ -0,0 +1,104 @@
+package tabular
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+const (
+	CSV = ','
+	TSV = '\t'
+)
+
+// columns are the header of the table (in order).
+var columns = []string{"version", "type", "title", "references", "closed", "authors"}
+
+var _ presenter.Presenter = (*Presenter)(nil)
+
+// Presenter renders the release as a table with a header row followed by one row per change, for loading release
+// data into spreadsheets and BI dashboards. A change with several change types has a row for each change type, and
+// the changes clustered under a change have rows following the row of the change.
+type Presenter struct {
+	config Config
+}
+
+type Config struct {
+	release.Description
+	Delimiter rune // the field separator: CSV (comma) or TSV (tab)
+}
+
+func NewTabularPresenter(config Config) (*Presenter, error) {
+	if config.Delimiter == 0 {
+		config.Delimiter = CSV
+	}
+	return &Presenter{
+		config: config,
+	}, nil
+}
+
+func (p Presenter) Present(writer io.Writer) error {
+	w := csv.NewWriter(writer)
+	w.Comma = p.config.Delimiter
+
+	if err := w.Write(columns); err != nil {
+		return err
+	}
+
+	for _, section := range p.config.SupportedChanges {
+		for _, c := range p.config.Changes.ByChangeType(section.ChangeType) {
+			if err := p.writeRows(w, section.ChangeType.Name, c); err != nil {
+				return err
+			}
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+func (p Presenter) writeRows(w *csv.Writer, changeType string, c change.Change) error {
+	var closed string
+	if !c.Timestamp.IsZero() {
+		closed = c.Timestamp.UTC().Format(time.RFC3339)
+	}
+
+	var refs []string
+	for _, ref := range c.References {
+		if ref.URL != "" {
+			refs = append(refs, ref.URL)
+		} else {
+			refs = append(refs, ref.Text)
+		}
+	}
+
+	var authors []string
+	for _, a := range c.Authors {
+		authors = append(authors, a.Text)
+	}
+
+	err := w.Write([]string{
+		p.config.Version,
+		changeType,
+		c.Text,
+		strings.Join(refs, " "),
+		closed,
+		strings.Join(authors, " "),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, child := range c.Children {
+		if err := p.writeRows(w, changeType, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
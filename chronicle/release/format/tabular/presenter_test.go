@@ -0,0 +1,75 @@
+package tabular
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func testDescription() release.Description {
+	bug := change.NewType("bug", change.SemVerPatch)
+	added := change.NewType("added", change.SemVerMinor)
+
+	return release.Description{
+		SupportedChanges: []change.TypeTitle{
+			{ChangeType: bug, Title: "Bug Fixes"},
+			{ChangeType: added, Title: "Added Features"},
+		},
+		Release: release.Release{
+			Version: "v0.19.1",
+			Date:    time.Date(2021, time.September, 16, 19, 34, 0, 0, time.UTC),
+		},
+		Changes: []change.Change{
+			{
+				ChangeTypes: []change.Type{bug},
+				Text:        "Redirect cursor hide/show to stderr, not stdout",
+				Timestamp:   time.Date(2021, time.September, 15, 10, 0, 0, 0, time.UTC),
+				References: []change.Reference{
+					{Text: "#456", URL: "https://github.com/anchore/syft/pull/456"},
+					{Text: "wagoodman"},
+				},
+				Authors: []change.Reference{{Text: "wagoodman"}},
+			},
+			{
+				ChangeTypes: []change.Type{added},
+				Text:        "Support a new config format",
+				Children: change.Changes{
+					{Text: "the first part", Authors: []change.Reference{{Text: "alice"}, {Text: "bob"}}},
+				},
+			},
+		},
+	}
+}
+
+func TestTabularPresenter_Present(t *testing.T) {
+	p, err := NewTabularPresenter(Config{Description: testDescription()})
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	require.NoError(t, p.Present(&buffer))
+
+	assert.Equal(t, `version,type,title,references,closed,authors
+v0.19.1,bug,"Redirect cursor hide/show to stderr, not stdout",https://github.com/anchore/syft/pull/456 wagoodman,2021-09-15T10:00:00Z,wagoodman
+v0.19.1,added,Support a new config format,,,
+v0.19.1,added,the first part,,,alice bob
+`, buffer.String())
+}
+
+func TestTabularPresenter_Present_tsv(t *testing.T) {
+	p, err := NewTabularPresenter(Config{Description: testDescription(), Delimiter: TSV})
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	require.NoError(t, p.Present(&buffer))
+
+	assert.Equal(t, "version\ttype\ttitle\treferences\tclosed\tauthors\n"+
+		"v0.19.1\tbug\tRedirect cursor hide/show to stderr, not stdout\thttps://github.com/anchore/syft/pull/456 wagoodman\t2021-09-15T10:00:00Z\twagoodman\n"+
+		"v0.19.1\tadded\tSupport a new config format\t\t\t\n"+
+		"v0.19.1\tadded\tthe first part\t\t\talice bob\n", buffer.String())
+}
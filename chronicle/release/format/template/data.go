@@ -0,0 +1,53 @@
+package template
+
+import (
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+// Data is the release model available to user-provided changelog templates (as the template dot).
+type Data struct {
+	release.Description
+	Title string
+}
+
+// Section is a single section of the changelog along with the changes that belong to it.
+type Section struct {
+	ChangeType change.Type
+	Title      string
+	Changes    change.Changes
+}
+
+// Sections returns each non-empty section of the changelog in the configured order.
+func (d Data) Sections() []Section {
+	var sections []Section
+	for _, supported := range d.SupportedChanges {
+		changes := d.Changes.ByChangeType(supported.ChangeType)
+		if len(changes) == 0 {
+			continue
+		}
+		sections = append(sections, Section{
+			ChangeType: supported.ChangeType,
+			Title:      supported.Title,
+			Changes:    changes,
+		})
+	}
+	return sections
+}
+
+// Funcs returns the functions available to user-provided changelog templates (in addition to the text/template
+// builtins).
+func Funcs() texttemplate.FuncMap {
+	return texttemplate.FuncMap{
+		"lower":     strings.ToLower,
+		"upper":     strings.ToUpper,
+		"trim":      strings.TrimSpace,
+		"join":      strings.Join,
+		"replace":   strings.ReplaceAll,
+		"contains":  strings.Contains,
+		"hasPrefix": strings.HasPrefix,
+	}
+}
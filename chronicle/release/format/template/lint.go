@@ -0,0 +1,371 @@
+package template
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template/parse"
+)
+
+// builtins are the functions provided by text/template itself, along with their result type (nil when the result
+// depends on the arguments).
+var builtins = map[string]reflect.Type{
+	"and":      nil,
+	"or":       nil,
+	"not":      reflect.TypeOf(true),
+	"len":      reflect.TypeOf(0),
+	"index":    nil,
+	"slice":    nil,
+	"call":     nil,
+	"print":    reflect.TypeOf(""),
+	"printf":   reflect.TypeOf(""),
+	"println":  reflect.TypeOf(""),
+	"html":     reflect.TypeOf(""),
+	"js":       reflect.TypeOf(""),
+	"urlquery": reflect.TypeOf(""),
+	"eq":       reflect.TypeOf(true),
+	"ne":       reflect.TypeOf(true),
+	"lt":       reflect.TypeOf(true),
+	"le":       reflect.TypeOf(true),
+	"gt":       reflect.TypeOf(true),
+	"ge":       reflect.TypeOf(true),
+}
+
+var parseErrorPattern = regexp.MustCompile(`^template: [^:]*:(\d+):(?:(\d+):)?\s*(.*)$`)
+
+// Problem is a single issue found within a template.
+type Problem struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (p Problem) String() string {
+	if p.Column > 0 {
+		return fmt.Sprintf("%d:%d: %s", p.Line, p.Column, p.Message)
+	}
+	return fmt.Sprintf("%d: %s", p.Line, p.Message)
+}
+
+// Lint parses the given template and checks all referenced fields, methods, functions, and templates against the
+// release model (Data) and the available functions (Funcs), returning any problems found ordered by position.
+func Lint(name, text string) []Problem {
+	l := linter{
+		text:  text,
+		funcs: make(map[string]reflect.Type),
+	}
+	for n, fn := range Funcs() {
+		l.funcs[n] = funcResultType(reflect.TypeOf(fn))
+	}
+	for n, t := range builtins {
+		l.funcs[n] = t
+	}
+
+	treeSet := make(map[string]*parse.Tree)
+	tree := parse.New(name)
+	// unknown functions are reported by the linter (with all other problems) instead of failing the parse
+	tree.Mode = parse.SkipFuncCheck
+	if _, err := tree.Parse(text, "", "", treeSet); err != nil {
+		return []Problem{parseProblem(err)}
+	}
+
+	l.trees = treeSet
+
+	var names []string
+	for n := range treeSet {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	for _, n := range names {
+		t := treeSet[n]
+		if t.Root == nil {
+			continue
+		}
+		// only the main template is known to be executed with the release model, other templates may be invoked
+		// with any value
+		var dot reflect.Type
+		if n == name {
+			dot = reflect.TypeOf(Data{})
+		}
+		l.vars = []variable{{name: "$", typ: dot}}
+		l.walk(t.Root, dot)
+	}
+
+	sort.SliceStable(l.problems, func(i, j int) bool {
+		if l.problems[i].Line != l.problems[j].Line {
+			return l.problems[i].Line < l.problems[j].Line
+		}
+		return l.problems[i].Column < l.problems[j].Column
+	})
+
+	return l.problems
+}
+
+func parseProblem(err error) Problem {
+	match := parseErrorPattern.FindStringSubmatch(err.Error())
+	if match == nil {
+		return Problem{Line: 1, Message: err.Error()}
+	}
+	line, _ := strconv.Atoi(match[1])
+	col, _ := strconv.Atoi(match[2])
+	return Problem{Line: line, Column: col, Message: match[3]}
+}
+
+type variable struct {
+	name string
+	typ  reflect.Type // nil when the type is unknown
+}
+
+type linter struct {
+	text     string
+	funcs    map[string]reflect.Type
+	trees    map[string]*parse.Tree
+	vars     []variable
+	problems []Problem
+}
+
+func (l *linter) report(node parse.Node, format string, args ...interface{}) {
+	pos := int(node.Position())
+	if pos > len(l.text) {
+		pos = len(l.text)
+	}
+	before := l.text[:pos]
+	l.problems = append(l.problems, Problem{
+		Line:    1 + strings.Count(before, "\n"),
+		Column:  pos - strings.LastIndex(before, "\n"),
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *linter) walk(node parse.Node, dot reflect.Type) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			l.walk(child, dot)
+		}
+	case *parse.ActionNode:
+		l.pipe(n.Pipe, dot, false)
+	case *parse.IfNode:
+		mark := len(l.vars)
+		l.pipe(n.Pipe, dot, false)
+		l.walk(n.List, dot)
+		l.walk(n.ElseList, dot)
+		l.vars = l.vars[:mark]
+	case *parse.WithNode:
+		mark := len(l.vars)
+		t := l.pipe(n.Pipe, dot, false)
+		l.walk(n.List, t)
+		l.vars = l.vars[:mark]
+		l.walk(n.ElseList, dot)
+	case *parse.RangeNode:
+		mark := len(l.vars)
+		elem := l.pipe(n.Pipe, dot, true)
+		l.walk(n.List, elem)
+		l.vars = l.vars[:mark]
+		l.walk(n.ElseList, dot)
+	case *parse.TemplateNode:
+		if _, ok := l.trees[n.Name]; !ok {
+			l.report(n, "template %q not defined", n.Name)
+		}
+		if n.Pipe != nil {
+			l.pipe(n.Pipe, dot, false)
+		}
+	}
+}
+
+// pipe checks the given pipeline, declaring any variables, and returns the type of the result (or the element type
+// when ranging).
+func (l *linter) pipe(pipe *parse.PipeNode, dot reflect.Type, isRange bool) reflect.Type {
+	var result reflect.Type
+	for i, cmd := range pipe.Cmds {
+		var prev reflect.Type
+		if i > 0 {
+			prev = result
+		}
+		result = l.command(cmd, dot, prev)
+	}
+
+	if !isRange {
+		for _, v := range pipe.Decl {
+			l.declare(v, result)
+		}
+		return result
+	}
+
+	key, elem := rangeTypes(result)
+	switch len(pipe.Decl) {
+	case 1:
+		l.declare(pipe.Decl[0], elem)
+	case 2:
+		l.declare(pipe.Decl[0], key)
+		l.declare(pipe.Decl[1], elem)
+	}
+	return elem
+}
+
+func (l *linter) declare(v *parse.VariableNode, t reflect.Type) {
+	if len(v.Ident) == 0 {
+		return
+	}
+	// note: assignment to an existing variable (with "=") shadows the existing variable type, which is fine for linting
+	l.vars = append(l.vars, variable{name: v.Ident[0], typ: t})
+}
+
+func (l *linter) lookupVar(name string) (reflect.Type, bool) {
+	for i := len(l.vars) - 1; i >= 0; i-- {
+		if l.vars[i].name == name {
+			return l.vars[i].typ, true
+		}
+	}
+	return nil, false
+}
+
+func (l *linter) command(cmd *parse.CommandNode, dot, prev reflect.Type) reflect.Type {
+	if len(cmd.Args) == 0 {
+		return nil
+	}
+
+	// all arguments are checked regardless of the command
+	for _, arg := range cmd.Args[1:] {
+		l.arg(arg, dot)
+	}
+
+	first := cmd.Args[0]
+	if ident, ok := first.(*parse.IdentifierNode); ok {
+		t, known := l.funcs[ident.Ident]
+		if !known {
+			l.report(ident, "function %q not defined", ident.Ident)
+			return nil
+		}
+		return t
+	}
+	return l.arg(first, dot)
+}
+
+func (l *linter) arg(node parse.Node, dot reflect.Type) reflect.Type {
+	switch n := node.(type) {
+	case *parse.DotNode:
+		return dot
+	case *parse.FieldNode:
+		return l.fields(n, dot, n.Ident)
+	case *parse.VariableNode:
+		t, ok := l.lookupVar(n.Ident[0])
+		if !ok {
+			// the parser already rejects undefined variables, so this is only for completeness
+			l.report(n, "undefined variable %q", n.Ident[0])
+			return nil
+		}
+		return l.fields(n, t, n.Ident[1:])
+	case *parse.ChainNode:
+		return l.fields(n, l.arg(n.Node, dot), n.Field)
+	case *parse.PipeNode:
+		mark := len(l.vars)
+		t := l.pipe(n, dot, false)
+		l.vars = l.vars[:mark]
+		return t
+	case *parse.IdentifierNode:
+		t, known := l.funcs[n.Ident]
+		if !known {
+			l.report(n, "function %q not defined", n.Ident)
+			return nil
+		}
+		return t
+	case *parse.StringNode:
+		return reflect.TypeOf("")
+	case *parse.BoolNode:
+		return reflect.TypeOf(true)
+	case *parse.NumberNode:
+		switch {
+		case n.IsInt:
+			return reflect.TypeOf(0)
+		case n.IsFloat:
+			return reflect.TypeOf(0.0)
+		}
+	}
+	return nil
+}
+
+// fields resolves each field (or method) in turn starting from the given type, reporting the first that does not exist.
+func (l *linter) fields(node parse.Node, t reflect.Type, names []string) reflect.Type {
+	for _, name := range names {
+		if t == nil {
+			// the type is not known (e.g. an interface{} value), so nothing further can be checked
+			return nil
+		}
+		next, ok := resolveField(t, name)
+		if !ok {
+			l.report(node, "can't evaluate field %q in type %s", name, t)
+			return nil
+		}
+		t = next
+	}
+	return t
+}
+
+// resolveField returns the type of the given field or method on the given type (nil if the type cannot be known).
+func resolveField(t reflect.Type, name string) (reflect.Type, bool) {
+	if m, ok := t.MethodByName(name); ok {
+		return funcResultType(m.Type), true
+	}
+	if t.Kind() != reflect.Ptr && t.Kind() != reflect.Interface {
+		if m, ok := reflect.PtrTo(t).MethodByName(name); ok {
+			return funcResultType(m.Type), true
+		}
+	}
+
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		f, ok := t.FieldByName(name)
+		if !ok || f.PkgPath != "" {
+			return nil, false
+		}
+		return f.Type, true
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, false
+		}
+		return t.Elem(), true
+	case reflect.Interface:
+		return nil, true
+	}
+	return nil, false
+}
+
+func funcResultType(fn reflect.Type) reflect.Type {
+	if fn == nil || fn.Kind() != reflect.Func || fn.NumOut() == 0 {
+		return nil
+	}
+	return fn.Out(0)
+}
+
+// rangeTypes returns the key and element types when ranging over a value of the given type.
+func rangeTypes(t reflect.Type) (reflect.Type, reflect.Type) {
+	if t == nil {
+		return nil, nil
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		return reflect.TypeOf(0), t.Elem()
+	case reflect.Map:
+		return t.Key(), t.Elem()
+	case reflect.Chan:
+		return nil, t.Elem()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return t, t
+	}
+	return nil, nil
+}
@@ -0,0 +1,82 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     []Problem
+	}{
+		{
+			name: "valid template",
+			template: `# {{ .Title }}
+
+## [{{ .Version }}]({{ .VCSReferenceURL }}) ({{ .Date.Format "2006-01-02" }})
+{{ range .Sections }}
+### {{ .Title | upper }}
+{{ range $i, $c := .Changes }}
+- {{ $c.Text }}{{ range .References }} [{{ .Text }}]({{ .URL }}){{ end }} {{ $.Version }} {{ $i }}
+{{- end }}
+{{ end }}
+{{ with .Notice }}{{ trim . }}{{ end }}
+{{ range .Changes }}{{ .Entry.Anything.Goes }}{{ end }}
+{{ len (.Changes.ByChangeType) }}
+{{ template "footer" . }}
+{{ define "footer" }}{{ .Whatever }}{{ end }}`,
+		},
+		{
+			name:     "unknown field",
+			template: "# {{ .Title }}\n\n{{ .Verison }}",
+			want: []Problem{
+				{Line: 3, Column: 4, Message: `can't evaluate field "Verison" in type template.Data`},
+			},
+		},
+		{
+			name:     "unknown nested field",
+			template: "{{ range .Sections }}\n{{ range .Changes }}{{ .Title }}{{ end }}{{ end }}",
+			want: []Problem{
+				{Line: 2, Column: 24, Message: `can't evaluate field "Title" in type change.Change`},
+			},
+		},
+		{
+			name:     "unknown field on variable",
+			template: "{{ $d := .Date }}{{ $d.Nope }} {{ $.Nope }}",
+			want: []Problem{
+				{Line: 1, Column: 23, Message: `can't evaluate field "Nope" in type time.Time`},
+				{Line: 1, Column: 36, Message: `can't evaluate field "Nope" in type template.Data`},
+			},
+		},
+		{
+			name:     "unknown functions",
+			template: "{{ .Title | shout }}\n{{ if nope .Version }}{{ end }}",
+			want: []Problem{
+				{Line: 1, Column: 13, Message: `function "shout" not defined`},
+				{Line: 2, Column: 7, Message: `function "nope" not defined`},
+			},
+		},
+		{
+			name:     "unknown template",
+			template: `{{ template "missing" . }}`,
+			want: []Problem{
+				{Line: 1, Column: 13, Message: `template "missing" not defined`},
+			},
+		},
+		{
+			name:     "syntax error",
+			template: "{{ .Title }}\n{{ if .Version }}",
+			want: []Problem{
+				{Line: 2, Message: "unexpected EOF"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Lint("test.tmpl", tt.template))
+		})
+	}
+}
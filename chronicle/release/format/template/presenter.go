@@ -0,0 +1,38 @@
+package template
+
+import (
+	"fmt"
+	"io"
+	texttemplate "text/template"
+
+	"github.com/wagoodman/go-presenter"
+)
+
+var _ presenter.Presenter = (*Presenter)(nil)
+
+// Presenter renders the release through a user-provided changelog template, with the release model (Data) as the
+// template dot and the functions from Funcs available.
+type Presenter struct {
+	data      Data
+	templater *texttemplate.Template
+}
+
+// NewTemplatePresenter parses the given template text (the name is used within any errors, e.g. the template path).
+func NewTemplatePresenter(name, text string, data Data) (*Presenter, error) {
+	templater, err := texttemplate.New(name).Funcs(Funcs()).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse changelog template: %w", err)
+	}
+
+	return &Presenter{
+		data:      data,
+		templater: templater,
+	}, nil
+}
+
+func (m Presenter) Present(writer io.Writer) error {
+	if err := m.templater.Execute(writer, m.data); err != nil {
+		return fmt.Errorf("unable to render changelog template: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package template
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func TestTemplatePresenter_Present(t *testing.T) {
+	bug := change.NewType("bug", change.SemVerPatch)
+	added := change.NewType("added", change.SemVerMinor)
+
+	data := Data{
+		Title: "Changelog",
+		Description: release.Description{
+			Release: release.Release{
+				Version: "v0.4.1",
+				Date:    time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+			},
+			SupportedChanges: []change.TypeTitle{
+				{ChangeType: bug, Title: "Bug Fixes"},
+				{ChangeType: added, Title: "Added Features"},
+			},
+			Changes: []change.Change{
+				{
+					Text:        "fix the thing",
+					ChangeTypes: []change.Type{bug},
+					References:  []change.Reference{{Text: "#12", URL: "https://github.com/anchore/chronicle/pull/12"}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name: "sections and functions",
+			template: `{{ .Title | upper }} {{ .Version }} ({{ .Date.Format "2006-01-02" }})
+{{ range .Sections }}[{{ .Title }}]
+{{ range .Changes }}* {{ .Text }}{{ range .References }} {{ .URL }}{{ end }}
+{{ end }}{{ end }}`,
+			want: `CHANGELOG v0.4.1 (2023-03-01)
+[Bug Fixes]
+* fix the thing https://github.com/anchore/chronicle/pull/12
+`,
+		},
+		{
+			name:     "parse error",
+			template: "{{ .Title ",
+			wantErr:  require.Error,
+		},
+		{
+			name:     "unknown field",
+			template: "{{ .Verison }}",
+			wantErr:  require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+
+			var buf bytes.Buffer
+			p, err := NewTemplatePresenter("changelog.tmpl", tt.template, data)
+			if err == nil {
+				err = p.Present(&buf)
+			}
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
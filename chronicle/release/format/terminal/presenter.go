@@ -0,0 +1,135 @@
+package terminal
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+// ANSI SGR escape sequences
+const (
+	reset   = "\x1b[0m"
+	bold    = "\x1b[1m"
+	dim     = "\x1b[2m"
+	red     = "\x1b[31m"
+	green   = "\x1b[32m"
+	yellow  = "\x1b[33m"
+	blue    = "\x1b[34m"
+	magenta = "\x1b[35m"
+	cyan    = "\x1b[36m"
+)
+
+var _ presenter.Presenter = (*Presenter)(nil)
+
+// Presenter renders the release for previewing within a terminal: each change type is color-coded by its category of
+// change, references are dimmed, and URLs are emitted as OSC 8 hyperlinks (so the reference text is clickable in
+// terminals that support them). Without color the same layout is rendered as plain text.
+type Presenter struct {
+	config Config
+}
+
+type Config struct {
+	release.Description
+	Title string
+	Color bool // emit ANSI escape sequences (typically only when writing to a terminal)
+}
+
+func NewTerminalPresenter(config Config) (*Presenter, error) {
+	return &Presenter{
+		config: config,
+	}, nil
+}
+
+func (p Presenter) Present(writer io.Writer) error {
+	var sb strings.Builder
+
+	title := strings.TrimSpace(fmt.Sprintf("%s %s", p.config.Title, p.config.Version))
+	sb.WriteString(p.style(bold, title))
+	if !p.config.Date.IsZero() {
+		sb.WriteString(p.style(dim, fmt.Sprintf(" (%s)", p.config.Date.Format("2006-01-02"))))
+	}
+	sb.WriteString("\n")
+
+	if url := p.config.VCSChangesURL; url != "" {
+		if p.config.Color {
+			sb.WriteString(p.style(dim, p.link("Full Changelog", url)) + "\n")
+		} else {
+			sb.WriteString("Full Changelog: " + url + "\n")
+		}
+	}
+
+	if p.config.Notice != "" {
+		sb.WriteString("\n" + p.config.Notice + "\n")
+	}
+
+	for _, section := range p.config.SupportedChanges {
+		summaries := p.config.Changes.ByChangeType(section.ChangeType)
+		if len(summaries) == 0 {
+			continue
+		}
+
+		sb.WriteString("\n" + p.style(bold+categoryColor(change.CategoryOf(section.ChangeType)), section.Title) + "\n")
+		for _, summary := range summaries {
+			sb.WriteString(p.entryLines(summary, "  "))
+		}
+	}
+
+	_, err := io.WriteString(writer, sb.String())
+	return err
+}
+
+func (p Presenter) entryLines(c change.Change, indent string) string {
+	line := fmt.Sprintf("%s• %s", indent, c.Text)
+
+	var refs []string
+	for _, ref := range c.References {
+		refs = append(refs, p.link(ref.Text, ref.URL))
+	}
+	if len(refs) > 0 {
+		line += " " + p.style(dim, strings.Join(refs, " "))
+	}
+	line += "\n"
+
+	// clustered changes are listed as nested bullets of the parent change
+	for _, child := range c.Children {
+		line += p.entryLines(child, indent+"  ")
+	}
+	return line
+}
+
+// style wraps the text with the given escape sequence (when color is enabled).
+func (p Presenter) style(sequence, text string) string {
+	if !p.config.Color || text == "" {
+		return text
+	}
+	return sequence + text + reset
+}
+
+// link makes the text a hyperlink to the given URL using the OSC 8 escape sequence (when color is enabled).
+func (p Presenter) link(text, url string) string {
+	if !p.config.Color || url == "" {
+		return text
+	}
+	return fmt.Sprintf("\x1b]8;;%s\x1b\\%s\x1b]8;;\x1b\\", url, text)
+}
+
+func categoryColor(c change.Category) string {
+	switch c {
+	case change.AddedCategory:
+		return green
+	case change.DeprecatedCategory:
+		return yellow
+	case change.RemovedCategory:
+		return magenta
+	case change.FixedCategory:
+		return cyan
+	case change.SecurityCategory:
+		return red
+	}
+	return blue
+}
@@ -0,0 +1,89 @@
+package terminal
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/go-testutils"
+)
+
+var updateTerminalPresenterGoldenFiles = flag.Bool("update-terminal", false, "update the *.golden files for terminal presenters")
+
+func testDescription() release.Description {
+	bug := change.NewType("bug", change.SemVerPatch)
+	added := change.NewType("added", change.SemVerMinor)
+
+	return release.Description{
+		SupportedChanges: []change.TypeTitle{
+			{ChangeType: bug, Title: "Bug Fixes"},
+			{ChangeType: added, Title: "Added Features"},
+		},
+		Release: release.Release{
+			Version: "v0.19.1",
+			Date:    time.Date(2021, time.September, 16, 19, 34, 0, 0, time.UTC),
+		},
+		VCSChangesURL: "https://github.com/anchore/syft/compare/v0.19.0...v0.19.1",
+		Changes: []change.Change{
+			{
+				ChangeTypes: []change.Type{bug},
+				Text:        "Redirect cursor hide/show to stderr",
+				References: []change.Reference{
+					{Text: "#456", URL: "https://github.com/anchore/syft/pull/456"},
+					{Text: "wagoodman"},
+				},
+			},
+			{
+				ChangeTypes: []change.Type{added},
+				Text:        "Support a new config format",
+				Children: change.Changes{
+					{Text: "the first part"},
+				},
+			},
+		},
+	}
+}
+
+func TestTerminalPresenter_Present(t *testing.T) {
+	assertPresenterAgainstGoldenSnapshot(t, Config{Title: "Changelog", Description: testDescription()})
+}
+
+func TestTerminalPresenter_Present_color(t *testing.T) {
+	assertPresenterAgainstGoldenSnapshot(t, Config{Title: "Changelog", Description: testDescription(), Color: true})
+}
+
+func assertPresenterAgainstGoldenSnapshot(t *testing.T, config Config) {
+	t.Helper()
+
+	p, err := NewTerminalPresenter(config)
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	require.NoError(t, p.Present(&buffer))
+	actual := buffer.Bytes()
+
+	if *updateTerminalPresenterGoldenFiles {
+		testutils.UpdateGoldenFileContents(t, actual)
+	}
+
+	expected := testutils.GetGoldenFileContents(t)
+
+	if !bytes.Equal(expected, actual) {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(string(expected), string(actual), true)
+		t.Errorf("mismatched output:\n%s", dmp.DiffPrettyText(diffs))
+	}
+}
+
+func TestPresenter_link(t *testing.T) {
+	assert.Equal(t, "#1", Presenter{}.link("#1", "https://example.com/1"))
+	assert.Equal(t, "\x1b]8;;https://example.com/1\x1b\\#1\x1b]8;;\x1b\\", Presenter{config: Config{Color: true}}.link("#1", "https://example.com/1"))
+	assert.Equal(t, "#1", Presenter{config: Config{Color: true}}.link("#1", ""))
+}
@@ -0,0 +1,142 @@
+package terraform
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+// Section is one of the sections of a release within the changelog of a terraform provider.
+type Section string
+
+const (
+	BreakingChangesSection Section = "BREAKING CHANGES"
+	NotesSection           Section = "NOTES"
+	FeaturesSection        Section = "FEATURES"
+	EnhancementsSection    Section = "ENHANCEMENTS"
+	BugFixesSection        Section = "BUG FIXES"
+)
+
+// Sections are all sections of a release, in the order used by the hashicorp providers.
+var Sections = []Section{
+	BreakingChangesSection,
+	NotesSection,
+	FeaturesSection,
+	EnhancementsSection,
+	BugFixesSection,
+}
+
+var _ presenter.Presenter = (*Presenter)(nil)
+
+// Presenter renders the release following the changelog conventions of terraform providers (as used by the hashicorp
+// providers and expected by the Terraform Registry): a "## x.y.z (Month D, YYYY)" version header followed by the
+// BREAKING CHANGES, NOTES, FEATURES, ENHANCEMENTS, and BUG FIXES sections (mapped from the change types, see
+// SectionOf), each with a "* " entry per change.
+type Presenter struct {
+	description release.Description
+}
+
+func NewTerraformPresenter(description release.Description) (*Presenter, error) {
+	return &Presenter{
+		description: description,
+	}, nil
+}
+
+func (m Presenter) Present(writer io.Writer) error {
+	_, err := io.WriteString(writer, m.render())
+	return err
+}
+
+func (m Presenter) render() string {
+	var sb strings.Builder
+	if m.description.Version == "" || m.description.Version == release.UnreleasedVersion {
+		sb.WriteString("## Unreleased\n\n")
+	} else {
+		fmt.Fprintf(&sb, "## %s (%s)\n\n", strings.TrimPrefix(m.description.Version, "v"), m.description.Date.Format("January 2, 2006"))
+	}
+
+	bySection := m.changesBySection()
+	for _, section := range Sections {
+		changes := bySection[section]
+		if len(changes) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "%s:\n\n", section)
+		for _, c := range changes {
+			writeEntry(&sb, c, "")
+		}
+		sb.WriteString("\n")
+	}
+
+	return strings.TrimRight(sb.String(), "\n") + "\n"
+}
+
+// changesBySection groups the changes by section. Each change is listed once, within the section of the first change
+// type it belongs to.
+func (m Presenter) changesBySection() map[Section]change.Changes {
+	results := make(map[Section]change.Changes)
+	seen := make(map[int]bool)
+	for _, supported := range m.description.SupportedChanges {
+		section := SectionOf(supported.ChangeType)
+		for idx, c := range m.description.Changes {
+			if seen[idx] || !change.ContainsAny([]change.Type{supported.ChangeType}, c.ChangeTypes) {
+				continue
+			}
+			seen[idx] = true
+			results[section] = append(results[section], c)
+		}
+	}
+	return results
+}
+
+// SectionOf maps a change type onto a section of a terraform provider release: breaking changes and removals are
+// BREAKING CHANGES, deprecations are NOTES, enhancements and improvements are ENHANCEMENTS, and otherwise the category
+// of change decides (see change.CategoryOf).
+func SectionOf(t change.Type) Section {
+	name := strings.ToLower(t.Name)
+	if strings.Contains(name, "enhance") || strings.Contains(name, "improve") {
+		return EnhancementsSection
+	}
+
+	switch change.CategoryOf(t) {
+	case change.RemovedCategory:
+		return BreakingChangesSection
+	case change.DeprecatedCategory:
+		return NotesSection
+	case change.AddedCategory:
+		return FeaturesSection
+	case change.FixedCategory, change.SecurityCategory:
+		return BugFixesSection
+	}
+
+	if strings.Contains(name, "break") || t.Kind == change.SemVerMajor {
+		return BreakingChangesSection
+	}
+	return EnhancementsSection
+}
+
+func writeEntry(sb *strings.Builder, c change.Change, indent string) {
+	fmt.Fprintf(sb, "%s* %s", indent, c.Text)
+
+	var refs []string
+	for _, ref := range c.References {
+		if ref.URL == "" {
+			refs = append(refs, ref.Text)
+		} else {
+			refs = append(refs, fmt.Sprintf("[%s](%s)", ref.Text, ref.URL))
+		}
+	}
+	if len(refs) > 0 {
+		fmt.Fprintf(sb, " (%s)", strings.Join(refs, ", "))
+	}
+	sb.WriteString("\n")
+
+	for _, child := range c.Children {
+		writeEntry(sb, child, indent+"  ")
+	}
+}
@@ -0,0 +1,103 @@
+package terraform
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/go-testutils"
+)
+
+var updateTerraformPresenterGoldenFiles = flag.Bool("update-terraform", false, "update the *.golden files for terraform presenters")
+
+func TestTerraformPresenter_Present(t *testing.T) {
+	bug := change.NewType("bug-fix", change.SemVerPatch)
+	added := change.NewType("added-feature", change.SemVerMinor)
+	breaking := change.NewType("breaking-feature", change.SemVerMajor)
+	enhancement := change.NewType("enhancement", change.SemVerMinor)
+	deprecated := change.NewType("deprecated-feature", change.SemVerPatch)
+
+	p, err := NewTerraformPresenter(release.Description{
+		Release: release.Release{
+			Version: "v0.19.1",
+			Date:    time.Date(2021, time.September, 16, 19, 34, 0, 0, time.UTC),
+		},
+		SupportedChanges: []change.TypeTitle{
+			{ChangeType: breaking, Title: "Breaking Changes"},
+			{ChangeType: added, Title: "Added Features"},
+			{ChangeType: enhancement, Title: "Enhancements"},
+			{ChangeType: bug, Title: "Bug Fixes"},
+			{ChangeType: deprecated, Title: "Deprecated Features"},
+		},
+		Changes: []change.Change{
+			{
+				ChangeTypes: []change.Type{bug},
+				Text:        "resource/syft_sbom: Redirect cursor hide/show to stderr",
+				References: []change.Reference{
+					{Text: "#456", URL: "https://github.com/anchore/syft/pull/456"},
+					{Text: "wagoodman"},
+				},
+			},
+			{
+				ChangeTypes: []change.Type{added, breaking},
+				Text:        "Support a new config format",
+				Children: change.Changes{
+					{Text: "the first part"},
+				},
+			},
+			{
+				ChangeTypes: []change.Type{enhancement},
+				Text:        "data-source/syft_sbom: Add the `format` argument",
+			},
+			{
+				ChangeTypes: []change.Type{deprecated},
+				Text:        "The `legacy` attribute is deprecated",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	assert.NoError(t, p.Present(&buffer))
+	actual := buffer.Bytes()
+
+	if *updateTerraformPresenterGoldenFiles {
+		testutils.UpdateGoldenFileContents(t, actual)
+	}
+
+	expected := testutils.GetGoldenFileContents(t)
+
+	if !bytes.Equal(expected, actual) {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(string(expected), string(actual), true)
+		t.Errorf("mismatched output:\n%s", dmp.DiffPrettyText(diffs))
+	}
+}
+
+func TestSectionOf(t *testing.T) {
+	tests := []struct {
+		changeType change.Type
+		want       Section
+	}{
+		{changeType: change.NewType("breaking-feature", change.SemVerMajor), want: BreakingChangesSection},
+		{changeType: change.NewType("removed-feature", change.SemVerMajor), want: BreakingChangesSection},
+		{changeType: change.NewType("deprecated-feature", change.SemVerMinor), want: NotesSection},
+		{changeType: change.NewType("added-feature", change.SemVerMinor), want: FeaturesSection},
+		{changeType: change.NewType("enhancement", change.SemVerMinor), want: EnhancementsSection},
+		{changeType: change.NewType("bug-fix", change.SemVerPatch), want: BugFixesSection},
+		{changeType: change.NewType("security-fixes", change.SemVerPatch), want: BugFixesSection},
+		{changeType: change.NewType("unknown", change.SemVerUnknown), want: EnhancementsSection},
+	}
+	for _, tt := range tests {
+		t.Run(tt.changeType.Name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SectionOf(tt.changeType))
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package index
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Entry is a single published release within the index.
+type Entry struct {
+	Version string    `json:"version"`
+	Date    time.Time `json:"date"`
+	URL     string    `json:"url,omitempty"`
+	Content string    `json:"content,omitempty"` // the release notes as an HTML fragment (only recorded for feeds)
+}
+
+// Index is the record of all published releases, newest first. The record is persisted as JSON so that the rendered
+// index can be regenerated in full on each run.
+type Index struct {
+	Entries []Entry `json:"releases"`
+}
+
+// Load reads the index record at the given path. A missing record results in an empty index.
+func Load(path string) (*Index, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &Index{}, nil
+		}
+		return nil, fmt.Errorf("unable to read release index %q: %w", path, err)
+	}
+
+	var idx Index
+	if err := json.Unmarshal(contents, &idx); err != nil {
+		return nil, fmt.Errorf("unable to parse release index %q: %w", path, err)
+	}
+	return &idx, nil
+}
+
+// Save writes the index record to the given path.
+func (i Index) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to write release index %q: %w", path, err)
+	}
+	defer f.Close()
+	return i.encode(f)
+}
+
+func (i Index) encode(writer io.Writer) error {
+	enc := json.NewEncoder(writer)
+	enc.SetIndent("", "  ")
+	return enc.Encode(i)
+}
+
+// Add records the given release, replacing any existing entry for the same version (so re-running for a release
+// updates it in place).
+func (i *Index) Add(entry Entry) {
+	var entries []Entry
+	for _, e := range i.Entries {
+		if e.Version != entry.Version {
+			entries = append(entries, e)
+		}
+	}
+	entries = append(entries, entry)
+
+	sort.SliceStable(entries, func(a, b int) bool {
+		return entries[a].Date.After(entries[b].Date)
+	})
+
+	i.Entries = entries
+}
+
+// RecordPath returns where the index record is kept for the given rendered index path (e.g. "releases/index.json" for
+// "releases/index.html"). A JSON index is its own record.
+func RecordPath(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + ".json"
+}
+
+// Update adds the given release to the index record for the rendered index at the given path, then regenerates the
+// rendered index from the complete record.
+func Update(path, title string, entry Entry) error {
+	recordPath := RecordPath(path)
+
+	idx, err := Load(recordPath)
+	if err != nil {
+		return err
+	}
+
+	idx.Add(entry)
+
+	if err := idx.Save(recordPath); err != nil {
+		return err
+	}
+
+	if recordPath == path {
+		return nil
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to write release index %q: %w", path, err)
+	}
+	defer f.Close()
+
+	return idx.Render(f, path, title)
+}
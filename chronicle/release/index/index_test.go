@@ -0,0 +1,127 @@
+package index
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex_Add(t *testing.T) {
+	idx := Index{}
+	idx.Add(Entry{Version: "v0.1.0", Date: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)})
+	idx.Add(Entry{Version: "v0.3.0", Date: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)})
+	idx.Add(Entry{Version: "v0.2.0", Date: time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)})
+	// re-running for an existing release replaces it
+	idx.Add(Entry{Version: "v0.2.0", Date: time.Date(2023, time.February, 2, 0, 0, 0, 0, time.UTC), URL: "v0.2.0.html"})
+
+	assert.Equal(t, []Entry{
+		{Version: "v0.3.0", Date: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{Version: "v0.2.0", Date: time.Date(2023, time.February, 2, 0, 0, 0, 0, time.UTC), URL: "v0.2.0.html"},
+		{Version: "v0.1.0", Date: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}, idx.Entries)
+}
+
+func TestIndex_Render(t *testing.T) {
+	idx := Index{
+		Entries: []Entry{
+			{Version: "v0.2.0", Date: time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC), URL: "v0.2.0.html", Content: "<p>the notes</p>"},
+			{Version: "v0.1.0", Date: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{
+			path: "index.md",
+			want: `# Releases
+
+- [v0.2.0](v0.2.0.html) (2023-02-01)
+- v0.1.0 (2023-01-01)
+`,
+		},
+		{
+			path: "index.html",
+			want: `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>Releases</title>
+</head>
+<body>
+  <h1>Releases</h1>
+  <ul>
+    <li><a href="v0.2.0.html">v0.2.0</a> <time datetime="2023-02-01">2023-02-01</time></li>
+    <li>v0.1.0 <time datetime="2023-01-01">2023-01-01</time></li>
+  </ul>
+</body>
+</html>
+`,
+		},
+		{
+			path: "index.atom",
+			want: `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Releases</title>
+  <id>urn:chronicle:index:index.atom</id>
+  <updated>2023-02-01T00:00:00Z</updated>
+  <entry>
+    <title>v0.2.0</title>
+    <id>v0.2.0.html</id>
+    <updated>2023-02-01T00:00:00Z</updated>
+    <link href="v0.2.0.html" rel="alternate"></link>
+    <content type="html">&lt;p&gt;the notes&lt;/p&gt;</content>
+  </entry>
+  <entry>
+    <title>v0.1.0</title>
+    <id>urn:chronicle:release:v0.1.0</id>
+    <updated>2023-01-01T00:00:00Z</updated>
+    <content type="html"></content>
+  </entry>
+</feed>
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, idx.Render(&buf, tt.path, "Releases"))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "index.md")
+
+	require.NoError(t, Update(path, "Releases", Entry{Version: "v0.1.0", Date: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)}))
+	require.NoError(t, Update(path, "Releases", Entry{Version: "v0.2.0", Date: time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)}))
+
+	rendered, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "# Releases\n\n- v0.2.0 (2023-02-01)\n- v0.1.0 (2023-01-01)\n", string(rendered))
+
+	record, err := Load(filepath.Join(dir, "index.json"))
+	require.NoError(t, err)
+	assert.Len(t, record.Entries, 2)
+}
+
+func TestIsFeed(t *testing.T) {
+	assert.True(t, IsFeed("releases.atom"))
+	assert.True(t, IsFeed("releases/index.RSS"))
+	assert.False(t, IsFeed("releases/index.html"))
+	assert.False(t, IsFeed("index.md"))
+}
+
+func TestLoad_missing(t *testing.T) {
+	idx, err := Load(filepath.Join(t.TempDir(), "index.json"))
+	require.NoError(t, err)
+	assert.Empty(t, idx.Entries)
+}
@@ -0,0 +1,104 @@
+package index
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"path/filepath"
+	"strings"
+	textTemplate "text/template"
+
+	"github.com/anchore/chronicle/chronicle/release/format/feed"
+)
+
+const (
+	markdownIndexTemplate = `# {{.Title}}
+{{ range .Entries }}
+- {{ if .URL }}[{{ .Version }}]({{ .URL }}){{ else }}{{ .Version }}{{ end }} ({{ .Date.Format "2006-01-02" }})
+{{- end }}
+`
+
+	htmlIndexTemplate = `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>{{.Title}}</title>
+</head>
+<body>
+  <h1>{{.Title}}</h1>
+  <ul>
+{{- range .Entries }}
+    <li>{{ if .URL }}<a href="{{ .URL }}">{{ .Version }}</a>{{ else }}{{ .Version }}{{ end }} <time datetime="{{ .Date.Format "2006-01-02" }}">{{ .Date.Format "2006-01-02" }}</time></li>
+{{- end }}
+  </ul>
+</body>
+</html>
+`
+)
+
+type renderData struct {
+	Title   string
+	Entries []Entry
+}
+
+// IsFeed indicates if the index at the given path is rendered as a feed (".atom" for Atom, ".rss" for RSS), which
+// includes the release notes of each release.
+func IsFeed(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".atom", ".rss":
+		return true
+	}
+	return false
+}
+
+// Render writes the index with the given title in the format implied by the path extension (".html"/".htm" for
+// HTML, ".json" for the raw record, ".atom" or ".rss" for a feed, otherwise markdown).
+func (i Index) Render(writer io.Writer, path, title string) error {
+	data := renderData{
+		Title:   title,
+		Entries: i.Entries,
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".atom":
+		return feed.WriteAtom(writer, i.feed(path, title))
+	case ".rss":
+		return feed.WriteRSS(writer, i.feed(path, title))
+	case ".html", ".htm":
+		tmpl, err := template.New("index").Parse(htmlIndexTemplate)
+		if err != nil {
+			return fmt.Errorf("unable to parse index template: %w", err)
+		}
+		return tmpl.Execute(writer, data)
+	case ".json":
+		return i.encode(writer)
+	default:
+		tmpl, err := textTemplate.New("index").Parse(markdownIndexTemplate)
+		if err != nil {
+			return fmt.Errorf("unable to parse index template: %w", err)
+		}
+		return tmpl.Execute(writer, data)
+	}
+}
+
+func (i Index) feed(path, title string) feed.Feed {
+	f := feed.Feed{
+		Title: title,
+		// an identifier is required, and must not change when the feed is regenerated
+		ID: "urn:chronicle:index:" + filepath.Base(path),
+	}
+	for _, e := range i.Entries {
+		id := e.URL
+		if id == "" {
+			id = "urn:chronicle:release:" + e.Version
+		}
+		f.Items = append(f.Items, feed.Item{
+			Title:   e.Version,
+			ID:      id,
+			Link:    e.URL,
+			Updated: e.Date,
+			Content: e.Content,
+		})
+	}
+	return f
+}
@@ -7,6 +7,9 @@ import (
 type MockSummarizer struct {
 	MockLastRelease string
 	MockRelease     string
+	MockDraft       bool // the release returned by Release is a draft
+	MockLastDraft   string
+	MockReleases    []Release // the releases listed by Releases (nil when the releases cannot be listed)
 	MockChanges     []change.Change
 	MockRefURL      string
 	MockChangesURL  string
@@ -21,12 +24,27 @@ func (m MockSummarizer) LastRelease() (*Release, error) {
 	}, nil
 }
 
+func (m MockSummarizer) Releases() ([]Release, error) {
+	return m.MockReleases, nil
+}
+
 func (m MockSummarizer) Release(_ string) (*Release, error) {
 	if m.MockRelease == "" {
 		return nil, nil
 	}
 	return &Release{
 		Version: m.MockRelease,
+		Draft:   m.MockDraft,
+	}, nil
+}
+
+func (m MockSummarizer) LatestDraftRelease() (*Release, error) {
+	if m.MockLastDraft == "" {
+		return nil, nil
+	}
+	return &Release{
+		Version: m.MockLastDraft,
+		Draft:   true,
 	}, nil
 }
 
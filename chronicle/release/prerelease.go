@@ -0,0 +1,77 @@
+package release
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+const (
+	// StableReleasesOnly only considers stable releases as the last release, so the changelog of a stable release
+	// covers everything since the previous stable release (the default).
+	StableReleasesOnly = "stable"
+	// IncludePrereleases considers any release (e.g. "v1.2.0-rc.1") as the last release.
+	IncludePrereleases = "include"
+	// ChannelAwarePrereleases considers pre-releases as the last release only when describing another pre-release, so
+	// each release candidate covers the changes since the previous candidate, while the stable release covers everything
+	// since the previous stable release.
+	ChannelAwarePrereleases = "channel"
+)
+
+// ReleaseLister is implemented by summarizers that can list their releases, so that the last release can be selected
+// per the pre-release mode (see ChangelogInfoConfig.PrereleaseMode).
+type ReleaseLister interface {
+	// Releases returns the releases that a changelog can start from (oldest first), so excludes the release being described (e.g. a tag at HEAD). If the releases cannot be listed then nil is returned (without an error), where LastRelease is used instead.
+	Releases() ([]Release, error)
+}
+
+// ParsePrereleaseMode validates whether pre-releases are considered as the last release.
+func ParsePrereleaseMode(value string) (string, error) {
+	switch v := strings.ToLower(strings.TrimSpace(value)); v {
+	case "", StableReleasesOnly:
+		return StableReleasesOnly, nil
+	case IncludePrereleases, ChannelAwarePrereleases:
+		return v, nil
+	}
+	return "", fmt.Errorf("unsupported pre-release mode %q (options: %s, %s, %s)", value, StableReleasesOnly, IncludePrereleases, ChannelAwarePrereleases)
+}
+
+// IsPrerelease indicates if the release is marked as a pre-release by the source or has a semver pre-release version
+// (e.g. "v1.2.0-rc.1").
+func (r Release) IsPrerelease() bool {
+	return r.Prerelease || isPrereleaseVersion(r.Version)
+}
+
+func isPrereleaseVersion(version string) bool {
+	v, err := semver.NewVersion(strings.TrimPrefix(version, "v"))
+	if err != nil {
+		return false
+	}
+	return v.PreRelease != ""
+}
+
+// selectLastRelease returns the most recent release that can start the changelog for the given version (which may be
+// empty when not known yet, e.g. when it is to be speculated, and is then treated as a stable release). When there are
+// only pre-releases the most recent pre-release is used.
+func selectLastRelease(releases []Release, mode, untilVersion string) *Release {
+	if len(releases) == 0 {
+		return nil
+	}
+
+	includePrereleases := mode == IncludePrereleases || (mode == ChannelAwarePrereleases && isPrereleaseVersion(untilVersion))
+
+	for i := len(releases) - 1; i >= 0; i-- {
+		if !includePrereleases && releases[i].IsPrerelease() {
+			log.Tracef("skipping release=%q as the last release: is a pre-release", releases[i].Version)
+			continue
+		}
+		return &releases[i]
+	}
+
+	latest := releases[len(releases)-1]
+	log.Debugf("no stable release found, using the latest pre-release=%q as the last release", latest.Version)
+	return &latest
+}
@@ -0,0 +1,118 @@
+package release
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePrereleaseMode(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr require.ErrorAssertionFunc
+	}{
+		{value: "", want: StableReleasesOnly},
+		{value: "Stable", want: StableReleasesOnly},
+		{value: "include", want: IncludePrereleases},
+		{value: " channel ", want: ChannelAwarePrereleases},
+		{value: "latest", wantErr: require.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			got, err := ParsePrereleaseMode(tt.value)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRelease_IsPrerelease(t *testing.T) {
+	assert.False(t, Release{Version: "v1.2.0"}.IsPrerelease())
+	assert.True(t, Release{Version: "v1.2.0-rc.1"}.IsPrerelease())
+	assert.True(t, Release{Version: "1.2.0-beta"}.IsPrerelease())
+	assert.True(t, Release{Version: "v1.2.0", Prerelease: true}.IsPrerelease())
+	assert.False(t, Release{Version: "nightly"}.IsPrerelease())
+}
+
+func Test_selectLastRelease(t *testing.T) {
+	releases := []Release{
+		{Version: "v1.1.0"},
+		{Version: "v1.2.0-rc.1"},
+		{Version: "v1.2.0-rc.2"},
+	}
+
+	tests := []struct {
+		name         string
+		releases     []Release
+		mode         string
+		untilVersion string
+		want         string
+	}{
+		{
+			name:         "stable only",
+			releases:     releases,
+			mode:         StableReleasesOnly,
+			untilVersion: "v1.2.0",
+			want:         "v1.1.0",
+		},
+		{
+			name:         "stable only for a pre-release",
+			releases:     releases,
+			mode:         StableReleasesOnly,
+			untilVersion: "v1.2.0-rc.3",
+			want:         "v1.1.0",
+		},
+		{
+			name:     "include pre-releases",
+			releases: releases,
+			mode:     IncludePrereleases,
+			want:     "v1.2.0-rc.2",
+		},
+		{
+			name:         "channel aware for a stable release",
+			releases:     releases,
+			mode:         ChannelAwarePrereleases,
+			untilVersion: "v1.2.0",
+			want:         "v1.1.0",
+		},
+		{
+			name:         "channel aware for a pre-release",
+			releases:     releases,
+			mode:         ChannelAwarePrereleases,
+			untilVersion: "v1.2.0-rc.3",
+			want:         "v1.2.0-rc.2",
+		},
+		{
+			name:     "channel aware for an unknown version",
+			releases: releases,
+			mode:     ChannelAwarePrereleases,
+			want:     "v1.1.0",
+		},
+		{
+			name:     "only pre-releases",
+			releases: releases[1:],
+			mode:     StableReleasesOnly,
+			want:     "v1.2.0-rc.2",
+		},
+		{
+			name: "no releases",
+			mode: StableReleasesOnly,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := selectLastRelease(tt.releases, tt.mode, tt.untilVersion)
+			if tt.want == "" {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want, got.Version)
+		})
+	}
+}
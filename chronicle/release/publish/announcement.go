@@ -0,0 +1,82 @@
+package publish
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+// linkLength is the number of characters any link counts as towards the length of a post (on both Mastodon and X,
+// links are shortened to a fixed length regardless of the URL).
+const linkLength = 23
+
+var linkPattern = regexp.MustCompile(`https?://\S+`)
+
+// AnnouncementData is the data available to announcement templates (e.g. "{{.Version}} is out: {{.Highlight}} {{.URL}}").
+type AnnouncementData struct {
+	Version    string // the version of the release being described (e.g. "v0.4.1")
+	Date       string // the date of the release being described (e.g. "2023-03-01")
+	Highlight  string // the text of the top change of the release (shortened as needed to fit the post)
+	URL        string // the URL of the release (e.g. the tag)
+	ChangesURL string // the URL of the changes within the release (e.g. the comparison between tags)
+}
+
+// RenderAnnouncement renders the given template as a short post for the release being described. When the post would
+// exceed the given character limit the highlight is shortened to fit (0 for no limit).
+func RenderAnnouncement(value string, description release.Description, highlight string, limit int) (string, error) {
+	tmpl, err := template.New("announcement").Option("missingkey=error").Parse(value)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse announcement template %q: %w", value, err)
+	}
+
+	data := AnnouncementData{
+		Version:    description.Version,
+		Date:       description.Date.Format("2006-01-02"),
+		Highlight:  strings.TrimSpace(highlight),
+		URL:        description.VCSReferenceURL,
+		ChangesURL: description.VCSChangesURL,
+	}
+
+	for {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return "", fmt.Errorf("unable to render announcement template %q: %w", value, err)
+		}
+		post := strings.TrimSpace(buf.String())
+
+		excess := PostLength(post) - limit
+		if limit <= 0 || excess <= 0 {
+			return post, nil
+		}
+		if data.Highlight == "" {
+			return "", fmt.Errorf("announcement is %d characters over the limit of %d even without a highlight", excess, limit)
+		}
+		data.Highlight = shorten(data.Highlight, utf8.RuneCountInString(data.Highlight)-excess)
+	}
+}
+
+// PostLength returns the number of characters the given post counts as, where links count as a fixed length.
+func PostLength(post string) int {
+	length := utf8.RuneCountInString(post)
+	for _, link := range linkPattern.FindAllString(post, -1) {
+		length += linkLength - utf8.RuneCountInString(link)
+	}
+	return length
+}
+
+// shorten truncates the text to (at most) the given number of characters, ending with an ellipsis.
+func shorten(text string, length int) string {
+	runes := []rune(text)
+	if length <= 1 {
+		return ""
+	}
+	if len(runes) <= length {
+		return text
+	}
+	return strings.TrimSpace(string(runes[:length-1])) + "…"
+}
@@ -0,0 +1,93 @@
+package publish
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+func TestPostLength(t *testing.T) {
+	assert.Equal(t, 5, PostLength("hello"))
+	assert.Equal(t, 4+linkLength, PostLength("see https://github.com/anchore/chronicle/compare/v0.4.0...v0.4.1"))
+	assert.Equal(t, 3, PostLength("…🎉!"))
+}
+
+func TestRenderAnnouncement(t *testing.T) {
+	description := release.Description{
+		Release: release.Release{
+			Version: "v0.4.1",
+			Date:    time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		VCSReferenceURL: "https://github.com/anchore/chronicle/tree/v0.4.1",
+		VCSChangesURL:   "https://github.com/anchore/chronicle/compare/v0.4.0...v0.4.1",
+	}
+	const tmpl = "{{.Version}} is out{{if .Highlight}}: {{.Highlight}}{{end}} {{.ChangesURL}}"
+
+	tests := []struct {
+		name      string
+		template  string
+		highlight string
+		limit     int
+		want      string
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name:      "fits",
+			template:  tmpl,
+			highlight: "Add support for the thing",
+			limit:     280,
+			want:      "v0.4.1 is out: Add support for the thing https://github.com/anchore/chronicle/compare/v0.4.0...v0.4.1",
+		},
+		{
+			name:      "no highlight",
+			template:  tmpl,
+			highlight: "",
+			limit:     280,
+			want:      "v0.4.1 is out https://github.com/anchore/chronicle/compare/v0.4.0...v0.4.1",
+		},
+		{
+			name:      "highlight is shortened",
+			template:  tmpl,
+			highlight: "Add support for the thing",
+			// "v0.4.1 is out: " (15) + highlight + " " (1) + link (23)
+			limit: 15 + 10 + 1 + linkLength,
+			want:  "v0.4.1 is out: Add suppo… https://github.com/anchore/chronicle/compare/v0.4.0...v0.4.1",
+		},
+		{
+			name:      "no limit",
+			template:  tmpl,
+			highlight: strings.Repeat("a", 600),
+			want:      "v0.4.1 is out: " + strings.Repeat("a", 600) + " https://github.com/anchore/chronicle/compare/v0.4.0...v0.4.1",
+		},
+		{
+			name:      "too long without a highlight",
+			template:  "{{.Version}} " + strings.Repeat("!", 300),
+			highlight: "Add support for the thing",
+			limit:     280,
+			wantErr:   require.Error,
+		},
+		{
+			name:     "unknown field",
+			template: "{{.Nope}}",
+			wantErr:  require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			got, err := RenderAnnouncement(tt.template, description, tt.highlight, tt.limit)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+			if tt.limit > 0 && err == nil {
+				assert.LessOrEqual(t, PostLength(got), tt.limit)
+			}
+		})
+	}
+}
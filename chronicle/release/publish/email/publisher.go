@@ -0,0 +1,85 @@
+package email
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release/publish"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+var _ publish.Publisher = (*Publisher)(nil)
+
+type Config struct {
+	Addr        string   // the SMTP server address (host:port)
+	Username    string   // the SMTP username (no authentication when empty)
+	Password    string   // the SMTP password
+	From        string   // the sender address
+	To          []string // the recipient addresses
+	Subject     string
+	ContentType string // the media type of the rendered changelog (e.g. "text/markdown; charset=utf-8")
+}
+
+// Publisher emails the rendered changelog to a set of recipients via SMTP.
+type Publisher struct {
+	config   Config
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewPublisher(config Config) (*Publisher, error) {
+	if config.Addr == "" {
+		return nil, fmt.Errorf("no SMTP server address configured")
+	}
+	if _, _, err := net.SplitHostPort(config.Addr); err != nil {
+		return nil, fmt.Errorf("invalid SMTP server address %q: %w", config.Addr, err)
+	}
+	if config.From == "" {
+		return nil, fmt.Errorf("no sender address configured")
+	}
+	if len(config.To) == 0 {
+		return nil, fmt.Errorf("no recipients configured")
+	}
+	if config.ContentType == "" {
+		config.ContentType = "text/plain; charset=utf-8"
+	}
+
+	log.WithFields("to", strings.Join(config.To, ", ")).Debug("email publisher")
+
+	return &Publisher{
+		config:   config,
+		sendMail: smtp.SendMail,
+	}, nil
+}
+
+func (p *Publisher) Publish(content []byte) (string, error) {
+	var auth smtp.Auth
+	if p.config.Username != "" {
+		host, _, _ := net.SplitHostPort(p.config.Addr)
+		auth = smtp.PlainAuth("", p.config.Username, p.config.Password, host)
+	}
+
+	if err := p.sendMail(p.config.Addr, auth, p.config.From, p.config.To, p.message(content)); err != nil {
+		return "", fmt.Errorf("unable to send email: %w", err)
+	}
+
+	log.WithFields("to", strings.Join(p.config.To, ", ")).Info("sent email")
+
+	// there is no URL for an email
+	return "", nil
+}
+
+func (p *Publisher) message(content []byte) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", p.config.From)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(p.config.To, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", p.config.Subject))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: %s\r\n", p.config.ContentType)
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n", "\r\n"))
+	return buf.Bytes()
+}
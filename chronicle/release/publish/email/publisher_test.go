@@ -0,0 +1,82 @@
+package email
+
+import (
+	"errors"
+	"net/smtp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublisher_Publish(t *testing.T) {
+	p, err := NewPublisher(Config{
+		Addr:        "smtp.example.com:587",
+		Username:    "user",
+		Password:    "pass",
+		From:        "releases@example.com",
+		To:          []string{"api-team@example.com", "cli-team@example.com"},
+		Subject:     "v0.2.0 release notes",
+		ContentType: "text/markdown; charset=utf-8",
+	})
+	require.NoError(t, err)
+
+	var gotAddr, gotFrom string
+	var gotTo []string
+	var gotAuth smtp.Auth
+	var gotMsg []byte
+	p.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotAuth, gotFrom, gotTo, gotMsg = addr, a, from, to, msg
+		return nil
+	}
+
+	url, err := p.Publish([]byte("# Changelog\n\n- a thing\n"))
+	require.NoError(t, err)
+
+	assert.Empty(t, url)
+	assert.Equal(t, "smtp.example.com:587", gotAddr)
+	assert.NotNil(t, gotAuth)
+	assert.Equal(t, "releases@example.com", gotFrom)
+	assert.Equal(t, []string{"api-team@example.com", "cli-team@example.com"}, gotTo)
+	assert.Equal(t, "From: releases@example.com\r\n"+
+		"To: api-team@example.com, cli-team@example.com\r\n"+
+		"Subject: v0.2.0 release notes\r\n"+
+		"MIME-Version: 1.0\r\n"+
+		"Content-Type: text/markdown; charset=utf-8\r\n"+
+		"\r\n"+
+		"# Changelog\r\n\r\n- a thing\r\n", string(gotMsg))
+}
+
+func TestPublisher_Publish_failure(t *testing.T) {
+	p, err := NewPublisher(Config{
+		Addr: "smtp.example.com:25",
+		From: "releases@example.com",
+		To:   []string{"api-team@example.com"},
+	})
+	require.NoError(t, err)
+
+	p.sendMail = func(_ string, a smtp.Auth, _ string, _ []string, _ []byte) error {
+		assert.Nil(t, a)
+		return errors.New("connection refused")
+	}
+
+	_, err = p.Publish([]byte("# Changelog\n"))
+	assert.ErrorContains(t, err, "connection refused")
+}
+
+func TestNewPublisher_validation(t *testing.T) {
+	valid := Config{Addr: "smtp.example.com:25", From: "releases@example.com", To: []string{"team@example.com"}}
+
+	_, err := NewPublisher(valid)
+	assert.NoError(t, err)
+
+	missingPort := valid
+	missingPort.Addr = "smtp.example.com"
+	_, err = NewPublisher(missingPort)
+	assert.Error(t, err)
+
+	noRecipients := valid
+	noRecipients.To = nil
+	_, err = NewPublisher(noRecipients)
+	assert.Error(t, err)
+}
@@ -0,0 +1,81 @@
+package gcs
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release/publish"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+var _ publish.Publisher = (*Publisher)(nil)
+
+const defaultEndpoint = "https://storage.googleapis.com"
+
+type Config struct {
+	Endpoint    string // the cloud storage endpoint (default is https://storage.googleapis.com)
+	Bucket      string
+	Key         string // the object name to upload to (already rendered)
+	ContentType string
+	Token       string // an OAuth2 access token (e.g. from "gcloud auth print-access-token")
+}
+
+// Publisher uploads the rendered changelog as an object to a google cloud storage bucket via the JSON API.
+type Publisher struct {
+	config Config
+	client *http.Client
+}
+
+func NewPublisher(config Config) (*Publisher, error) {
+	if config.Bucket == "" {
+		return nil, fmt.Errorf("no bucket configured")
+	}
+	if config.Token == "" {
+		return nil, fmt.Errorf("no access token provided (set GOOGLE_OAUTH_ACCESS_TOKEN)")
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = defaultEndpoint
+	}
+
+	log.WithFields("bucket", config.Bucket, "key", config.Key).Debug("gcs publisher")
+
+	return &Publisher{
+		config: config,
+		client: &http.Client{},
+	}, nil
+}
+
+func (p *Publisher) Publish(content []byte) (string, error) {
+	endpoint := strings.TrimSuffix(p.config.Endpoint, "/")
+	uploadURL := fmt.Sprintf("%s/upload/storage/v1/b/%s/o?uploadType=media&name=%s", endpoint, url.PathEscape(p.config.Bucket), url.QueryEscape(p.config.Key))
+
+	req, err := http.NewRequest(http.MethodPost, uploadURL, bytes.NewReader(content))
+	if err != nil {
+		return "", fmt.Errorf("unable to create upload request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+p.config.Token)
+	if p.config.ContentType != "" {
+		req.Header.Set("Content-Type", p.config.ContentType)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to upload object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("unable to upload object: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	objectURL := fmt.Sprintf("%s/%s/%s", endpoint, p.config.Bucket, p.config.Key)
+
+	log.WithFields("url", objectURL).Info("uploaded object")
+
+	return objectURL, nil
+}
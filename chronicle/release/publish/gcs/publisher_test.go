@@ -0,0 +1,58 @@
+package gcs
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublisher_Publish(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/upload/storage/v1/b/releases/o", r.URL.Path)
+		assert.Equal(t, "media", r.URL.Query().Get("uploadType"))
+		assert.Equal(t, "notes/v0.2.0/CHANGELOG.md", r.URL.Query().Get("name"))
+		assert.Equal(t, "Bearer the-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		b, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"version": "v0.2.0"}`, string(b))
+		_, _ = w.Write([]byte(`{"name": "notes/v0.2.0/CHANGELOG.md"}`))
+	}))
+	defer server.Close()
+
+	p, err := NewPublisher(Config{
+		Endpoint:    server.URL,
+		Bucket:      "releases",
+		Key:         "notes/v0.2.0/CHANGELOG.md",
+		ContentType: "application/json",
+		Token:       "the-token",
+	})
+	require.NoError(t, err)
+
+	url, err := p.Publish([]byte(`{"version": "v0.2.0"}`))
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/releases/notes/v0.2.0/CHANGELOG.md", url)
+}
+
+func TestPublisher_Publish_failure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error": {"message": "Invalid Credentials"}}`))
+	}))
+	defer server.Close()
+
+	p, err := NewPublisher(Config{
+		Endpoint: server.URL,
+		Bucket:   "releases",
+		Key:      "CHANGELOG.md",
+		Token:    "the-token",
+	})
+	require.NoError(t, err)
+
+	_, err = p.Publish([]byte("# Changelog\n"))
+	require.ErrorContains(t, err, "Invalid Credentials")
+}
@@ -0,0 +1,99 @@
+package gist
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release/publish"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+var _ publish.Publisher = (*Publisher)(nil)
+
+type Config struct {
+	APIURL      string // the base URL of the github REST API (e.g. https://api.github.com)
+	Token       string // a github token with the "gist" scope
+	Public      bool   // when false the gist is secret (only accessible to those with the URL)
+	Filename    string // the name of the file within the gist (e.g. CHANGELOG.md)
+	Description string
+}
+
+// Publisher uploads the rendered changelog as a new github gist, which is useful for sharing a preview of the
+// changelog before the release exists.
+type Publisher struct {
+	config Config
+	client *http.Client
+}
+
+func NewPublisher(config Config) (*Publisher, error) {
+	if config.Token == "" {
+		return nil, fmt.Errorf("no github token provided (set GITHUB_TOKEN)")
+	}
+	if config.Filename == "" {
+		return nil, fmt.Errorf("no gist filename configured")
+	}
+
+	log.WithFields("public", config.Public, "filename", config.Filename).Debug("gist publisher")
+
+	return &Publisher{
+		config: config,
+		client: &http.Client{},
+	}, nil
+}
+
+type gistFile struct {
+	Content string `json:"content"`
+}
+
+type gistRequest struct {
+	Description string              `json:"description,omitempty"`
+	Public      bool                `json:"public"`
+	Files       map[string]gistFile `json:"files"`
+}
+
+func (p *Publisher) Publish(content []byte) (string, error) {
+	reqBody, err := json.Marshal(gistRequest{
+		Description: p.config.Description,
+		Public:      p.config.Public,
+		Files: map[string]gistFile{
+			p.config.Filename: {Content: string(content)},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(p.config.APIURL, "/")+"/gists", bytes.NewReader(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("unable to create gist request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.config.Token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to create gist: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("unable to create gist: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var doc struct {
+		HTMLURL string `json:"html_url"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return "", fmt.Errorf("unable to parse gist response: %w", err)
+	}
+
+	log.WithFields("url", doc.HTMLURL).Info("published gist")
+
+	return doc.HTMLURL, nil
+}
@@ -0,0 +1,68 @@
+package gist
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublisher_Publish(t *testing.T) {
+	var got gistRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/gists", r.URL.Path)
+		assert.Equal(t, "Bearer the-token", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"html_url": "https://gist.github.com/someone/abc123"}`))
+	}))
+	defer server.Close()
+
+	p, err := NewPublisher(Config{
+		APIURL:      server.URL + "/",
+		Token:       "the-token",
+		Filename:    "CHANGELOG.md",
+		Description: "v0.2.0 changelog",
+	})
+	require.NoError(t, err)
+
+	url, err := p.Publish([]byte("# Changelog\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://gist.github.com/someone/abc123", url)
+	assert.Equal(t, gistRequest{
+		Description: "v0.2.0 changelog",
+		Public:      false,
+		Files: map[string]gistFile{
+			"CHANGELOG.md": {Content: "# Changelog\n"},
+		},
+	}, got)
+}
+
+func TestPublisher_Publish_failure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message": "Bad credentials"}`))
+	}))
+	defer server.Close()
+
+	p, err := NewPublisher(Config{
+		APIURL:   server.URL,
+		Token:    "the-token",
+		Filename: "CHANGELOG.md",
+	})
+	require.NoError(t, err)
+
+	_, err = p.Publish([]byte("# Changelog\n"))
+	require.ErrorContains(t, err, "Bad credentials")
+}
+
+func TestNewPublisher_requiresToken(t *testing.T) {
+	_, err := NewPublisher(Config{Filename: "CHANGELOG.md"})
+	require.ErrorContains(t, err, "no github token")
+}
@@ -0,0 +1,89 @@
+package publish
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Ledger is the record of every release that has been published to each destination, keyed by fingerprint. This is
+// kept across runs so that CI retries and reruns do not announce the same release to the same destination twice.
+type Ledger struct {
+	Entries map[string]LedgerEntry `json:"entries"`
+}
+
+type LedgerEntry struct {
+	Target      string    `json:"target"`
+	Project     string    `json:"project"`
+	Version     string    `json:"version"`
+	URL         string    `json:"url,omitempty"`
+	PublishedAt time.Time `json:"publishedAt"`
+}
+
+// Fingerprint identifies the publishing of a single release of a project (e.g. the git remote URL) to a single
+// destination.
+func Fingerprint(target, project, version string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(target+"\x00"+project+"\x00"+version)))
+}
+
+// LoadLedger reads the ledger from the given path, returning an empty ledger if the file does not exist.
+func LoadLedger(path string) (*Ledger, error) {
+	ledger := &Ledger{
+		Entries: make(map[string]LedgerEntry),
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ledger, nil
+		}
+		return nil, fmt.Errorf("unable to read publish ledger %q: %w", path, err)
+	}
+
+	if err := json.Unmarshal(contents, ledger); err != nil {
+		return nil, fmt.Errorf("unable to parse publish ledger %q: %w", path, err)
+	}
+	if ledger.Entries == nil {
+		ledger.Entries = make(map[string]LedgerEntry)
+	}
+	return ledger, nil
+}
+
+// Save writes the ledger to the given path, creating any parent directories.
+func (l Ledger) Save(path string) error {
+	contents, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("unable to create publish ledger directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, contents, 0600); err != nil {
+		return fmt.Errorf("unable to write publish ledger %q: %w", path, err)
+	}
+	return nil
+}
+
+// Find returns the entry for the given release and destination (if it has been published to).
+func (l Ledger) Find(target, project, version string) (LedgerEntry, bool) {
+	entry, ok := l.Entries[Fingerprint(target, project, version)]
+	return entry, ok
+}
+
+// Record adds an entry for each successful result.
+func (l *Ledger) Record(project, version string, results Results, now time.Time) {
+	for _, result := range results.Succeeded() {
+		l.Entries[Fingerprint(result.Name, project, version)] = LedgerEntry{
+			Target:      result.Name,
+			Project:     project,
+			Version:     version,
+			URL:         result.URL,
+			PublishedAt: now,
+		}
+	}
+}
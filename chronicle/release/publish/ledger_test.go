@@ -0,0 +1,55 @@
+package publish
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ledger.json")
+
+	ledger, err := LoadLedger(path)
+	require.NoError(t, err)
+	assert.Empty(t, ledger.Entries)
+
+	now := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	ledger.Record("github.com/anchore/chronicle", "v0.4.1", Results{
+		{Name: "gist", URL: "https://gist.github.com/1"},
+		{Name: "s3", Err: fmt.Errorf("nope")},
+	}, now)
+
+	require.NoError(t, ledger.Save(path))
+
+	loaded, err := LoadLedger(path)
+	require.NoError(t, err)
+
+	entry, ok := loaded.Find("gist", "github.com/anchore/chronicle", "v0.4.1")
+	require.True(t, ok)
+	assert.Equal(t, LedgerEntry{
+		Target:      "gist",
+		Project:     "github.com/anchore/chronicle",
+		Version:     "v0.4.1",
+		URL:         "https://gist.github.com/1",
+		PublishedAt: now,
+	}, entry)
+
+	_, ok = loaded.Find("s3", "github.com/anchore/chronicle", "v0.4.1")
+	assert.False(t, ok, "failed results should not be recorded")
+
+	_, ok = loaded.Find("gist", "github.com/anchore/chronicle", "v0.4.2")
+	assert.False(t, ok, "other releases should not match")
+
+	_, ok = loaded.Find("gist", "github.com/anchore/syft", "v0.4.1")
+	assert.False(t, ok, "other projects should not match")
+}
+
+func TestFingerprint(t *testing.T) {
+	// the separator prevents ambiguity between the fields
+	assert.NotEqual(t, Fingerprint("a", "bc", "d"), Fingerprint("ab", "c", "d"))
+	assert.Equal(t, Fingerprint("a", "b", "c"), Fingerprint("a", "b", "c"))
+}
@@ -0,0 +1,90 @@
+package mastodon
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release/publish"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// DefaultMaxCharacters is the post length limit of a default Mastodon instance (instances may configure their own).
+const DefaultMaxCharacters = 500
+
+var _ publish.Publisher = (*Publisher)(nil)
+
+type Config struct {
+	URL        string // the base URL of the mastodon instance (e.g. https://fosstodon.org)
+	Token      string // an access token with the "write:statuses" scope
+	Visibility string // the visibility of the post: "public", "unlisted", "private", or "direct" (default is the account default)
+}
+
+// Publisher posts the rendered content as a status on a mastodon instance.
+type Publisher struct {
+	config Config
+	client *http.Client
+}
+
+func NewPublisher(config Config) (*Publisher, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("no mastodon instance URL configured")
+	}
+	if config.Token == "" {
+		return nil, fmt.Errorf("no mastodon token provided (set MASTODON_TOKEN)")
+	}
+
+	log.WithFields("instance", config.URL).Debug("mastodon publisher")
+
+	return &Publisher{
+		config: config,
+		client: &http.Client{},
+	}, nil
+}
+
+type statusRequest struct {
+	Status     string `json:"status"`
+	Visibility string `json:"visibility,omitempty"`
+}
+
+func (p *Publisher) Publish(content []byte) (string, error) {
+	reqBody, err := json.Marshal(statusRequest{
+		Status:     string(content),
+		Visibility: p.config.Visibility,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(p.config.URL, "/")+"/api/v1/statuses", bytes.NewReader(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("unable to create mastodon request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.config.Token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to post mastodon status: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("unable to post mastodon status: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var doc struct {
+		URL string `json:"url"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return "", fmt.Errorf("unable to parse mastodon response: %w", err)
+	}
+
+	log.WithFields("url", doc.URL).Info("posted mastodon status")
+
+	return doc.URL, nil
+}
@@ -0,0 +1,63 @@
+package mastodon
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublisher_Publish(t *testing.T) {
+	var got statusRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/statuses", r.URL.Path)
+		assert.Equal(t, "Bearer the-token", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+
+		_, _ = w.Write([]byte(`{"id": "1", "url": "https://fosstodon.org/@chronicle/1"}`))
+	}))
+	defer server.Close()
+
+	p, err := NewPublisher(Config{
+		URL:        server.URL + "/",
+		Token:      "the-token",
+		Visibility: "unlisted",
+	})
+	require.NoError(t, err)
+
+	url, err := p.Publish([]byte("v0.4.1 is out"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://fosstodon.org/@chronicle/1", url)
+	assert.Equal(t, statusRequest{
+		Status:     "v0.4.1 is out",
+		Visibility: "unlisted",
+	}, got)
+}
+
+func TestPublisher_Publish_failure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"error": "Validation failed: Text character limit of 500 exceeded"}`))
+	}))
+	defer server.Close()
+
+	p, err := NewPublisher(Config{
+		URL:   server.URL,
+		Token: "the-token",
+	})
+	require.NoError(t, err)
+
+	_, err = p.Publish([]byte("v0.4.1 is out"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "character limit")
+}
+
+func TestNewPublisher_missingToken(t *testing.T) {
+	_, err := NewPublisher(Config{URL: "https://fosstodon.org"})
+	require.Error(t, err)
+}
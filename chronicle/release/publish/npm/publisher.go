@@ -0,0 +1,127 @@
+package npm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release/publish"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// DefaultRegistryURL is the URL of the public npm registry.
+const DefaultRegistryURL = "https://registry.npmjs.org"
+
+var _ publish.Publisher = (*Publisher)(nil)
+
+type Config struct {
+	RegistryURL string // the base URL of the npm registry (e.g. https://registry.npmjs.org)
+	Token       string // an npm access token with write access to the package
+	Package     string // the name of the package (e.g. @anchore/chronicle)
+}
+
+// Publisher replaces the readme of a package on the npm registry (the description shown on the package page) with the
+// rendered changelog, which is useful when the package is published by a separate pipeline from the release notes.
+// There is no endpoint for changing only the readme, so the package document is fetched, updated, and written back
+// (the same as "npm deprecate" does).
+type Publisher struct {
+	config Config
+	client *http.Client
+}
+
+func NewPublisher(config Config) (*Publisher, error) {
+	if config.Token == "" {
+		return nil, fmt.Errorf("no npm token provided (set NPM_TOKEN)")
+	}
+	if config.Package == "" {
+		return nil, fmt.Errorf("no npm package configured")
+	}
+	if config.RegistryURL == "" {
+		config.RegistryURL = DefaultRegistryURL
+	}
+
+	log.WithFields("registry", config.RegistryURL, "package", config.Package).Debug("npm publisher")
+
+	return &Publisher{
+		config: config,
+		client: &http.Client{},
+	}, nil
+}
+
+func (p *Publisher) Publish(content []byte) (string, error) {
+	docURL := strings.TrimSuffix(p.config.RegistryURL, "/") + "/" + escapePackageName(p.config.Package)
+
+	doc, err := p.fetch(docURL)
+	if err != nil {
+		return "", err
+	}
+
+	doc["readme"] = string(content)
+
+	reqBody, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, docURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("unable to create npm request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.config.Token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to update npm package: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("unable to update npm package: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	log.WithFields("package", p.config.Package).Info("published npm readme")
+
+	// the package page is only known for the public registry
+	if strings.TrimSuffix(p.config.RegistryURL, "/") == DefaultRegistryURL {
+		return "https://www.npmjs.com/package/" + p.config.Package, nil
+	}
+	return "", nil
+}
+
+// fetch returns the full (not the abbreviated install) document of the package, which includes the revision that the
+// update must be made against.
+func (p *Publisher) fetch(docURL string) (map[string]interface{}, error) {
+	req, err := http.NewRequest(http.MethodGet, docURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create npm request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.config.Token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch npm package: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("unable to fetch npm package: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var doc map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("unable to parse npm package: %w", err)
+	}
+	return doc, nil
+}
+
+// escapePackageName escapes the slash of a scoped package name, as the registry expects (e.g. @scope%2fname).
+func escapePackageName(name string) string {
+	return strings.Replace(name, "/", "%2f", 1)
+}
@@ -0,0 +1,73 @@
+package npm
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublisher_Publish(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/@anchore%2fchronicle", r.URL.RawPath)
+		assert.Equal(t, "Bearer the-token", r.Header.Get("Authorization"))
+
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"_id": "@anchore/chronicle", "_rev": "3-abc", "name": "@anchore/chronicle", "readme": "old", "versions": {"0.1.0": {}}}`))
+		case http.MethodPut:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"ok": true}`))
+		default:
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	p, err := NewPublisher(Config{
+		RegistryURL: server.URL + "/",
+		Token:       "the-token",
+		Package:     "@anchore/chronicle",
+	})
+	require.NoError(t, err)
+
+	url, err := p.Publish([]byte("# Changelog\n"))
+	require.NoError(t, err)
+
+	assert.Empty(t, url)
+	assert.Equal(t, map[string]interface{}{
+		"_id":      "@anchore/chronicle",
+		"_rev":     "3-abc",
+		"name":     "@anchore/chronicle",
+		"readme":   "# Changelog\n",
+		"versions": map[string]interface{}{"0.1.0": map[string]interface{}{}},
+	}, got)
+}
+
+func TestPublisher_Publish_failure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error": "Not found"}`))
+	}))
+	defer server.Close()
+
+	p, err := NewPublisher(Config{
+		RegistryURL: server.URL,
+		Token:       "the-token",
+		Package:     "chronicle",
+	})
+	require.NoError(t, err)
+
+	_, err = p.Publish([]byte("# Changelog\n"))
+	require.ErrorContains(t, err, "Not found")
+}
+
+func TestNewPublisher_requiresToken(t *testing.T) {
+	_, err := NewPublisher(Config{Package: "chronicle"})
+	require.ErrorContains(t, err, "no npm token")
+}
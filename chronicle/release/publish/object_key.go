@@ -0,0 +1,72 @@
+package publish
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+// ObjectKeyData is the data available to object key templates (e.g. "releases/{{.Version}}/CHANGELOG.{{.Format}}").
+type ObjectKeyData struct {
+	Version string // the version of the release being described (e.g. "v0.4.1" or "(Unreleased)")
+	Date    string // the date of the release being described (e.g. "2023-03-01")
+	Format  string // the output format of the rendered changelog (e.g. "md" or "json")
+}
+
+// RenderObjectKey renders the given object key template for the release being described.
+func RenderObjectKey(keyTemplate string, description release.Description, format string) (string, error) {
+	key, err := RenderTemplate(keyTemplate, description, format)
+	if err != nil {
+		return "", err
+	}
+
+	key = strings.TrimPrefix(key, "/")
+	if key == "" {
+		return "", fmt.Errorf("object key template %q rendered an empty key", keyTemplate)
+	}
+	return key, nil
+}
+
+// RenderTemplate renders the given template (with ObjectKeyData) for the release being described.
+func RenderTemplate(value string, description release.Description, format string) (string, error) {
+	tmpl, err := template.New("publish").Option("missingkey=error").Parse(value)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse template %q: %w", value, err)
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, ObjectKeyData{
+		Version: description.Version,
+		Date:    description.Date.Format("2006-01-02"),
+		Format:  format,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to render template %q: %w", value, err)
+	}
+	return buf.String(), nil
+}
+
+// ContentType returns the media type for the given output format.
+func ContentType(format string) string {
+	switch format {
+	case "md":
+		return "text/markdown; charset=utf-8"
+	case "json", "scoop", "slack", "discord":
+		return "application/json"
+	case "html":
+		return "text/html; charset=utf-8"
+	case "atom":
+		return "application/atom+xml"
+	case "rss":
+		return "application/rss+xml"
+	case "csv":
+		return "text/csv; charset=utf-8"
+	case "tsv":
+		return "text/tab-separated-values; charset=utf-8"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
@@ -0,0 +1,61 @@
+package publish
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+func TestRenderObjectKey(t *testing.T) {
+	description := release.Description{
+		Release: release.Release{
+			Version: "v0.4.1",
+			Date:    time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "all fields",
+			template: "releases/{{.Version}}/{{.Date}}/CHANGELOG.{{.Format}}",
+			want:     "releases/v0.4.1/2023-03-01/CHANGELOG.md",
+		},
+		{
+			name:     "leading slash is removed",
+			template: "/{{.Version}}.{{.Format}}",
+			want:     "v0.4.1.md",
+		},
+		{
+			name:     "unknown field",
+			template: "{{.Nope}}",
+			wantErr:  require.Error,
+		},
+		{
+			name:     "empty key",
+			template: "",
+			wantErr:  require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			got, err := RenderObjectKey(tt.template, description, "md")
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package publish
+
+// Publisher sends a rendered changelog to an external destination.
+type Publisher interface {
+	// Publish uploads the given rendered changelog, returning the URL where it can be found (if any).
+	Publish(content []byte) (string, error)
+}
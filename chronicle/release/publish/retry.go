@@ -0,0 +1,124 @@
+package publish
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// sleep is swapped out within tests to avoid waiting on the backoff.
+var sleep = time.Sleep
+
+// Target is a named publisher (e.g. "gist" or "s3"), where the name is used for reporting and resuming.
+type Target struct {
+	Name      string
+	Publisher Publisher
+	Content   []byte // the content to publish instead of the full changelog (e.g. the slice routed to a team)
+}
+
+// RetryConfig describes how many times a publisher is attempted before giving up, with the delay doubling after each
+// failed attempt.
+type RetryConfig struct {
+	Attempts int           // the total number of attempts per target (values less than 1 are treated as 1)
+	Backoff  time.Duration // the delay before the first retry
+}
+
+// Result is the outcome of publishing to a single target.
+type Result struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+	Err  error  `json:"-"`
+}
+
+type Results []Result
+
+// PublishAll publishes the given content to every target, retrying each target independently. A failure for one target
+// does not prevent publishing to the remaining targets.
+func PublishAll(targets []Target, content []byte, config RetryConfig) Results {
+	var results Results
+	for _, t := range targets {
+		targetContent := content
+		if t.Content != nil {
+			targetContent = t.Content
+		}
+		url, err := publishWithRetry(t, targetContent, config)
+		results = append(results, Result{
+			Name: t.Name,
+			URL:  url,
+			Err:  err,
+		})
+	}
+	return results
+}
+
+func publishWithRetry(t Target, content []byte, config RetryConfig) (string, error) {
+	attempts := config.Attempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	delay := config.Backoff
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		var url string
+		url, err = t.Publisher.Publish(content)
+		if err == nil {
+			return url, nil
+		}
+		if attempt < attempts {
+			log.WithFields("target", t.Name, "attempt", attempt, "delay", delay).Warnf("unable to publish changelog, retrying: %+v", err)
+			sleep(delay)
+			delay *= 2
+		}
+	}
+	return "", err
+}
+
+// Succeeded returns the results for the targets that were published to.
+func (r Results) Succeeded() Results {
+	var succeeded Results
+	for _, result := range r {
+		if result.Err == nil {
+			succeeded = append(succeeded, result)
+		}
+	}
+	return succeeded
+}
+
+// Failed returns the results for the targets that could not be published to.
+func (r Results) Failed() Results {
+	var failed Results
+	for _, result := range r {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// URLs returns the non-empty URLs of all successful results.
+func (r Results) URLs() []string {
+	var urls []string
+	for _, result := range r.Succeeded() {
+		if result.URL != "" {
+			urls = append(urls, result.URL)
+		}
+	}
+	return urls
+}
+
+// Err returns an error summarizing all failed targets (or nil if every target was published to).
+func (r Results) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	var msgs []string
+	for _, result := range failed {
+		msgs = append(msgs, fmt.Sprintf("%s: %v", result.Name, result.Err))
+	}
+	return fmt.Errorf("unable to publish changelog to %d of %d destinations (%s)", len(failed), len(r), strings.Join(msgs, "; "))
+}
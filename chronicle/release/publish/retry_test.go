@@ -0,0 +1,91 @@
+package publish
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type flakyPublisher struct {
+	failures int // the number of attempts that fail before succeeding
+	attempts int
+	url      string
+	content  []byte
+}
+
+func (p *flakyPublisher) Publish(content []byte) (string, error) {
+	p.attempts++
+	p.content = content
+	if p.attempts <= p.failures {
+		return "", fmt.Errorf("attempt %d failed", p.attempts)
+	}
+	return p.url, nil
+}
+
+func TestPublishAll(t *testing.T) {
+	var delays []time.Duration
+	sleep = func(d time.Duration) {
+		delays = append(delays, d)
+	}
+	t.Cleanup(func() {
+		sleep = time.Sleep
+	})
+
+	recovers := &flakyPublisher{failures: 2, url: "https://example.com/recovers"}
+	broken := &flakyPublisher{failures: 10}
+	works := &flakyPublisher{url: "https://example.com/works"}
+
+	results := PublishAll([]Target{
+		{Name: "recovers", Publisher: recovers},
+		{Name: "broken", Publisher: broken},
+		{Name: "works", Publisher: works},
+	}, []byte("content"), RetryConfig{Attempts: 3, Backoff: time.Second})
+
+	assert.Equal(t, 3, recovers.attempts)
+	assert.Equal(t, 3, broken.attempts)
+	assert.Equal(t, 1, works.attempts)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, time.Second, 2 * time.Second}, delays)
+
+	require.Len(t, results, 3)
+	assert.Equal(t, []string{"https://example.com/recovers", "https://example.com/works"}, results.URLs())
+
+	failed := results.Failed()
+	require.Len(t, failed, 1)
+	assert.Equal(t, "broken", failed[0].Name)
+
+	err := results.Err()
+	require.Error(t, err)
+	assert.Equal(t, "unable to publish changelog to 1 of 3 destinations (broken: attempt 3 failed)", err.Error())
+}
+
+func TestPublishAll_noRetries(t *testing.T) {
+	sleep = func(time.Duration) {
+		t.Fatal("should not sleep")
+	}
+	t.Cleanup(func() {
+		sleep = time.Sleep
+	})
+
+	p := &flakyPublisher{failures: 1}
+	results := PublishAll([]Target{{Name: "once", Publisher: p}}, nil, RetryConfig{})
+
+	assert.Equal(t, 1, p.attempts)
+	assert.Error(t, results.Err())
+}
+
+func TestPublishAll_targetContent(t *testing.T) {
+	full := &flakyPublisher{}
+	routed := &flakyPublisher{}
+
+	results := PublishAll([]Target{
+		{Name: "full", Publisher: full},
+		{Name: "routed", Publisher: routed, Content: []byte("slice")},
+	}, []byte("content"), RetryConfig{})
+
+	require.NoError(t, results.Err())
+	assert.Equal(t, "content", string(full.content))
+	assert.Equal(t, "slice", string(routed.content))
+}
@@ -0,0 +1,51 @@
+package publish
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+// Route sends the slice of a changelog made up of the changes to the given components (see change.Component) to the
+// given destinations, so that each team only receives the release notes relevant to them.
+type Route struct {
+	Components   []string
+	Destinations []Destination
+}
+
+// Destination is where the release notes of a route are sent.
+type Destination struct {
+	Kind    string // the kind of destination: "slack" or "email"
+	Address string // the slack channel (e.g. "#team-api") or email address
+}
+
+// ParseDestination parses a destination of the form "<kind>:<address>" (e.g. "slack:#team-api" or
+// "email:api-team@example.com").
+func ParseDestination(value string) (Destination, error) {
+	fields := strings.SplitN(strings.TrimSpace(value), ":", 2)
+	if len(fields) != 2 || strings.TrimSpace(fields[1]) == "" {
+		return Destination{}, fmt.Errorf("invalid destination %q (expected slack:<channel> or email:<address>)", value)
+	}
+
+	d := Destination{
+		Kind:    strings.ToLower(strings.TrimSpace(fields[0])),
+		Address: strings.TrimSpace(fields[1]),
+	}
+	switch d.Kind {
+	case "slack", "email":
+		return d, nil
+	}
+	return Destination{}, fmt.Errorf("unsupported destination kind %q in %q (options: slack, email)", d.Kind, value)
+}
+
+func (d Destination) String() string {
+	return d.Kind + ":" + d.Address
+}
+
+// Slice returns the given release description with only the changes routed by this route, which may be empty.
+func (r Route) Slice(description release.Description, componentPattern *regexp.Regexp) release.Description {
+	description.Changes = description.Changes.ByComponent(componentPattern, r.Components...)
+	return description
+}
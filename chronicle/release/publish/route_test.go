@@ -0,0 +1,77 @@
+package publish
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func TestParseDestination(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Destination
+		wantErr bool
+	}{
+		{
+			value: "slack:#team-api",
+			want:  Destination{Kind: "slack", Address: "#team-api"},
+		},
+		{
+			value: " Email: api-team@example.com ",
+			want:  Destination{Kind: "email", Address: "api-team@example.com"},
+		},
+		{
+			value:   "#team-api",
+			wantErr: true,
+		},
+		{
+			value:   "slack:",
+			wantErr: true,
+		},
+		{
+			value:   "teams:releases",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseDestination(tt.value)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRoute_Slice(t *testing.T) {
+	pattern := regexp.MustCompile(`^(?P<component>[\w./-]+):\s+`)
+	description := release.Description{
+		Release: release.Release{Version: "v0.2.0"},
+		Changes: change.Changes{
+			{Text: "api: add the thing"},
+			{Text: "cli: fix the flag"},
+			{Text: "API: remove the other thing"},
+			{Text: "update docs"},
+		},
+	}
+
+	slice := Route{Components: []string{"api"}}.Slice(description, pattern)
+
+	assert.Equal(t, "v0.2.0", slice.Version)
+	assert.Equal(t, change.Changes{
+		{Text: "api: add the thing"},
+		{Text: "API: remove the other thing"},
+	}, slice.Changes)
+	// the original description is untouched
+	assert.Len(t, description.Changes, 4)
+
+	assert.Empty(t, Route{Components: []string{"ui"}}.Slice(description, pattern).Changes)
+}
@@ -0,0 +1,113 @@
+package s3
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/anchore/chronicle/chronicle/release/publish"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+var _ publish.Publisher = (*Publisher)(nil)
+
+type Config struct {
+	Endpoint        string // the S3-compatible endpoint (e.g. https://s3.us-east-1.amazonaws.com or a MinIO server)
+	Region          string
+	Bucket          string
+	Key             string // the object key to upload to (already rendered)
+	ContentType     string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string // optional, for temporary credentials
+}
+
+// Publisher uploads the rendered changelog as an object to an S3-compatible bucket (addressed path-style, which is
+// supported by AWS as well as most S3-compatible stores).
+type Publisher struct {
+	config Config
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewPublisher(config Config) (*Publisher, error) {
+	if config.Bucket == "" {
+		return nil, fmt.Errorf("no bucket configured")
+	}
+	if config.Region == "" {
+		return nil, fmt.Errorf("no region configured")
+	}
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("no credentials provided (set AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY)")
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", config.Region)
+	}
+
+	log.WithFields("endpoint", config.Endpoint, "bucket", config.Bucket, "key", config.Key).Debug("s3 publisher")
+
+	return &Publisher{
+		config: config,
+		client: &http.Client{},
+		now:    time.Now,
+	}, nil
+}
+
+func (p *Publisher) objectURL() string {
+	var segments []string
+	for _, s := range strings.Split(p.config.Key, "/") {
+		segments = append(segments, uriEncode(s))
+	}
+	return fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(p.config.Endpoint, "/"), uriEncode(p.config.Bucket), strings.Join(segments, "/"))
+}
+
+// uriEncode percent-encodes everything but the unreserved characters, as is required for the canonical request of
+// a signature (which is stricter than url.PathEscape).
+func uriEncode(s string) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '.', c == '_', c == '~':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func (p *Publisher) Publish(content []byte) (string, error) {
+	objectURL := p.objectURL()
+
+	req, err := http.NewRequest(http.MethodPut, objectURL, bytes.NewReader(content))
+	if err != nil {
+		return "", fmt.Errorf("unable to create upload request: %w", err)
+	}
+	if p.config.ContentType != "" {
+		req.Header.Set("Content-Type", p.config.ContentType)
+	}
+
+	signV4(req, hashHex(content), credentials{
+		AccessKeyID:     p.config.AccessKeyID,
+		SecretAccessKey: p.config.SecretAccessKey,
+		SessionToken:    p.config.SessionToken,
+	}, p.config.Region, p.now())
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to upload object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("unable to upload object: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	log.WithFields("url", objectURL).Info("uploaded object")
+
+	return objectURL, nil
+}
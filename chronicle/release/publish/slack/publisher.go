@@ -0,0 +1,120 @@
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release/publish"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+const DefaultAPIURL = "https://slack.com/api"
+
+var _ publish.Publisher = (*Publisher)(nil)
+
+type Config struct {
+	APIURL  string // the base URL of the slack web API (defaults to https://slack.com/api)
+	Token   string // a bot token with the "chat:write" scope
+	Channel string // the channel name (e.g. "#team-api") or ID to post to
+}
+
+// Publisher posts the rendered changelog as a message to a slack channel.
+type Publisher struct {
+	config Config
+	client *http.Client
+}
+
+func NewPublisher(config Config) (*Publisher, error) {
+	if config.Token == "" {
+		return nil, fmt.Errorf("no slack token provided (set SLACK_TOKEN)")
+	}
+	if config.Channel == "" {
+		return nil, fmt.Errorf("no slack channel configured")
+	}
+	if config.APIURL == "" {
+		config.APIURL = DefaultAPIURL
+	}
+
+	log.WithFields("channel", config.Channel).Debug("slack publisher")
+
+	return &Publisher{
+		config: config,
+		client: &http.Client{},
+	}, nil
+}
+
+type postMessageRequest struct {
+	Channel string          `json:"channel"`
+	Text    string          `json:"text"`
+	Mrkdwn  bool            `json:"mrkdwn"`
+	Blocks  json.RawMessage `json:"blocks,omitempty"`
+}
+
+func (p *Publisher) Publish(content []byte) (string, error) {
+	reqBody, err := json.Marshal(newPostMessageRequest(p.config.Channel, content))
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(p.config.APIURL, "/")+"/chat.postMessage", bytes.NewReader(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("unable to create slack request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+p.config.Token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to post slack message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("unable to post slack message: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	// note: the slack web API responds with a 200 even for failures, which are indicated within the body
+	var doc struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return "", fmt.Errorf("unable to parse slack response: %w", err)
+	}
+	if !doc.OK {
+		return "", fmt.Errorf("unable to post slack message to %q: %s", p.config.Channel, doc.Error)
+	}
+
+	log.WithFields("channel", p.config.Channel).Info("posted slack message")
+
+	// slack messages do not have a URL without an additional API call (which would require another scope)
+	return "", nil
+}
+
+// newPostMessageRequest posts the content as the text of the message, unless the content is already a Block Kit
+// message payload (e.g. from the "slack" output format), which is posted as-is.
+func newPostMessageRequest(channel string, content []byte) postMessageRequest {
+	var payload struct {
+		Text   string          `json:"text"`
+		Blocks json.RawMessage `json:"blocks"`
+	}
+	if err := json.Unmarshal(content, &payload); err == nil && len(payload.Blocks) > 0 {
+		return postMessageRequest{
+			Channel: channel,
+			Text:    payload.Text,
+			Mrkdwn:  true,
+			Blocks:  payload.Blocks,
+		}
+	}
+
+	return postMessageRequest{
+		Channel: channel,
+		Text:    string(content),
+		Mrkdwn:  true,
+	}
+}
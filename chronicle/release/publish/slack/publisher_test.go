@@ -0,0 +1,84 @@
+package slack
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublisher_Publish(t *testing.T) {
+	var got postMessageRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/chat.postMessage", r.URL.Path)
+		assert.Equal(t, "Bearer the-token", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+
+		_, _ = w.Write([]byte(`{"ok": true, "channel": "C123", "ts": "1700000000.000100"}`))
+	}))
+	defer server.Close()
+
+	p, err := NewPublisher(Config{
+		APIURL:  server.URL + "/",
+		Token:   "the-token",
+		Channel: "#team-api",
+	})
+	require.NoError(t, err)
+
+	url, err := p.Publish([]byte("# Changelog\n"))
+	require.NoError(t, err)
+
+	assert.Empty(t, url)
+	assert.Equal(t, postMessageRequest{
+		Channel: "#team-api",
+		Text:    "# Changelog\n",
+		Mrkdwn:  true,
+	}, got)
+}
+
+func Test_newPostMessageRequest_blockKit(t *testing.T) {
+	got := newPostMessageRequest("#team-api", []byte(`{"text": "Changelog v0.2.0", "blocks": [{"type": "header"}]}`))
+
+	assert.Equal(t, postMessageRequest{
+		Channel: "#team-api",
+		Text:    "Changelog v0.2.0",
+		Mrkdwn:  true,
+		Blocks:  json.RawMessage(`[{"type": "header"}]`),
+	}, got)
+
+	// other JSON content is posted as text
+	got = newPostMessageRequest("#team-api", []byte(`{"version": "v0.2.0"}`))
+	assert.Equal(t, `{"version": "v0.2.0"}`, got.Text)
+	assert.Nil(t, got.Blocks)
+}
+
+func TestPublisher_Publish_failure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the slack web API reports failures with a 200
+		_, _ = w.Write([]byte(`{"ok": false, "error": "channel_not_found"}`))
+	}))
+	defer server.Close()
+
+	p, err := NewPublisher(Config{
+		APIURL:  server.URL,
+		Token:   "the-token",
+		Channel: "#nope",
+	})
+	require.NoError(t, err)
+
+	_, err = p.Publish([]byte("# Changelog\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "channel_not_found")
+}
+
+func TestNewPublisher_validation(t *testing.T) {
+	_, err := NewPublisher(Config{Channel: "#team-api"})
+	assert.Error(t, err)
+
+	_, err = NewPublisher(Config{Token: "the-token"})
+	assert.Error(t, err)
+}
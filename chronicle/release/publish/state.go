@@ -0,0 +1,80 @@
+package publish
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+// State is the record of the last changelog that was published, allowing for publishing to be resumed (e.g. only to
+// the destinations that previously failed) without generating the changelog again.
+type State struct {
+	Project     string              `json:"project"` // identifies the repo the changelog was generated for (e.g. the git remote URL)
+	Format      string              `json:"format"`
+	Description release.Description `json:"description"`
+	Content     string              `json:"content"`   // the rendered changelog
+	Published   Results             `json:"published"` // the destinations that have been published to
+}
+
+// LoadState reads the publish state from the given path.
+func LoadState(path string) (*State, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no previously generated changelog found at %q", path)
+		}
+		return nil, fmt.Errorf("unable to read publish state %q: %w", path, err)
+	}
+
+	var state State
+	if err := json.Unmarshal(contents, &state); err != nil {
+		return nil, fmt.Errorf("unable to parse publish state %q: %w", path, err)
+	}
+	return &state, nil
+}
+
+// Save writes the publish state to the given path, creating any parent directories.
+func (s State) Save(path string) error {
+	contents, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("unable to create publish state directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, contents, 0600); err != nil {
+		return fmt.Errorf("unable to write publish state %q: %w", path, err)
+	}
+	return nil
+}
+
+// IsPublished indicates if the given destination has already been published to.
+func (s State) IsPublished(name string) bool {
+	for _, result := range s.Published {
+		if result.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Record adds the successful results to the state (replacing any previous result for the same destination).
+func (s *State) Record(results Results) {
+	for _, result := range results.Succeeded() {
+		replaced := false
+		for i := range s.Published {
+			if s.Published[i].Name == result.Name {
+				s.Published[i] = result
+				replaced = true
+			}
+		}
+		if !replaced {
+			s.Published = append(s.Published, result)
+		}
+	}
+}
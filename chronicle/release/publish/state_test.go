@@ -0,0 +1,53 @@
+package publish
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+func TestState_SaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "state.json")
+
+	state := State{
+		Format: "md",
+		Description: release.Description{
+			Release: release.Release{
+				Version: "v0.4.1",
+				Date:    time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+			},
+			VCSReferenceURL: "https://github.com/anchore/chronicle/releases/tag/v0.4.1",
+		},
+		Content: "# Changelog",
+	}
+	state.Record(Results{
+		{Name: "gist", URL: "https://gist.github.com/1"},
+		{Name: "s3", Err: fmt.Errorf("nope")},
+	})
+
+	require.NoError(t, state.Save(path))
+
+	loaded, err := LoadState(path)
+	require.NoError(t, err)
+	assert.Equal(t, state, *loaded)
+	assert.True(t, loaded.IsPublished("gist"))
+	assert.False(t, loaded.IsPublished("s3"))
+
+	// a later success replaces the previous result for the same destination
+	loaded.Record(Results{
+		{Name: "gist", URL: "https://gist.github.com/2"},
+		{Name: "s3", URL: "https://bucket.s3.amazonaws.com/v0.4.1"},
+	})
+	assert.Equal(t, []string{"https://gist.github.com/2", "https://bucket.s3.amazonaws.com/v0.4.1"}, loaded.Published.URLs())
+}
+
+func TestLoadState_missing(t *testing.T) {
+	_, err := LoadState(filepath.Join(t.TempDir(), "state.json"))
+	assert.ErrorContains(t, err, "no previously generated changelog found")
+}
@@ -0,0 +1,170 @@
+package x
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // nolint:gosec // required by OAuth 1.0a HMAC-SHA1 signatures
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anchore/chronicle/chronicle/release/publish"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+const (
+	DefaultAPIURL = "https://api.twitter.com/2"
+
+	// MaxCharacters is the post length limit for accounts without a subscription.
+	MaxCharacters = 280
+)
+
+var _ publish.Publisher = (*Publisher)(nil)
+
+type Config struct {
+	APIURL            string // the base URL of the X API v2 (defaults to https://api.twitter.com/2)
+	APIKey            string // the consumer key of the app
+	APISecret         string // the consumer secret of the app
+	AccessToken       string // the access token of the account to post as (with read and write permissions)
+	AccessTokenSecret string // the access token secret of the account to post as
+}
+
+// Publisher posts the rendered content to X (authenticated as a user of an app, via OAuth 1.0a).
+type Publisher struct {
+	config Config
+	client *http.Client
+	now    func() time.Time
+	nonce  func() string
+}
+
+func NewPublisher(config Config) (*Publisher, error) {
+	if config.APIKey == "" || config.APISecret == "" || config.AccessToken == "" || config.AccessTokenSecret == "" {
+		return nil, fmt.Errorf("no X API keys provided (set X_API_KEY, X_API_SECRET, X_ACCESS_TOKEN, and X_ACCESS_TOKEN_SECRET)")
+	}
+	if config.APIURL == "" {
+		config.APIURL = DefaultAPIURL
+	}
+
+	return &Publisher{
+		config: config,
+		client: &http.Client{},
+		now:    time.Now,
+		nonce:  randomNonce,
+	}, nil
+}
+
+type tweetRequest struct {
+	Text string `json:"text"`
+}
+
+func (p *Publisher) Publish(content []byte) (string, error) {
+	reqBody, err := json.Marshal(tweetRequest{
+		Text: string(content),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := strings.TrimSuffix(p.config.APIURL, "/") + "/tweets"
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(reqBody))
+	if err != nil {
+		return "", fmt.Errorf("unable to create X request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", p.authorization(http.MethodPost, endpoint))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to post to X: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("unable to post to X: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var doc struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return "", fmt.Errorf("unable to parse X response: %w", err)
+	}
+
+	postURL := "https://x.com/i/web/status/" + doc.Data.ID
+	log.WithFields("url", postURL).Info("posted to X")
+
+	return postURL, nil
+}
+
+// authorization returns the OAuth 1.0a header for the given request. Note: JSON request bodies are not part of the
+// signature (only form-encoded bodies are).
+func (p *Publisher) authorization(method, endpoint string) string {
+	params := map[string]string{
+		"oauth_consumer_key":     p.config.APIKey,
+		"oauth_nonce":            p.nonce(),
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(p.now().Unix(), 10),
+		"oauth_token":            p.config.AccessToken,
+		"oauth_version":          "1.0",
+	}
+	params["oauth_signature"] = signature(method, endpoint, params, p.config.APISecret, p.config.AccessTokenSecret)
+
+	keys := sortedKeys(params)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", percentEncode(k), percentEncode(params[k])))
+	}
+	return "OAuth " + strings.Join(pairs, ", ")
+}
+
+func signature(method, endpoint string, params map[string]string, consumerSecret, tokenSecret string) string {
+	keys := sortedKeys(params)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, percentEncode(k)+"="+percentEncode(params[k]))
+	}
+
+	base := strings.Join([]string{
+		method,
+		percentEncode(endpoint),
+		percentEncode(strings.Join(pairs, "&")),
+	}, "&")
+
+	mac := hmac.New(sha1.New, []byte(percentEncode(consumerSecret)+"&"+percentEncode(tokenSecret)))
+	mac.Write([]byte(base))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func sortedKeys(params map[string]string) []string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// percentEncode encodes the value per RFC 3986 (as required by OAuth 1.0a), which differs from query escaping.
+func percentEncode(value string) string {
+	return strings.ReplaceAll(url.QueryEscape(value), "+", "%20")
+}
+
+func randomNonce() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,79 @@
+package x
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_signature(t *testing.T) {
+	// the worked example from the X (twitter) developer documentation for creating a signature
+	params := map[string]string{
+		"status":                 "Hello Ladies + Gentlemen, a signed OAuth request!",
+		"include_entities":       "true",
+		"oauth_consumer_key":     "xvz1evFS4wEEPTGEFPHBog",
+		"oauth_nonce":            "kYjzVBB8Y0ZFabxSWbWovY3uYSQ2pTgmZeNu2VS4cg",
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        "1318622958",
+		"oauth_token":            "370773112-GmHxMAgYyLbNEtIKZeRNFsMKPR9EyMZeS9weJAEb",
+		"oauth_version":          "1.0",
+	}
+
+	got := signature(http.MethodPost, "https://api.twitter.com/1.1/statuses/update.json", params, "kAcSOqF21Fu85e7zjz7ZN2U4ZRhfV3WpwPAoE3Z7kBw", "LswwdoUaIvS8ltyTt5jkRh4J50vUPVVHtR2YPi5kE")
+	assert.Equal(t, "hCtSmYh+iHYCEqBWrE7C7hYmtUk=", got)
+}
+
+func Test_percentEncode(t *testing.T) {
+	assert.Equal(t, "Ladies%20%2B%20Gentlemen", percentEncode("Ladies + Gentlemen"))
+	assert.Equal(t, "a%2Ab~c-d_e.f", percentEncode("a*b~c-d_e.f"))
+}
+
+func TestPublisher_Publish(t *testing.T) {
+	var got tweetRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/2/tweets", r.URL.Path)
+
+		auth := r.Header.Get("Authorization")
+		assert.True(t, strings.HasPrefix(auth, "OAuth "), auth)
+		assert.Contains(t, auth, `oauth_consumer_key="the-key"`)
+		assert.Contains(t, auth, `oauth_token="the-token"`)
+		assert.Contains(t, auth, `oauth_nonce="the-nonce"`)
+		assert.Contains(t, auth, `oauth_timestamp="1677628800"`)
+		assert.Contains(t, auth, `oauth_signature="`)
+
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data": {"id": "1630000000000000000", "text": "v0.4.1 is out"}}`))
+	}))
+	defer server.Close()
+
+	p, err := NewPublisher(Config{
+		APIURL:            server.URL + "/2",
+		APIKey:            "the-key",
+		APISecret:         "the-secret",
+		AccessToken:       "the-token",
+		AccessTokenSecret: "the-token-secret",
+	})
+	require.NoError(t, err)
+	p.now = func() time.Time { return time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC) }
+	p.nonce = func() string { return "the-nonce" }
+
+	url, err := p.Publish([]byte("v0.4.1 is out"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://x.com/i/web/status/1630000000000000000", url)
+	assert.Equal(t, tweetRequest{Text: "v0.4.1 is out"}, got)
+}
+
+func TestNewPublisher_missingKeys(t *testing.T) {
+	_, err := NewPublisher(Config{APIKey: "the-key"})
+	require.Error(t, err)
+}
@@ -6,6 +6,8 @@ import (
 
 // Release represents a version of software at a point in time.
 type Release struct {
-	Version string
-	Date    time.Time
+	Version    string
+	Date       time.Time
+	Draft      bool // the release has not been published yet (e.g. a github draft release), so its notes may still be replaced
+	Prerelease bool // the release is marked as a pre-release by the source (see IsPrerelease)
 }
@@ -0,0 +1,86 @@
+package commits
+
+import (
+	"regexp"
+	"strings"
+)
+
+// e.g. "feat(parser)!: support nested lists" (see https://www.conventionalcommits.org)
+var conventionalSubjectPattern = regexp.MustCompile(`^(?P<type>[a-zA-Z]+)(\((?P<scope>[^)]*)\))?(?P<breaking>!)?:\s+(?P<description>.+)$`)
+
+// e.g. "Change-Id: I0123456789abcdef0123456789abcdef01234567"
+var trailerPattern = regexp.MustCompile(`^(?P<key>[A-Za-z][A-Za-z0-9 -]*):\s*(?P<value>.+)$`)
+
+// conventionalCommit is the parsed subject (and trailers) of a commit message that follows the conventional commits
+// specification.
+type conventionalCommit struct {
+	Type        string
+	Scope       string
+	Breaking    bool
+	Description string
+	Trailers    []trailer
+}
+
+type trailer struct {
+	Key   string
+	Value string
+}
+
+// parseConventionalCommit returns the parsed commit message, or nil if the subject is not a conventional commit.
+func parseConventionalCommit(message string) *conventionalCommit {
+	subject := strings.TrimSpace(strings.SplitN(message, "\n", 2)[0])
+	match := conventionalSubjectPattern.FindStringSubmatch(subject)
+	if match == nil {
+		return nil
+	}
+
+	c := conventionalCommit{
+		Type:        strings.ToLower(match[conventionalSubjectPattern.SubexpIndex("type")]),
+		Scope:       match[conventionalSubjectPattern.SubexpIndex("scope")],
+		Breaking:    match[conventionalSubjectPattern.SubexpIndex("breaking")] != "",
+		Description: strings.TrimSpace(match[conventionalSubjectPattern.SubexpIndex("description")]),
+		Trailers:    parseTrailers(message),
+	}
+
+	for _, t := range c.Trailers {
+		if t.Key == "BREAKING CHANGE" || t.Key == "BREAKING-CHANGE" {
+			c.Breaking = true
+		}
+	}
+
+	return &c
+}
+
+// parseTrailers returns the "key: value" lines of the last paragraph of the commit message (as git interpret-trailers
+// does). The subject is never considered a trailer.
+func parseTrailers(message string) []trailer {
+	paragraphs := strings.Split(strings.TrimSpace(strings.ReplaceAll(message, "\r\n", "\n")), "\n\n")
+	if len(paragraphs) < 2 {
+		return nil
+	}
+
+	var trailers []trailer
+	for _, line := range strings.Split(paragraphs[len(paragraphs)-1], "\n") {
+		match := trailerPattern.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			// a paragraph with any other lines is part of the body, not the trailers
+			return nil
+		}
+		trailers = append(trailers, trailer{
+			Key:   match[trailerPattern.SubexpIndex("key")],
+			Value: strings.TrimSpace(match[trailerPattern.SubexpIndex("value")]),
+		})
+	}
+	return trailers
+}
+
+// trailerValues returns the values of all trailers with the given key (matched ignoring case).
+func trailerValues(trailers []trailer, key string) []string {
+	var values []string
+	for _, t := range trailers {
+		if strings.EqualFold(t.Key, key) {
+			values = append(values, t.Value)
+		}
+	}
+	return values
+}
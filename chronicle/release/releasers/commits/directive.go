@@ -0,0 +1,64 @@
+package commits
+
+import "strings"
+
+// directiveKey is the trailer that committers use to control how their commit appears in the changelog
+const directiveKey = "Changelog"
+
+// directive is an override of how a commit appears in the changelog, given by the committer as a "Changelog" trailer:
+//
+//	Changelog: none                   (the commit is left out of the changelog)
+//	Changelog: fix: a better title    (the commit is a "fix" described as "a better title")
+//	Changelog: a better title         (the commit keeps its type and is described as "a better title")
+type directive struct {
+	Suppress    bool
+	Type        string
+	Breaking    bool
+	Description string
+}
+
+// parseDirective returns the directive from the trailers, or nil when there is none. When there are several
+// directives, the last one is used.
+func parseDirective(trailers []trailer) *directive {
+	values := trailerValues(trailers, directiveKey)
+	if len(values) == 0 {
+		return nil
+	}
+	value := strings.TrimSpace(values[len(values)-1])
+
+	if strings.EqualFold(value, "none") {
+		return &directive{Suppress: true}
+	}
+
+	if match := conventionalSubjectPattern.FindStringSubmatch(value); match != nil {
+		return &directive{
+			Type:        strings.ToLower(match[conventionalSubjectPattern.SubexpIndex("type")]),
+			Breaking:    match[conventionalSubjectPattern.SubexpIndex("breaking")] != "",
+			Description: strings.TrimSpace(match[conventionalSubjectPattern.SubexpIndex("description")]),
+		}
+	}
+
+	return &directive{Description: value}
+}
+
+// apply overrides the commit with the directive. A commit that is not a conventional commit (nil) is only described by
+// a directive that gives a type.
+func (d directive) apply(c *conventionalCommit, trailers []trailer) *conventionalCommit {
+	if c == nil {
+		if d.Type == "" {
+			return nil
+		}
+		c = &conventionalCommit{Trailers: trailers}
+	}
+
+	result := *c
+	if d.Type != "" {
+		// the classification is replaced entirely, including whether the change is breaking
+		result.Type = d.Type
+		result.Breaking = d.Breaking
+	}
+	if d.Description != "" {
+		result.Description = d.Description
+	}
+	return &result
+}
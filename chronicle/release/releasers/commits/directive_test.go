@@ -0,0 +1,62 @@
+package commits
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseDirective(t *testing.T) {
+	tests := []struct {
+		name     string
+		trailers []trailer
+		want     *directive
+	}{
+		{
+			name:     "no directive",
+			trailers: []trailer{{Key: "Change-Id", Value: changeID}},
+		},
+		{
+			name:     "suppressed",
+			trailers: []trailer{{Key: "Changelog", Value: "None"}},
+			want:     &directive{Suppress: true},
+		},
+		{
+			name:     "type and title",
+			trailers: []trailer{{Key: "changelog", Value: "Fix!: a better title"}},
+			want:     &directive{Type: "fix", Breaking: true, Description: "a better title"},
+		},
+		{
+			name:     "title only",
+			trailers: []trailer{{Key: "Changelog", Value: "a better title"}},
+			want:     &directive{Description: "a better title"},
+		},
+		{
+			name: "last directive wins",
+			trailers: []trailer{
+				{Key: "Changelog", Value: "none"},
+				{Key: "Changelog", Value: "feat: add the thing"},
+			},
+			want: &directive{Type: "feat", Description: "add the thing"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseDirective(tt.trailers))
+		})
+	}
+}
+
+func Test_directive_apply(t *testing.T) {
+	commit := &conventionalCommit{Type: "feat", Breaking: true, Description: "add the thing"}
+
+	assert.Equal(t, &conventionalCommit{Type: "feat", Breaking: true, Description: "a better title"}, directive{Description: "a better title"}.apply(commit, nil))
+	assert.Equal(t, &conventionalCommit{Type: "fix", Description: "add the thing"}, directive{Type: "fix"}.apply(commit, nil))
+
+	// the commit is not modified
+	assert.Equal(t, "add the thing", commit.Description)
+
+	// a commit that is not a conventional commit needs a type to be included
+	assert.Nil(t, directive{Description: "a better title"}.apply(nil, nil))
+	assert.Equal(t, &conventionalCommit{Type: "fix", Description: "a title"}, directive{Type: "fix", Description: "a title"}.apply(nil, nil))
+}
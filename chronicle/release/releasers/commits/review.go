@@ -0,0 +1,72 @@
+package commits
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+const (
+	// changeIDTrailer is added by the gerrit commit-msg hook to identify the review a commit was submitted from
+	changeIDTrailer = "Change-Id"
+
+	// differentialTrailer is added by arcanist (phabricator) to identify the review a commit was landed from
+	differentialTrailer = "Differential Revision"
+)
+
+// e.g. "I0123456789abcdef0123456789abcdef01234567"
+var changeIDPattern = regexp.MustCompile(`^I[0-9a-f]{40}$`)
+
+// e.g. "D123" or "https://phabricator.example.com/D123"
+var differentialPattern = regexp.MustCompile(`(?:^|/)(?P<revision>D\d+)/?$`)
+
+// reviewReferences returns the references (and identities) for the code reviews that the commit trailers point to,
+// linked to the configured gerrit and phabricator instances.
+func reviewReferences(config Config, trailers []trailer) (refs []change.Reference, identities []string) {
+	for _, id := range trailerValues(trailers, changeIDTrailer) {
+		if !changeIDPattern.MatchString(id) {
+			log.Tracef("ignoring malformed %s trailer %q", changeIDTrailer, id)
+			continue
+		}
+		refs = append(refs, change.Reference{
+			Text: "Change " + id[:9],
+			URL:  joinURL(config.GerritURL, "q/"+id),
+		})
+		// note: this matches the identity of changes from the gerrit summarizer
+		identities = append(identities, "gerrit:"+id)
+	}
+
+	for _, value := range trailerValues(trailers, differentialTrailer) {
+		match := differentialPattern.FindStringSubmatch(value)
+		if match == nil {
+			log.Tracef("ignoring malformed %s trailer %q", differentialTrailer, value)
+			continue
+		}
+		revision := match[differentialPattern.SubexpIndex("revision")]
+
+		url := joinURL(config.PhabricatorURL, revision)
+		if strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://") {
+			// arcanist records the full URL of the revision, which is kept when no instance is configured
+			if config.PhabricatorURL == "" {
+				url = value
+			}
+		}
+
+		refs = append(refs, change.Reference{
+			Text: revision,
+			URL:  url,
+		})
+		identities = append(identities, "phabricator:"+revision)
+	}
+
+	return refs, identities
+}
+
+func joinURL(base, path string) string {
+	if base == "" {
+		return ""
+	}
+	return strings.TrimSuffix(base, "/") + "/" + path
+}
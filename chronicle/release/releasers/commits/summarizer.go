@@ -0,0 +1,141 @@
+package commits
+
+import (
+	"fmt"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/tags"
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+var _ release.Summarizer = (*Summarizer)(nil)
+
+// breakingType is the key within ChangeTypesByType for commits marked as breaking changes (e.g. "feat!: ...")
+const breakingType = "breaking"
+
+type Config struct {
+	ChangeTypesByType change.TypeSet // the change type for each conventional commit type (e.g. "feat" or "fix")
+	GerritURL         string         // the gerrit base URL that "Change-Id" trailers link to (e.g. "https://review.example.com")
+	PhabricatorURL    string         // the phabricator base URL that "Differential Revision" trailers link to (e.g. "https://phabricator.example.com")
+}
+
+// Summarizer builds changes from the conventional commit messages between two tags in the local repo alone (that is,
+// no API is used). Releases are determined by the semver tags in the local repo.
+type Summarizer struct {
+	tags.Releaser
+	git    git.Interface
+	config Config
+}
+
+func NewSummarizer(gitter git.Interface, config Config) (*Summarizer, error) {
+	log.WithFields("gerrit", config.GerritURL, "phabricator", config.PhabricatorURL).Debug("commits summarizer")
+
+	return &Summarizer{
+		Releaser: tags.NewReleaser(gitter),
+		git:      gitter,
+		config:   config,
+	}, nil
+}
+
+func (s *Summarizer) ReferenceURL(_ string) string {
+	// the local repo has no notion of where a release is hosted
+	return ""
+}
+
+func (s *Summarizer) ChangesURL(_, _ string) string {
+	// the local repo has no notion of where the source changes are hosted
+	return ""
+}
+
+// Changes returns a change for each conventional commit after the since ref up to (and including) the until ref (or
+// HEAD when there is no until ref). Commits that are not conventional commits, or have a type without a change type,
+// are not included. A "Changelog" trailer overrides the type and text of the commit, or leaves it out with
+// "Changelog: none" (see directive).
+func (s *Summarizer) Changes(sinceRef, untilRef string) ([]change.Change, error) {
+	var err error
+	if untilRef == "" {
+		untilRef, err = s.git.HeadTagOrCommit()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	commits, err := s.git.CommitLogBetween(git.Range{
+		SinceRef:     sinceRef,
+		UntilRef:     untilRef,
+		IncludeStart: sinceRef == "",
+		IncludeEnd:   true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch commit range: %w", err)
+	}
+
+	log.Debugf("total commits discovered: %d", len(commits))
+
+	var changes []change.Change
+	for _, c := range commits {
+		cc := parseConventionalCommit(c.Message)
+		trailers := parseTrailers(c.Message)
+		if d := parseDirective(trailers); d != nil {
+			if d.Suppress {
+				log.Tracef("commit %s filtered out: suppressed by its %q trailer", shortHash(c.Hash), directiveKey)
+				continue
+			}
+			cc = d.apply(cc, trailers)
+		}
+		if cc == nil {
+			log.Tracef("commit %s filtered out: not a conventional commit (%q)", shortHash(c.Hash), c.Subject())
+			continue
+		}
+
+		changeTypes := s.config.ChangeTypesByType.ChangeTypes(cc.Type)
+		if cc.Breaking {
+			if breaking := s.config.ChangeTypesByType.ChangeTypes(breakingType); len(breaking) > 0 {
+				changeTypes = breaking
+			}
+		}
+		if len(changeTypes) == 0 {
+			log.Tracef("commit %s filtered out: no change type for %q", shortHash(c.Hash), cc.Type)
+			continue
+		}
+
+		refs := []change.Reference{
+			{
+				Text: shortHash(c.Hash),
+			},
+		}
+		reviewRefs, reviewIdentities := reviewReferences(s.config, cc.Trailers)
+		refs = append(refs, reviewRefs...)
+
+		var authors []change.Reference
+		if c.AuthorName != "" {
+			authors = append(authors, change.Reference{
+				Text: c.AuthorName,
+			})
+		}
+
+		changes = append(changes, change.Change{
+			Text:        cc.Description,
+			ChangeTypes: changeTypes,
+			Timestamp:   c.Timestamp,
+			References:  refs,
+			EntryType:   "commit",
+			Entry:       c,
+			Identities:  append([]string{"commit:" + c.Hash}, reviewIdentities...),
+			Authors:     authors,
+		})
+	}
+
+	log.Debugf("commits contributing to changelog: %d", len(changes))
+
+	return changes, nil
+}
+
+func shortHash(hash string) string {
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+	return hash
+}
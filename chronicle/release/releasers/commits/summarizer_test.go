@@ -0,0 +1,236 @@
+package commits
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal/git"
+)
+
+const changeID = "I0123456789abcdef0123456789abcdef01234567"
+
+func Test_parseConventionalCommit(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    *conventionalCommit
+	}{
+		{
+			name:    "type only",
+			message: "fix: handle empty tags\n",
+			want:    &conventionalCommit{Type: "fix", Description: "handle empty tags"},
+		},
+		{
+			name:    "scope and breaking marker",
+			message: "Feat(parser)!: support nested lists",
+			want:    &conventionalCommit{Type: "feat", Scope: "parser", Breaking: true, Description: "support nested lists"},
+		},
+		{
+			name:    "breaking change trailer",
+			message: "feat: drop the v1 API\n\nThe v1 API has been deprecated for a year.\n\nBREAKING CHANGE: the v1 API is gone",
+			want: &conventionalCommit{
+				Type:        "feat",
+				Breaking:    true,
+				Description: "drop the v1 API",
+				Trailers:    []trailer{{Key: "BREAKING CHANGE", Value: "the v1 API is gone"}},
+			},
+		},
+		{
+			name:    "not a conventional commit",
+			message: "Merge branch 'main' into feature",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseConventionalCommit(tt.message))
+		})
+	}
+}
+
+func Test_parseTrailers(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    []trailer
+	}{
+		{
+			name:    "subject only",
+			message: "Change-Id: " + changeID,
+		},
+		{
+			name:    "trailers after the body",
+			message: "fix: the thing\n\nA longer description.\n\nChange-Id: " + changeID + "\nSigned-off-by: Someone <someone@example.com>\n",
+			want: []trailer{
+				{Key: "Change-Id", Value: changeID},
+				{Key: "Signed-off-by", Value: "Someone <someone@example.com>"},
+			},
+		},
+		{
+			name:    "last paragraph is part of the body",
+			message: "fix: the thing\n\nNote: this is a longer description\nthat spans lines.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseTrailers(tt.message))
+		})
+	}
+}
+
+func Test_reviewReferences(t *testing.T) {
+	trailers := []trailer{
+		{Key: "Change-Id", Value: changeID},
+		{Key: "Change-Id", Value: "not-a-change-id"},
+		{Key: "Differential Revision", Value: "https://phab.example.com/D123"},
+	}
+
+	tests := []struct {
+		name           string
+		config         Config
+		wantRefs       []change.Reference
+		wantIdentities []string
+	}{
+		{
+			name: "no instances configured",
+			wantRefs: []change.Reference{
+				{Text: "Change I01234567"},
+				{Text: "D123", URL: "https://phab.example.com/D123"},
+			},
+			wantIdentities: []string{"gerrit:" + changeID, "phabricator:D123"},
+		},
+		{
+			name: "instances configured",
+			config: Config{
+				GerritURL:      "https://review.example.com/",
+				PhabricatorURL: "https://phabricator.example.com",
+			},
+			wantRefs: []change.Reference{
+				{Text: "Change I01234567", URL: "https://review.example.com/q/" + changeID},
+				{Text: "D123", URL: "https://phabricator.example.com/D123"},
+			},
+			wantIdentities: []string{"gerrit:" + changeID, "phabricator:D123"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refs, identities := reviewReferences(tt.config, trailers)
+			assert.Equal(t, tt.wantRefs, refs)
+			assert.Equal(t, tt.wantIdentities, identities)
+		})
+	}
+}
+
+func TestSummarizer_Changes(t *testing.T) {
+	bug := change.NewType("bug-fix", change.SemVerPatch)
+	feature := change.NewType("added-feature", change.SemVerMinor)
+	breaking := change.NewType("breaking-feature", change.SemVerMajor)
+	timestamp := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	gitter := git.MockInterface{
+		MockHeadOrTagCommit: "abcdef0",
+		MockCommitLog: []git.Commit{
+			{
+				Hash:       "1111111111111111111111111111111111111111",
+				Message:    "fix: handle empty tags\n\nChange-Id: " + changeID,
+				AuthorName: "Someone",
+				Timestamp:  timestamp,
+			},
+			{
+				Hash:      "2222222222222222222222222222222222222222",
+				Message:   "docs: update the readme",
+				Timestamp: timestamp,
+			},
+			{
+				Hash:      "3333333333333333333333333333333333333333",
+				Message:   "feat!: drop the v1 API",
+				Timestamp: timestamp,
+			},
+			{
+				Hash:      "4444444444444444444444444444444444444444",
+				Message:   "update dependencies",
+				Timestamp: timestamp,
+			},
+		},
+	}
+
+	summer, err := NewSummarizer(gitter, Config{
+		ChangeTypesByType: change.TypeSet{
+			"fix":      bug,
+			"feat":     feature,
+			"breaking": breaking,
+		},
+		GerritURL: "https://review.example.com",
+	})
+	require.NoError(t, err)
+
+	changes, err := summer.Changes("v0.1.0", "")
+	require.NoError(t, err)
+
+	require.Len(t, changes, 2)
+
+	assert.Equal(t, "handle empty tags", changes[0].Text)
+	assert.Equal(t, []change.Type{bug}, changes[0].ChangeTypes)
+	assert.Equal(t, []change.Reference{
+		{Text: "1111111"},
+		{Text: "Change I01234567", URL: "https://review.example.com/q/" + changeID},
+	}, changes[0].References)
+	assert.Equal(t, []string{"commit:1111111111111111111111111111111111111111", "gerrit:" + changeID}, changes[0].Identities)
+	assert.Equal(t, []change.Reference{{Text: "Someone"}}, changes[0].Authors)
+
+	assert.Equal(t, "drop the v1 API", changes[1].Text)
+	assert.Equal(t, []change.Type{breaking}, changes[1].ChangeTypes)
+	assert.Empty(t, changes[1].Authors)
+}
+
+func TestSummarizer_Changes_directives(t *testing.T) {
+	bug := change.NewType("bug-fix", change.SemVerPatch)
+	feature := change.NewType("added-feature", change.SemVerMinor)
+
+	gitter := git.MockInterface{
+		MockHeadOrTagCommit: "abcdef0",
+		MockCommitLog: []git.Commit{
+			{
+				Hash:    "1111111111111111111111111111111111111111",
+				Message: "fix: tweak the build\n\nChangelog: none",
+			},
+			{
+				Hash:    "2222222222222222222222222222222222222222",
+				Message: "feat: add the thing\n\nChangelog: fix: handle the empty case",
+			},
+			{
+				Hash:    "3333333333333333333333333333333333333333",
+				Message: "Add the other thing\n\nChangelog: feat: add the other thing",
+			},
+			{
+				Hash:    "4444444444444444444444444444444444444444",
+				Message: "feat: add a thing\n\nChangelog: Add support for a thing",
+			},
+		},
+	}
+
+	summer, err := NewSummarizer(gitter, Config{
+		ChangeTypesByType: change.TypeSet{
+			"fix":  bug,
+			"feat": feature,
+		},
+	})
+	require.NoError(t, err)
+
+	changes, err := summer.Changes("v0.1.0", "")
+	require.NoError(t, err)
+
+	require.Len(t, changes, 3)
+
+	assert.Equal(t, "handle the empty case", changes[0].Text)
+	assert.Equal(t, []change.Type{bug}, changes[0].ChangeTypes)
+
+	assert.Equal(t, "add the other thing", changes[1].Text)
+	assert.Equal(t, []change.Type{feature}, changes[1].ChangeTypes)
+
+	assert.Equal(t, "Add support for a thing", changes[2].Text)
+	assert.Equal(t, []change.Type{feature}, changes[2].ChangeTypes)
+}
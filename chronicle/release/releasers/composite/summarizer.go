@@ -0,0 +1,187 @@
+package composite
+
+import (
+	"fmt"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+var _ release.Summarizer = (*Summarizer)(nil)
+var _ release.ContributorSummarizer = (*Summarizer)(nil)
+
+// Source is a named summarizer that contributes changes to a composite summarizer.
+type Source struct {
+	Name       string
+	Summarizer release.Summarizer
+}
+
+// Config controls how the changes from the sources are merged.
+type Config struct {
+	// TitleSimilarity is how alike (from 0 to 1) the normalized titles of changes from different sources must be for
+	// the changes to be considered the same, for changes that do not share an identity (e.g. a commit and a PR whose
+	// merge commit is not known). Titles are compared ignoring case, punctuation, conventional commit prefixes, and
+	// a trailing PR number. Disabled when 0.
+	TitleSimilarity float64
+}
+
+// Summarizer combines the changes from several sources. Sources are given in priority order: releases and URLs are
+// always taken from the first source, and when several sources report the same change (that is, changes that share
+// an identity, or optionally have near-identical titles) the change from the highest priority source is kept
+// (including its text and change types) while the references from the remaining sources are merged into it.
+type Summarizer struct {
+	config  Config
+	sources []Source
+}
+
+func NewSummarizer(config Config, sources ...Source) (*Summarizer, error) {
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no sources given for composite summarizer")
+	}
+
+	var names []string
+	for _, s := range sources {
+		names = append(names, s.Name)
+	}
+
+	log.WithFields("sources", names, "title-similarity", config.TitleSimilarity).Debug("composite summarizer")
+
+	return &Summarizer{
+		config:  config,
+		sources: sources,
+	}, nil
+}
+
+func (s *Summarizer) primary() release.Summarizer {
+	return s.sources[0].Summarizer
+}
+
+func (s *Summarizer) LastRelease() (*release.Release, error) {
+	return s.primary().LastRelease()
+}
+
+// Releases lists the releases of the primary source (when it can list them).
+func (s *Summarizer) Releases() ([]release.Release, error) {
+	lister, ok := s.primary().(release.ReleaseLister)
+	if !ok {
+		return nil, nil
+	}
+	return lister.Releases()
+}
+
+func (s *Summarizer) Release(ref string) (*release.Release, error) {
+	return s.primary().Release(ref)
+}
+
+func (s *Summarizer) ReferenceURL(tag string) string {
+	return s.primary().ReferenceURL(tag)
+}
+
+func (s *Summarizer) ChangesURL(sinceRef, untilRef string) string {
+	return s.primary().ChangesURL(sinceRef, untilRef)
+}
+
+// NewContributors returns the new contributors found by the primary source (contributors are people, not changes, so
+// they are not merged across sources).
+func (s *Summarizer) NewContributors(sinceRef, untilRef string) ([]release.Contributor, error) {
+	cs, ok := s.primary().(release.ContributorSummarizer)
+	if !ok {
+		log.Warnf("the primary source %q does not support finding new contributors", s.sources[0].Name)
+		return nil, nil
+	}
+	return cs.NewContributors(sinceRef, untilRef)
+}
+
+func (s *Summarizer) Changes(sinceRef, untilRef string) ([]change.Change, error) {
+	var results []change.Change
+	for _, source := range s.sources {
+		changes, err := source.Summarizer.Changes(sinceRef, untilRef)
+		if err != nil {
+			return nil, fmt.Errorf("unable to summarize changes from %q: %w", source.Name, err)
+		}
+
+		log.WithFields("source", source.Name).Debugf("changes discovered: %d", len(changes))
+
+		// only changes from higher priority sources are considered duplicates (changes from within the same source
+		// are always distinct, e.g. a PR split into several entries)
+		results = mergeChanges(s.config, results, changes, source.Name)
+	}
+
+	return results, nil
+}
+
+func mergeChanges(config Config, existing, changes []change.Change, sourceName string) []change.Change {
+	results := existing
+	for _, c := range changes {
+		idx := findDuplicate(config, existing, c)
+		if idx < 0 {
+			results = append(results, c)
+			continue
+		}
+
+		log.Tracef("change %q from %q is a duplicate of %q", c.Text, sourceName, results[idx].Text)
+		results[idx] = mergeChange(results[idx], c)
+	}
+	return results
+}
+
+func findDuplicate(config Config, changes []change.Change, c change.Change) int {
+	for idx, existing := range changes {
+		if existing.SharesIdentity(c) {
+			return idx
+		}
+	}
+
+	if config.TitleSimilarity <= 0 {
+		return -1
+	}
+
+	// identities are preferred over titles, so titles are only compared once no change shares an identity
+	best, bestSimilarity := -1, config.TitleSimilarity
+	for idx, existing := range changes {
+		if similarity := titleSimilarity(existing.Text, c.Text); similarity >= bestSimilarity {
+			best, bestSimilarity = idx, similarity
+		}
+	}
+	return best
+}
+
+// mergeChange folds the references and identities of a lower priority change into a higher priority change.
+func mergeChange(preferred, other change.Change) change.Change {
+	// the slices may be shared with other changes from the same source, so never append to them in place
+	preferred.References = append([]change.Reference(nil), preferred.References...)
+	preferred.Identities = append([]string(nil), preferred.Identities...)
+
+	for _, ref := range other.References {
+		if !hasReference(preferred.References, ref) {
+			preferred.References = append(preferred.References, ref)
+		}
+	}
+
+	for _, id := range other.Identities {
+		if !hasIdentity(preferred.Identities, id) {
+			preferred.Identities = append(preferred.Identities, id)
+		}
+	}
+
+	return preferred
+}
+
+func hasReference(refs []change.Reference, ref change.Reference) bool {
+	for _, r := range refs {
+		if r == ref {
+			return true
+		}
+	}
+	return false
+}
+
+func hasIdentity(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+package composite
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+type mockSummarizer struct {
+	release.Summarizer
+	changes []change.Change
+	err     error
+	url     string
+}
+
+func (m mockSummarizer) Changes(_, _ string) ([]change.Change, error) {
+	return m.changes, m.err
+}
+
+func (m mockSummarizer) ReferenceURL(tag string) string {
+	return m.url + "/" + tag
+}
+
+func TestSummarizer_Changes(t *testing.T) {
+	bug := change.NewType("bug-fix", change.SemVerPatch)
+	feature := change.NewType("added-feature", change.SemVerMinor)
+
+	github := mockSummarizer{
+		url: "https://github.com/org/repo",
+		changes: []change.Change{
+			{
+				Text:        "fix the bug",
+				ChangeTypes: []change.Type{bug},
+				References:  []change.Reference{{Text: "PR #1", URL: "pr-1-url"}},
+				Identities:  []string{"github-pr:1", "commit:abc"},
+			},
+			{
+				Text:        "first part",
+				ChangeTypes: []change.Type{feature},
+				References:  []change.Reference{{Text: "PR #2", URL: "pr-2-url"}},
+				Identities:  []string{"github-pr:2", "commit:def"},
+			},
+			{
+				// a PR split into several entries is not deduplicated
+				Text:        "second part",
+				ChangeTypes: []change.Type{feature},
+				References:  []change.Reference{{Text: "PR #2", URL: "pr-2-url"}},
+				Identities:  []string{"github-pr:2", "commit:def"},
+			},
+		},
+	}
+
+	jira := mockSummarizer{
+		changes: []change.Change{
+			{
+				Text:        "The bug is fixed",
+				ChangeTypes: []change.Type{feature},
+				References:  []change.Reference{{Text: "CHR-1", URL: "chr-1-url"}},
+				Identities:  []string{"jira:CHR-1", "commit:abc"},
+			},
+			{
+				Text:        "Unrelated",
+				ChangeTypes: []change.Type{bug},
+				References:  []change.Reference{{Text: "CHR-2", URL: "chr-2-url"}},
+				Identities:  []string{"jira:CHR-2", "commit:ghi"},
+			},
+		},
+	}
+
+	s, err := NewSummarizer(Config{}, Source{Name: "github", Summarizer: github}, Source{Name: "jira", Summarizer: jira})
+	require.NoError(t, err)
+
+	changes, err := s.Changes("v0.1.0", "v0.2.0")
+	require.NoError(t, err)
+
+	assert.Equal(t, []change.Change{
+		{
+			// text and change types from the higher priority source win
+			Text:        "fix the bug",
+			ChangeTypes: []change.Type{bug},
+			References:  []change.Reference{{Text: "PR #1", URL: "pr-1-url"}, {Text: "CHR-1", URL: "chr-1-url"}},
+			Identities:  []string{"github-pr:1", "commit:abc", "jira:CHR-1"},
+		},
+		github.changes[1],
+		github.changes[2],
+		jira.changes[1],
+	}, changes)
+
+	// the original changes are not modified
+	assert.Len(t, github.changes[0].References, 1)
+
+	// releases and URLs come from the primary source
+	assert.Equal(t, "https://github.com/org/repo/v0.2.0", s.ReferenceURL("v0.2.0"))
+}
+
+func TestSummarizer_Changes_error(t *testing.T) {
+	s, err := NewSummarizer(Config{}, Source{Name: "github", Summarizer: mockSummarizer{}}, Source{Name: "jira", Summarizer: mockSummarizer{err: fmt.Errorf("bad")}})
+	require.NoError(t, err)
+
+	_, err = s.Changes("v0.1.0", "")
+	require.ErrorContains(t, err, "jira")
+}
+
+func TestNewSummarizer_noSources(t *testing.T) {
+	_, err := NewSummarizer(Config{})
+	require.Error(t, err)
+}
+
+func TestSummarizer_Changes_titleSimilarity(t *testing.T) {
+	feature := change.NewType("added-feature", change.SemVerMinor)
+
+	github := mockSummarizer{
+		changes: []change.Change{
+			{
+				Text:        "Add support for the thing",
+				ChangeTypes: []change.Type{feature},
+				References:  []change.Reference{{Text: "PR #12", URL: "pr-12-url"}},
+				Identities:  []string{"github-pr:12"},
+			},
+		},
+	}
+
+	commits := mockSummarizer{
+		changes: []change.Change{
+			{
+				Text:        "feat: add support for the thing (#12)",
+				ChangeTypes: []change.Type{feature},
+				References:  []change.Reference{{Text: "abc1234"}},
+				Identities:  []string{"commit:abc1234"},
+			},
+			{
+				Text:        "feat: add support for another thing",
+				ChangeTypes: []change.Type{feature},
+				References:  []change.Reference{{Text: "def5678"}},
+				Identities:  []string{"commit:def5678"},
+			},
+		},
+	}
+
+	sources := []Source{{Name: "github", Summarizer: github}, {Name: "commits", Summarizer: commits}}
+
+	s, err := NewSummarizer(Config{TitleSimilarity: 0.9}, sources...)
+	require.NoError(t, err)
+
+	changes, err := s.Changes("v0.1.0", "v0.2.0")
+	require.NoError(t, err)
+
+	assert.Equal(t, []change.Change{
+		{
+			Text:        "Add support for the thing",
+			ChangeTypes: []change.Type{feature},
+			References:  []change.Reference{{Text: "PR #12", URL: "pr-12-url"}, {Text: "abc1234"}},
+			Identities:  []string{"github-pr:12", "commit:abc1234"},
+		},
+		commits.changes[1],
+	}, changes)
+
+	// without a threshold only the identities are compared
+	s, err = NewSummarizer(Config{}, sources...)
+	require.NoError(t, err)
+
+	changes, err = s.Changes("v0.1.0", "v0.2.0")
+	require.NoError(t, err)
+	assert.Len(t, changes, 3)
+}
@@ -0,0 +1,74 @@
+package composite
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	// e.g. "feat(api)!: " from a conventional commit
+	conventionalPrefixPattern = regexp.MustCompile(`^[a-zA-Z]+(\([^)]*\))?!?:\s*`)
+	// e.g. " (#123)" appended to a squash merged commit
+	pullRequestSuffixPattern = regexp.MustCompile(`\s*\(#\d+\)$`)
+)
+
+// normalizeTitle reduces a change title to the words that identify it, so that the same change reported by different
+// sources (e.g. "feat(api): Add the thing (#12)" as a commit and "Add the thing" as a PR) compares as equal.
+func normalizeTitle(title string) string {
+	title = strings.TrimSpace(title)
+	title = pullRequestSuffixPattern.ReplaceAllString(title, "")
+	title = conventionalPrefixPattern.ReplaceAllString(title, "")
+
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	return strings.Join(words, " ")
+}
+
+// titleSimilarity returns how alike the two titles are once normalized, from 0 (nothing in common) to 1 (the same),
+// based on the edit distance between them.
+func titleSimilarity(a, b string) float64 {
+	x, y := []rune(normalizeTitle(a)), []rune(normalizeTitle(b))
+	longest := len(x)
+	if len(y) > longest {
+		longest = len(y)
+	}
+	if longest == 0 {
+		// note: titles without any words are not considered alike (there is nothing to compare)
+		return 0
+	}
+	return 1 - float64(editDistance(x, y))/float64(longest)
+}
+
+// editDistance is the number of single character insertions, deletions, or substitutions to turn one string into
+// the other (the levenshtein distance).
+func editDistance(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func min(values ...int) int {
+	result := values[0]
+	for _, v := range values[1:] {
+		if v < result {
+			result = v
+		}
+	}
+	return result
+}
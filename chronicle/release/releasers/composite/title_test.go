@@ -0,0 +1,32 @@
+package composite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_normalizeTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{title: "Add the thing", want: "add the thing"},
+		{title: "feat(api)!: Add the thing (#123)", want: "add the thing"},
+		{title: "fix: don't panic on empty input.", want: "don t panic on empty input"},
+		{title: "  Update   README  ", want: "update readme"},
+		{title: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeTitle(tt.title))
+		})
+	}
+}
+
+func Test_titleSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, titleSimilarity("fix: Handle the empty case (#4)", "Handle the empty case"))
+	assert.InDelta(t, 0.95, titleSimilarity("handle the empty cases", "handle the empty case"), 0.01)
+	assert.Less(t, titleSimilarity("add the thing", "remove the other thing"), 0.6)
+	assert.Equal(t, 0.0, titleSimilarity("", "..."))
+}
@@ -0,0 +1,61 @@
+package fragments
+
+import (
+	"strconv"
+	"strings"
+)
+
+// fragment is a single news fragment file (e.g. "changelog.d/123.fix.md"), named "<id>.<type>[.<counter>][.<ext>]"
+// where the ID is an issue or PR number, or an arbitrary name prefixed with "+" for changes without an issue or PR.
+type fragment struct {
+	Name string // the file name of the fragment
+	ID   string // the issue or PR number, or empty for orphan fragments
+	Type string
+	Text string
+}
+
+var fragmentExtensions = []string{".md", ".rst", ".txt"}
+
+// parseFragment parses the fragment file name and contents, returning nil if the file name is not a fragment.
+func parseFragment(name, contents string) *fragment {
+	base := name
+	for _, ext := range fragmentExtensions {
+		if strings.HasSuffix(base, ext) {
+			base = strings.TrimSuffix(base, ext)
+			break
+		}
+	}
+
+	fields := strings.Split(base, ".")
+	switch len(fields) {
+	case 2:
+	case 3:
+		// a counter allows several fragments of the same type for the same issue (e.g. "123.fix.1")
+		if _, err := strconv.Atoi(fields[2]); err != nil {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	id, fragmentType := fields[0], fields[1]
+	if id == "" || fragmentType == "" {
+		return nil
+	}
+
+	text := strings.TrimSpace(contents)
+	if text == "" {
+		return nil
+	}
+
+	if strings.HasPrefix(id, "+") {
+		id = ""
+	}
+
+	return &fragment{
+		Name: name,
+		ID:   id,
+		Type: fragmentType,
+		Text: text,
+	}
+}
@@ -0,0 +1,186 @@
+package fragments
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/tags"
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+var _ release.Summarizer = (*Summarizer)(nil)
+
+type Config struct {
+	RepoPath          string         // the root of the git repo
+	Dir               string         // the directory of fragment files, relative to the repo root (e.g. "changelog.d")
+	IssueURLTemplate  string         // the URL for a fragment ID, where "{id}" is replaced (e.g. "https://github.com/org/repo/issues/{id}")
+	ChangeTypesByType change.TypeSet // the change type for each fragment type (e.g. "fix" or "feature")
+}
+
+// Summarizer builds changes from news fragment files (towncrier-style), where each change is written by the
+// contributor as a file within the fragment directory. Releases are determined by the semver tags in the local repo.
+// The summarizer is safe for concurrent use.
+type Summarizer struct {
+	tags.Releaser
+	git    git.Interface
+	config Config
+	lock   sync.Mutex
+	// consumed are the paths (relative to the repo root) of the fragment files within the working tree that were used
+	// for the last set of changes
+	consumed []string
+}
+
+func NewSummarizer(gitter git.Interface, config Config) (*Summarizer, error) {
+	if config.Dir == "" {
+		return nil, fmt.Errorf("no fragment directory configured")
+	}
+
+	log.WithFields("dir", config.Dir).Debug("fragment summarizer")
+
+	return &Summarizer{
+		Releaser: tags.NewReleaser(gitter),
+		git:      gitter,
+		config:   config,
+	}, nil
+}
+
+func (s *Summarizer) ReferenceURL(_ string) string {
+	// fragments have no notion of where a release is hosted
+	return ""
+}
+
+func (s *Summarizer) ChangesURL(_, _ string) string {
+	// fragments have no notion of where the source changes are hosted
+	return ""
+}
+
+// Changes returns a change for each fragment that is new since the given ref. When there is no until ref the fragments
+// are read from the working tree (that is, the fragments that will be consumed by the next release), otherwise they are
+// read from the git tree at the until ref.
+func (s *Summarizer) Changes(sinceRef, untilRef string) ([]change.Change, error) {
+	var files map[string]string
+	var err error
+	if untilRef == "" {
+		files, err = readWorkingTreeFiles(filepath.Join(s.config.RepoPath, s.config.Dir))
+	} else {
+		files, err = s.git.FilesAt(untilRef, s.config.Dir)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read fragments: %w", err)
+	}
+
+	// fragments that were already present at the last release belong to that release (this supports workflows where
+	// fragments are never removed)
+	if sinceRef != "" {
+		previous, err := s.git.FilesAt(sinceRef, s.config.Dir)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read fragments at %q: %w", sinceRef, err)
+		}
+		for name := range previous {
+			delete(files, name)
+		}
+	}
+
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var consumed []string
+	var changes []change.Change
+	for _, name := range names {
+		f := parseFragment(name, files[name])
+		if f == nil {
+			log.Tracef("file %q is not a fragment", name)
+			continue
+		}
+
+		changeTypes := s.config.ChangeTypesByType.ChangeTypes(f.Type)
+		if len(changeTypes) == 0 {
+			log.Warnf("fragment %q has an unknown type %q (ignoring)", name, f.Type)
+			continue
+		}
+
+		var refs []change.Reference
+		if f.ID != "" {
+			refs = append(refs, change.Reference{
+				Text: "#" + f.ID,
+				URL:  s.issueURL(f.ID),
+			})
+		}
+
+		changes = append(changes, change.Change{
+			Text:        f.Text,
+			ChangeTypes: changeTypes,
+			References:  refs,
+			EntryType:   "fragment",
+			Entry:       *f,
+			Identities:  []string{"fragment:" + name},
+		})
+
+		consumed = append(consumed, filepath.Join(s.config.Dir, name))
+	}
+
+	s.lock.Lock()
+	s.consumed = consumed
+	s.lock.Unlock()
+
+	log.Debugf("fragments contributing to changelog: %d", len(changes))
+
+	return changes, nil
+}
+
+func (s *Summarizer) issueURL(id string) string {
+	if s.config.IssueURLTemplate == "" {
+		return ""
+	}
+	return strings.ReplaceAll(s.config.IssueURLTemplate, "{id}", id)
+}
+
+// RemoveConsumed deletes the fragment files that were used for the last set of changes from the working tree, which
+// is typically done once the changelog for a release has been written.
+func (s *Summarizer) RemoveConsumed() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, p := range s.consumed {
+		err := os.Remove(filepath.Join(s.config.RepoPath, p))
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("unable to remove fragment %q: %w", p, err)
+		}
+		log.Debugf("removed fragment %q", p)
+	}
+	s.consumed = nil
+	return nil
+}
+
+func readWorkingTreeFiles(dir string) (map[string]string, error) {
+	files := make(map[string]string)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return files, nil
+		}
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		contents, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		files[entry.Name()] = string(contents)
+	}
+	return files, nil
+}
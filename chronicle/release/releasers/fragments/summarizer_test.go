@@ -0,0 +1,150 @@
+package fragments
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal/git"
+)
+
+func Test_parseFragment(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		want     *fragment
+	}{
+		{
+			name:     "123.fix.md",
+			contents: "Fix the thing\n",
+			want:     &fragment{Name: "123.fix.md", ID: "123", Type: "fix", Text: "Fix the thing"},
+		},
+		{
+			name:     "123.fix.1.md",
+			contents: "Fix the other thing",
+			want:     &fragment{Name: "123.fix.1.md", ID: "123", Type: "fix", Text: "Fix the other thing"},
+		},
+		{
+			name:     "+tooling.feature",
+			contents: "Add the tool",
+			want:     &fragment{Name: "+tooling.feature", Type: "feature", Text: "Add the tool"},
+		},
+		{
+			name:     "README.md",
+			contents: "not a fragment",
+		},
+		{
+			name:     "123.fix.notacounter.md",
+			contents: "not a fragment",
+		},
+		{
+			name:     "123.fix.md",
+			contents: "  \n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseFragment(tt.name, tt.contents))
+		})
+	}
+}
+
+func TestSummarizer_Changes(t *testing.T) {
+	bug := change.NewType("bug-fix", change.SemVerPatch)
+	feature := change.NewType("added-feature", change.SemVerMinor)
+
+	gitter := git.MockInterface{
+		MockFiles: map[string]map[string]string{
+			"v0.1.0": {
+				"1.fix.md": "Fix the first thing",
+			},
+			"v0.2.0": {
+				"1.fix.md":     "Fix the first thing",
+				"2.feature.md": "Add the feature",
+				"+misc.fix.md": "Fix something without an issue",
+				"3.unknown.md": "Unknown type",
+			},
+		},
+	}
+
+	s, err := NewSummarizer(gitter, Config{
+		Dir:              "changelog.d",
+		IssueURLTemplate: "https://github.com/org/repo/issues/{id}",
+		ChangeTypesByType: change.TypeSet{
+			"fix":     bug,
+			"feature": feature,
+		},
+	})
+	require.NoError(t, err)
+
+	changes, err := s.Changes("v0.1.0", "v0.2.0")
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+
+	assert.Equal(t, "Fix something without an issue", changes[0].Text)
+	assert.Equal(t, []change.Type{bug}, changes[0].ChangeTypes)
+	assert.Empty(t, changes[0].References)
+
+	assert.Equal(t, "Add the feature", changes[1].Text)
+	assert.Equal(t, []change.Type{feature}, changes[1].ChangeTypes)
+	assert.Equal(t, []change.Reference{{Text: "#2", URL: "https://github.com/org/repo/issues/2"}}, changes[1].References)
+}
+
+func TestSummarizer_Changes_workingTree(t *testing.T) {
+	repo := t.TempDir()
+	dir := filepath.Join(repo, "changelog.d")
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "5.fix.md"), []byte("Fix the thing"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("how to write fragments"), 0600))
+
+	s, err := NewSummarizer(git.MockInterface{}, Config{
+		RepoPath:          repo,
+		Dir:               "changelog.d",
+		ChangeTypesByType: change.TypeSet{"fix": change.NewType("bug-fix", change.SemVerPatch)},
+	})
+	require.NoError(t, err)
+
+	changes, err := s.Changes("", "")
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, []change.Reference{{Text: "#5"}}, changes[0].References)
+
+	require.NoError(t, s.RemoveConsumed())
+
+	assert.NoFileExists(t, filepath.Join(dir, "5.fix.md"))
+	assert.FileExists(t, filepath.Join(dir, "README.md"))
+}
+
+func TestSummarizer_Changes_concurrent(t *testing.T) {
+	repo := t.TempDir()
+	dir := filepath.Join(repo, "changelog.d")
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "5.fix.md"), []byte("Fix the thing"), 0600))
+
+	s, err := NewSummarizer(git.MockInterface{}, Config{
+		RepoPath:          repo,
+		Dir:               "changelog.d",
+		ChangeTypesByType: change.TypeSet{"fix": change.NewType("bug-fix", change.SemVerPatch)},
+	})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			changes, err := s.Changes("", "")
+			assert.NoError(t, err)
+			assert.Len(t, changes, 1)
+		}()
+	}
+	wg.Wait()
+
+	require.NoError(t, s.RemoveConsumed())
+	assert.NoFileExists(t, filepath.Join(dir, "5.fix.md"))
+}
@@ -0,0 +1,132 @@
+package gerrit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// the gerrit REST API prefixes all JSON responses with this magic line to prevent XSSI
+const xssiPrefix = ")]}'"
+
+// timestamps are always in UTC with nanosecond precision
+const timestampLayout = "2006-01-02 15:04:05.000000000"
+
+type gerritChange struct {
+	Number    int
+	ChangeID  string
+	Subject   string
+	Owner     string
+	Branch    string
+	Topic     string
+	Hashtags  []string
+	Submitted time.Time
+}
+
+// fetchMergedChanges returns all merged changes for the given project (optionally limited to a branch and a
+// submission time lower bound).
+// nolint:funlen
+func fetchMergedChanges(client *http.Client, host, user, password, project, branch string, since *time.Time) ([]gerritChange, error) {
+	query := []string{"status:merged", "project:" + project}
+	if branch != "" {
+		query = append(query, "branch:"+branch)
+	}
+	if since != nil {
+		query = append(query, fmt.Sprintf("after:%q", since.UTC().Format(timestampLayout)))
+	}
+
+	endpoint := strings.TrimSuffix(host, "/") + "/changes/"
+	if user != "" {
+		// authenticated requests are made against the "/a/" prefixed endpoints
+		endpoint = strings.TrimSuffix(host, "/") + "/a/changes/"
+	}
+
+	var allChanges []gerritChange
+	for {
+		params := url.Values{}
+		params.Set("q", strings.Join(query, " "))
+		params.Set("o", "DETAILED_ACCOUNTS")
+		params.Set("start", fmt.Sprintf("%d", len(allChanges)))
+
+		req, err := http.NewRequest(http.MethodGet, endpoint+"?"+params.Encode(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create request for changes: %w", err)
+		}
+		req.Header.Set("Accept", "application/json")
+		if user != "" {
+			req.SetBasicAuth(user, password)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch changes: %w", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unable to fetch changes: HTTP %d", resp.StatusCode)
+		}
+
+		var doc []struct {
+			Number   int      `json:"_number"`
+			ChangeID string   `json:"change_id"`
+			Subject  string   `json:"subject"`
+			Branch   string   `json:"branch"`
+			Topic    string   `json:"topic"`
+			Hashtags []string `json:"hashtags"`
+			Owner    struct {
+				Username string `json:"username"`
+				Name     string `json:"name"`
+			} `json:"owner"`
+			Submitted   string `json:"submitted"`
+			MoreChanges bool   `json:"_more_changes"`
+		}
+
+		reader := bufio.NewReader(resp.Body)
+		prefix, err := reader.ReadString('\n')
+		if err == nil && strings.TrimSpace(prefix) != xssiPrefix {
+			err = fmt.Errorf("missing XSSI prefix")
+		}
+		if err == nil {
+			err = json.NewDecoder(reader).Decode(&doc)
+		}
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse changes: %w", err)
+		}
+
+		for _, c := range doc {
+			submitted, err := time.Parse(timestampLayout, c.Submitted)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse submitted time for change %d: %w", c.Number, err)
+			}
+
+			owner := c.Owner.Username
+			if owner == "" {
+				owner = c.Owner.Name
+			}
+
+			allChanges = append(allChanges, gerritChange{
+				Number:    c.Number,
+				ChangeID:  c.ChangeID,
+				Subject:   c.Subject,
+				Owner:     owner,
+				Branch:    c.Branch,
+				Topic:     c.Topic,
+				Hashtags:  c.Hashtags,
+				Submitted: submitted,
+			})
+		}
+
+		// only the last change in the page indicates if there are more results
+		if len(doc) == 0 || !doc[len(doc)-1].MoreChanges {
+			break
+		}
+	}
+
+	return allChanges, nil
+}
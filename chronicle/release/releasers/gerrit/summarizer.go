@@ -0,0 +1,210 @@
+package gerrit
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/tags"
+	"github.com/anchore/chronicle/internal"
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+var _ release.Summarizer = (*Summarizer)(nil)
+
+type Config struct {
+	Host                 string         // the gerrit base URL (e.g. "https://review.example.com")
+	Project              string         // the gerrit project name (defaults to the project in the git remote URL)
+	Branch               string         // only consider changes merged into this branch (default is all branches)
+	ChangeTypesByHashtag change.TypeSet // the change type for each change hashtag
+	ChangeTypesByTopic   change.TypeSet // the change type for each change topic (used when no hashtag matches)
+	HTTPClient           *http.Client   // the client that API requests are made with, e.g. for a proxy or instrumentation (optional)
+	User                 string         // the user to authenticate as (optional, requests are anonymous without a user)
+	Password             string         // the HTTP password of the user (as generated within the gerrit user settings)
+}
+
+// Summarizer builds changes from merged gerrit changes between two tags. Releases are determined by the semver tags in
+// the local repo.
+type Summarizer struct {
+	tags.Releaser
+	git    git.Interface
+	client *http.Client
+	config Config
+}
+
+func NewSummarizer(gitter git.Interface, config Config) (*Summarizer, error) {
+	if config.Host == "" {
+		return nil, fmt.Errorf("no gerrit host configured")
+	}
+
+	if config.Project == "" {
+		repoURL, err := gitter.RemoteURL()
+		if err != nil {
+			return nil, err
+		}
+
+		config.Project = extractGerritProject(repoURL)
+		if config.Project == "" {
+			return nil, fmt.Errorf("failed to extract gerrit project from %q", repoURL)
+		}
+	}
+
+	log.WithFields("host", config.Host, "project", config.Project, "branch", config.Branch).Debug("gerrit summarizer")
+
+	client := config.HTTPClient
+	if client == nil {
+		client = &http.Client{}
+	}
+
+	return &Summarizer{
+		Releaser: tags.NewReleaser(gitter),
+		git:      gitter,
+		client:   client,
+		config:   config,
+	}, nil
+}
+
+// ReferenceURL returns the gitiles URL for the given tag.
+func (s *Summarizer) ReferenceURL(tag string) string {
+	return fmt.Sprintf("%s/plugins/gitiles/%s/+/refs/tags/%s", s.baseURL(), s.config.Project, url.PathEscape(tag))
+}
+
+// ChangesURL returns the gitiles log URL between the two refs.
+func (s *Summarizer) ChangesURL(sinceRef, untilRef string) string {
+	if untilRef == "" {
+		untilRef = "HEAD"
+	}
+	if sinceRef == "" {
+		return fmt.Sprintf("%s/plugins/gitiles/%s/+log/%s", s.baseURL(), s.config.Project, untilRef)
+	}
+	return fmt.Sprintf("%s/plugins/gitiles/%s/+log/%s..%s", s.baseURL(), s.config.Project, sinceRef, untilRef)
+}
+
+func (s *Summarizer) baseURL() string {
+	return strings.TrimSuffix(s.config.Host, "/")
+}
+
+func (s *Summarizer) changeURL(number int) string {
+	return fmt.Sprintf("%s/c/%s/+/%d", s.baseURL(), s.config.Project, number)
+}
+
+func (s *Summarizer) Changes(sinceRef, untilRef string) ([]change.Change, error) {
+	var since, until *time.Time
+	if sinceRef != "" {
+		sinceTag, err := s.git.SearchForTag(sinceRef)
+		if err != nil {
+			return nil, err
+		}
+		since = &sinceTag.Timestamp
+	}
+
+	if untilRef != "" {
+		untilTag, err := s.git.SearchForTag(untilRef)
+		if err != nil {
+			return nil, err
+		}
+		until = &untilTag.Timestamp
+	}
+
+	changes, err := fetchMergedChanges(s.client, s.baseURL(), s.config.User, s.config.Password, s.config.Project, s.config.Branch, since)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("total merged changes discovered: %d", len(changes))
+
+	changes = filterChanges(changes, changesWithinRange(since, until), changesWithChangeTypes(s.config))
+
+	log.Debugf("merged changes contributing to changelog: %d", len(changes))
+
+	var results []change.Change
+	for _, c := range changes {
+		results = append(results, change.Change{
+			Text:        c.Subject,
+			ChangeTypes: changeTypes(s.config, c),
+			Timestamp:   c.Submitted,
+			References: []change.Reference{
+				{
+					Text: fmt.Sprintf("Change %d", c.Number),
+					URL:  s.changeURL(c.Number),
+				},
+				{
+					Text: c.Owner,
+				},
+			},
+			EntryType:  "gerritChange",
+			Entry:      c,
+			Identities: []string{"gerrit:" + c.ChangeID},
+		})
+	}
+	return results, nil
+}
+
+func changeTypes(config Config, c gerritChange) []change.Type {
+	if types := config.ChangeTypesByHashtag.ChangeTypes(c.Hashtags...); len(types) > 0 {
+		return types
+	}
+	if c.Topic == "" {
+		return nil
+	}
+	return config.ChangeTypesByTopic.ChangeTypes(c.Topic)
+}
+
+type changeFilter func(c gerritChange) bool
+
+func filterChanges(changes []gerritChange, filters ...changeFilter) []gerritChange {
+	results := make([]gerritChange, 0, len(changes))
+
+changeLoop:
+	for _, c := range changes {
+		for _, f := range filters {
+			if !f(c) {
+				continue changeLoop
+			}
+		}
+		results = append(results, c)
+	}
+
+	return results
+}
+
+func changesWithinRange(since, until *time.Time) changeFilter {
+	return func(c gerritChange) bool {
+		if since != nil && !c.Submitted.After(*since) {
+			log.Tracef("change %d filtered out: submitted before %s (submitted %s)", c.Number, internal.FormatDateTime(*since), internal.FormatDateTime(c.Submitted))
+			return false
+		}
+		if until != nil && c.Submitted.After(*until) {
+			log.Tracef("change %d filtered out: submitted after %s (submitted %s)", c.Number, internal.FormatDateTime(*until), internal.FormatDateTime(c.Submitted))
+			return false
+		}
+		return true
+	}
+}
+
+func changesWithChangeTypes(config Config) changeFilter {
+	return func(c gerritChange) bool {
+		keep := len(changeTypes(config, c)) > 0
+		if !keep {
+			log.Tracef("change %d filtered out: no change types", c.Number)
+		}
+		return keep
+	}
+}
+
+func extractGerritProject(u string) string {
+	urlObj, err := url.Parse(u)
+	if err != nil || urlObj.Host == "" {
+		return ""
+	}
+
+	p := strings.Trim(urlObj.Path, "/")
+	// authenticated HTTP remotes are prefixed with "/a/"
+	p = strings.TrimPrefix(p, "a/")
+	return strings.TrimSuffix(p, ".git")
+}
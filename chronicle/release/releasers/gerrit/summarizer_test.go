@@ -0,0 +1,155 @@
+package gerrit
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func Test_extractGerritProject(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{
+			url:  "https://review.example.com/platform/build",
+			want: "platform/build",
+		},
+		{
+			url:  "https://review.example.com/a/platform/build.git",
+			want: "platform/build",
+		},
+		{
+			url:  "ssh://someone@review.example.com:29418/platform/build",
+			want: "platform/build",
+		},
+		{
+			url: "git@github.com:anchore/chronicle.git",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			assert.Equal(t, tt.want, extractGerritProject(tt.url))
+		})
+	}
+}
+
+func TestSummarizer_URLs(t *testing.T) {
+	s := Summarizer{
+		config: Config{
+			Host:    "https://review.example.com/",
+			Project: "platform/build",
+		},
+	}
+
+	assert.Equal(t, "https://review.example.com/plugins/gitiles/platform/build/+/refs/tags/v0.2.0", s.ReferenceURL("v0.2.0"))
+	assert.Equal(t, "https://review.example.com/plugins/gitiles/platform/build/+log/v0.1.0..v0.2.0", s.ChangesURL("v0.1.0", "v0.2.0"))
+	assert.Equal(t, "https://review.example.com/plugins/gitiles/platform/build/+log/v0.1.0..HEAD", s.ChangesURL("v0.1.0", ""))
+	assert.Equal(t, "https://review.example.com/c/platform/build/+/12", s.changeURL(12))
+}
+
+func Test_fetchMergedChanges(t *testing.T) {
+	since := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/changes/", r.URL.Path)
+		assert.Equal(t, `status:merged project:platform/build branch:main after:"2021-09-01 00:00:00.000000000"`, r.URL.Query().Get("q"))
+
+		fmt.Fprintln(w, xssiPrefix)
+		switch r.URL.Query().Get("start") {
+		case "0":
+			fmt.Fprint(w, `[{"_number": 1, "change_id": "I1", "subject": "fix the bug", "branch": "main", "hashtags": ["bug"], "owner": {"username": "someone"}, "submitted": "2021-09-16 19:34:00.000000000", "_more_changes": true}]`)
+		case "1":
+			fmt.Fprint(w, `[{"_number": 2, "change_id": "I2", "subject": "add the feature", "branch": "main", "topic": "feature", "owner": {"name": "Some One"}, "submitted": "2021-09-17 19:34:00.000000000"}]`)
+		default:
+			t.Errorf("unexpected start: %q", r.URL.Query().Get("start"))
+		}
+	}))
+	defer server.Close()
+
+	changes, err := fetchMergedChanges(server.Client(), server.URL, "", "", "platform/build", "main", &since)
+	require.NoError(t, err)
+
+	assert.Equal(t, []gerritChange{
+		{
+			Number:    1,
+			ChangeID:  "I1",
+			Subject:   "fix the bug",
+			Owner:     "someone",
+			Branch:    "main",
+			Hashtags:  []string{"bug"},
+			Submitted: time.Date(2021, 9, 16, 19, 34, 0, 0, time.UTC),
+		},
+		{
+			Number:    2,
+			ChangeID:  "I2",
+			Subject:   "add the feature",
+			Owner:     "Some One",
+			Branch:    "main",
+			Topic:     "feature",
+			Submitted: time.Date(2021, 9, 17, 19, 34, 0, 0, time.UTC),
+		},
+	}, changes)
+}
+
+func Test_fetchMergedChanges_authenticated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/a/changes/", r.URL.Path)
+
+		user, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "someone", user)
+		assert.Equal(t, "secret", password)
+
+		fmt.Fprintln(w, xssiPrefix)
+		fmt.Fprint(w, `[]`)
+	}))
+	defer server.Close()
+
+	changes, err := fetchMergedChanges(server.Client(), server.URL, "someone", "secret", "platform/build", "main", nil)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func Test_changeTypes(t *testing.T) {
+	bugFix := change.NewType("bug-fix", change.SemVerPatch)
+	feature := change.NewType("added-feature", change.SemVerMinor)
+
+	config := Config{
+		ChangeTypesByHashtag: change.TypeSet{"bug": bugFix},
+		ChangeTypesByTopic:   change.TypeSet{"feature": feature},
+	}
+
+	tests := []struct {
+		name   string
+		change gerritChange
+		want   []change.Type
+	}{
+		{
+			name:   "by hashtag",
+			change: gerritChange{Hashtags: []string{"bug"}, Topic: "feature"},
+			want:   []change.Type{bugFix},
+		},
+		{
+			name:   "by topic",
+			change: gerritChange{Hashtags: []string{"other"}, Topic: "feature"},
+			want:   []change.Type{feature},
+		},
+		{
+			name:   "no match",
+			change: gerritChange{Hashtags: []string{"other"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, changeTypes(config, tt.change))
+		})
+	}
+}
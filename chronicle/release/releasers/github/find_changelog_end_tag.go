@@ -9,27 +9,69 @@ import (
 )
 
 func FindChangelogEndTag(summer release.Summarizer, gitter git.Interface) (string, error) {
+	endRelease, err := FindChangelogEndRelease(summer, gitter)
+	if err != nil || endRelease == nil {
+		return "", err
+	}
+	return endRelease.Version, nil
+}
+
+// FindChangelogEndRelease returns the release being described for the tag at HEAD, which is either a tag without a
+// release or a tag with a draft release (whose notes are to be replaced). If there is no such tag then nil is returned.
+func FindChangelogEndRelease(summer release.Summarizer, gitter git.Interface) (*release.Release, error) {
 	// check if the current commit is tagged, then use that
 	currentTag, err := gitter.HeadTag()
 	if err != nil {
-		return "", fmt.Errorf("problem while attempting to find head tag: %w", err)
+		return nil, fmt.Errorf("problem while attempting to find head tag: %w", err)
 	}
 	if currentTag == "" {
-		return "", nil
+		return nil, nil
 	}
 
 	if taggedRelease, err := summer.Release(currentTag); err != nil {
 		// TODO: assert the error specifically confirms that the release does not exist, not just any error
 		// no release found, assume that this is the correct release info
-		return "", fmt.Errorf("unable to fetch release=%q : %w", currentTag, err)
+		return nil, fmt.Errorf("unable to fetch release=%q : %w", currentTag, err)
+	} else if taggedRelease != nil && taggedRelease.Draft {
+		log.Infof("found existing tag=%q at HEAD with a draft release, whose notes are to be replaced", currentTag)
+		return &release.Release{Version: currentTag, Draft: true}, nil
 	} else if taggedRelease != nil {
 		log.Debugf("found existing tag=%q however, it already has an associated release. ignoring...", currentTag)
 		// return commitRef, nil
-		return "", nil
+		return nil, nil
 	}
 
 	log.Debugf("found existing tag=%q at HEAD which does not have an associated release", currentTag)
 
 	// a tag was found and there is no existing release for this tag
-	return currentTag, nil
+	return &release.Release{Version: currentTag}, nil
+}
+
+// FindDraftEndRelease returns the most recent draft release (for sources with draft releases) to end the changelog
+// with, along with its tag. The tag is empty when it does not exist in the local repo yet, since the tag of a draft
+// release may only be created once the release is published (so the changelog ends at the current revision instead).
+func FindDraftEndRelease(summer release.Summarizer, gitter git.Interface) (*release.Release, string, error) {
+	drafts, ok := summer.(release.DraftSummarizer)
+	if !ok {
+		log.Debug("the source does not have draft releases")
+		return nil, "", nil
+	}
+
+	draft, err := drafts.LatestDraftRelease()
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to fetch the latest draft release: %w", err)
+	}
+	if draft == nil {
+		log.Debug("no draft release found")
+		return nil, "", nil
+	}
+
+	tag, err := gitter.SearchForTag(draft.Version)
+	if err != nil || tag == nil {
+		log.Infof("found draft release=%q, which is not tagged yet", draft.Version)
+		return draft, "", nil
+	}
+
+	log.Infof("found draft release=%q with an existing tag", draft.Version)
+	return draft, tag.Name, nil
 }
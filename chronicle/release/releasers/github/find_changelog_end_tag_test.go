@@ -2,6 +2,7 @@ package github
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -36,6 +37,17 @@ func TestFindChangelogEndTag(t *testing.T) {
 			},
 			want: "",
 		},
+		{
+			name: "draft release for existing tag at head should return head tag",
+			gitter: git.MockInterface{
+				MockHeadTag: "v0.1.0",
+			},
+			summer: release.MockSummarizer{
+				MockRelease: "v0.1.0",
+				MockDraft:   true,
+			},
+			want: "v0.1.0",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -48,3 +60,67 @@ func TestFindChangelogEndTag(t *testing.T) {
 		})
 	}
 }
+
+func TestFindDraftEndRelease(t *testing.T) {
+	tests := []struct {
+		name      string
+		summer    release.Summarizer
+		gitter    git.Interface
+		wantDraft string
+		wantTag   string
+	}{
+		{
+			name:   "no draft release",
+			summer: release.MockSummarizer{},
+			gitter: git.MockInterface{},
+		},
+		{
+			name: "draft release with an existing tag",
+			summer: release.MockSummarizer{
+				MockLastDraft: "v0.2.0",
+			},
+			gitter: git.MockInterface{
+				MockSearchTag: "v0.2.0",
+			},
+			wantDraft: "v0.2.0",
+			wantTag:   "v0.2.0",
+		},
+		{
+			name: "draft release that is not tagged yet",
+			summer: release.MockSummarizer{
+				MockLastDraft: "v0.2.0",
+			},
+			gitter:    git.MockInterface{},
+			wantDraft: "v0.2.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			draft, tag, err := FindDraftEndRelease(tt.summer, tt.gitter)
+			require.NoError(t, err)
+			if tt.wantDraft == "" {
+				assert.Nil(t, draft)
+			} else {
+				require.NotNil(t, draft)
+				assert.Equal(t, tt.wantDraft, draft.Version)
+				assert.True(t, draft.Draft)
+			}
+			assert.Equal(t, tt.wantTag, tag)
+		})
+	}
+}
+
+func Test_latestDraftRelease(t *testing.T) {
+	now := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	releases := []ghRelease{
+		{Tag: "v0.3.0", IsDraft: true, CreatedAt: now.Add(-time.Hour)},
+		{Tag: "v0.4.0", IsDraft: true, CreatedAt: now},
+		{Tag: "v0.2.0", Date: now.Add(-24 * time.Hour), CreatedAt: now.Add(-48 * time.Hour)},
+	}
+
+	got := latestDraftRelease(releases)
+	require.NotNil(t, got)
+	assert.Equal(t, "v0.4.0", got.Tag)
+
+	assert.Nil(t, latestDraftRelease(releases[2:]))
+}
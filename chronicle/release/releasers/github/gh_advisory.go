@@ -0,0 +1,155 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal"
+)
+
+// Advisories selects the published security advisories (GHSA) of the repo to include in the changelog, so that
+// security fixes are called out in their own section.
+type Advisories struct {
+	Include    bool        // include the advisories published within the release
+	ChangeType change.Type // the change type (that is, the changelog section) for the advisories
+}
+
+type ghAdvisory struct {
+	GHSAID      string    `json:"ghsa_id"`
+	CVEID       string    `json:"cve_id"`
+	Summary     string    `json:"summary"`
+	Severity    string    `json:"severity"`
+	URL         string    `json:"html_url"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+func advisorySubject(id string) string {
+	return "advisory " + id
+}
+
+// selectAdvisories returns the advisories published after the since time and at or before the until time (either may
+// be nil for an open-ended window).
+func selectAdvisories(advisories []ghAdvisory, since, until *time.Time) []ghAdvisory {
+	var selected []ghAdvisory
+	for _, a := range advisories {
+		if since != nil && !a.PublishedAt.After(*since) {
+			traceExcluded(advisorySubject(a.GHSAID), "published at or before %s (published %s)", internal.FormatDateTime(*since), internal.FormatDateTime(a.PublishedAt))
+			continue
+		}
+		if until != nil && a.PublishedAt.After(*until) {
+			traceExcluded(advisorySubject(a.GHSAID), "published after %s (published %s)", internal.FormatDateTime(*until), internal.FormatDateTime(a.PublishedAt))
+			continue
+		}
+		selected = append(selected, a)
+	}
+	return selected
+}
+
+func createChangesFromAdvisories(config Config, advisories []ghAdvisory) (changes []change.Change) {
+	for _, a := range advisories {
+		traceIncluded(advisorySubject(a.GHSAID), "as %s", config.Advisories.ChangeType.Name)
+
+		text := a.Summary
+		if a.Severity != "" {
+			text = fmt.Sprintf("%s (%s severity)", a.Summary, strings.ToLower(a.Severity))
+		}
+
+		references := []change.Reference{
+			{
+				Text: a.GHSAID,
+				URL:  a.URL,
+			},
+		}
+		if a.CVEID != "" {
+			references = append(references, change.Reference{
+				Text: a.CVEID,
+				URL:  "https://nvd.nist.gov/vuln/detail/" + a.CVEID,
+			})
+		}
+
+		changes = append(changes, change.Change{
+			Text:        text,
+			ChangeTypes: []change.Type{config.Advisories.ChangeType},
+			Timestamp:   a.PublishedAt,
+			References:  references,
+			EntryType:   "githubAdvisory",
+			Entry:       a,
+			Identities:  []string{"github-advisory:" + a.GHSAID},
+		})
+	}
+	return changes
+}
+
+// advisoryFetcher fetches the published security advisories of a repo (via the repository security advisories API).
+type advisoryFetcher struct {
+	client  *http.Client
+	baseURL string
+}
+
+func newAdvisoryFetcher(client *http.Client, apiURL, owner, repo string) *advisoryFetcher {
+	return &advisoryFetcher{
+		client:  client,
+		baseURL: fmt.Sprintf("%s/repos/%s/%s", strings.TrimSuffix(apiURL, "/"), owner, repo),
+	}
+}
+
+// published returns the published advisories, most recently published first, stopping once reaching advisories
+// published before the given since time (when given).
+func (f *advisoryFetcher) published(since *time.Time, paging pagination) ([]ghAdvisory, error) {
+	var all []ghAdvisory
+	for page := 1; ; page++ {
+		advisories, err := f.page(page, int(paging.size()))
+		if err != nil {
+			return nil, err
+		}
+
+		var reachedBoundary bool
+		for _, a := range advisories {
+			if since != nil && a.PublishedAt.Before(*since) {
+				reachedBoundary = true
+				break
+			}
+			all = append(all, a)
+		}
+
+		if reachedBoundary || len(advisories) < int(paging.size()) || paging.exhausted(page, "security advisories") {
+			break
+		}
+	}
+	return all, nil
+}
+
+func (f *advisoryFetcher) page(page, size int) ([]ghAdvisory, error) {
+	u := fmt.Sprintf("%s/security-advisories?state=published&sort=published&direction=desc&per_page=%d&page=%d", f.baseURL, size, page)
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d for the security advisories: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var advisories []ghAdvisory
+	if err := json.Unmarshal(body, &advisories); err != nil {
+		return nil, fmt.Errorf("unable to parse the security advisories: %w", err)
+	}
+	return advisories, nil
+}
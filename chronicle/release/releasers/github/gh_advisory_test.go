@@ -0,0 +1,108 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func Test_selectAdvisories(t *testing.T) {
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	advisories := []ghAdvisory{
+		{GHSAID: "GHSA-before", PublishedAt: since},
+		{GHSAID: "GHSA-within", PublishedAt: since.Add(time.Hour)},
+		{GHSAID: "GHSA-at-end", PublishedAt: until},
+		{GHSAID: "GHSA-after", PublishedAt: until.Add(time.Second)},
+	}
+
+	var got []string
+	for _, a := range selectAdvisories(advisories, &since, &until) {
+		got = append(got, a.GHSAID)
+	}
+	assert.Equal(t, []string{"GHSA-within", "GHSA-at-end"}, got)
+	assert.Len(t, selectAdvisories(advisories, nil, nil), 4)
+}
+
+func Test_createChangesFromAdvisories(t *testing.T) {
+	security := change.NewType("security-advisories", change.SemVerPatch)
+	published := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	withCVE := ghAdvisory{
+		GHSAID:      "GHSA-aaaa-bbbb-cccc",
+		CVEID:       "CVE-2023-1234",
+		Summary:     "Path traversal in the archive extractor",
+		Severity:    "HIGH",
+		URL:         "https://github.com/anchore/chronicle/security/advisories/GHSA-aaaa-bbbb-cccc",
+		PublishedAt: published,
+	}
+	withoutCVE := ghAdvisory{
+		GHSAID:      "GHSA-dddd-eeee-ffff",
+		Summary:     "Token written to the debug log",
+		URL:         "https://github.com/anchore/chronicle/security/advisories/GHSA-dddd-eeee-ffff",
+		PublishedAt: published,
+	}
+
+	changes := createChangesFromAdvisories(Config{Advisories: Advisories{Include: true, ChangeType: security}}, []ghAdvisory{withCVE, withoutCVE})
+
+	assert.Equal(t, []change.Change{
+		{
+			Text:        "Path traversal in the archive extractor (high severity)",
+			ChangeTypes: []change.Type{security},
+			Timestamp:   published,
+			References: []change.Reference{
+				{Text: "GHSA-aaaa-bbbb-cccc", URL: "https://github.com/anchore/chronicle/security/advisories/GHSA-aaaa-bbbb-cccc"},
+				{Text: "CVE-2023-1234", URL: "https://nvd.nist.gov/vuln/detail/CVE-2023-1234"},
+			},
+			EntryType:  "githubAdvisory",
+			Entry:      withCVE,
+			Identities: []string{"github-advisory:GHSA-aaaa-bbbb-cccc"},
+		},
+		{
+			Text:        "Token written to the debug log",
+			ChangeTypes: []change.Type{security},
+			Timestamp:   published,
+			References: []change.Reference{
+				{Text: "GHSA-dddd-eeee-ffff", URL: "https://github.com/anchore/chronicle/security/advisories/GHSA-dddd-eeee-ffff"},
+			},
+			EntryType:  "githubAdvisory",
+			Entry:      withoutCVE,
+			Identities: []string{"github-advisory:GHSA-dddd-eeee-ffff"},
+		},
+	}, changes)
+}
+
+func Test_advisoryFetcher_published(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/anchore/chronicle/security-advisories", r.URL.Path)
+		assert.Equal(t, "published", r.URL.Query().Get("state"))
+		pages = append(pages, r.URL.Query().Get("page"))
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `[{"ghsa_id": "GHSA-3", "published_at": "2023-03-01T00:00:00Z"}, {"ghsa_id": "GHSA-2", "published_at": "2023-02-01T00:00:00Z"}]`)
+		default:
+			fmt.Fprint(w, `[{"ghsa_id": "GHSA-1", "published_at": "2023-01-01T00:00:00Z"}]`)
+		}
+	}))
+	defer server.Close()
+
+	since := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+	got, err := newAdvisoryFetcher(server.Client(), server.URL, "anchore", "chronicle").published(&since, pagination{PageSize: 2})
+	require.NoError(t, err)
+
+	var ids []string
+	for _, a := range got {
+		ids = append(ids, a.GHSAID)
+	}
+	// fetching stops once reaching advisories published before the release
+	assert.Equal(t, []string{"GHSA-3", "GHSA-2"}, ids)
+	assert.Equal(t, []string{"1", "2"}, pages)
+}
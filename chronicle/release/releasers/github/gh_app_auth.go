@@ -0,0 +1,199 @@
+package github
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// AppAuth is the configuration for authenticating as a GitHub App installation (instead of with a personal token).
+type AppAuth struct {
+	ID             int64  // the GitHub App ID
+	InstallationID int64  // the installation of the app on the owner of the repo (discovered from the repo when 0)
+	PrivateKey     string // the PEM encoded private key of the app
+	PrivateKeyFile string // the path to the PEM encoded private key of the app (when PrivateKey is not given)
+}
+
+// Enabled indicates if GitHub App authentication has been configured.
+func (a AppAuth) Enabled() bool {
+	return a.ID != 0
+}
+
+// appTokenSource mints (and re-mints) installation access tokens for a GitHub App. Installation tokens expire after an
+// hour, so this should be wrapped with oauth2.ReuseTokenSource to only mint a new token once the current one expires.
+type appTokenSource struct {
+	apiURL         string
+	appID          int64
+	installationID int64
+	owner          string
+	repo           string
+	key            *rsa.PrivateKey
+	client         *http.Client
+	now            func() time.Time
+}
+
+func newAppTokenSource(client *http.Client, apiURL, owner, repo string, auth AppAuth) (oauth2.TokenSource, error) {
+	pemBytes := []byte(auth.PrivateKey)
+	if auth.PrivateKey == "" {
+		if auth.PrivateKeyFile == "" {
+			return nil, fmt.Errorf("no private key configured for github app %d", auth.ID)
+		}
+		var err error
+		pemBytes, err = os.ReadFile(auth.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read github app private key: %w", err)
+		}
+	}
+
+	key, err := parseRSAPrivateKey(pemBytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse github app private key: %w", err)
+	}
+
+	log.WithFields("app", auth.ID, "installation", auth.InstallationID).Debug("authenticating as a github app")
+
+	return oauth2.ReuseTokenSource(nil, &appTokenSource{
+		apiURL:         strings.TrimSuffix(apiURL, "/"),
+		appID:          auth.ID,
+		installationID: auth.InstallationID,
+		owner:          owner,
+		repo:           repo,
+		key:            key,
+		client:         client,
+		now:            time.Now,
+	}), nil
+}
+
+func parseRSAPrivateKey(pemBytes []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// Token mints a new installation access token.
+func (s *appTokenSource) Token() (*oauth2.Token, error) {
+	jwt, err := s.appJWT()
+	if err != nil {
+		return nil, err
+	}
+
+	if s.installationID == 0 {
+		s.installationID, err = s.findInstallationID(jwt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var token struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	err = s.do(http.MethodPost, fmt.Sprintf("/app/installations/%d/access_tokens", s.installationID), jwt, &token)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create github app installation token: %w", err)
+	}
+
+	log.WithFields("installation", s.installationID, "expires", token.ExpiresAt).Trace("minted github app installation token")
+
+	return &oauth2.Token{
+		AccessToken: token.Token,
+		TokenType:   "Bearer",
+		Expiry:      token.ExpiresAt,
+	}, nil
+}
+
+// findInstallationID returns the installation of the app that has access to the repo.
+func (s *appTokenSource) findInstallationID(jwt string) (int64, error) {
+	var installation struct {
+		ID int64 `json:"id"`
+	}
+	err := s.do(http.MethodGet, fmt.Sprintf("/repos/%s/%s/installation", s.owner, s.repo), jwt, &installation)
+	if err != nil {
+		return 0, fmt.Errorf("unable to find github app installation for %s/%s: %w", s.owner, s.repo, err)
+	}
+	return installation.ID, nil
+}
+
+// appJWT returns a short-lived JWT (signed with the app private key) that authenticates as the app itself.
+func (s *appTokenSource) appJWT() (string, error) {
+	now := s.now()
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		// allow for clock drift between this host and github
+		"iat": now.Add(-60 * time.Second).Unix(),
+		// github does not allow for an expiration more than 10 minutes in the future
+		"exp": now.Add(9 * time.Minute).Unix(),
+		"iss": fmt.Sprintf("%d", s.appID),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("unable to sign github app JWT: %w", err)
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+func (s *appTokenSource) do(method, path, jwt string, result interface{}) error {
+	req, err := http.NewRequest(method, s.apiURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+jwt)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return json.Unmarshal(body, result)
+}
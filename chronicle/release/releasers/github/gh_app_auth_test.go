@@ -0,0 +1,128 @@
+package github
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAppKey(t *testing.T) (*rsa.PrivateKey, string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+	return key, string(keyPEM)
+}
+
+func verifyTestJWT(t *testing.T, key *rsa.PrivateKey, authHeader string) map[string]interface{} {
+	t.Helper()
+	parts := strings.Split(strings.TrimPrefix(authHeader, "Bearer "), ".")
+	require.Len(t, parts, 3)
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	require.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], sig))
+
+	claimBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	var claims map[string]interface{}
+	require.NoError(t, json.Unmarshal(claimBytes, &claims))
+	return claims
+}
+
+func TestAppTokenSource(t *testing.T) {
+	key, keyPEM := newTestAppKey(t)
+
+	var minted int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims := verifyTestJWT(t, key, r.Header.Get("Authorization"))
+		assert.Equal(t, "1234", claims["iss"])
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/anchore/chronicle/installation":
+			fmt.Fprint(w, `{"id": 42}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/app/installations/42/access_tokens":
+			minted++
+			fmt.Fprintf(w, `{"token": "token-%d", "expires_at": %q}`, minted, time.Now().Add(time.Hour).Format(time.RFC3339))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	src, err := newAppTokenSource(server.Client(), server.URL, "anchore", "chronicle", AppAuth{
+		ID:         1234,
+		PrivateKey: keyPEM,
+	})
+	require.NoError(t, err)
+
+	token, err := src.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token.AccessToken)
+
+	// the token is reused until it expires
+	token, err = src.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token.AccessToken)
+	assert.Equal(t, 1, minted)
+}
+
+func TestAppTokenSource_refresh(t *testing.T) {
+	key, keyPEM := newTestAppKey(t)
+	keyPath := filepath.Join(t.TempDir(), "app.pem")
+	require.NoError(t, os.WriteFile(keyPath, []byte(keyPEM), 0600))
+
+	var minted int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		verifyTestJWT(t, key, r.Header.Get("Authorization"))
+		if r.URL.Path != "/app/installations/7/access_tokens" {
+			http.NotFound(w, r)
+			return
+		}
+		minted++
+		// already expired, so each use requires a new token
+		fmt.Fprintf(w, `{"token": "token-%d", "expires_at": %q}`, minted, time.Now().Add(-time.Minute).Format(time.RFC3339))
+	}))
+	t.Cleanup(server.Close)
+
+	src, err := newAppTokenSource(server.Client(), server.URL, "anchore", "chronicle", AppAuth{
+		ID:             1234,
+		InstallationID: 7,
+		PrivateKeyFile: keyPath,
+	})
+	require.NoError(t, err)
+
+	for i := 1; i <= 2; i++ {
+		token, err := src.Token()
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("token-%d", i), token.AccessToken)
+	}
+}
+
+func TestNewAppTokenSource_badKey(t *testing.T) {
+	_, err := newAppTokenSource(http.DefaultClient, "https://api.github.com", "anchore", "chronicle", AppAuth{ID: 1, PrivateKey: "nope"})
+	assert.ErrorContains(t, err, "unable to parse github app private key")
+
+	_, err = newAppTokenSource(http.DefaultClient, "https://api.github.com", "anchore", "chronicle", AppAuth{ID: 1})
+	assert.ErrorContains(t, err, "no private key configured")
+}
@@ -0,0 +1,106 @@
+package github
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+// botSuffix is appended to the login of bots (apps) on github (e.g. "dependabot[bot]"), however, is not part of the
+// login returned by the GraphQL API.
+const botSuffix = "[bot]"
+
+// isBotActor indicates if the GraphQL type of an author (actor) is a bot.
+func isBotActor(typename githubv4.String) bool {
+	return typename == "Bot"
+}
+
+// authorHandle returns the login of an author as it is shown on github, so that bots can be matched as
+// "dependabot[bot]" (or "*[bot]").
+func authorHandle(login string, bot bool) string {
+	if bot && !strings.HasSuffix(login, botSuffix) {
+		return login + botSuffix
+	}
+	return login
+}
+
+// authorReference credits the given user (or bot) on a change, linking to their profile (bots are apps, which have no
+// user profile, so link to the app instead).
+func authorReference(config Config, login string, bot bool) change.Reference {
+	if bot {
+		name := strings.TrimSuffix(login, botSuffix)
+		return change.Reference{
+			Text: name + botSuffix,
+			URL:  fmt.Sprintf("https://%s/apps/%s", config.Host, name),
+		}
+	}
+	return change.Reference{
+		Text: login,
+		URL:  fmt.Sprintf("https://%s/%s", config.Host, login),
+	}
+}
+
+// ValidateAuthorPattern ensures the given author pattern is a valid glob (see path.Match).
+func ValidateAuthorPattern(pattern string) error {
+	_, err := path.Match(pattern, "")
+	return err
+}
+
+// matchesAuthor indicates if the author handle matches the pattern, which is either the exact handle or a glob
+// (e.g. "*-bot"). Both are matched ignoring case, as github does. Note: a handle such as "renovate[bot]" would be a
+// character class as a glob, so is first compared literally.
+func matchesAuthor(pattern, handle string) bool {
+	pattern, handle = strings.ToLower(pattern), strings.ToLower(handle)
+	if pattern == handle {
+		return true
+	}
+	matched, err := path.Match(pattern, handle)
+	return err == nil && matched
+}
+
+func matchesAnyAuthor(patterns []string, handle string) bool {
+	for _, p := range patterns {
+		if matchesAuthor(p, handle) {
+			return true
+		}
+	}
+	return false
+}
+
+// authorAllowed indicates if changes by the given author are kept: authors matching any excluded pattern are always
+// removed, and when there are included patterns only the authors matching one of them are kept.
+func authorAllowed(config Config, handle string) bool {
+	if matchesAnyAuthor(config.ExcludeAuthors, handle) {
+		return false
+	}
+	if len(config.IncludeAuthors) > 0 {
+		return matchesAnyAuthor(config.IncludeAuthors, handle)
+	}
+	return true
+}
+
+func prsWithAllowedAuthor(config Config) prFilter {
+	return func(pr ghPullRequest) bool {
+		handle := authorHandle(pr.Author, pr.AuthorIsBot)
+		keep := authorAllowed(config, handle)
+		if !keep {
+			traceExcluded(prSubject(pr.Number), "authored by %q", handle)
+		}
+		return keep
+	}
+}
+
+func issuesWithAllowedAuthor(config Config) issueFilter {
+	return func(issue ghIssue) bool {
+		handle := authorHandle(issue.Author, issue.AuthorIsBot)
+		keep := authorAllowed(config, handle)
+		if !keep {
+			traceExcluded(issueSubject(issue.Number), "authored by %q", handle)
+		}
+		return keep
+	}
+}
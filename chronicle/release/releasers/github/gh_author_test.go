@@ -0,0 +1,117 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_authorHandle(t *testing.T) {
+	assert.Equal(t, "wagoodman", authorHandle("wagoodman", false))
+	assert.Equal(t, "dependabot[bot]", authorHandle("dependabot", true))
+	assert.Equal(t, "dependabot[bot]", authorHandle("dependabot[bot]", true))
+}
+
+func Test_matchesAuthor(t *testing.T) {
+	tests := []struct {
+		pattern string
+		handle  string
+		want    bool
+	}{
+		{pattern: "dependabot[bot]", handle: "dependabot[bot]", want: true},
+		{pattern: "Dependabot[bot]", handle: "dependabot[bot]", want: true},
+		{pattern: "dependabot[bot]", handle: "dependabot", want: false},
+		{pattern: "*[[]bot]", handle: "renovate[bot]", want: true},
+		{pattern: "*-bot", handle: "release-bot", want: true},
+		{pattern: "*-bot", handle: "robot", want: false},
+		{pattern: "wagoodman", handle: "WAGOODMAN", want: true},
+		{pattern: "[", handle: "[", want: true},
+		{pattern: "[", handle: "x", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+"~"+tt.handle, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchesAuthor(tt.pattern, tt.handle))
+		})
+	}
+}
+
+func Test_authorAllowed(t *testing.T) {
+	tests := []struct {
+		name    string
+		exclude []string
+		include []string
+		handle  string
+		want    bool
+	}{
+		{
+			name:   "no rules",
+			handle: "dependabot[bot]",
+			want:   true,
+		},
+		{
+			name:    "excluded",
+			exclude: []string{"dependabot[bot]", "*-bot"},
+			handle:  "release-bot",
+			want:    false,
+		},
+		{
+			name:    "not excluded",
+			exclude: []string{"dependabot[bot]", "*-bot"},
+			handle:  "wagoodman",
+			want:    true,
+		},
+		{
+			name:    "allow-listed",
+			include: []string{"wagoodman", "kzantow"},
+			handle:  "kzantow",
+			want:    true,
+		},
+		{
+			name:    "not allow-listed",
+			include: []string{"wagoodman", "kzantow"},
+			handle:  "someone-else",
+			want:    false,
+		},
+		{
+			name:    "exclusion wins over the allow-list",
+			exclude: []string{"*-bot"},
+			include: []string{"*"},
+			handle:  "release-bot",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := Config{ExcludeAuthors: tt.exclude, IncludeAuthors: tt.include}
+			assert.Equal(t, tt.want, authorAllowed(config, tt.handle))
+		})
+	}
+}
+
+func Test_authorFilters(t *testing.T) {
+	config := Config{ExcludeAuthors: []string{"dependabot[bot]"}}
+
+	prs, _ := filterPRs([]ghPullRequest{
+		{Number: 1, Author: "dependabot", AuthorIsBot: true},
+		{Number: 2, Author: "wagoodman"},
+		// a user could be named after a bot, but is not a bot
+		{Number: 3, Author: "dependabot"},
+	}, prsWithAllowedAuthor(config))
+
+	var prNumbers []int
+	for _, pr := range prs {
+		prNumbers = append(prNumbers, pr.Number)
+	}
+	assert.Equal(t, []int{2, 3}, prNumbers)
+
+	issues := filterIssues([]ghIssue{
+		{Number: 4, Author: "dependabot", AuthorIsBot: true},
+		{Number: 5, Author: "wagoodman"},
+	}, issuesWithAllowedAuthor(config))
+
+	var issueNumbers []int
+	for _, issue := range issues {
+		issueNumbers = append(issueNumbers, issue.Number)
+	}
+	assert.Equal(t, []int{5}, issueNumbers)
+}
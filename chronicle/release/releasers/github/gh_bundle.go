@@ -0,0 +1,194 @@
+package github
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// bundleDir is where the responses are kept within a bundle archive (one JSON file per response, named by cache key).
+const bundleDir = "responses"
+
+// Bundle holds the API responses fetched during a run, so that the same run can be repeated without network access:
+// an internet-connected runner records the responses (see Config.Record) and exports the bundle, then an air-gapped
+// machine imports it and replays the responses (see Config.Replay) to render and publish the changelog locally.
+// Responses are keyed like the on-disk cache (by request method, URL, and body). A bundle is safe for concurrent use.
+type Bundle struct {
+	lock    sync.Mutex
+	entries map[string]cacheEntry
+}
+
+func NewBundle() *Bundle {
+	return &Bundle{
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// ReadBundle reads a bundle from a gzipped tar archive (as written by Bundle.Write).
+func ReadBundle(reader io.Reader) (*Bundle, error) {
+	gz, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read bundle: %w", err)
+	}
+	defer gz.Close()
+
+	b := NewBundle()
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read bundle: %w", err)
+		}
+
+		dir, name := path.Split(header.Name)
+		if header.Typeflag != tar.TypeReg || path.Clean(dir) != bundleDir || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+
+		var entry cacheEntry
+		if err := json.NewDecoder(tr).Decode(&entry); err != nil {
+			return nil, fmt.Errorf("unable to read bundled response %q: %w", header.Name, err)
+		}
+		b.entries[strings.TrimSuffix(name, ".json")] = entry
+	}
+
+	return b, nil
+}
+
+// Write writes the bundle as a gzipped tar archive.
+func (b *Bundle) Write(writer io.Writer) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	gz := gzip.NewWriter(writer)
+	tw := tar.NewWriter(gz)
+
+	keys := make([]string, 0, len(b.entries))
+	for key := range b.entries {
+		keys = append(keys, key)
+	}
+	// note: sorted so that the same responses always result in the same archive
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		entry := b.entries[key]
+		by, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    path.Join(bundleDir, key+".json"),
+			Mode:    0644,
+			Size:    int64(len(by)),
+			ModTime: entry.StoredAt,
+		}); err != nil {
+			return fmt.Errorf("unable to write bundle: %w", err)
+		}
+		if _, err := tw.Write(by); err != nil {
+			return fmt.Errorf("unable to write bundle: %w", err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("unable to write bundle: %w", err)
+	}
+	return gz.Close()
+}
+
+// Len returns the number of responses within the bundle.
+func (b *Bundle) Len() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return len(b.entries)
+}
+
+func (b *Bundle) add(key string, entry cacheEntry) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.entries[key] = entry
+}
+
+func (b *Bundle) get(key string) (cacheEntry, bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	entry, ok := b.entries[key]
+	return entry, ok
+}
+
+// recordTransport adds every successful response (served from the network or the cache) to a bundle.
+type recordTransport struct {
+	base   http.RoundTripper
+	bundle *Bundle
+	now    func() time.Time
+}
+
+func (t *recordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && !isGraphQLRequest(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	if !hasGraphQLErrors(req, respBody) {
+		t.bundle.add(cacheKey(req, body), cacheEntry{
+			URL:      req.URL.String(),
+			ETag:     resp.Header.Get("ETag"),
+			StoredAt: t.now(),
+			Header:   resp.Header,
+			Body:     respBody,
+		})
+	}
+
+	return resp, nil
+}
+
+// replayTransport serves responses from a bundle only (no requests are made), failing any request that was not
+// recorded within the bundle.
+type replayTransport struct {
+	bundle *Bundle
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, ok := t.bundle.get(cacheKey(req, body))
+	if !ok {
+		return nil, fmt.Errorf("no response for %s %s within the bundle (was it exported with the same options?)", req.Method, req.URL)
+	}
+
+	log.Tracef("using bundled response for %s %s", req.Method, req.URL)
+	return entry.response(req), nil
+}
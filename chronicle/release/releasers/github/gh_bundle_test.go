@@ -0,0 +1,65 @@
+package github
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundle_RecordAndReplay(t *testing.T) {
+	s := &cachedServer{body: `{"number": 1}`}
+	server := newCachedServer(t, s)
+
+	bundle := NewBundle()
+	recorder := &http.Client{Transport: &recordTransport{
+		base:   http.DefaultTransport,
+		bundle: bundle,
+		now:    func() time.Time { return time.Unix(1700000000, 0) },
+	}}
+
+	assert.Equal(t, `{"number": 1}`, doCachedRequest(t, recorder, http.MethodGet, server.URL+"/repos/o/r/pulls/1", ""))
+	graphQLResponse := doCachedRequest(t, recorder, http.MethodPost, server.URL+"/graphql", `{"query": "a"}`)
+	assert.Equal(t, 2, bundle.Len())
+
+	// GraphQL errors (e.g. RATE_LIMITED) are never recorded
+	s.graphQLError = true
+	doCachedRequest(t, recorder, http.MethodPost, server.URL+"/graphql", `{"query": "b"}`)
+	assert.Equal(t, 2, bundle.Len())
+
+	var archive bytes.Buffer
+	require.NoError(t, bundle.Write(&archive))
+
+	imported, err := ReadBundle(&archive)
+	require.NoError(t, err)
+	assert.Equal(t, 2, imported.Len())
+
+	requests := s.requests
+	replayer := &http.Client{Transport: &replayTransport{bundle: imported}}
+
+	assert.Equal(t, `{"number": 1}`, doCachedRequest(t, replayer, http.MethodGet, server.URL+"/repos/o/r/pulls/1", ""))
+	assert.Equal(t, graphQLResponse, doCachedRequest(t, replayer, http.MethodPost, server.URL+"/graphql", `{"query": "a"}`))
+	assert.Equal(t, requests, s.requests, "no requests are made when replaying")
+
+	// requests that were not recorded fail rather than reaching the network
+	_, err = replayer.Get(server.URL + "/repos/o/r/pulls/2")
+	assert.ErrorContains(t, err, "no response for GET")
+	_, err = replayer.Post(server.URL+"/graphql", "application/json", bytes.NewBufferString(`{"query": "b"}`))
+	assert.Error(t, err)
+	assert.Equal(t, requests, s.requests)
+}
+
+func TestBundle_Write_deterministic(t *testing.T) {
+	bundle := NewBundle()
+	for _, key := range []string{"b", "a", "c"} {
+		bundle.add(key, cacheEntry{URL: "https://api.github.com/" + key, StoredAt: time.Unix(1700000000, 0).UTC()})
+	}
+
+	var first, second bytes.Buffer
+	require.NoError(t, bundle.Write(&first))
+	require.NoError(t, bundle.Write(&second))
+	assert.Equal(t, first.Bytes(), second.Bytes())
+}
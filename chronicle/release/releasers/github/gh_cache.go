@@ -0,0 +1,228 @@
+package github
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// cacheTransport persists API responses on disk so that repeated runs do not re-download everything. Responses are
+// keyed by the request method, URL, and body (GraphQL queries are all POSTed to the same URL). A cached response is
+// used as-is while younger than the TTL, after which it is revalidated with its ETag (a 304 refreshes the entry
+// without re-downloading it). Responses without an ETag (e.g. GraphQL) are re-fetched once stale, so are only cached
+// with a positive TTL (within which changes made since, such as a newly merged PR, are not seen).
+//
+// Failing to read or write the cache is never fatal: the request is made as if there were no cache.
+type cacheTransport struct {
+	base http.RoundTripper
+	dir  string
+	ttl  time.Duration
+	now  func() time.Time
+}
+
+type cacheEntry struct {
+	URL      string      `json:"url"`
+	ETag     string      `json:"etag,omitempty"`
+	StoredAt time.Time   `json:"storedAt"`
+	Header   http.Header `json:"header"`
+	Body     []byte      `json:"body"`
+}
+
+func newCacheTransport(base http.RoundTripper, dir string, ttl time.Duration) *cacheTransport {
+	return &cacheTransport{
+		base: base,
+		dir:  dir,
+		ttl:  ttl,
+		now:  time.Now,
+	}
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && !isGraphQLRequest(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	key := cacheKey(req, body)
+
+	entry := t.load(key)
+	if entry != nil && t.now().Sub(entry.StoredAt) < t.ttl {
+		log.Tracef("using cached response for %s %s", req.Method, req.URL)
+		return entry.response(req), nil
+	}
+
+	attemptReq := req.Clone(req.Context())
+	if body != nil {
+		attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	if entry != nil && entry.ETag != "" {
+		attemptReq.Header.Set("If-None-Match", entry.ETag)
+	}
+
+	resp, err := t.base.RoundTrip(attemptReq)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && entry != nil && entry.ETag != "":
+		resp.Body.Close()
+		log.Tracef("cached response for %s %s is still valid", req.Method, req.URL)
+		entry.StoredAt = t.now()
+		t.store(key, *entry)
+		return entry.response(req), nil
+
+	case resp.StatusCode == http.StatusOK:
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read response body: %w", err)
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+		etag := resp.Header.Get("ETag")
+		// note: a response that cannot be revalidated is never served without a TTL, so is not worth storing
+		if (etag != "" || t.ttl > 0) && !hasGraphQLErrors(req, respBody) {
+			t.store(key, cacheEntry{
+				URL:      req.URL.String(),
+				ETag:     etag,
+				StoredAt: t.now(),
+				Header:   resp.Header,
+				Body:     respBody,
+			})
+		}
+	}
+
+	return resp, nil
+}
+
+func (t *cacheTransport) path(key string) string {
+	return filepath.Join(t.dir, key+".json")
+}
+
+func (t *cacheTransport) load(key string) *cacheEntry {
+	by, err := os.ReadFile(t.path(key))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Debugf("unable to read cached response: %+v", err)
+		}
+		return nil
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(by, &entry); err != nil {
+		log.Debugf("ignoring corrupt cached response %q: %+v", t.path(key), err)
+		return nil
+	}
+	return &entry
+}
+
+func (t *cacheTransport) store(key string, entry cacheEntry) {
+	if err := t.write(key, entry); err != nil {
+		log.Debugf("unable to cache response for %s: %+v", entry.URL, err)
+	}
+}
+
+func (t *cacheTransport) write(key string, entry cacheEntry) error {
+	by, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(t.dir, 0700); err != nil {
+		return err
+	}
+
+	// write to a temp file and rename so that concurrent runs never read a partially written entry
+	tmp, err := os.CreateTemp(t.dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(by); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), t.path(key))
+}
+
+func (e cacheEntry) response(req *http.Request) *http.Response {
+	header := e.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+func cacheKey(req *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(req.Method + "\x00" + req.URL.String() + "\x00"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func isGraphQLRequest(req *http.Request) bool {
+	return req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/graphql")
+}
+
+// hasGraphQLErrors indicates if the response is a GraphQL response with errors (e.g. RATE_LIMITED), which is returned
+// with a 200 but must not be cached.
+func hasGraphQLErrors(req *http.Request, body []byte) bool {
+	if !isGraphQLRequest(req) {
+		return false
+	}
+	var payload struct {
+		Errors []json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return true
+	}
+	return len(payload.Errors) > 0
+}
+
+// readRequestBody returns the request body without modifying the request (the body is consumed if it cannot be
+// rewound, in which case it is replaced when the request is sent).
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	rc := req.Body
+	if req.GetBody != nil {
+		var err error
+		rc, err = req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read request body: %w", err)
+		}
+	}
+	defer rc.Close()
+	body, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read request body: %w", err)
+	}
+	return body, nil
+}
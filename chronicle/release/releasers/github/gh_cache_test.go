@@ -0,0 +1,154 @@
+package github
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cachedServer struct {
+	requests     int
+	revalidated  int
+	etag         string
+	body         string
+	graphQLError bool
+}
+
+func newCachedServer(t *testing.T, s *cachedServer) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests++
+		if r.Method == http.MethodPost {
+			reqBody, _ := io.ReadAll(r.Body)
+			if s.graphQLError {
+				fmt.Fprint(w, `{"errors": [{"type": "RATE_LIMITED"}]}`)
+				return
+			}
+			fmt.Fprintf(w, `{"data": {"query": %q}}`, string(reqBody))
+			return
+		}
+		if s.etag != "" && r.Header.Get("If-None-Match") == s.etag {
+			s.revalidated++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		if s.etag != "" {
+			w.Header().Set("ETag", s.etag)
+		}
+		fmt.Fprint(w, s.body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func doCachedRequest(t *testing.T, client *http.Client, method, url, body string) string {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	by, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(by)
+}
+
+func TestCacheTransport_ETagRevalidation(t *testing.T) {
+	s := &cachedServer{etag: `"v1"`, body: `{"number": 1}`}
+	server := newCachedServer(t, s)
+
+	now := time.Unix(1700000000, 0)
+	transport := newCacheTransport(http.DefaultTransport, t.TempDir(), time.Hour)
+	transport.now = func() time.Time { return now }
+	client := &http.Client{Transport: transport}
+
+	assert.Equal(t, `{"number": 1}`, doCachedRequest(t, client, http.MethodGet, server.URL+"/repos/o/r/pulls/1", ""))
+	assert.Equal(t, 1, s.requests)
+
+	// within the TTL the cached response is used without a request
+	assert.Equal(t, `{"number": 1}`, doCachedRequest(t, client, http.MethodGet, server.URL+"/repos/o/r/pulls/1", ""))
+	assert.Equal(t, 1, s.requests)
+
+	// once stale the response is revalidated (and the 304 served from the cache)
+	now = now.Add(2 * time.Hour)
+	assert.Equal(t, `{"number": 1}`, doCachedRequest(t, client, http.MethodGet, server.URL+"/repos/o/r/pulls/1", ""))
+	assert.Equal(t, 2, s.requests)
+	assert.Equal(t, 1, s.revalidated)
+
+	// a changed resource is re-downloaded
+	now = now.Add(2 * time.Hour)
+	s.etag, s.body = `"v2"`, `{"number": 2}`
+	assert.Equal(t, `{"number": 2}`, doCachedRequest(t, client, http.MethodGet, server.URL+"/repos/o/r/pulls/1", ""))
+	assert.Equal(t, 3, s.requests)
+
+	// other URLs are cached separately
+	assert.Equal(t, `{"number": 2}`, doCachedRequest(t, client, http.MethodGet, server.URL+"/repos/o/r/pulls/2", ""))
+	assert.Equal(t, 4, s.requests)
+}
+
+func TestCacheTransport_GraphQL(t *testing.T) {
+	s := &cachedServer{}
+	server := newCachedServer(t, s)
+
+	now := time.Unix(1700000000, 0)
+	transport := newCacheTransport(http.DefaultTransport, t.TempDir(), time.Hour)
+	transport.now = func() time.Time { return now }
+	client := &http.Client{Transport: transport}
+
+	first := doCachedRequest(t, client, http.MethodPost, server.URL+"/graphql", "query-1")
+	assert.Contains(t, first, "query-1")
+	assert.Equal(t, first, doCachedRequest(t, client, http.MethodPost, server.URL+"/graphql", "query-1"))
+	assert.Equal(t, 1, s.requests)
+
+	// queries are keyed by their body
+	assert.Contains(t, doCachedRequest(t, client, http.MethodPost, server.URL+"/graphql", "query-2"), "query-2")
+	assert.Equal(t, 2, s.requests)
+
+	// without an ETag, stale responses are re-fetched
+	now = now.Add(2 * time.Hour)
+	doCachedRequest(t, client, http.MethodPost, server.URL+"/graphql", "query-1")
+	assert.Equal(t, 3, s.requests)
+}
+
+func TestCacheTransport_NoTTL(t *testing.T) {
+	s := &cachedServer{etag: `"v1"`, body: `{"number": 1}`}
+	server := newCachedServer(t, s)
+
+	dir := t.TempDir()
+	client := &http.Client{Transport: newCacheTransport(http.DefaultTransport, dir, 0)}
+
+	// every GraphQL query is made, since the responses cannot be revalidated
+	doCachedRequest(t, client, http.MethodPost, server.URL+"/graphql", "query")
+	doCachedRequest(t, client, http.MethodPost, server.URL+"/graphql", "query")
+	assert.Equal(t, 2, s.requests)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	// responses with an ETag are always revalidated
+	assert.Equal(t, `{"number": 1}`, doCachedRequest(t, client, http.MethodGet, server.URL+"/repos/o/r/pulls/1", ""))
+	assert.Equal(t, `{"number": 1}`, doCachedRequest(t, client, http.MethodGet, server.URL+"/repos/o/r/pulls/1", ""))
+	assert.Equal(t, 4, s.requests)
+	assert.Equal(t, 1, s.revalidated)
+}
+
+func TestCacheTransport_SkipsGraphQLErrors(t *testing.T) {
+	s := &cachedServer{graphQLError: true}
+	server := newCachedServer(t, s)
+
+	client := &http.Client{Transport: newCacheTransport(http.DefaultTransport, t.TempDir(), time.Hour)}
+
+	doCachedRequest(t, client, http.MethodPost, server.URL+"/graphql", "query")
+	doCachedRequest(t, client, http.MethodPost, server.URL+"/graphql", "query")
+	assert.Equal(t, 2, s.requests)
+}
@@ -0,0 +1,126 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+	"golang.org/x/oauth2"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+const defaultHost = "github.com"
+
+// DefaultAPIURL returns the REST API base URL for the given github host: api.github.com for github.com, otherwise the
+// API path of a GitHub Enterprise Server instance (e.g. https://github.example.com/api/v3).
+func DefaultAPIURL(host string) string {
+	if host == "" || host == defaultHost {
+		return "https://api.github.com"
+	}
+	return fmt.Sprintf("https://%s/api/v3", host)
+}
+
+// graphQLURL returns the GraphQL endpoint that accompanies the given REST API base URL.
+func graphQLURL(apiURL string) string {
+	apiURL = strings.TrimSuffix(apiURL, "/")
+	if strings.HasSuffix(apiURL, "/api/v3") {
+		// GitHub Enterprise Server serves the GraphQL API alongside the REST API (not under it)
+		return strings.TrimSuffix(apiURL, "/v3") + "/graphql"
+	}
+	return apiURL + "/graphql"
+}
+
+// newHTTPClient returns a client authenticated as a GitHub App installation (when configured), otherwise with the
+// first github token found (see ResolveToken). Responses are cached on disk when a cache directory is configured. When
+// a client is configured it is copied (so its timeout, cookie jar and redirect policy still apply), with the
+// authentication, rate limiting and caching layered over its transport. When replaying a bundle no requests are made
+// (so no token is needed).
+func newHTTPClient(config Config, owner, repo string) (*http.Client, error) {
+	client := &http.Client{}
+	if config.HTTPClient != nil {
+		c := *config.HTTPClient
+		client = &c
+	}
+
+	if config.Replay != nil {
+		client.Transport = newRequestLogTransport(&replayTransport{bundle: config.Replay})
+		return client, nil
+	}
+
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	var src oauth2.TokenSource
+	if config.App.Enabled() {
+		var err error
+		src, err = newAppTokenSource(&http.Client{Transport: base}, config.APIURL, owner, repo, config.App)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		token, err := ResolveToken(config.Host, config.Token, config.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		src = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
+	}
+
+	if config.CacheDir != "" {
+		cache := newCacheTransport(base, config.CacheDir, config.CacheTTL)
+		cache.now = config.Clock.Now
+		base = cache
+	}
+
+	if config.Record != nil {
+		base = &recordTransport{base: base, bundle: config.Record, now: config.Clock.Now}
+	}
+
+	client.Transport = newRequestLogTransport(newRateLimitTransport(&oauth2.Transport{
+		Source: oauth2.ReuseTokenSource(nil, src),
+		Base:   base,
+	}, config.MaxRateLimitWait))
+	return client, nil
+}
+
+func newGraphQLClient(apiURL string, httpClient *http.Client) *githubv4.Client {
+	return githubv4.NewEnterpriseClient(graphQLURL(apiURL), httpClient)
+}
+
+// updatedBefore indicates if an entity last updated at the given time can be skipped when only entities updated since
+// the given time are of interest (for queries ordered by the most recently updated first, all remaining entities can
+// be skipped as well).
+func updatedBefore(updatedAt time.Time, since *time.Time) bool {
+	if since == nil || updatedAt.IsZero() {
+		return false
+	}
+	return updatedAt.Before(*since)
+}
+
+// pagination bounds the number of results fetched by a paginated query.
+type pagination struct {
+	PageSize int               // the number of results per page (1-100, defaults to 100)
+	MaxPages int               // the maximum number of pages to fetch (0 for no limit)
+	Warnings *release.Warnings // collects a warning when the results are cut short (optional)
+}
+
+func (p pagination) size() githubv4.Int {
+	if p.PageSize <= 0 || p.PageSize > 100 {
+		return 100
+	}
+	return githubv4.Int(p.PageSize)
+}
+
+// exhausted indicates if no more pages should be fetched after the given number of pages, raising a warning since any
+// remaining results will be missing from the changelog.
+func (p pagination) exhausted(pages int, what string) bool {
+	if p.MaxPages <= 0 || pages < p.MaxPages {
+		return false
+	}
+	p.Warnings.Add(release.TruncatedWarning, "stopped fetching %s after %d pages (github.max-pages), older %s will be missing", what, pages, what)
+	return true
+}
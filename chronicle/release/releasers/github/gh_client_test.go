@@ -0,0 +1,47 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingTransport struct {
+	requests []*http.Request
+}
+
+func (r *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.requests = append(r.requests, req)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(`{}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestNewHTTPClient_injectedClient(t *testing.T) {
+	recorder := &recordingTransport{}
+	injected := &http.Client{Transport: recorder, Timeout: 5 * time.Second}
+
+	client, err := newHTTPClient(Config{Token: "secret", HTTPClient: injected}, "anchore", "chronicle")
+	require.NoError(t, err)
+
+	// the injected client is left as-is, while its settings carry over
+	assert.NotSame(t, injected, client)
+	assert.Same(t, recorder, injected.Transport)
+	assert.Equal(t, 5*time.Second, client.Timeout)
+
+	resp, err := client.Get("https://api.github.com/repos/anchore/chronicle")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// requests go through the injected transport, authenticated
+	require.Len(t, recorder.requests, 1)
+	assert.Equal(t, "Bearer secret", recorder.requests[0].Header.Get("Authorization"))
+}
@@ -0,0 +1,110 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// commitPRFetcher resolves commits to the PRs they originate from (via the commit to PR association API).
+type commitPRFetcher struct {
+	client  *http.Client
+	baseURL string
+}
+
+func newCommitPRFetcher(client *http.Client, apiURL, owner, repo string) *commitPRFetcher {
+	return &commitPRFetcher{
+		client:  client,
+		baseURL: fmt.Sprintf("%s/repos/%s/%s", strings.TrimSuffix(apiURL, "/"), owner, repo),
+	}
+}
+
+// mergedPRs returns the numbers of the merged PRs associated with the given commit.
+func (f *commitPRFetcher) mergedPRs(commit string) ([]int, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/commits/%s/pulls", f.baseURL, commit), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d for the PRs of commit %s: %s", resp.StatusCode, commit, strings.TrimSpace(string(body)))
+	}
+
+	var prs []struct {
+		Number   int     `json:"number"`
+		MergedAt *string `json:"merged_at"`
+	}
+	if err := json.Unmarshal(body, &prs); err != nil {
+		return nil, fmt.Errorf("unable to parse the PRs of commit %s: %w", commit, err)
+	}
+
+	var numbers []int
+	for _, pr := range prs {
+		if pr.MergedAt != nil {
+			numbers = append(numbers, pr.Number)
+		}
+	}
+	return numbers, nil
+}
+
+// associateCommits resolves the commits within the release that are not the merge commit of any PR (e.g. the commits
+// of a rebase merged PR, or a PR squash merged onto another branch and cherry-picked) back to the PRs they originate
+// from, returning the merge commits of those PRs so they are considered part of the release.
+func associateCommits(prs []ghPullRequest, commits []string, lookup func(commit string) ([]int, error)) ([]string, error) {
+	byNumber := make(map[int]ghPullRequest)
+	mergeCommits := strset.New()
+	for _, pr := range prs {
+		byNumber[pr.Number] = pr
+		if pr.MergeCommit != "" {
+			mergeCommits.Add(pr.MergeCommit)
+		}
+	}
+
+	selected := strset.New(commits...)
+	var associated []string
+	for _, commit := range commits {
+		if mergeCommits.Has(commit) {
+			continue
+		}
+
+		numbers, err := lookup(commit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, number := range numbers {
+			pr, ok := byNumber[number]
+			if !ok {
+				log.Tracef("commit %s is associated with PR #%d, which is not one of the merged PRs fetched", commit, number)
+				continue
+			}
+			if pr.MergeCommit == "" || selected.Has(pr.MergeCommit) {
+				continue
+			}
+			traceIncluded(prSubject(pr.Number), "associated with commit %s", commit)
+			selected.Add(pr.MergeCommit)
+			associated = append(associated, pr.MergeCommit)
+		}
+	}
+
+	log.Debugf("PRs associated with commits in the release: %d", len(associated))
+	return associated, nil
+}
@@ -0,0 +1,57 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_associateCommits(t *testing.T) {
+	prs := []ghPullRequest{
+		{Number: 1, MergeCommit: "merge-1"},
+		{Number: 2, MergeCommit: "merge-2"},
+		{Number: 3, MergeCommit: "merge-3"},
+	}
+
+	associations := map[string][]int{
+		"rebased-a": {2},
+		"rebased-b": {2},
+		"picked":    {3, 99},
+	}
+
+	var looked []string
+	lookup := func(commit string) ([]int, error) {
+		looked = append(looked, commit)
+		return associations[commit], nil
+	}
+
+	got, err := associateCommits(prs, []string{"merge-1", "rebased-a", "rebased-b", "picked", "unknown"}, lookup)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"merge-2", "merge-3"}, got)
+	// commits that are already the merge commit of a PR are not looked up
+	assert.Equal(t, []string{"rebased-a", "rebased-b", "picked", "unknown"}, looked)
+}
+
+func Test_associateCommits_error(t *testing.T) {
+	_, err := associateCommits(nil, []string{"abc"}, func(string) ([]int, error) {
+		return nil, fmt.Errorf("boom")
+	})
+	require.Error(t, err)
+}
+
+func Test_commitPRFetcher_mergedPRs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/anchore/chronicle/commits/abc/pulls", r.URL.Path)
+		fmt.Fprint(w, `[{"number": 12, "merged_at": "2022-01-01T00:00:00Z"}, {"number": 13, "merged_at": null}]`)
+	}))
+	defer server.Close()
+
+	got, err := newCommitPRFetcher(server.Client(), server.URL, "anchore", "chronicle").mergedPRs("abc")
+	require.NoError(t, err)
+	assert.Equal(t, []int{12}, got)
+}
@@ -0,0 +1,137 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+var _ release.ContributorSummarizer = (*Summarizer)(nil)
+
+// NewContributors returns the authors whose first merged PR in the repo was merged within the release. Bots and the
+// authors excluded by github.exclude-authors (or not within github.include-authors) are never considered.
+func (s *Summarizer) NewContributors(sinceRef, untilRef string) ([]release.Contributor, error) {
+	var err error
+	var sinceTag, untilTag *git.Tag
+	if sinceRef != "" {
+		sinceTag, err = s.git.SearchForTag(sinceRef)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if untilRef != "" {
+		untilTag, err = s.git.SearchForTag(untilRef)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var fetchSince *time.Time
+	if s.config.IncrementalFetch && sinceTag != nil {
+		fetchSince = &sinceTag.Timestamp
+	}
+
+	allMergedPRs, err := fetchMergedPRs(s.client, s.userName, s.repoName, fetchSince, s.pagination())
+	if err != nil {
+		return nil, err
+	}
+
+	return selectNewContributors(s.config, allMergedPRs, sinceTag, untilTag, func(author string) (bool, error) {
+		return hasMergedPRsBefore(s.client, s.userName, s.repoName, author, sinceTag.Timestamp)
+	})
+}
+
+// selectNewContributors returns a contributor for each author of a PR merged within the release whose first merged PR
+// was within the release (determined by hasPriorPRs, which is only called when there is a start to the release).
+func selectNewContributors(config Config, allMergedPRs []ghPullRequest, sinceTag, untilTag *git.Tag, hasPriorPRs func(author string) (bool, error)) ([]release.Contributor, error) {
+	filters := []prFilter{prsWithHumanAuthor(), prsWithAllowedAuthor(config)}
+	if sinceTag != nil {
+		filters = append(filters, prsAfter(sinceTag.Timestamp.UTC()))
+	}
+	if untilTag != nil {
+		filters = append(filters, prsAtOrBefore(untilTag.Timestamp.UTC()))
+	}
+	prs, _ := filterPRs(allMergedPRs, filters...)
+
+	// the first PR of each author within the release
+	first := make(map[string]ghPullRequest)
+	for _, pr := range prs {
+		if existing, ok := first[pr.Author]; !ok || pr.MergedAt.Before(existing.MergedAt) {
+			first[pr.Author] = pr
+		}
+	}
+
+	var firstPRs []ghPullRequest
+	for _, pr := range first {
+		firstPRs = append(firstPRs, pr)
+	}
+	sort.Slice(firstPRs, func(i, j int) bool {
+		if firstPRs[i].MergedAt.Equal(firstPRs[j].MergedAt) {
+			return firstPRs[i].Number < firstPRs[j].Number
+		}
+		return firstPRs[i].MergedAt.Before(firstPRs[j].MergedAt)
+	})
+
+	var contributors []release.Contributor
+	for _, pr := range firstPRs {
+		if sinceTag != nil {
+			prior, err := hasPriorPRs(pr.Author)
+			if err != nil {
+				return nil, fmt.Errorf("unable to find prior PRs by %q: %w", pr.Author, err)
+			}
+			if prior {
+				continue
+			}
+		}
+
+		log.Tracef("new contributor %q: first PR #%d", pr.Author, pr.Number)
+
+		ref := authorReference(config, pr.Author, false)
+		contributors = append(contributors, release.Contributor{
+			Name: ref.Text,
+			URL:  ref.URL,
+			FirstContribution: change.Reference{
+				Text: fmt.Sprintf("PR #%d", pr.Number),
+				URL:  pr.URL,
+			},
+		})
+	}
+	return contributors, nil
+}
+
+func prsWithHumanAuthor() prFilter {
+	return func(pr ghPullRequest) bool {
+		keep := pr.Author != "" && !pr.AuthorIsBot
+		if !keep {
+			traceExcluded(prSubject(pr.Number), "not authored by a user")
+		}
+		return keep
+	}
+}
+
+// hasMergedPRsBefore indicates if the given author has any PRs in the repo that were merged before the given time
+// (via the search API, so that the entire history of PRs does not need to be fetched).
+func hasMergedPRsBefore(client *githubv4.Client, user, repo, author string, before time.Time) (bool, error) {
+	var query struct {
+		Search struct {
+			IssueCount githubv4.Int
+		} `graphql:"search(query:$query, type:ISSUE, first:1)"`
+	}
+
+	variables := map[string]interface{}{
+		"query": githubv4.String(fmt.Sprintf("repo:%s/%s is:pr is:merged author:%s merged:<%s", user, repo, author, before.UTC().Format(time.RFC3339))),
+	}
+
+	if err := client.Query(context.Background(), &query, variables); err != nil {
+		return false, err
+	}
+	return query.Search.IssueCount > 0, nil
+}
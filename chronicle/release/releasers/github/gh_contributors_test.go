@@ -0,0 +1,76 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal/git"
+)
+
+func Test_selectNewContributors(t *testing.T) {
+	since := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(30 * 24 * time.Hour)
+
+	pr := func(number int, author string, mergedAt time.Time) ghPullRequest {
+		return ghPullRequest{
+			Number:   number,
+			Author:   author,
+			MergedAt: mergedAt,
+			URL:      "pr-url",
+		}
+	}
+
+	prs := []ghPullRequest{
+		pr(1, "regular", since.Add(time.Hour)),
+		pr(2, "alice", since.Add(3*time.Hour)),
+		// alice's first PR within the release is the one that is referenced
+		pr(3, "alice", since.Add(2*time.Hour)),
+		pr(4, "bob", since.Add(time.Hour)),
+		// merged before the release
+		pr(5, "carol", since.Add(-time.Hour)),
+		// merged after the release
+		pr(6, "dave", until.Add(time.Hour)),
+		{Number: 7, Author: "dependabot", AuthorIsBot: true, MergedAt: since.Add(time.Hour)},
+		pr(8, "excluded-bot", since.Add(time.Hour)),
+	}
+
+	config := Config{
+		Host:           "github.com",
+		ExcludeAuthors: []string{"*-bot"},
+	}
+
+	var asked []string
+	hasPriorPRs := func(author string) (bool, error) {
+		asked = append(asked, author)
+		return author == "regular", nil
+	}
+
+	got, err := selectNewContributors(config, prs, &git.Tag{Timestamp: since}, &git.Tag{Timestamp: until}, hasPriorPRs)
+	require.NoError(t, err)
+
+	assert.Equal(t, []release.Contributor{
+		{
+			Name:              "bob",
+			URL:               "https://github.com/bob",
+			FirstContribution: change.Reference{Text: "PR #4", URL: "pr-url"},
+		},
+		{
+			Name:              "alice",
+			URL:               "https://github.com/alice",
+			FirstContribution: change.Reference{Text: "PR #3", URL: "pr-url"},
+		},
+	}, got)
+	assert.ElementsMatch(t, []string{"regular", "alice", "bob"}, asked)
+
+	// without a start to the release, everyone is a new contributor (and there is nothing to ask)
+	asked = nil
+	got, err = selectNewContributors(config, prs[:2], nil, nil, hasPriorPRs)
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Empty(t, asked)
+}
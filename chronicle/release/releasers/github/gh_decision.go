@@ -0,0 +1,26 @@
+package github
+
+import (
+	"fmt"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// traceExcluded logs why an issue or PR was left out of the changelog. The subject (e.g. "PR #12") and the decision are
+// attached as fields, so the decisions can be collected (e.g. for a debug bundle) without parsing the messages.
+func traceExcluded(subject, format string, args ...interface{}) {
+	log.WithFields("subject", subject, "decision", "excluded").Tracef(subject+" filtered out: "+format, args...)
+}
+
+// traceIncluded logs why an issue or PR was kept in the changelog (see traceExcluded).
+func traceIncluded(subject, format string, args ...interface{}) {
+	log.WithFields("subject", subject, "decision", "included").Tracef(subject+" included: "+format, args...)
+}
+
+func prSubject(number int) string {
+	return fmt.Sprintf("PR #%d", number)
+}
+
+func issueSubject(number int) string {
+	return fmt.Sprintf("issue #%d", number)
+}
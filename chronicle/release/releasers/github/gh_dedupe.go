@@ -0,0 +1,90 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+const (
+	// PreferIssueTitle keeps the issue entry over the entries of the PRs that closed it (the default).
+	PreferIssueTitle = "issue"
+	// PreferPRTitle keeps the PR entries over the entry of the issue that they closed.
+	PreferPRTitle = "pr"
+)
+
+// ParseTitlePreference validates which entry to keep when both an issue and the PR that closed it are in the changelog.
+func ParseTitlePreference(value string) (string, error) {
+	switch v := strings.ToLower(strings.TrimSpace(value)); v {
+	case "", PreferIssueTitle:
+		return PreferIssueTitle, nil
+	case PreferPRTitle:
+		return PreferPRTitle, nil
+	}
+	return "", fmt.Errorf("unsupported title preference %q (options: %s, %s)", value, PreferIssueTitle, PreferPRTitle)
+}
+
+// dedupeLinkedChanges removes the duplicate entries for an issue and the PRs that closed it, which happens when both
+// are within the release and carry mapped labels. Issues and PRs are linked by the closing references of each PR (and
+// the issue timelines with link-issues-by-timeline), which are captured within the identities of each change. Either
+// the issue or the PR entries are kept, per the given preference. Issues with clustered PRs are always kept, since the
+// PRs are already listed beneath them.
+func dedupeLinkedChanges(changes []change.Change, preference string) []change.Change {
+	drop := make(map[int]bool)
+	for i, issueChange := range changes {
+		issue, ok := issueChange.Entry.(ghIssue)
+		if !ok {
+			continue
+		}
+		issueID := fmt.Sprintf("github-issue:%d", issue.Number)
+
+		for j, prChange := range changes {
+			pr, ok := prChange.Entry.(ghPullRequest)
+			if !ok || drop[j] || !closes(prChange, issueID) {
+				continue
+			}
+
+			if preference == PreferPRTitle && len(issueChange.Children) == 0 {
+				traceExcluded(issueSubject(issue.Number), "closed by PR #%d within the same release", pr.Number)
+				drop[i] = true
+				changes[j].References = appendMissingReference(prChange.References, issueChange.References[0])
+				continue
+			}
+
+			traceExcluded(prSubject(pr.Number), "closed issue #%d within the same release", issue.Number)
+			drop[j] = true
+		}
+	}
+
+	if len(drop) == 0 {
+		return changes
+	}
+
+	results := make([]change.Change, 0, len(changes)-len(drop))
+	for idx, c := range changes {
+		if !drop[idx] {
+			results = append(results, c)
+		}
+	}
+	return results
+}
+
+// closes indicates if the given PR change was derived from a PR linked to the issue with the given identity.
+func closes(prChange change.Change, issueID string) bool {
+	for _, id := range prChange.Identities {
+		if id == issueID {
+			return true
+		}
+	}
+	return false
+}
+
+func appendMissingReference(references []change.Reference, ref change.Reference) []change.Reference {
+	for _, r := range references {
+		if r == ref {
+			return references
+		}
+	}
+	return append(references, ref)
+}
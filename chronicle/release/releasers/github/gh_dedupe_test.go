@@ -0,0 +1,85 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func Test_dedupeLinkedChanges(t *testing.T) {
+	issue := ghIssue{Number: 1, Title: "the thing is broken", URL: "https://github.com/anchore/chronicle/issues/1", Closed: true}
+	pr := ghPullRequest{Number: 2, Title: "fix the thing", URL: "https://github.com/anchore/chronicle/pull/2", MergeCommit: "abc", LinkedIssues: []ghIssue{issue}}
+	unrelated := ghPullRequest{Number: 3, Title: "add another thing", MergeCommit: "def"}
+
+	config := Config{Host: "github.com"}
+	issueChanges := createChangesFromIssues(config, []ghPullRequest{pr}, []ghIssue{issue})
+	prChanges := createChangesFromPRs(config, []ghPullRequest{pr, unrelated})
+
+	texts := func(changes []change.Change) (result []string) {
+		for _, c := range changes {
+			result = append(result, c.Text)
+		}
+		return result
+	}
+
+	t.Run("issue wins", func(t *testing.T) {
+		changes := append(append([]change.Change{}, issueChanges...), prChanges...)
+		got := dedupeLinkedChanges(changes, PreferIssueTitle)
+		assert.Equal(t, []string{"the thing is broken", "add another thing"}, texts(got))
+	})
+
+	t.Run("PR wins", func(t *testing.T) {
+		changes := append(append([]change.Change{}, issueChanges...), prChanges...)
+		got := dedupeLinkedChanges(changes, PreferPRTitle)
+		require.Equal(t, []string{"fix the thing", "add another thing"}, texts(got))
+		// the PR entry references the issue it closed
+		assert.Contains(t, got[0].References, change.Reference{Text: "Issue #1", URL: issue.URL})
+	})
+
+	t.Run("clustered issues are kept", func(t *testing.T) {
+		clustered := append([]change.Change{}, issueChanges...)
+		clustered[0].Children = []change.Change{{Text: "fix the thing"}}
+		changes := append(clustered, prChanges...)
+		got := dedupeLinkedChanges(changes, PreferPRTitle)
+		assert.Equal(t, []string{"the thing is broken", "add another thing"}, texts(got))
+	})
+
+	t.Run("no links", func(t *testing.T) {
+		got := dedupeLinkedChanges(createChangesFromPRs(config, []ghPullRequest{unrelated}), PreferIssueTitle)
+		assert.Equal(t, []string{"add another thing"}, texts(got))
+	})
+}
+
+func Test_standardQualitativePrFilters_titlePreference(t *testing.T) {
+	bug := change.NewType("bug", change.SemVerPatch)
+	pr := ghPullRequest{
+		Number:       2,
+		Labels:       []string{"bug"},
+		LinkedIssues: []ghIssue{{Number: 1, Closed: true}},
+	}
+
+	config := Config{ChangeTypesByLabel: change.TypeSet{"bug": bug}}
+
+	kept, _ := filterPRs([]ghPullRequest{pr}, standardQualitativePrFilters(config)...)
+	assert.Empty(t, kept, "PRs that closed an issue are hidden when the issue title is preferred")
+
+	config.PreferTitleFrom = PreferPRTitle
+	kept, _ = filterPRs([]ghPullRequest{pr}, standardQualitativePrFilters(config)...)
+	assert.Len(t, kept, 1)
+}
+
+func TestParseTitlePreference(t *testing.T) {
+	got, err := ParseTitlePreference("")
+	require.NoError(t, err)
+	assert.Equal(t, PreferIssueTitle, got)
+
+	got, err = ParseTitlePreference("PR")
+	require.NoError(t, err)
+	assert.Equal(t, PreferPRTitle, got)
+
+	_, err = ParseTitlePreference("commit")
+	assert.Error(t, err)
+}
@@ -0,0 +1,80 @@
+package github
+
+import (
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// DependencyUpdates collapses the PRs from dependency update bots (e.g. dependabot and renovate) into a single entry,
+// instead of listing each bump individually.
+type DependencyUpdates struct {
+	Group   bool     // collapse the PRs by the given authors into a single entry (per change type)
+	Authors []string // the authors of dependency update PRs (e.g. "dependabot[bot]"), matched as with ExcludeAuthors
+	Title   string   // the text of the collapsed entry (e.g. "Dependency updates")
+}
+
+// isDependencyUpdate indicates if the change is a PR by one of the dependency update authors.
+func (d DependencyUpdates) isDependencyUpdate(c change.Change) bool {
+	pr, ok := c.Entry.(ghPullRequest)
+	if !ok {
+		return false
+	}
+	handle := authorHandle(pr.Author, pr.AuthorIsBot)
+	for _, pattern := range d.Authors {
+		if matchesAuthor(pattern, handle) {
+			return true
+		}
+	}
+	return false
+}
+
+// groupDependencyUpdates replaces the dependency update PRs with a single entry per change type, where each PR is
+// listed beneath it. The entry takes the place of the first PR it replaces. A lone dependency update is left as-is.
+func groupDependencyUpdates(config DependencyUpdates, changes []change.Change) []change.Change {
+	groups := make(map[string][]change.Change)
+	for _, c := range changes {
+		if config.isDependencyUpdate(c) {
+			key := typeNames(c.ChangeTypes)
+			groups[key] = append(groups[key], c)
+		}
+	}
+
+	var results []change.Change
+	for _, c := range changes {
+		if !config.isDependencyUpdate(c) {
+			results = append(results, c)
+			continue
+		}
+
+		key := typeNames(c.ChangeTypes)
+		children, ok := groups[key]
+		switch {
+		case !ok:
+			// already grouped
+			continue
+		case len(children) == 1:
+			results = append(results, c)
+		default:
+			log.Tracef("grouped %d dependency updates as %s", len(children), key)
+			results = append(results, dependencyUpdatesChange(config, children))
+		}
+		delete(groups, key)
+	}
+	return results
+}
+
+func dependencyUpdatesChange(config DependencyUpdates, children []change.Change) change.Change {
+	group := change.Change{
+		Text:        config.Title,
+		ChangeTypes: children[0].ChangeTypes,
+		EntryType:   "githubDependencyUpdates",
+		Children:    children,
+	}
+	for _, c := range children {
+		if c.Timestamp.After(group.Timestamp) {
+			group.Timestamp = c.Timestamp
+		}
+		group.Identities = append(group.Identities, c.Identities...)
+	}
+	return group
+}
@@ -0,0 +1,56 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func Test_groupDependencyUpdates(t *testing.T) {
+	bug := change.NewType("bug-fix", change.SemVerPatch)
+	t1 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+
+	prChange := func(number int, author string, bot bool, ts time.Time, types ...change.Type) change.Change {
+		return change.Change{
+			Text:        "PR",
+			ChangeTypes: types,
+			Timestamp:   ts,
+			Entry:       ghPullRequest{Number: number, Author: author, AuthorIsBot: bot},
+			Identities:  []string{fmt.Sprintf("github-pr:%d", number)},
+		}
+	}
+
+	feature := prChange(1, "alice", false, t1, bug)
+	bumpA := prChange(2, "dependabot", true, t1, change.UnknownType)
+	bumpB := prChange(3, "renovate", true, t2, change.UnknownType)
+	bumpFix := prChange(4, "dependabot", true, t1, bug)
+	issue := change.Change{Text: "issue", Entry: ghIssue{Number: 5}}
+
+	config := DependencyUpdates{
+		Group:   true,
+		Authors: []string{"dependabot[bot]", "renovate[bot]"},
+		Title:   "Dependency updates",
+	}
+
+	got := groupDependencyUpdates(config, []change.Change{feature, bumpA, issue, bumpFix, bumpB})
+
+	assert.Equal(t, []change.Change{
+		feature,
+		{
+			Text:        "Dependency updates",
+			ChangeTypes: []change.Type{change.UnknownType},
+			Timestamp:   t2,
+			EntryType:   "githubDependencyUpdates",
+			Children:    []change.Change{bumpA, bumpB},
+			Identities:  []string{"github-pr:2", "github-pr:3"},
+		},
+		issue,
+		// a lone dependency update (for the change type) is left as-is
+		bumpFix,
+	}, got)
+}
@@ -0,0 +1,185 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal"
+)
+
+// Discussions selects the github discussions to include in the changelog, for projects that make announcements (e.g.
+// deprecations) as discussions rather than issues.
+type Discussions struct {
+	Categories []string    // include the discussions within these categories (e.g. "Announcements"), matched ignoring case
+	Pinned     bool        // include the pinned discussions (in any category)
+	ChangeType change.Type // the change type (that is, the changelog section) for the discussions
+}
+
+// Enabled indicates if any discussions are to be included.
+func (d Discussions) Enabled() bool {
+	return len(d.Categories) > 0 || d.Pinned
+}
+
+type ghDiscussion struct {
+	Title       string
+	Number      int
+	URL         string
+	Author      string
+	AuthorIsBot bool
+	Category    string
+	CreatedAt   time.Time
+	Pinned      bool
+}
+
+func discussionSubject(number int) string {
+	return fmt.Sprintf("discussion #%d", number)
+}
+
+// selectDiscussions returns the discussions in the configured categories (or pinned, when configured) that were
+// created after the since time and at or before the until time (either may be nil for an open-ended window).
+func selectDiscussions(config Discussions, discussions []ghDiscussion, since, until *time.Time) []ghDiscussion {
+	var selected []ghDiscussion
+	for _, d := range discussions {
+		if !config.Pinned || !d.Pinned {
+			if !containsFold(config.Categories, d.Category) {
+				traceExcluded(discussionSubject(d.Number), "not pinned or in a selected category (category %q)", d.Category)
+				continue
+			}
+		}
+		if since != nil && !d.CreatedAt.After(*since) {
+			traceExcluded(discussionSubject(d.Number), "created at or before %s (created %s)", internal.FormatDateTime(*since), internal.FormatDateTime(d.CreatedAt))
+			continue
+		}
+		if until != nil && d.CreatedAt.After(*until) {
+			traceExcluded(discussionSubject(d.Number), "created after %s (created %s)", internal.FormatDateTime(*until), internal.FormatDateTime(d.CreatedAt))
+			continue
+		}
+		selected = append(selected, d)
+	}
+	return selected
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func createChangesFromDiscussions(config Config, discussions []ghDiscussion) (changes []change.Change) {
+	for _, d := range discussions {
+		traceIncluded(discussionSubject(d.Number), "as %s", config.Discussions.ChangeType.Name)
+
+		var authors []change.Reference
+		if d.Author != "" {
+			authors = append(authors, authorReference(config, d.Author, d.AuthorIsBot))
+		}
+
+		changes = append(changes, change.Change{
+			Text:        d.Title,
+			ChangeTypes: []change.Type{config.Discussions.ChangeType},
+			Timestamp:   d.CreatedAt,
+			References: []change.Reference{
+				{
+					Text: fmt.Sprintf("Discussion #%d", d.Number),
+					URL:  d.URL,
+				},
+			},
+			EntryType:  "githubDiscussion",
+			Entry:      d,
+			Identities: []string{fmt.Sprintf("github-discussion:%d", d.Number)},
+			Authors:    authors,
+		})
+	}
+	return changes
+}
+
+// fetchDiscussions returns the discussions of the repo, most recently created first, stopping once reaching
+// discussions created before the given since time (when given). Pinned discussions are marked as such.
+// nolint:funlen
+func fetchDiscussions(client *githubv4.Client, user, repo string, since *time.Time, paging pagination) ([]ghDiscussion, error) {
+	var query struct {
+		Repository struct {
+			PinnedDiscussions struct {
+				Nodes []struct {
+					Discussion struct {
+						Number githubv4.Int
+					}
+				}
+			} `graphql:"pinnedDiscussions(first:10)"`
+			Discussions struct {
+				PageInfo struct {
+					EndCursor   githubv4.String
+					HasNextPage bool
+				}
+				Nodes []struct {
+					Title  githubv4.String
+					Number githubv4.Int
+					URL    githubv4.String
+					Author struct {
+						Login    githubv4.String
+						Typename githubv4.String `graphql:"__typename"`
+					}
+					Category struct {
+						Name githubv4.String
+					}
+					CreatedAt githubv4.DateTime
+				}
+			} `graphql:"discussions(first:$pageSize, after:$discussionsCursor, orderBy:{field:CREATED_AT, direction:DESC})"`
+		} `graphql:"repository(owner:$repositoryOwner, name:$repositoryName)"`
+	}
+	variables := map[string]interface{}{
+		"repositoryOwner":   githubv4.String(user),
+		"repositoryName":    githubv4.String(repo),
+		"discussionsCursor": (*githubv4.String)(nil), // Null after argument to get first page.
+		"pageSize":          paging.size(),
+	}
+
+	var allDiscussions []ghDiscussion
+	pinned := make(map[int]bool)
+	for pages := 1; ; pages++ {
+		err := client.Query(context.Background(), &query, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, n := range query.Repository.PinnedDiscussions.Nodes {
+			pinned[int(n.Discussion.Number)] = true
+		}
+
+		var reachedBoundary bool
+		for _, n := range query.Repository.Discussions.Nodes {
+			if since != nil && n.CreatedAt.Time.Before(*since) {
+				reachedBoundary = true
+				break
+			}
+			allDiscussions = append(allDiscussions, ghDiscussion{
+				Title:       string(n.Title),
+				Number:      int(n.Number),
+				URL:         string(n.URL),
+				Author:      string(n.Author.Login),
+				AuthorIsBot: isBotActor(n.Author.Typename),
+				Category:    string(n.Category.Name),
+				CreatedAt:   n.CreatedAt.Time,
+			})
+		}
+
+		if reachedBoundary || !query.Repository.Discussions.PageInfo.HasNextPage || paging.exhausted(pages, "discussions") {
+			break
+		}
+		variables["discussionsCursor"] = githubv4.NewString(query.Repository.Discussions.PageInfo.EndCursor)
+	}
+
+	for i := range allDiscussions {
+		allDiscussions[i].Pinned = pinned[allDiscussions[i].Number]
+	}
+
+	return allDiscussions, nil
+}
@@ -0,0 +1,94 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func Test_selectDiscussions(t *testing.T) {
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	within := since.Add(24 * time.Hour)
+
+	discussions := []ghDiscussion{
+		{Number: 1, Category: "Announcements", CreatedAt: within},
+		{Number: 2, Category: "announcements", CreatedAt: since},
+		{Number: 3, Category: "Announcements", CreatedAt: until.Add(time.Second)},
+		{Number: 4, Category: "Q&A", CreatedAt: within},
+		{Number: 5, Category: "Q&A", CreatedAt: within, Pinned: true},
+		{Number: 6, Category: "announcements", CreatedAt: until},
+	}
+
+	numbers := func(ds []ghDiscussion) (n []int) {
+		for _, d := range ds {
+			n = append(n, d.Number)
+		}
+		return n
+	}
+
+	tests := []struct {
+		name         string
+		config       Discussions
+		since, until *time.Time
+		want         []int
+	}{
+		{
+			name:   "by category within the release",
+			config: Discussions{Categories: []string{"Announcements"}},
+			since:  &since,
+			until:  &until,
+			want:   []int{1, 6},
+		},
+		{
+			name:   "pinned in any category",
+			config: Discussions{Categories: []string{"Announcements"}, Pinned: true},
+			since:  &since,
+			until:  &until,
+			want:   []int{1, 5, 6},
+		},
+		{
+			name:   "open-ended release",
+			config: Discussions{Pinned: true},
+			want:   []int{5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, numbers(selectDiscussions(tt.config, discussions, tt.since, tt.until)))
+		})
+	}
+}
+
+func Test_createChangesFromDiscussions(t *testing.T) {
+	announcement := change.NewType("announcements", change.SemVerUnknown)
+	created := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	d := ghDiscussion{
+		Title:     "Deprecating the v1 API",
+		Number:    42,
+		URL:       "https://github.com/anchore/chronicle/discussions/42",
+		Author:    "someone",
+		Category:  "Announcements",
+		CreatedAt: created,
+	}
+
+	changes := createChangesFromDiscussions(Config{Host: "github.com", Discussions: Discussions{ChangeType: announcement}}, []ghDiscussion{d})
+
+	assert.Equal(t, []change.Change{
+		{
+			Text:        "Deprecating the v1 API",
+			ChangeTypes: []change.Type{announcement},
+			Timestamp:   created,
+			References: []change.Reference{
+				{Text: "Discussion #42", URL: "https://github.com/anchore/chronicle/discussions/42"},
+			},
+			EntryType:  "githubDiscussion",
+			Entry:      d,
+			Identities: []string{"github-discussion:42"},
+			Authors:    []change.Reference{{Text: "someone", URL: "https://github.com/someone"}},
+		},
+	}, changes)
+}
@@ -2,26 +2,26 @@ package github
 
 import (
 	"context"
-	"os"
 	"strings"
 	"time"
 
 	"github.com/shurcooL/githubv4"
-	"golang.org/x/oauth2"
 
 	"github.com/anchore/chronicle/internal"
-	"github.com/anchore/chronicle/internal/log"
 )
 
 type ghIssue struct {
-	Title      string
-	Number     int
-	Author     string
-	ClosedAt   time.Time
-	Closed     bool
-	NotPlanned bool
-	Labels     []string
-	URL        string
+	Title       string
+	Number      int
+	Author      string
+	AuthorIsBot bool // the author is a bot (e.g. a GitHub App such as dependabot)
+	ClosedAt    time.Time
+	Closed      bool
+	NotPlanned  bool
+	Labels      []string
+	URL         string
+	Milestone   string   // the title of the milestone the issue is in (if any)
+	Assignees   []string // the logins of the users assigned to the issue
 }
 
 type issueFilter func(issue ghIssue) bool
@@ -51,7 +51,7 @@ func issuesAtOrAfter(since time.Time) issueFilter {
 	return func(issue ghIssue) bool {
 		keep := issue.ClosedAt.After(since) || issue.ClosedAt.Equal(since)
 		if !keep {
-			log.Tracef("issue #%d filtered out: closed at or before %s (closed %s)", issue.Number, internal.FormatDateTime(since), internal.FormatDateTime(issue.ClosedAt))
+			traceExcluded(issueSubject(issue.Number), "closed at or before %s (closed %s)", internal.FormatDateTime(since), internal.FormatDateTime(issue.ClosedAt))
 		}
 		return keep
 	}
@@ -61,7 +61,7 @@ func issuesAtOrBefore(since time.Time) issueFilter {
 	return func(issue ghIssue) bool {
 		keep := issue.ClosedAt.Before(since) || issue.ClosedAt.Equal(since)
 		if !keep {
-			log.Tracef("issue #%d filtered out: closed at or after %s (closed %s)", issue.Number, internal.FormatDateTime(since), internal.FormatDateTime(issue.ClosedAt))
+			traceExcluded(issueSubject(issue.Number), "closed at or after %s (closed %s)", internal.FormatDateTime(since), internal.FormatDateTime(issue.ClosedAt))
 		}
 		return keep
 	}
@@ -71,7 +71,7 @@ func issuesAfter(since time.Time) issueFilter {
 	return func(issue ghIssue) bool {
 		keep := issue.ClosedAt.After(since)
 		if !keep {
-			log.Tracef("issue #%d filtered out: closed before %s (closed %s)", issue.Number, internal.FormatDateTime(since), internal.FormatDateTime(issue.ClosedAt))
+			traceExcluded(issueSubject(issue.Number), "closed before %s (closed %s)", internal.FormatDateTime(since), internal.FormatDateTime(issue.ClosedAt))
 		}
 		return keep
 	}
@@ -82,7 +82,7 @@ func issuesBefore(since time.Time) issueFilter {
 	return func(issue ghIssue) bool {
 		keep := issue.ClosedAt.Before(since)
 		if !keep {
-			log.Tracef("issue #%d filtered out: closed after %s (closed %s)", issue.Number, internal.FormatDateTime(since), internal.FormatDateTime(issue.ClosedAt))
+			traceExcluded(issueSubject(issue.Number), "closed after %s (closed %s)", internal.FormatDateTime(since), internal.FormatDateTime(issue.ClosedAt))
 		}
 		return keep
 	}
@@ -98,18 +98,20 @@ func issuesWithLabel(labels ...string) issueFilter {
 			}
 		}
 
-		log.Tracef("issue #%d filtered out: missing required label", issue.Number)
+		traceExcluded(issueSubject(issue.Number), "missing required label")
 
 		return false
 	}
 }
 
+// issuesWithoutLabel excludes issues with any of the given labels, regardless of any other labels (labels are matched
+// ignoring case, as github does).
 func issuesWithoutLabel(labels ...string) issueFilter {
 	return func(issue ghIssue) bool {
 		for _, targetLabel := range labels {
 			for _, l := range issue.Labels {
-				if l == targetLabel {
-					log.Tracef("issue #%d filtered out: has label %q", issue.Number, l)
+				if strings.EqualFold(l, targetLabel) {
+					traceExcluded(issueSubject(issue.Number), "has label %q", l)
 
 					return false
 				}
@@ -123,10 +125,10 @@ func excludeIssuesNotPlanned(allMergedPRs []ghPullRequest) issueFilter {
 	return func(issue ghIssue) bool {
 		if issue.NotPlanned {
 			if len(getLinkedPRs(allMergedPRs, issue)) > 0 {
-				log.Tracef("issue #%d included: is closed as not planned but has linked PRs", issue.Number)
+				traceIncluded(issueSubject(issue.Number), "is closed as not planned but has linked PRs")
 				return true
 			}
-			log.Tracef("issue #%d filtered out: as not planned", issue.Number)
+			traceExcluded(issueSubject(issue.Number), "as not planned")
 			return false
 		}
 		return true
@@ -138,7 +140,7 @@ func issuesWithChangeTypes(config Config) issueFilter {
 		changeTypes := config.ChangeTypesByLabel.ChangeTypes(issue.Labels...)
 		keep := len(changeTypes) > 0
 		if !keep {
-			log.Tracef("issue #%d filtered out: no change types", issue.Number)
+			traceExcluded(issueSubject(issue.Number), "no change types")
 		}
 		return keep
 	}
@@ -148,24 +150,20 @@ func issuesWithoutLabels() issueFilter {
 	return func(issue ghIssue) bool {
 		keep := len(issue.Labels) == 0
 		if !keep {
-			log.Tracef("issue #%d filtered out: has labels", issue.Number)
+			traceExcluded(issueSubject(issue.Number), "has labels")
 		}
 		return keep
 	}
 }
 
+// fetchClosedIssues returns all closed issues, most recently updated first. When a since time is given, issues that
+// have not been updated since then are not fetched (they could not have been closed since then), which is filtered
+// server-side.
 // nolint:funlen
-func fetchClosedIssues(user, repo string) ([]ghIssue, error) {
-	src := oauth2.StaticTokenSource(
-		// TODO: DI this
-		&oauth2.Token{AccessToken: os.Getenv("GITHUB_TOKEN")},
-	)
-	httpClient := oauth2.NewClient(context.Background(), src)
-	client := githubv4.NewClient(httpClient)
+func fetchClosedIssues(client *githubv4.Client, user, repo string, since *time.Time, paging pagination) ([]ghIssue, error) {
 	var allIssues []ghIssue
 
 	{
-		// TODO: act on hitting a rate limit
 		type rateLimit struct {
 			Cost      githubv4.Int
 			Limit     githubv4.Int
@@ -188,12 +186,22 @@ func fetchClosedIssues(user, repo string) ([]ghIssue, error) {
 							Number githubv4.Int
 							URL    githubv4.String
 							Author struct {
-								Login githubv4.String
+								Login    githubv4.String
+								Typename githubv4.String `graphql:"__typename"`
 							}
 							Closed      githubv4.Boolean
 							ClosedAt    githubv4.DateTime
+							UpdatedAt   githubv4.DateTime
 							StateReason githubv4.String
-							Labels      struct {
+							Milestone   struct {
+								Title githubv4.String
+							}
+							Assignees struct {
+								Nodes []struct {
+									Login githubv4.String
+								}
+							} `graphql:"assignees(first:10)"`
+							Labels struct {
 								Edges []struct {
 									Node struct {
 										Name githubv4.String
@@ -202,7 +210,7 @@ func fetchClosedIssues(user, repo string) ([]ghIssue, error) {
 							} `graphql:"labels(first:100)"`
 						}
 					}
-				} `graphql:"issues(first:100, states:CLOSED, after:$issuesCursor)"`
+				} `graphql:"issues(first:$pageSize, states:CLOSED, after:$issuesCursor, orderBy:{field:UPDATED_AT, direction:DESC}, filterBy:{since:$issuesSince})"`
 			} `graphql:"repository(owner:$repositoryOwner, name:$repositoryName)"`
 
 			RateLimit rateLimit
@@ -211,34 +219,53 @@ func fetchClosedIssues(user, repo string) ([]ghIssue, error) {
 			"repositoryOwner": githubv4.String(user),
 			"repositoryName":  githubv4.String(repo),
 			"issuesCursor":    (*githubv4.String)(nil), // Null after argument to get first page.
+			"issuesSince":     (*githubv4.DateTime)(nil),
+			"pageSize":        paging.size(),
+		}
+		if since != nil {
+			variables["issuesSince"] = githubv4.NewDateTime(githubv4.DateTime{Time: *since})
 		}
 
 		// var limit rateLimit
-		for {
+		for pages := 1; ; pages++ {
 			err := client.Query(context.Background(), &query, variables)
 			if err != nil {
 				return nil, err
 			}
 			// limit = query.RateLimit
 
+			var reachedBoundary bool
 			for _, iEdge := range query.Repository.Issues.Edges {
+				if updatedBefore(iEdge.Node.UpdatedAt.Time, since) {
+					reachedBoundary = true
+					break
+				}
+
 				var labels []string
 				for _, lEdge := range iEdge.Node.Labels.Edges {
 					labels = append(labels, string(lEdge.Node.Name))
 				}
+
+				var assignees []string
+				for _, aNode := range iEdge.Node.Assignees.Nodes {
+					assignees = append(assignees, string(aNode.Login))
+				}
 				allIssues = append(allIssues, ghIssue{
-					Title:      string(iEdge.Node.Title),
-					Author:     string(iEdge.Node.Author.Login),
-					ClosedAt:   iEdge.Node.ClosedAt.Time,
-					Closed:     bool(iEdge.Node.Closed),
-					Labels:     labels,
-					URL:        string(iEdge.Node.URL),
-					Number:     int(iEdge.Node.Number),
-					NotPlanned: strings.EqualFold("NOT_PLANNED", string(iEdge.Node.StateReason)),
+					Title:       string(iEdge.Node.Title),
+					Author:      string(iEdge.Node.Author.Login),
+					AuthorIsBot: isBotActor(iEdge.Node.Author.Typename),
+					ClosedAt:    iEdge.Node.ClosedAt.Time,
+					Closed:      bool(iEdge.Node.Closed),
+					Labels:      labels,
+					URL:         string(iEdge.Node.URL),
+					Number:      int(iEdge.Node.Number),
+					NotPlanned:  strings.EqualFold("NOT_PLANNED", string(iEdge.Node.StateReason)),
+					Milestone:   string(iEdge.Node.Milestone.Title),
+					Assignees:   assignees,
 				})
 			}
 
-			if !query.Repository.Issues.PageInfo.HasNextPage {
+			if reachedBoundary || !query.Repository.Issues.PageInfo.HasNextPage || paging.exhausted(pages, "closed issues") {
 				break
 			}
 			variables["issuesCursor"] = githubv4.NewString(query.Repository.Issues.PageInfo.EndCursor)
@@ -1,10 +1,16 @@
 package github
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/anchore/chronicle/chronicle/release/change"
 )
@@ -233,6 +239,16 @@ func Test_issuesWithoutLabel(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name: "matches on label ignoring case",
+			labels: []string{
+				"changelog/ignore",
+			},
+			issue: ghIssue{
+				Labels: []string{"bug", "Changelog/Ignore"},
+			},
+			expected: false,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -394,3 +410,52 @@ func Test_issuesWithChangeTypes(t *testing.T) {
 		})
 	}
 }
+
+func Test_fetchClosedIssues_pagination(t *testing.T) {
+	since := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	var requests []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Contains(t, req.Query, "filterBy:{since:$issuesSince}")
+		requests = append(requests, req.Variables)
+
+		page := len(requests)
+		fmt.Fprintf(w, `{"data": {"repository": {"issues": {
+			"pageInfo": {"endCursor": "cursor-%d", "hasNextPage": true},
+			"edges": [{"node": {"title": "issue %d", "number": %d, "closed": true, "closedAt": "2023-03-02T00:00:00Z", "updatedAt": "2023-03-02T00:00:00Z"}}]
+		}}}}`, page, page, page)
+	}))
+	t.Cleanup(server.Close)
+
+	client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+	issues, err := fetchClosedIssues(client, "anchore", "chronicle", &since, pagination{PageSize: 25, MaxPages: 3})
+	require.NoError(t, err)
+
+	var numbers []int
+	for _, i := range issues {
+		numbers = append(numbers, i.Number)
+	}
+	assert.Equal(t, []int{1, 2, 3}, numbers)
+
+	require.Len(t, requests, 3)
+	assert.Equal(t, float64(25), requests[0]["pageSize"])
+	assert.Equal(t, "2023-03-01T00:00:00Z", requests[0]["issuesSince"])
+	assert.Nil(t, requests[0]["issuesCursor"])
+	assert.Equal(t, "cursor-2", requests[2]["issuesCursor"])
+}
+
+func Test_pagination(t *testing.T) {
+	assert.Equal(t, githubv4.Int(100), pagination{}.size())
+	assert.Equal(t, githubv4.Int(100), pagination{PageSize: 500}.size())
+	assert.Equal(t, githubv4.Int(10), pagination{PageSize: 10}.size())
+
+	assert.False(t, pagination{}.exhausted(1000, "issues"))
+	assert.False(t, pagination{MaxPages: 2}.exhausted(1, "issues"))
+	assert.True(t, pagination{MaxPages: 2}.exhausted(2, "issues"))
+}
@@ -0,0 +1,55 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// MilestoneReplacesRange selects every merged PR and closed issue in the milestone, irrespective of the release tags
+	// (the default).
+	MilestoneReplacesRange = "replace"
+	// MilestoneFiltersRange selects the PRs and issues within the release (per the release tags) that are also in the
+	// milestone.
+	MilestoneFiltersRange = "filter"
+
+	// milestoneVersionPlaceholder is replaced with the release version within the configured milestone title.
+	milestoneVersionPlaceholder = "{version}"
+)
+
+// ParseMilestoneMode validates how a milestone selects the changes of a release.
+func ParseMilestoneMode(value string) (string, error) {
+	switch v := strings.ToLower(strings.TrimSpace(value)); v {
+	case "", MilestoneReplacesRange:
+		return MilestoneReplacesRange, nil
+	case MilestoneFiltersRange:
+		return MilestoneFiltersRange, nil
+	}
+	return "", fmt.Errorf("unsupported milestone mode %q (options: %s, %s)", value, MilestoneReplacesRange, MilestoneFiltersRange)
+}
+
+// resolveMilestone returns the milestone title for the given release version (the tag or commit at the end of the
+// release), or an empty string when changes are not selected by milestone.
+func resolveMilestone(title, version string) string {
+	return strings.ReplaceAll(title, milestoneVersionPlaceholder, version)
+}
+
+func prsInMilestone(milestone string) prFilter {
+	return func(pr ghPullRequest) bool {
+		keep := strings.EqualFold(pr.Milestone, milestone)
+		if !keep {
+			traceExcluded(prSubject(pr.Number), "not in milestone %q (milestone %q)", milestone, pr.Milestone)
+		}
+		return keep
+	}
+}
+
+func issuesInMilestone(milestone string) issueFilter {
+	return func(issue ghIssue) bool {
+		keep := strings.EqualFold(issue.Milestone, milestone)
+		if !keep {
+			traceExcluded(issueSubject(issue.Number), "not in milestone %q (milestone %q)", milestone, issue.Milestone)
+		}
+		return keep
+	}
+}
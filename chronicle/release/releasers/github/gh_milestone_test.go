@@ -0,0 +1,62 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMilestoneMode(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr require.ErrorAssertionFunc
+	}{
+		{value: "", want: MilestoneReplacesRange},
+		{value: "replace", want: MilestoneReplacesRange},
+		{value: " Filter ", want: MilestoneFiltersRange},
+		{value: "union", wantErr: require.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			got, err := ParseMilestoneMode(tt.value)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_resolveMilestone(t *testing.T) {
+	assert.Equal(t, "", resolveMilestone("", "v1.4.0"))
+	assert.Equal(t, "Sprint 12", resolveMilestone("Sprint 12", "v1.4.0"))
+	assert.Equal(t, "v1.4.0", resolveMilestone("{version}", "v1.4.0"))
+	assert.Equal(t, "Release v1.4.0", resolveMilestone("Release {version}", "v1.4.0"))
+}
+
+func Test_milestoneFilters(t *testing.T) {
+	prs := []ghPullRequest{
+		{Number: 1, Milestone: "v1.4.0"},
+		{Number: 2, Milestone: "V1.4.0"},
+		{Number: 3, Milestone: "v1.5.0"},
+		{Number: 4},
+	}
+	issues := []ghIssue{
+		{Number: 5, Milestone: "v1.4.0"},
+		{Number: 6},
+	}
+
+	keptPRs, _ := filterPRs(prs, prsInMilestone("v1.4.0"))
+	var prNumbers []int
+	for _, pr := range keptPRs {
+		prNumbers = append(prNumbers, pr.Number)
+	}
+	assert.Equal(t, []int{1, 2}, prNumbers)
+
+	keptIssues := filterIssues(issues, issuesInMilestone("v1.4.0"))
+	require.Len(t, keptIssues, 1)
+	assert.Equal(t, 5, keptIssues[0].Number)
+}
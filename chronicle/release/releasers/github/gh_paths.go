@@ -0,0 +1,172 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// the PR files API lists at most 3000 files for a PR
+const maxPRFilePages = 30
+
+// ValidatePathPattern ensures each element of the given path pattern is a valid glob (see path.Match).
+func ValidatePathPattern(pattern string) error {
+	for _, element := range strings.Split(strings.Trim(pattern, "/"), "/") {
+		if _, err := path.Match(element, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchesPath indicates if the file path matches the glob pattern, where "**" matches any number of directories (e.g.
+// "services/api/**" matches every file beneath services/api) and every other element is matched as with path.Match.
+func matchesPath(pattern, file string) bool {
+	return matchesPathElements(strings.Split(strings.Trim(pattern, "/"), "/"), strings.Split(strings.Trim(file, "/"), "/"))
+}
+
+func matchesPathElements(patterns, elements []string) bool {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			// try matching the rest of the pattern at every depth (including none)
+			for i := 0; i <= len(elements); i++ {
+				if matchesPathElements(patterns[1:], elements[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(elements) == 0 {
+			return false
+		}
+		if matched, err := path.Match(patterns[0], elements[0]); err != nil || !matched {
+			return false
+		}
+		patterns, elements = patterns[1:], elements[1:]
+	}
+	return len(elements) == 0
+}
+
+func matchesAnyPath(patterns, files []string) bool {
+	for _, f := range files {
+		for _, p := range patterns {
+			if matchesPath(p, f) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// prsTouchingPaths returns the PRs that changed a file matching any of the path globs, looking up the files of each PR
+// with the given function.
+func prsTouchingPaths(patterns []string, prs []ghPullRequest, lookup func(number int) ([]string, error)) ([]ghPullRequest, error) {
+	var results []ghPullRequest
+	for _, pr := range prs {
+		files, err := lookup(pr.Number)
+		if err != nil {
+			return nil, err
+		}
+		if !matchesAnyPath(patterns, files) {
+			traceExcluded(prSubject(pr.Number), "did not change any files matching %v", patterns)
+			continue
+		}
+		results = append(results, pr)
+	}
+	log.Debugf("PRs changing files within the paths: %d of %d", len(results), len(prs))
+	return results, nil
+}
+
+func prsWithNumbers(prs []ghPullRequest) prFilter {
+	numbers := make(map[int]bool)
+	for _, pr := range prs {
+		numbers[pr.Number] = true
+	}
+	return func(pr ghPullRequest) bool {
+		return numbers[pr.Number]
+	}
+}
+
+// issuesLinkedToPRs keeps the issues that are linked to any of the given PRs.
+func issuesLinkedToPRs(prs []ghPullRequest) issueFilter {
+	return func(issue ghIssue) bool {
+		if len(getLinkedPRs(prs, issue)) == 0 {
+			traceExcluded(issueSubject(issue.Number), "not linked to a PR that changed files within the paths")
+			return false
+		}
+		return true
+	}
+}
+
+// prFilesFetcher lists the files changed by PRs (via the PR files API).
+type prFilesFetcher struct {
+	client  *http.Client
+	baseURL string
+}
+
+func newPRFilesFetcher(client *http.Client, apiURL, owner, repo string) *prFilesFetcher {
+	return &prFilesFetcher{
+		client:  client,
+		baseURL: fmt.Sprintf("%s/repos/%s/%s", strings.TrimSuffix(apiURL, "/"), owner, repo),
+	}
+}
+
+// files returns the paths of the files changed by the given PR (including the previous path of renamed files).
+func (f *prFilesFetcher) files(number int) ([]string, error) {
+	var all []string
+	for page := 1; page <= maxPRFilePages; page++ {
+		files, err := f.page(number, page)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			all = append(all, file.Filename)
+			if file.PreviousFilename != "" {
+				all = append(all, file.PreviousFilename)
+			}
+		}
+		if len(files) < 100 {
+			break
+		}
+	}
+	return all, nil
+}
+
+type ghPRFile struct {
+	Filename         string `json:"filename"`
+	PreviousFilename string `json:"previous_filename"`
+}
+
+func (f *prFilesFetcher) page(number, page int) ([]ghPRFile, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/pulls/%d/files?per_page=100&page=%d", f.baseURL, number, page), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d for the files of PR #%d: %s", resp.StatusCode, number, strings.TrimSpace(string(body)))
+	}
+
+	var files []ghPRFile
+	if err := json.Unmarshal(body, &files); err != nil {
+		return nil, fmt.Errorf("unable to parse the files of PR #%d: %w", number, err)
+	}
+	return files, nil
+}
@@ -0,0 +1,80 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_matchesPath(t *testing.T) {
+	tests := []struct {
+		pattern string
+		file    string
+		want    bool
+	}{
+		{pattern: "services/api/**", file: "services/api/main.go", want: true},
+		{pattern: "services/api/**", file: "services/api/internal/handler/handler.go", want: true},
+		{pattern: "services/api/**", file: "services/web/main.go", want: false},
+		{pattern: "services/api/**", file: "services/api-gateway/main.go", want: false},
+		{pattern: "**/*.proto", file: "api.proto", want: true},
+		{pattern: "**/*.proto", file: "proto/v1/api.proto", want: true},
+		{pattern: "services/*/go.mod", file: "services/api/go.mod", want: true},
+		{pattern: "services/*/go.mod", file: "services/api/sub/go.mod", want: false},
+		{pattern: "README.md", file: "README.md", want: true},
+		{pattern: "README.md", file: "docs/README.md", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %s", tt.pattern, tt.file), func(t *testing.T) {
+			assert.Equal(t, tt.want, matchesPath(tt.pattern, tt.file))
+		})
+	}
+}
+
+func TestValidatePathPattern(t *testing.T) {
+	assert.NoError(t, ValidatePathPattern("services/api/**"))
+	assert.Error(t, ValidatePathPattern("services/[api/**"))
+}
+
+func Test_prsTouchingPaths(t *testing.T) {
+	files := map[int][]string{
+		1: {"services/api/main.go"},
+		2: {"services/web/main.go", "README.md"},
+		3: {"services/web/moved.go", "services/api/moved.go"},
+	}
+	prs := []ghPullRequest{{Number: 1}, {Number: 2}, {Number: 3}}
+
+	got, err := prsTouchingPaths([]string{"services/api/**"}, prs, func(number int) ([]string, error) {
+		return files[number], nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []ghPullRequest{{Number: 1}, {Number: 3}}, got)
+
+	_, err = prsTouchingPaths([]string{"services/api/**"}, prs, func(number int) ([]string, error) {
+		return nil, fmt.Errorf("bad")
+	})
+	require.Error(t, err)
+}
+
+func Test_issuesLinkedToPRs(t *testing.T) {
+	linked := ghIssue{Number: 10, URL: "https://github.com/anchore/chronicle/issues/10"}
+	unlinked := ghIssue{Number: 11, URL: "https://github.com/anchore/chronicle/issues/11"}
+	prs := []ghPullRequest{{Number: 1, LinkedIssues: []ghIssue{linked}}}
+
+	assert.Equal(t, []ghIssue{linked}, filterIssues([]ghIssue{linked, unlinked}, issuesLinkedToPRs(prs)))
+}
+
+func Test_prFilesFetcher_files(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/anchore/chronicle/pulls/7/files", r.URL.Path)
+		_, _ = w.Write([]byte(`[{"filename": "services/api/new.go", "previous_filename": "services/api/old.go"}, {"filename": "go.mod"}]`))
+	}))
+	defer server.Close()
+
+	got, err := newPRFilesFetcher(server.Client(), server.URL, "anchore", "chronicle").files(7)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"services/api/new.go", "services/api/old.go", "go.mod"}, got)
+}
@@ -0,0 +1,129 @@
+package github
+
+import (
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+var (
+	fencedBlockPattern = regexp.MustCompile("(?ms)^\\s*```[^\\n]*\\n(.*?)^\\s*```")
+	// releaseNoteBlockPattern is like fencedBlockPattern, however, captures the info string as well
+	releaseNoteBlockPattern = regexp.MustCompile("(?ms)^\\s*```([^\\n]*)\\n(.*?)^\\s*```")
+	htmlCommentPattern      = regexp.MustCompile(`(?s)<!--.*?-->`)
+	headingPattern          = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+)
+
+// prChangelogEntry is a single changelog entry declared within a PR body, allowing for one PR to yield several entries.
+// Entries are declared as a YAML list under a "changelog" key within a fenced block, for example:
+//
+//	```yaml
+//	changelog:
+//	  - type: added-feature
+//	    text: add support for the thing
+//	  - type: bug
+//	    text: fix the other thing
+//	```
+//
+// where "type" is either a change type name or a label that maps to a change type.
+type prChangelogEntry struct {
+	Type string `yaml:"type"`
+	Text string `yaml:"text"`
+}
+
+// parseChangelogEntries returns all changelog entries declared within the given PR body (if any).
+func parseChangelogEntries(body string) []prChangelogEntry {
+	for _, match := range fencedBlockPattern.FindAllStringSubmatch(body, -1) {
+		var doc struct {
+			Changelog []prChangelogEntry `yaml:"changelog"`
+		}
+		if err := yaml.Unmarshal([]byte(match[1]), &doc); err != nil {
+			continue
+		}
+
+		var entries []prChangelogEntry
+		for _, e := range doc.Changelog {
+			e.Text = strings.TrimSpace(e.Text)
+			if e.Text == "" {
+				continue
+			}
+			entries = append(entries, e)
+		}
+
+		if len(entries) > 0 {
+			return entries
+		}
+	}
+	return nil
+}
+
+// changeTypesForEntry resolves the change type for an entry declared within a PR body, which may refer to either the
+// change type name or one of the labels that map to the change type.
+func changeTypesForEntry(config Config, entry prChangelogEntry) []change.Type {
+	if ty := config.ChangeTypesByLabel.ChangeTypes(entry.Type); len(ty) > 0 {
+		return ty
+	}
+	for _, ty := range config.ChangeTypesByLabel {
+		if strings.EqualFold(ty.Name, entry.Type) {
+			return []change.Type{ty}
+		}
+	}
+	log.Tracef("unable to resolve change type %q for changelog entry %q", entry.Type, entry.Text)
+	return change.UnknownTypes
+}
+
+// parseReleaseNote returns the user-facing release note declared within the given PR body (if any), either as a fenced
+// block with the given info string (e.g. "```release-note") or as the section under the given markdown heading
+// (e.g. "## Release Notes"). The note is collapsed into a single line.
+func parseReleaseNote(body, blockName, heading string) string {
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+
+	if blockName != "" {
+		for _, match := range releaseNoteBlockPattern.FindAllStringSubmatch(body, -1) {
+			if !strings.EqualFold(strings.TrimSpace(match[1]), blockName) {
+				continue
+			}
+			if note := collapseWhitespace(match[2]); note != "" {
+				return note
+			}
+		}
+	}
+
+	if heading != "" {
+		if note := collapseWhitespace(headingSection(body, heading)); note != "" {
+			return note
+		}
+	}
+
+	return ""
+}
+
+// headingSection returns the content under the given markdown heading, up until the next heading of the same or a
+// higher level.
+func headingSection(body, heading string) string {
+	var lines []string
+	level := 0
+	for _, line := range strings.Split(body, "\n") {
+		match := headingPattern.FindStringSubmatch(strings.TrimSpace(line))
+		if level == 0 {
+			if match != nil && strings.EqualFold(match[2], heading) {
+				level = len(match[1])
+			}
+			continue
+		}
+		if match != nil && len(match[1]) <= level {
+			break
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func collapseWhitespace(s string) string {
+	s = htmlCommentPattern.ReplaceAllString(s, "")
+	return strings.Join(strings.Fields(s), " ")
+}
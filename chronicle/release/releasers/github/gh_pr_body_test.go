@@ -0,0 +1,245 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func Test_parseChangelogEntries(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []prChangelogEntry
+	}{
+		{
+			name: "no body",
+		},
+		{
+			name: "no fenced block",
+			body: "this PR does things\n\nchangelog:\n  - type: bug\n    text: nope",
+		},
+		{
+			name: "fenced block without changelog key",
+			body: "```yaml\nsomething: else\n```",
+		},
+		{
+			name: "multiple entries",
+			body: "This PR does a lot.\n\n```yaml\nchangelog:\n  - type: added-feature\n    text: add the thing\n  - type: bug\n    text: \"fix the other thing\"\n```\n\ntrailing text",
+			want: []prChangelogEntry{
+				{Type: "added-feature", Text: "add the thing"},
+				{Type: "bug", Text: "fix the other thing"},
+			},
+		},
+		{
+			name: "skip non-matching blocks and empty entries",
+			body: "```go\nfunc main() {}\n```\n\n```\nchangelog:\n  - type: bug\n  - type: bug\n    text: fix it\n```",
+			want: []prChangelogEntry{
+				{Type: "bug", Text: "fix it"},
+			},
+		},
+		{
+			name: "CRLF and indented fences",
+			body: "body\r\n  ```changelog\r\n  changelog:\r\n    - type: bug\r\n      text: fix it\r\n  ```\r\n",
+			want: []prChangelogEntry{
+				{Type: "bug", Text: "fix it"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseChangelogEntries(tt.body))
+		})
+	}
+}
+
+func Test_createChangesFromPRs_splitsEntries(t *testing.T) {
+	bug := change.NewType("bug-fix", change.SemVerPatch)
+	feature := change.NewType("added-feature", change.SemVerMinor)
+
+	config := Config{
+		Host: "github.com",
+		ChangeTypesByLabel: change.TypeSet{
+			"bug":         bug,
+			"enhancement": feature,
+		},
+	}
+
+	pr := ghPullRequest{
+		Title:  "a very large PR",
+		Body:   "```yaml\nchangelog:\n  - type: added-feature\n    text: add the thing\n  - type: bug\n    text: fix the other thing\n  - type: nope\n    text: something else\n```",
+		Number: 1,
+		Author: "someone",
+		URL:    "some-url",
+		Labels: []string{"enhancement"},
+	}
+
+	changes := createChangesFromPRs(config, []ghPullRequest{pr})
+
+	var texts []string
+	var types [][]change.Type
+	for _, c := range changes {
+		texts = append(texts, c.Text)
+		types = append(types, c.ChangeTypes)
+		assert.Equal(t, "PR #1", c.References[0].Text)
+	}
+
+	assert.Equal(t, []string{"add the thing", "fix the other thing", "something else"}, texts)
+	assert.Equal(t, [][]change.Type{{feature}, {bug}, change.UnknownTypes}, types)
+}
+
+func Test_createChangesFromPRs_splitEntriesAreIndependent(t *testing.T) {
+	config := Config{
+		Host:               "github.com",
+		ChangeTypesByLabel: change.TypeSet{"bug": change.NewType("bug-fix", change.SemVerPatch)},
+	}
+
+	pr := ghPullRequest{
+		Title:  "a very large PR",
+		Body:   "```yaml\nchangelog:\n  - type: bug\n    text: fix the thing\n  - type: bug\n    text: fix the other thing\n```",
+		Number: 1,
+		Author: "someone",
+		URL:    "some-url",
+	}
+
+	changes := createChangesFromPRs(config, []ghPullRequest{pr})
+	require.Len(t, changes, 2)
+
+	changes[0].References[0].Text = "modified"
+	changes[0].References = append(changes[0].References, change.Reference{Text: "appended"})
+	changes[0].Authors[0].Text = "modified"
+
+	want := []change.Reference{
+		{Text: "PR #1", URL: "some-url"},
+		{Text: "someone", URL: "https://github.com/someone"},
+	}
+	assert.Equal(t, want, changes[1].References)
+	assert.Equal(t, []change.Reference{{Text: "someone", URL: "https://github.com/someone"}}, changes[1].Authors)
+}
+
+func Test_parseReleaseNote(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		block   string
+		heading string
+		want    string
+	}{
+		{
+			name:  "no release note",
+			body:  "this PR does a thing",
+			block: "release-note",
+			want:  "",
+		},
+		{
+			name:  "fenced block",
+			body:  "some details\n\n```release-note\nAdd support for\nthe thing\n```\n",
+			block: "release-note",
+			want:  "Add support for the thing",
+		},
+		{
+			name:  "other fenced blocks are ignored",
+			body:  "```go\nfmt.Println()\n```\n```release-note\nfix the thing\n```",
+			block: "release-note",
+			want:  "fix the thing",
+		},
+		{
+			name:  "block disabled",
+			body:  "```release-note\nfix the thing\n```",
+			block: "",
+			want:  "",
+		},
+		{
+			name:  "empty block",
+			body:  "```release-note\n\n```",
+			block: "release-note",
+			want:  "",
+		},
+		{
+			name:    "heading section",
+			body:    "## Summary\nsome details\n\n## Release Notes\n<!-- describe the change for users -->\nFix the thing\n\n## Checklist\n- [x] tests",
+			heading: "Release Notes",
+			want:    "Fix the thing",
+		},
+		{
+			name:    "heading section includes sub-headings",
+			body:    "# Release notes\nFix the thing\n### details\nfor real\n# Other\nnope",
+			heading: "release notes",
+			want:    "Fix the thing ### details for real",
+		},
+		{
+			name:    "heading section with only a template comment",
+			body:    "## Release Notes\n<!-- describe the change for users -->\n## Checklist",
+			heading: "Release Notes",
+			want:    "",
+		},
+		{
+			name:    "fenced block preferred over heading",
+			body:    "## Release Notes\nfrom heading\n```release-note\nfrom block\n```",
+			block:   "release-note",
+			heading: "Release Notes",
+			want:    "from block",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseReleaseNote(tt.body, tt.block, tt.heading))
+		})
+	}
+}
+
+func Test_createChangesFromPRs_releaseNote(t *testing.T) {
+	config := Config{
+		Host:             "github.com",
+		ReleaseNoteBlock: "release-note",
+	}
+
+	prs := []ghPullRequest{
+		{
+			Title:  "refactor the thing internals",
+			Body:   "```release-note\nThe thing is now twice as fast\n```",
+			Number: 1,
+		},
+		{
+			Title:  "fix bug",
+			Number: 2,
+		},
+	}
+
+	changes := createChangesFromPRs(config, prs)
+
+	var texts []string
+	for _, c := range changes {
+		texts = append(texts, c.Text)
+	}
+
+	assert.Equal(t, []string{"The thing is now twice as fast", "fix bug"}, texts)
+}
+
+func Test_createChangesFromIssues_releaseNote(t *testing.T) {
+	config := Config{
+		Host:             "github.com",
+		ReleaseNoteBlock: "release-note",
+	}
+
+	issue := ghIssue{
+		Title:  "thing is slow",
+		Number: 1,
+	}
+
+	pr := ghPullRequest{
+		Title:        "refactor the thing internals",
+		Body:         "```release-note\nThe thing is now twice as fast\n```",
+		Number:       2,
+		LinkedIssues: []ghIssue{issue},
+	}
+
+	changes := createChangesFromIssues(config, []ghPullRequest{pr}, []ghIssue{issue})
+
+	if assert.Len(t, changes, 1) {
+		assert.Equal(t, "The thing is now twice as fast", changes[0].Text)
+	}
+}
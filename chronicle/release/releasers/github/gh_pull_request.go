@@ -2,12 +2,12 @@ package github
 
 import (
 	"context"
-	"os"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/scylladb/go-set/strset"
 	"github.com/shurcooL/githubv4"
-	"golang.org/x/oauth2"
 
 	"github.com/anchore/chronicle/internal"
 	"github.com/anchore/chronicle/internal/git"
@@ -16,13 +16,17 @@ import (
 
 type ghPullRequest struct {
 	Title        string
+	Body         string
 	Number       int
 	Author       string
+	AuthorIsBot  bool // the author is a bot (e.g. a GitHub App such as dependabot)
 	MergedAt     time.Time
 	Labels       []string
 	URL          string
 	LinkedIssues []ghIssue
 	MergeCommit  string
+	Milestone    string // the title of the milestone the PR is in (if any)
+	BaseBranch   string // the branch the PR was merged into (e.g. "main")
 }
 
 type prFilter func(issue ghPullRequest) bool
@@ -72,7 +76,7 @@ func prsAtOrAfter(since time.Time) prFilter {
 	return func(pr ghPullRequest) bool {
 		keep := pr.MergedAt.After(since) || pr.MergedAt.Equal(since)
 		if !keep {
-			log.Tracef("PR #%d filtered out: merged at or before %s (merged %s)", pr.Number, internal.FormatDateTime(since), internal.FormatDateTime(pr.MergedAt))
+			traceExcluded(prSubject(pr.Number), "merged at or before %s (merged %s)", internal.FormatDateTime(since), internal.FormatDateTime(pr.MergedAt))
 		}
 		return keep
 	}
@@ -82,7 +86,7 @@ func prsAtOrBefore(since time.Time) prFilter {
 	return func(pr ghPullRequest) bool {
 		keep := pr.MergedAt.Before(since) || pr.MergedAt.Equal(since)
 		if !keep {
-			log.Tracef("PR #%d filtered out: merged at or after %s (merged %s)", pr.Number, internal.FormatDateTime(since), internal.FormatDateTime(pr.MergedAt))
+			traceExcluded(prSubject(pr.Number), "merged at or after %s (merged %s)", internal.FormatDateTime(since), internal.FormatDateTime(pr.MergedAt))
 		}
 		return keep
 	}
@@ -92,7 +96,7 @@ func prsAfter(since time.Time) prFilter {
 	return func(pr ghPullRequest) bool {
 		keep := pr.MergedAt.After(since)
 		if !keep {
-			log.Tracef("PR #%d filtered out: merged before %s (merged %s)", pr.Number, internal.FormatDateTime(since), internal.FormatDateTime(pr.MergedAt))
+			traceExcluded(prSubject(pr.Number), "merged before %s (merged %s)", internal.FormatDateTime(since), internal.FormatDateTime(pr.MergedAt))
 		}
 		return keep
 	}
@@ -103,7 +107,7 @@ func prsBefore(since time.Time) prFilter {
 	return func(pr ghPullRequest) bool {
 		keep := pr.MergedAt.Before(since)
 		if !keep {
-			log.Tracef("PR #%d filtered out: merged after %s (merged %s)", pr.Number, internal.FormatDateTime(since), internal.FormatDateTime(pr.MergedAt))
+			traceExcluded(prSubject(pr.Number), "merged after %s (merged %s)", internal.FormatDateTime(since), internal.FormatDateTime(pr.MergedAt))
 		}
 		return keep
 	}
@@ -113,7 +117,7 @@ func prsWithoutClosedLinkedIssue() prFilter {
 	return func(pr ghPullRequest) bool {
 		for _, i := range pr.LinkedIssues {
 			if i.Closed {
-				log.Tracef("PR #%d filtered out: has closed linked issue", pr.Number)
+				traceExcluded(prSubject(pr.Number), "has closed linked issue")
 				return false
 			}
 		}
@@ -128,7 +132,7 @@ func prsWithClosedLinkedIssue() prFilter {
 				return true
 			}
 		}
-		log.Tracef("PR #%d filtered out: does not have a closed linked issue", pr.Number)
+		traceExcluded(prSubject(pr.Number), "does not have a closed linked issue")
 		return false
 	}
 }
@@ -137,7 +141,7 @@ func prsWithoutOpenLinkedIssue() prFilter {
 	return func(pr ghPullRequest) bool {
 		for _, i := range pr.LinkedIssues {
 			if !i.Closed {
-				log.Tracef("PR #%d filtered out: has linked issue that is still open: issue %d", pr.Number, i.Number)
+				traceExcluded(prSubject(pr.Number), "has linked issue that is still open: issue %d", i.Number)
 
 				return false
 			}
@@ -155,7 +159,7 @@ func prsWithLabel(labels ...string) prFilter {
 				}
 			}
 		}
-		log.Tracef("PR #%d filtered out: missing required label", pr.Number)
+		traceExcluded(prSubject(pr.Number), "missing required label")
 
 		return false
 	}
@@ -165,7 +169,7 @@ func prsWithoutLabels() prFilter {
 	return func(pr ghPullRequest) bool {
 		keep := len(pr.Labels) == 0
 		if !keep {
-			log.Tracef("PR #%d filtered out: has labels", pr.Number)
+			traceExcluded(prSubject(pr.Number), "has labels")
 		}
 		return keep
 	}
@@ -175,7 +179,7 @@ func prsWithoutLinkedIssues() prFilter {
 	return func(pr ghPullRequest) bool {
 		keep := len(pr.LinkedIssues) == 0
 		if !keep {
-			log.Tracef("PR #%d filtered out: has linked issues", pr.Number)
+			traceExcluded(prSubject(pr.Number), "has linked issues")
 		}
 		return keep
 	}
@@ -187,18 +191,20 @@ func prsWithChangeTypes(config Config) prFilter {
 
 		keep := len(changeTypes) > 0
 		if !keep {
-			log.Tracef("PR #%d filtered out: no change types", pr.Number)
+			traceExcluded(prSubject(pr.Number), "no change types")
 		}
 		return keep
 	}
 }
 
+// prsWithoutLabel excludes PRs with any of the given labels, regardless of any other labels (labels are matched
+// ignoring case, as github does).
 func prsWithoutLabel(labels ...string) prFilter {
 	return func(pr ghPullRequest) bool {
 		for _, targetLabel := range labels {
 			for _, l := range pr.Labels {
-				if l == targetLabel {
-					log.Tracef("PR #%d filtered out: has label %q", pr.Number, l)
+				if strings.EqualFold(l, targetLabel) {
+					traceExcluded(prSubject(pr.Number), "has label %q", l)
 					return false
 				}
 			}
@@ -208,11 +214,33 @@ func prsWithoutLabel(labels ...string) prFilter {
 	}
 }
 
+// ValidateBranchPattern ensures the given branch pattern is a valid glob (see path.Match).
+func ValidateBranchPattern(pattern string) error {
+	_, err := path.Match(pattern, "")
+	return err
+}
+
+// prsMergedInto keeps the PRs merged into the branch, which is either the exact branch name or a glob (e.g.
+// "release/*"), where the glob does not match across a "/".
+func prsMergedInto(pattern string) prFilter {
+	return func(pr ghPullRequest) bool {
+		keep := pr.BaseBranch == pattern
+		if !keep {
+			matched, err := path.Match(pattern, pr.BaseBranch)
+			keep = err == nil && matched
+		}
+		if !keep {
+			traceExcluded(prSubject(pr.Number), "not merged into %q (merged into %q)", pattern, pr.BaseBranch)
+		}
+		return keep
+	}
+}
+
 func prsWithoutMergeCommit(commits ...string) prFilter {
 	commitSet := strset.New(commits...)
 	return func(pr ghPullRequest) bool {
 		if !commitSet.Has(pr.MergeCommit) {
-			log.Tracef("PR #%d filtered out: has merge commit outside of valid set %s", pr.Number, pr.MergeCommit)
+			traceExcluded(prSubject(pr.Number), "has merge commit outside of valid set %s", pr.MergeCommit)
 			return false
 		}
 
@@ -226,29 +254,24 @@ func keepPRsWithCommits(prs []ghPullRequest, commits []string, filters ...prFilt
 	commitSet := strset.New(commits...)
 	for _, pr := range prs {
 		if commitSet.Has(pr.MergeCommit) {
-			log.Tracef("PR #%d included: has selected commit %s", pr.Number, pr.MergeCommit)
+			traceIncluded(prSubject(pr.Number), "has selected commit %s", pr.MergeCommit)
 			keep, _ := filterPRs([]ghPullRequest{pr}, filters...)
 			results = append(results, keep...)
 		} else {
-			log.Tracef("PR #%d filtered out: does not have merge commit %s", pr.Number, pr.MergeCommit)
+			traceExcluded(prSubject(pr.Number), "does not have merge commit %s", pr.MergeCommit)
 		}
 	}
 
 	return results
 }
 
+// fetchMergedPRs returns all merged PRs, most recently updated first. When a since time is given, PRs that have not
+// been updated since then are not fetched (they could not have been merged since then).
 // nolint:funlen
-func fetchMergedPRs(user, repo string) ([]ghPullRequest, error) {
-	src := oauth2.StaticTokenSource(
-		// TODO: DI this
-		&oauth2.Token{AccessToken: os.Getenv("GITHUB_TOKEN")},
-	)
-	httpClient := oauth2.NewClient(context.Background(), src)
-	client := githubv4.NewClient(httpClient)
+func fetchMergedPRs(client *githubv4.Client, user, repo string, since *time.Time, paging pagination) ([]ghPullRequest, error) {
 	var allPRs []ghPullRequest
 
 	{
-		// TODO: act on hitting a rate limit
 		type rateLimit struct {
 			Cost      githubv4.Int
 			Limit     githubv4.Int
@@ -268,16 +291,23 @@ func fetchMergedPRs(user, repo string) ([]ghPullRequest, error) {
 					Edges []struct {
 						Node struct {
 							Title  githubv4.String
+							Body   githubv4.String
 							Number githubv4.Int
 							URL    githubv4.String
 							Author struct {
-								Login githubv4.String
+								Login    githubv4.String
+								Typename githubv4.String `graphql:"__typename"`
 							}
 							MergeCommit struct {
 								OID githubv4.String
 							}
-							MergedAt githubv4.DateTime
-							Labels   struct {
+							BaseRefName githubv4.String
+							MergedAt    githubv4.DateTime
+							UpdatedAt   githubv4.DateTime
+							Milestone   struct {
+								Title githubv4.String
+							}
+							Labels struct {
 								Edges []struct {
 									Node struct {
 										Name githubv4.String
@@ -290,11 +320,20 @@ func fetchMergedPRs(user, repo string) ([]ghPullRequest, error) {
 									Number githubv4.Int
 									URL    githubv4.String
 									Author struct {
-										Login githubv4.String
+										Login    githubv4.String
+										Typename githubv4.String `graphql:"__typename"`
+									}
+									ClosedAt  githubv4.DateTime
+									Closed    githubv4.Boolean
+									Milestone struct {
+										Title githubv4.String
 									}
-									ClosedAt githubv4.DateTime
-									Closed   githubv4.Boolean
-									Labels   struct {
+									Assignees struct {
+										Nodes []struct {
+											Login githubv4.String
+										}
+									} `graphql:"assignees(first:10)"`
+									Labels struct {
 										Edges []struct {
 											Node struct {
 												Name githubv4.String
@@ -305,7 +344,7 @@ func fetchMergedPRs(user, repo string) ([]ghPullRequest, error) {
 							} `graphql:"closingIssuesReferences(last:10)"`
 						}
 					}
-				} `graphql:"pullRequests(first:100, states:MERGED, after:$prCursor)"`
+				} `graphql:"pullRequests(first:$pageSize, states:MERGED, after:$prCursor, orderBy:{field:UPDATED_AT, direction:DESC})"`
 			} `graphql:"repository(owner:$repositoryOwner, name:$repositoryName)"`
 
 			RateLimit rateLimit
@@ -314,17 +353,24 @@ func fetchMergedPRs(user, repo string) ([]ghPullRequest, error) {
 			"repositoryOwner": githubv4.String(user),
 			"repositoryName":  githubv4.String(repo),
 			"prCursor":        (*githubv4.String)(nil), // Null after argument to get first page.
+			"pageSize":        paging.size(),
 		}
 
 		// var limit rateLimit
-		for {
+		for pages := 1; ; pages++ {
 			err := client.Query(context.Background(), &query, variables)
 			if err != nil {
 				return nil, err
 			}
 			// limit = query.RateLimit
 
+			var reachedBoundary bool
 			for _, prEdge := range query.Repository.PullRequests.Edges {
+				if updatedBefore(prEdge.Node.UpdatedAt.Time, since) {
+					reachedBoundary = true
+					break
+				}
+
 				var labels []string
 				for _, lEdge := range prEdge.Node.Labels.Edges {
 					labels = append(labels, string(lEdge.Node.Name))
@@ -332,30 +378,41 @@ func fetchMergedPRs(user, repo string) ([]ghPullRequest, error) {
 
 				var linkedIssues []ghIssue
 				for _, iNodes := range prEdge.Node.ClosingIssuesReferences.Nodes {
+					var assignees []string
+					for _, aNode := range iNodes.Assignees.Nodes {
+						assignees = append(assignees, string(aNode.Login))
+					}
 					linkedIssues = append(linkedIssues, ghIssue{
-						Title:    string(iNodes.Title),
-						Author:   string(iNodes.Author.Login),
-						ClosedAt: iNodes.ClosedAt.Time,
-						Closed:   bool(iNodes.Closed),
-						Labels:   labels,
-						URL:      string(iNodes.URL),
-						Number:   int(iNodes.Number),
+						Title:       string(iNodes.Title),
+						Author:      string(iNodes.Author.Login),
+						AuthorIsBot: isBotActor(iNodes.Author.Typename),
+						ClosedAt:    iNodes.ClosedAt.Time,
+						Closed:      bool(iNodes.Closed),
+						Labels:      labels,
+						URL:         string(iNodes.URL),
+						Number:      int(iNodes.Number),
+						Milestone:   string(iNodes.Milestone.Title),
+						Assignees:   assignees,
 					})
 				}
 
 				allPRs = append(allPRs, ghPullRequest{
 					Title:        string(prEdge.Node.Title),
+					Body:         string(prEdge.Node.Body),
 					Author:       string(prEdge.Node.Author.Login),
+					AuthorIsBot:  isBotActor(prEdge.Node.Author.Typename),
 					MergedAt:     prEdge.Node.MergedAt.Time,
 					Labels:       labels,
 					URL:          string(prEdge.Node.URL),
 					Number:       int(prEdge.Node.Number),
 					LinkedIssues: linkedIssues,
 					MergeCommit:  string(prEdge.Node.MergeCommit.OID),
+					Milestone:    string(prEdge.Node.Milestone.Title),
+					BaseBranch:   string(prEdge.Node.BaseRefName),
 				})
 			}
 
-			if !query.Repository.PullRequests.PageInfo.HasNextPage {
+			if reachedBoundary || !query.Repository.PullRequests.PageInfo.HasNextPage || paging.exhausted(pages, "merged PRs") {
 				break
 			}
 			variables["prCursor"] = githubv4.NewString(query.Repository.PullRequests.PageInfo.EndCursor)
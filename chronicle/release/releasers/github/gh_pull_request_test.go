@@ -233,6 +233,16 @@ func Test_prsWithoutLabel(t *testing.T) {
 			},
 			keep: true,
 		},
+		{
+			name: "matches on label ignoring case",
+			labels: []string{
+				"changelog/ignore",
+			},
+			pr: ghPullRequest{
+				Labels: []string{"bug", "Changelog/Ignore"},
+			},
+			keep: false,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -370,6 +380,51 @@ func Test_prsWithoutMergeCommit(t *testing.T) {
 	}
 }
 
+func Test_prsMergedInto(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		branch   string
+		expected bool
+	}{
+		{
+			name:     "same branch",
+			pattern:  "main",
+			branch:   "main",
+			expected: true,
+		},
+		{
+			name:     "other branch",
+			pattern:  "main",
+			branch:   "feature/thing",
+			expected: false,
+		},
+		{
+			name:     "glob",
+			pattern:  "release/*",
+			branch:   "release/1.x",
+			expected: true,
+		},
+		{
+			name:     "glob does not match across directories",
+			pattern:  "release/*",
+			branch:   "release/1.x/hotfix",
+			expected: false,
+		},
+		{
+			name:     "literal brackets",
+			pattern:  "release[1]",
+			branch:   "release[1]",
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, prsMergedInto(tt.pattern)(ghPullRequest{BaseBranch: tt.branch}))
+		})
+	}
+}
+
 func Test_prsWithChangeTypes(t *testing.T) {
 	tests := []struct {
 		name     string
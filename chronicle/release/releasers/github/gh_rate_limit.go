@@ -0,0 +1,251 @@
+package github
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+const (
+	// maxTransientRetries is the number of times a request is retried after a server error (unrelated to rate limits)
+	maxTransientRetries = 4
+	// secondaryRateLimitWait is the wait when a secondary rate limit does not indicate when to retry (per the github
+	// docs, at least one minute)
+	secondaryRateLimitWait = time.Minute
+)
+
+// rateLimitTransport retries requests that were rejected by a github primary or secondary rate limit (waiting until
+// the limit resets) or that failed with a transient server error (with exponential backoff and jitter). The total time
+// spent waiting is capped, after which the request fails with an error describing the rate limit.
+//
+// The transport is safe for concurrent use, and the limit is shared by all requests made through it: while one request
+// is waiting for a rate limit to reset, new requests wait as well (instead of being rejected), and requests limited by
+// the same reset wait alongside each other without adding to the total wait.
+type rateLimitTransport struct {
+	base         http.RoundTripper
+	maxWait      time.Duration
+	lock         sync.Mutex
+	waited       time.Duration // the total time spent waiting across all requests
+	blockedUntil time.Time     // when the latest rate limit resets
+	blocked      int           // the number of requests currently waiting for a rate limit to reset
+	now          func() time.Time
+	sleep        func(time.Duration)
+	jitter       func(time.Duration) time.Duration
+}
+
+func newRateLimitTransport(base http.RoundTripper, maxWait time.Duration) *rateLimitTransport {
+	return &rateLimitTransport{
+		base:    base,
+		maxWait: maxWait,
+		now:     time.Now,
+		sleep:   time.Sleep,
+		jitter: func(d time.Duration) time.Duration {
+			// between 50% and 150% of the given duration
+			// nolint:gosec // jitter does not need a cryptographically secure source
+			return d/2 + time.Duration(rand.Int63n(int64(d)+1))
+		},
+	}
+}
+
+type rateLimit struct {
+	wait    time.Duration
+	reason  string
+	resetAt time.Time
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.awaitReset(req)
+
+	var transientRetries int
+	for {
+		attemptReq, err := rewind(req)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		if err != nil {
+			return nil, err
+		}
+
+		limit, err := t.checkResponse(resp, transientRetries)
+		if err != nil || limit == nil {
+			return resp, err
+		}
+
+		if limit.resetAt.IsZero() {
+			transientRetries++
+		}
+
+		resp.Body.Close()
+
+		if err := t.reserveWait(*limit); err != nil {
+			return nil, err
+		}
+
+		log.WithFields("wait", limit.wait.Round(time.Second), "reason", limit.reason).Warn("github API request limited, retrying")
+
+		t.sleep(limit.wait)
+		t.releaseWait(*limit)
+
+		if req.Context().Err() != nil {
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+// awaitReset waits (before the request is sent) while other requests are waiting for a rate limit to reset, since the
+// request would otherwise be limited as well.
+func (t *rateLimitTransport) awaitReset(req *http.Request) {
+	t.lock.Lock()
+	var wait time.Duration
+	if t.blocked > 0 {
+		wait = t.blockedUntil.Sub(t.now())
+	}
+	t.lock.Unlock()
+
+	if wait > 0 {
+		log.WithFields("url", req.URL.String(), "wait", wait.Round(time.Second)).Debug("waiting for the github API rate limit to reset")
+		t.sleep(wait)
+	}
+}
+
+// reserveWait accounts for the given wait, returning an error if the total wait would exceed the allowed maximum. A
+// wait for a reset that another request is already waiting for does not add to the total.
+func (t *rateLimitTransport) reserveWait(limit rateLimit) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if limit.resetAt.IsZero() || t.blocked == 0 || limit.resetAt.After(t.blockedUntil) {
+		if t.waited+limit.wait > t.maxWait {
+			return t.exceededError(limit)
+		}
+		t.waited += limit.wait
+	}
+
+	if !limit.resetAt.IsZero() {
+		if limit.resetAt.After(t.blockedUntil) {
+			t.blockedUntil = limit.resetAt
+		}
+		t.blocked++
+	}
+	return nil
+}
+
+// releaseWait accounts for the end of a wait reserved with reserveWait.
+func (t *rateLimitTransport) releaseWait(limit rateLimit) {
+	if limit.resetAt.IsZero() {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.blocked--
+}
+
+// rewind returns the request to send for the next attempt, with a fresh body when the request has one.
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.GetBody == nil {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("unable to rewind request body: %w", err)
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = body
+	return clone, nil
+}
+
+// checkResponse returns how long to wait before retrying the request, or nil if the response should be returned as-is.
+func (t *rateLimitTransport) checkResponse(resp *http.Response, transientRetries int) (*rateLimit, error) {
+	switch {
+	case resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests:
+		body, err := peekBody(resp)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusForbidden && resp.Header.Get("X-RateLimit-Remaining") != "0" &&
+			resp.Header.Get("Retry-After") == "" && !strings.Contains(strings.ToLower(body), "rate limit") {
+			// a regular permission error
+			return nil, nil
+		}
+		return t.limitFromHeaders(resp, body), nil
+
+	case resp.StatusCode == http.StatusOK && resp.Header.Get("X-RateLimit-Remaining") == "0":
+		// the GraphQL API reports exceeding the primary rate limit as an error within a successful response
+		body, err := peekBody(resp)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.Contains(body, "RATE_LIMITED") {
+			return nil, nil
+		}
+		return t.limitFromHeaders(resp, body), nil
+
+	case resp.StatusCode == http.StatusBadGateway || resp.StatusCode == http.StatusServiceUnavailable || resp.StatusCode == http.StatusGatewayTimeout:
+		if transientRetries >= maxTransientRetries {
+			return nil, nil
+		}
+		return &rateLimit{
+			wait:   t.jitter(time.Second << transientRetries),
+			reason: fmt.Sprintf("server error (%s)", resp.Status),
+		}, nil
+	}
+	return nil, nil
+}
+
+// limitFromHeaders determines when the rate limit resets from the Retry-After or X-RateLimit-Reset headers.
+func (t *rateLimitTransport) limitFromHeaders(resp *http.Response, body string) *rateLimit {
+	now := t.now()
+
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		wait := time.Duration(seconds) * time.Second
+		return &rateLimit{wait: wait, reason: "secondary rate limit", resetAt: now.Add(wait)}
+	}
+
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		if epoch, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			resetAt := time.Unix(epoch, 0)
+			// allow for clock drift between this host and github
+			wait := resetAt.Sub(now) + time.Second
+			if wait < time.Second {
+				wait = time.Second
+			}
+			return &rateLimit{wait: wait, reason: "primary rate limit", resetAt: resetAt}
+		}
+	}
+
+	reason := "rate limit"
+	if strings.Contains(strings.ToLower(body), "secondary rate limit") {
+		reason = "secondary rate limit"
+	}
+	return &rateLimit{wait: secondaryRateLimitWait, reason: reason, resetAt: now.Add(secondaryRateLimitWait)}
+}
+
+func (t *rateLimitTransport) exceededError(limit rateLimit) error {
+	if limit.resetAt.IsZero() {
+		return fmt.Errorf("github API request failed with a %s after retrying for %s", limit.reason, t.waited.Round(time.Second))
+	}
+	return fmt.Errorf("github API %s exceeded (resets at %s, in %s), which is beyond the remaining allowed wait of %s: "+
+		"increase github.max-rate-limit-wait, retry later, or authenticate as a GitHub App for a higher rate limit",
+		limit.reason, limit.resetAt.Format(time.RFC3339), limit.wait.Round(time.Second), (t.maxWait - t.waited).Round(time.Second))
+}
+
+// peekBody reads the response body while leaving it available to be read again.
+func peekBody(resp *http.Response) (string, error) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return "", fmt.Errorf("unable to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return string(body), nil
+}
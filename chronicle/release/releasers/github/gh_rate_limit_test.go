@@ -0,0 +1,268 @@
+package github
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type limitedResponse struct {
+	status  int
+	headers map[string]string
+	body    string
+}
+
+func newLimitedServer(t *testing.T, responses []limitedResponse) (*httptest.Server, *[]string) {
+	t.Helper()
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqBody, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(reqBody))
+
+		idx := len(bodies) - 1
+		if idx >= len(responses) {
+			idx = len(responses) - 1
+		}
+		resp := responses[idx]
+		for k, v := range resp.headers {
+			w.Header().Set(k, v)
+		}
+		w.WriteHeader(resp.status)
+		fmt.Fprint(w, resp.body)
+	}))
+	t.Cleanup(server.Close)
+	return server, &bodies
+}
+
+func newTestRateLimitTransport(maxWait time.Duration, now time.Time) (*rateLimitTransport, *[]time.Duration) {
+	var waits []time.Duration
+	transport := newRateLimitTransport(http.DefaultTransport, maxWait)
+	transport.now = func() time.Time { return now }
+	transport.sleep = func(d time.Duration) { waits = append(waits, d) }
+	transport.jitter = func(d time.Duration) time.Duration { return d }
+	return transport, &waits
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	reset := strconv.FormatInt(now.Add(30*time.Second).Unix(), 10)
+	ok := limitedResponse{status: http.StatusOK, body: `{"data": {}}`}
+
+	tests := []struct {
+		name       string
+		responses  []limitedResponse
+		maxWait    time.Duration
+		wantWaits  []time.Duration
+		wantStatus int
+		wantErr    string
+	}{
+		{
+			name:       "no limits",
+			responses:  []limitedResponse{ok},
+			maxWait:    time.Minute,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "primary rate limit waits until reset",
+			responses: []limitedResponse{
+				{status: http.StatusForbidden, headers: map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": reset}},
+				ok,
+			},
+			maxWait:    time.Minute,
+			wantWaits:  []time.Duration{31 * time.Second},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "graphql primary rate limit",
+			responses: []limitedResponse{
+				{status: http.StatusOK, headers: map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": reset}, body: `{"errors": [{"type": "RATE_LIMITED"}]}`},
+				ok,
+			},
+			maxWait:    time.Minute,
+			wantWaits:  []time.Duration{31 * time.Second},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "secondary rate limit with retry-after",
+			responses: []limitedResponse{
+				{status: http.StatusForbidden, headers: map[string]string{"Retry-After": "5"}},
+				{status: http.StatusTooManyRequests, headers: map[string]string{"Retry-After": "10"}},
+				ok,
+			},
+			maxWait:    time.Minute,
+			wantWaits:  []time.Duration{5 * time.Second, 10 * time.Second},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "secondary rate limit without headers",
+			responses: []limitedResponse{
+				{status: http.StatusForbidden, body: `{"message": "You have exceeded a secondary rate limit"}`},
+				ok,
+			},
+			maxWait:    time.Hour,
+			wantWaits:  []time.Duration{time.Minute},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "permission errors are not retried",
+			responses: []limitedResponse{
+				{status: http.StatusForbidden, headers: map[string]string{"X-RateLimit-Remaining": "4000"}, body: `{"message": "Resource not accessible by integration"}`},
+			},
+			maxWait:    time.Hour,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "server errors are retried with backoff",
+			responses: []limitedResponse{
+				{status: http.StatusBadGateway},
+				{status: http.StatusServiceUnavailable},
+				ok,
+			},
+			maxWait:    time.Minute,
+			wantWaits:  []time.Duration{time.Second, 2 * time.Second},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "server errors eventually returned",
+			responses: []limitedResponse{
+				{status: http.StatusBadGateway},
+			},
+			maxWait:    time.Hour,
+			wantWaits:  []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second},
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			name: "wait beyond the max",
+			responses: []limitedResponse{
+				{status: http.StatusForbidden, headers: map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": reset}},
+			},
+			maxWait: 10 * time.Second,
+			wantErr: "github API primary rate limit exceeded (resets at",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, bodies := newLimitedServer(t, tt.responses)
+			transport, waits := newTestRateLimitTransport(tt.maxWait, now)
+			client := &http.Client{Transport: transport}
+
+			resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"query": "{}"}`))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Contains(t, err.Error(), "github.max-rate-limit-wait")
+				return
+			}
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Equal(t, tt.wantWaits, *waits)
+
+			// the request body is sent in full for every attempt
+			for _, b := range *bodies {
+				assert.Equal(t, `{"query": "{}"}`, b)
+			}
+
+			// the final response body is still readable
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.responses[len(*waits)%len(tt.responses)].body, string(body))
+		})
+	}
+}
+
+func TestRateLimitTransport_totalWait(t *testing.T) {
+	server, _ := newLimitedServer(t, []limitedResponse{
+		{status: http.StatusForbidden, headers: map[string]string{"Retry-After": "20"}},
+	})
+	transport, waits := newTestRateLimitTransport(time.Minute, time.Now())
+	client := &http.Client{Transport: transport}
+
+	// the wait is accounted across all requests, not per request
+	_, err := client.Get(server.URL)
+	require.Error(t, err)
+	assert.Equal(t, []time.Duration{20 * time.Second, 20 * time.Second, 20 * time.Second}, *waits)
+}
+
+func TestRateLimitTransport_concurrentRequests(t *testing.T) {
+	const requests = 3
+
+	var served int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&served, 1) <= requests {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	transport := newRateLimitTransport(http.DefaultTransport, 45*time.Second)
+	now := time.Now()
+	transport.now = func() time.Time { return now }
+
+	// hold every request within its wait until all requests are waiting, so the waits overlap
+	var sleeping int32
+	allSleeping := make(chan struct{})
+	transport.sleep = func(time.Duration) {
+		if atomic.AddInt32(&sleeping, 1) == requests {
+			close(allSleeping)
+		}
+		select {
+		case <-allSleeping:
+		case <-time.After(5 * time.Second):
+		}
+	}
+
+	client := &http.Client{Transport: transport}
+
+	var wg sync.WaitGroup
+	errs := make([]error, requests)
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			errs[i] = err
+		}(i)
+	}
+	wg.Wait()
+
+	// requests limited by the same reset wait together, so only one wait counts towards the max
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 30*time.Second, transport.waited)
+	assert.Equal(t, 0, transport.blocked)
+}
+
+func TestRateLimitTransport_awaitReset(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	transport, waits := newTestRateLimitTransport(time.Minute, now)
+	transport.blockedUntil = now.Add(20 * time.Second)
+
+	req := httptest.NewRequest(http.MethodGet, "https://api.github.com/graphql", nil)
+
+	// a reset that no request is waiting for (any longer) does not delay new requests
+	transport.awaitReset(req)
+	assert.Empty(t, *waits)
+
+	transport.blocked = 1
+	transport.awaitReset(req)
+	assert.Equal(t, []time.Duration{20 * time.Second}, *waits)
+}
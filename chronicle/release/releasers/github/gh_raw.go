@@ -0,0 +1,90 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// rawFetcher fetches the REST API payloads for issues and PRs, which include fields that chronicle does not model.
+type rawFetcher struct {
+	client  *http.Client
+	baseURL string
+	cache   map[string]map[string]interface{}
+}
+
+func newRawFetcher(client *http.Client, apiURL, owner, repo string) *rawFetcher {
+	return &rawFetcher{
+		client:  client,
+		baseURL: fmt.Sprintf("%s/repos/%s/%s", strings.TrimSuffix(apiURL, "/"), owner, repo),
+		cache:   make(map[string]map[string]interface{}),
+	}
+}
+
+// attach sets the raw payload on each github issue and PR change (including clustered changes).
+func (f *rawFetcher) attach(changes []change.Change) error {
+	for i := range changes {
+		var path string
+		switch entry := changes[i].Entry.(type) {
+		case ghPullRequest:
+			path = fmt.Sprintf("pulls/%d", entry.Number)
+		case ghIssue:
+			path = fmt.Sprintf("issues/%d", entry.Number)
+		}
+
+		if path != "" {
+			raw, err := f.fetch(path)
+			if err != nil {
+				return err
+			}
+			changes[i].Raw = raw
+		}
+
+		if err := f.attach(changes[i].Children); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *rawFetcher) fetch(path string) (map[string]interface{}, error) {
+	if raw, ok := f.cache[path]; ok {
+		return raw, nil
+	}
+
+	log.WithFields("path", path).Trace("fetching raw API payload")
+
+	req, err := http.NewRequest(http.MethodGet, f.baseURL+"/"+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d for %q: %s", resp.StatusCode, path, strings.TrimSpace(string(body)))
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("unable to parse payload for %q: %w", path, err)
+	}
+
+	f.cache[path] = raw
+	return raw, nil
+}
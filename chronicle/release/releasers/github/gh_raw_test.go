@@ -0,0 +1,62 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func Test_rawFetcher_attach(t *testing.T) {
+	requests := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/repos/anchore/chronicle/pulls/2":
+			fmt.Fprint(w, `{"number": 2, "draft": false, "auto_merge": null}`)
+		case "/repos/anchore/chronicle/issues/1":
+			fmt.Fprint(w, `{"number": 1, "reactions": {"+1": 3}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	pr := ghPullRequest{Number: 2}
+	changes := []change.Change{
+		{
+			Text:  "issue",
+			Entry: ghIssue{Number: 1},
+			Children: change.Changes{
+				{Text: "pr", Entry: pr},
+			},
+		},
+		{Text: "same pr", Entry: pr},
+		{Text: "not from github", Entry: "something else"},
+	}
+
+	f := newRawFetcher(server.Client(), server.URL+"/", "anchore", "chronicle")
+	require.NoError(t, f.attach(changes))
+
+	assert.Equal(t, map[string]interface{}{"+1": float64(3)}, changes[0].Raw["reactions"])
+	assert.Equal(t, float64(2), changes[0].Children[0].Raw["number"])
+	assert.Equal(t, float64(2), changes[1].Raw["number"])
+	assert.Nil(t, changes[2].Raw)
+
+	// payloads are only fetched once
+	assert.Equal(t, 1, requests["/repos/anchore/chronicle/pulls/2"])
+}
+
+func Test_rawFetcher_attach_error(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	f := newRawFetcher(server.Client(), server.URL, "anchore", "chronicle")
+	err := f.attach([]change.Change{{Entry: ghIssue{Number: 1}}})
+	assert.ErrorContains(t, err, `unexpected status 404 for "issues/1"`)
+}
@@ -0,0 +1,71 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/anchore/chronicle/chronicle/release/feedback"
+)
+
+var _ feedback.Source = (*Summarizer)(nil)
+
+// ReleaseFeedback returns the reactions on the published github release for the given version (or the latest
+// release when no version is given).
+func (s *Summarizer) ReleaseFeedback(version string) (*feedback.Report, error) {
+	if version == "" {
+		latest, err := s.LastRelease()
+		if err != nil {
+			return nil, err
+		}
+		version = latest.Version
+	}
+
+	report, err := fetchReleaseFeedback(s.client, s.userName, s.repoName, version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch reactions for release %q: %w", version, err)
+	}
+	return report, nil
+}
+
+func fetchReleaseFeedback(client *githubv4.Client, user, repo, tag string) (*feedback.Report, error) {
+	var query struct {
+		Repository struct {
+			Release *struct {
+				TagName        githubv4.String
+				URL            githubv4.String
+				ReactionGroups []struct {
+					Content  githubv4.String
+					Reactors struct {
+						TotalCount githubv4.Int
+					}
+				}
+			} `graphql:"release(tagName:$tagName)"`
+		} `graphql:"repository(owner:$repositoryOwner, name:$repositoryName)"`
+	}
+	variables := map[string]interface{}{
+		"repositoryOwner": githubv4.String(user),
+		"repositoryName":  githubv4.String(repo),
+		"tagName":         githubv4.String(tag),
+	}
+
+	if err := client.Query(context.Background(), &query, variables); err != nil {
+		return nil, err
+	}
+
+	rel := query.Repository.Release
+	if rel == nil {
+		return nil, feedback.ErrReleaseNotFound
+	}
+
+	reactions := make(map[string]int)
+	for _, group := range rel.ReactionGroups {
+		if count := int(group.Reactors.TotalCount); count > 0 {
+			reactions[string(group.Content)] = count
+		}
+	}
+
+	report := feedback.NewReport(string(rel.TagName), string(rel.URL), reactions)
+	return &report, nil
+}
@@ -0,0 +1,50 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release/feedback"
+)
+
+func Test_fetchReleaseFeedback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"repository": {"release": {
+			"tagName": "v0.1.0",
+			"url": "https://github.com/anchore/chronicle/releases/tag/v0.1.0",
+			"reactionGroups": [
+				{"content": "THUMBS_UP", "reactors": {"totalCount": 4}},
+				{"content": "THUMBS_DOWN", "reactors": {"totalCount": 1}},
+				{"content": "HEART", "reactors": {"totalCount": 0}}
+			]
+		}}}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	report, err := fetchReleaseFeedback(githubv4.NewEnterpriseClient(server.URL, server.Client()), "anchore", "chronicle", "v0.1.0")
+	require.NoError(t, err)
+	assert.Equal(t, &feedback.Report{
+		Version:   "v0.1.0",
+		URL:       "https://github.com/anchore/chronicle/releases/tag/v0.1.0",
+		Reactions: map[string]int{"THUMBS_UP": 4, "THUMBS_DOWN": 1},
+		Up:        4,
+		Down:      1,
+		Score:     0.8,
+	}, report)
+}
+
+func Test_fetchReleaseFeedback_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"repository": {"release": null}}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	_, err := fetchReleaseFeedback(githubv4.NewEnterpriseClient(server.URL, server.Client()), "anchore", "chronicle", "v9.9.9")
+	assert.ErrorIs(t, err, feedback.ErrReleaseNotFound)
+}
@@ -2,19 +2,19 @@ package github
 
 import (
 	"context"
-	"os"
 	"sort"
 	"time"
 
 	"github.com/shurcooL/githubv4"
-	"golang.org/x/oauth2"
 )
 
 type ghRelease struct {
-	Tag      string
-	Date     time.Time
-	IsLatest bool
-	IsDraft  bool
+	Tag          string
+	Date         time.Time
+	CreatedAt    time.Time
+	IsLatest     bool
+	IsDraft      bool
+	IsPrerelease bool
 }
 
 func latestNonDraftRelease(releases []ghRelease) *ghRelease {
@@ -26,19 +26,23 @@ func latestNonDraftRelease(releases []ghRelease) *ghRelease {
 	return nil
 }
 
+// latestDraftRelease returns the most recently created draft release (drafts have no publish date to order by).
+func latestDraftRelease(releases []ghRelease) *ghRelease {
+	var latest *ghRelease
+	for i := range releases {
+		if releases[i].IsDraft && (latest == nil || releases[i].CreatedAt.After(latest.CreatedAt)) {
+			latest = &releases[i]
+		}
+	}
+	return latest
+}
+
 // nolint:funlen
-func fetchAllReleases(user, repo string) ([]ghRelease, error) {
-	src := oauth2.StaticTokenSource(
-		// TODO: DI this
-		&oauth2.Token{AccessToken: os.Getenv("GITHUB_TOKEN")},
-	)
-	httpClient := oauth2.NewClient(context.Background(), src)
-	client := githubv4.NewClient(httpClient)
+func fetchAllReleases(client *githubv4.Client, user, repo string) ([]ghRelease, error) {
 	var allReleases []ghRelease
 
 	// Query some details about a repository, an ghIssue in it, and its comments.
 	{
-		// TODO: act on hitting a rate limit
 		type rateLimit struct {
 			Cost      githubv4.Int
 			Limit     githubv4.Int
@@ -57,10 +61,12 @@ func fetchAllReleases(user, repo string) ([]ghRelease, error) {
 					}
 					Edges []struct {
 						Node struct {
-							TagName     githubv4.String
-							IsLatest    githubv4.Boolean
-							IsDraft     githubv4.Boolean
-							PublishedAt githubv4.DateTime
+							TagName      githubv4.String
+							IsLatest     githubv4.Boolean
+							IsDraft      githubv4.Boolean
+							IsPrerelease githubv4.Boolean
+							PublishedAt  githubv4.DateTime
+							CreatedAt    githubv4.DateTime
 						}
 					}
 				} `graphql:"releases(first:100, after:$releasesCursor)"`
@@ -84,10 +90,12 @@ func fetchAllReleases(user, repo string) ([]ghRelease, error) {
 
 			for _, iEdge := range query.Repository.Releases.Edges {
 				allReleases = append(allReleases, ghRelease{
-					Tag:      string(iEdge.Node.TagName),
-					IsLatest: bool(iEdge.Node.IsLatest),
-					IsDraft:  bool(iEdge.Node.IsDraft),
-					Date:     iEdge.Node.PublishedAt.Time,
+					Tag:          string(iEdge.Node.TagName),
+					IsLatest:     bool(iEdge.Node.IsLatest),
+					IsDraft:      bool(iEdge.Node.IsDraft),
+					IsPrerelease: bool(iEdge.Node.IsPrerelease),
+					Date:         iEdge.Node.PublishedAt.Time,
+					CreatedAt:    iEdge.Node.CreatedAt.Time,
 				})
 			}
 
@@ -110,15 +118,7 @@ func fetchAllReleases(user, repo string) ([]ghRelease, error) {
 	return allReleases, nil
 }
 
-func fetchRelease(user, repo, tag string) (*ghRelease, error) {
-	src := oauth2.StaticTokenSource(
-		// TODO: DI this
-		&oauth2.Token{AccessToken: os.Getenv("GITHUB_TOKEN")},
-	)
-	httpClient := oauth2.NewClient(context.Background(), src)
-	client := githubv4.NewClient(httpClient)
-
-	// TODO: act on hitting a rate limit
+func fetchRelease(client *githubv4.Client, user, repo, tag string) (*ghRelease, error) {
 	type rateLimit struct {
 		Cost      githubv4.Int
 		Limit     githubv4.Int
@@ -131,10 +131,12 @@ func fetchRelease(user, repo, tag string) (*ghRelease, error) {
 			DatabaseID githubv4.Int
 			URL        githubv4.URI
 			Release    struct {
-				TagName     githubv4.String
-				IsLatest    githubv4.Boolean
-				IsDraft     githubv4.Boolean
-				PublishedAt githubv4.DateTime
+				TagName      githubv4.String
+				IsLatest     githubv4.Boolean
+				IsDraft      githubv4.Boolean
+				IsPrerelease githubv4.Boolean
+				PublishedAt  githubv4.DateTime
+				CreatedAt    githubv4.DateTime
 			} `graphql:"release(tagName:$tagName)"`
 		} `graphql:"repository(owner:$repositoryOwner, name:$repositoryName)"`
 
@@ -152,9 +154,11 @@ func fetchRelease(user, repo, tag string) (*ghRelease, error) {
 	}
 
 	return &ghRelease{
-		Tag:      string(query.Repository.Release.TagName),
-		IsLatest: bool(query.Repository.Release.IsLatest),
-		IsDraft:  bool(query.Repository.Release.IsDraft),
-		Date:     query.Repository.Release.PublishedAt.Time,
+		Tag:          string(query.Repository.Release.TagName),
+		IsLatest:     bool(query.Repository.Release.IsLatest),
+		IsDraft:      bool(query.Repository.Release.IsDraft),
+		IsPrerelease: bool(query.Repository.Release.IsPrerelease),
+		Date:         query.Repository.Release.PublishedAt.Time,
+		CreatedAt:    query.Repository.Release.CreatedAt.Time,
 	}, nil
 }
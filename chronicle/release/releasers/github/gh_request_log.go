@@ -0,0 +1,60 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// requestLogTransport traces each API request (without credentials) along with the outcome and how long it took, so
+// the queries behind a changelog can be collected (e.g. for a debug bundle).
+type requestLogTransport struct {
+	base http.RoundTripper
+}
+
+func newRequestLogTransport(base http.RoundTripper) *requestLogTransport {
+	return &requestLogTransport{
+		base: base,
+	}
+}
+
+func (t *requestLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fields := []interface{}{"method", req.Method, "url", req.URL.String()}
+	if variables := graphQLVariables(req); variables != nil {
+		fields = append(fields, "variables", variables)
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	fields = append(fields, "duration", time.Since(start).String())
+
+	if err != nil {
+		log.WithFields(append(fields, "error", err.Error())...).Tracef("api request failed: %s %s", req.Method, req.URL.Path)
+		return nil, err
+	}
+
+	log.WithFields(append(fields, "status", resp.StatusCode)...).Tracef("api request: %s %s", req.Method, req.URL.Path)
+	return resp, nil
+}
+
+// graphQLVariables returns the variables of a GraphQL query (e.g. the page cursor and size), which are what
+// distinguishes the otherwise identical requests of a paginated query.
+func graphQLVariables(req *http.Request) map[string]interface{} {
+	// note: only a body that can be re-read is inspected, since the request body must be left intact
+	if !isGraphQLRequest(req) || req.GetBody == nil {
+		return nil
+	}
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil
+	}
+	var payload struct {
+		Variables map[string]interface{} `json:"variables"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil
+	}
+	return payload.Variables
+}
@@ -0,0 +1,43 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_graphQLVariables(t *testing.T) {
+	query := `{"query": "query($pageSize:Int!){...}", "variables": {"pageSize": 100, "prCursor": null}}`
+
+	req, err := http.NewRequest(http.MethodPost, "https://api.github.com/graphql", strings.NewReader(query))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"pageSize": float64(100), "prCursor": nil}, graphQLVariables(req))
+
+	// the body is left intact for the request itself
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, query, string(body))
+
+	req, err = http.NewRequest(http.MethodGet, "https://api.github.com/repos/anchore/chronicle/issues/1", nil)
+	require.NoError(t, err)
+	assert.Nil(t, graphQLVariables(req))
+}
+
+func Test_requestLogTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRequestLogTransport(http.DefaultTransport)}
+	resp, err := client.Post(server.URL+"/graphql", "application/json", strings.NewReader(`{"variables": {"pageSize": 1}}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusTeapot, resp.StatusCode)
+}
@@ -0,0 +1,239 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+var (
+	// e.g. `Revert "add the thing"` (the default title for revert PRs made from the GitHub UI and `git revert`)
+	revertTitlePattern = regexp.MustCompile(`^Revert "(?P<title>.+)"$`)
+	// e.g. "Reverts anchore/chronicle#123" (the default body for revert PRs made from the GitHub UI)
+	revertsPRPattern = regexp.MustCompile(`(?m)^Reverts [\w.-]+/[\w.-]+#(?P<number>\d+)`)
+	// e.g. "This reverts commit 2a8f9e2..." (the default message trailer from `git revert`)
+	revertsCommitPattern = regexp.MustCompile(`(?m)This reverts commit (?P<commit>[0-9a-f]{7,40})`)
+	// e.g. `Reapply "add the thing"` (the title from `git revert` of a revert) or `Reland: add the thing`
+	relandTitlePattern = regexp.MustCompile(`(?i)^(?:reapply|re-?land)(?::\s*|\s+)"?(?P<title>.+?)"?$`)
+)
+
+// revertChain is a change that was reverted within the same release, along with all subsequent reverts and re-lands
+// of the same change (e.g. "add the thing" -> `Revert "add the thing"` -> `Reapply "add the thing"`).
+type revertChain struct {
+	Original ghPullRequest
+	// Chain are the PRs that reverted or re-landed the original change (in merge order)
+	Chain []ghPullRequest
+	// RevertCommits are any commits that reverted the change without a PR
+	RevertCommits []string
+	// Landed indicates if the change is present at the end of the chain
+	Landed bool
+}
+
+func (c revertChain) prs() []ghPullRequest {
+	return append([]ghPullRequest{c.Original}, c.Chain...)
+}
+
+// final returns the PR that reflects the change at the end of the chain (only valid when the change is landed).
+func (c revertChain) final() ghPullRequest {
+	final := c.Chain[len(c.Chain)-1]
+	// describe the change as the original PR did, but reference the final landing PR
+	final.Title = c.Original.Title
+	final.Body = c.Original.Body
+	final.Labels = mergeLabels(final.Labels, c.Original.Labels)
+	if len(final.LinkedIssues) == 0 {
+		final.LinkedIssues = c.Original.LinkedIssues
+	}
+	return final
+}
+
+// findRevertChains returns all PRs that have been reverted by another PR (or commit) where both fall within the given
+// set of candidate PRs and commits for a release. Reverts of reverts and re-lands of reverted changes are tracked
+// within the same chain.
+func findRevertChains(prs []ghPullRequest, commits []git.Commit) []*revertChain {
+	ordered := make([]ghPullRequest, len(prs))
+	copy(ordered, prs)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].MergedAt.Before(ordered[j].MergedAt)
+	})
+
+	var chains []*revertChain
+	byNumber := make(map[int]*revertChain)
+
+	for _, pr := range ordered {
+		if target := findRevertedPR(ordered, pr.Title, pr.Body); target != nil && target.Number != pr.Number {
+			if c, ok := byNumber[target.Number]; ok {
+				// a revert of a revert (or of a re-land) toggles the change
+				c.Chain = append(c.Chain, pr)
+				c.Landed = !c.Landed
+				byNumber[pr.Number] = c
+				continue
+			}
+			c := &revertChain{Original: *target, Chain: []ghPullRequest{pr}}
+			chains = append(chains, c)
+			byNumber[target.Number] = c
+			byNumber[pr.Number] = c
+			continue
+		}
+
+		if target := findRelandedPR(ordered, pr); target != nil {
+			if c, ok := byNumber[target.Number]; ok && !c.Landed {
+				c.Chain = append(c.Chain, pr)
+				c.Landed = true
+				byNumber[pr.Number] = c
+			}
+		}
+	}
+
+	mergeCommits := make(map[string]struct{})
+	for _, pr := range ordered {
+		mergeCommits[pr.MergeCommit] = struct{}{}
+	}
+
+	for _, c := range commits {
+		if _, ok := mergeCommits[c.Hash]; ok {
+			// this is already accounted for by a revert PR
+			continue
+		}
+		target := findRevertedPR(ordered, "", c.Message)
+		if target == nil {
+			continue
+		}
+		if chain, ok := byNumber[target.Number]; ok {
+			if chain.Landed {
+				chain.RevertCommits = append(chain.RevertCommits, c.Hash)
+				chain.Landed = false
+			}
+			continue
+		}
+		chain := &revertChain{Original: *target, RevertCommits: []string{c.Hash}}
+		chains = append(chains, chain)
+		byNumber[target.Number] = chain
+	}
+
+	return chains
+}
+
+// resolveRevertChains removes all PRs that participate in a revert chain, replacing landed chains with a single PR
+// describing the original change but referencing the final landing PR.
+func resolveRevertChains(prs []ghPullRequest, chains []*revertChain) []ghPullRequest {
+	removed := make(map[int]struct{})
+	finals := make(map[int]ghPullRequest)
+	for _, c := range chains {
+		for _, pr := range c.prs() {
+			removed[pr.Number] = struct{}{}
+		}
+		if c.Landed && len(c.Chain) > 0 {
+			final := c.final()
+			finals[final.Number] = final
+		}
+	}
+
+	var kept []ghPullRequest
+	for _, pr := range prs {
+		if final, ok := finals[pr.Number]; ok {
+			kept = append(kept, final)
+			continue
+		}
+		if _, ok := removed[pr.Number]; ok {
+			continue
+		}
+		kept = append(kept, pr)
+	}
+	return kept
+}
+
+// findRevertedPR returns the PR that the given title and body indicate has been reverted (if any).
+func findRevertedPR(prs []ghPullRequest, title, body string) *ghPullRequest {
+	if m := revertsPRPattern.FindStringSubmatch(body); m != nil {
+		number, err := strconv.Atoi(m[revertsPRPattern.SubexpIndex("number")])
+		if err == nil {
+			for i := range prs {
+				if prs[i].Number == number {
+					return &prs[i]
+				}
+			}
+		}
+	}
+
+	if m := revertsCommitPattern.FindStringSubmatch(body); m != nil {
+		commit := m[revertsCommitPattern.SubexpIndex("commit")]
+		for i := range prs {
+			if prs[i].MergeCommit != "" && strings.HasPrefix(prs[i].MergeCommit, commit) {
+				return &prs[i]
+			}
+		}
+	}
+
+	if m := revertTitlePattern.FindStringSubmatch(strings.TrimSpace(title)); m != nil {
+		return findMostRecentPRWithTitle(prs, m[revertTitlePattern.SubexpIndex("title")])
+	}
+
+	return nil
+}
+
+// findRelandedPR returns the (previously reverted) PR that the given PR indicates it is re-landing (if any).
+func findRelandedPR(prs []ghPullRequest, pr ghPullRequest) *ghPullRequest {
+	m := relandTitlePattern.FindStringSubmatch(strings.TrimSpace(pr.Title))
+	if m == nil {
+		return nil
+	}
+	target := findMostRecentPRWithTitle(prs, m[relandTitlePattern.SubexpIndex("title")])
+	if target == nil || target.Number == pr.Number {
+		return nil
+	}
+	return target
+}
+
+func findMostRecentPRWithTitle(prs []ghPullRequest, title string) *ghPullRequest {
+	for i := len(prs) - 1; i >= 0; i-- {
+		if prs[i].Title == title {
+			return &prs[i]
+		}
+	}
+	return nil
+}
+
+func mergeLabels(labelSets ...[]string) []string {
+	seen := make(map[string]struct{})
+	var results []string
+	for _, labels := range labelSets {
+		for _, l := range labels {
+			if _, ok := seen[l]; ok {
+				continue
+			}
+			seen[l] = struct{}{}
+			results = append(results, l)
+		}
+	}
+	return results
+}
+
+func logRevertChains(chains []*revertChain) {
+	if len(chains) == 0 {
+		return
+	}
+	log.Infof("resolved %d reverted changes", len(chains))
+	for idx, c := range chains {
+		var branch = treeBranch
+		if idx == len(chains)-1 {
+			branch = treeLeaf
+		}
+		history := fmt.Sprintf("PR #%d", c.Original.Number)
+		for _, pr := range c.Chain {
+			history += fmt.Sprintf(" -> PR #%d", pr.Number)
+		}
+		for _, commit := range c.RevertCommits {
+			history += fmt.Sprintf(" -> commit %s", commit)
+		}
+		if c.Landed {
+			log.Debugf("  %s %s (landed)", branch, history)
+		} else {
+			log.Debugf("  %s %s (cancelled)", branch, history)
+		}
+	}
+}
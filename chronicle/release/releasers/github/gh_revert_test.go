@@ -0,0 +1,147 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/chronicle/internal/git"
+)
+
+func Test_findRevertChains(t *testing.T) {
+	start := time.Date(2021, time.September, 16, 19, 34, 0, 0, time.UTC)
+	at := func(hours int) time.Time {
+		return start.Add(time.Duration(hours) * time.Hour)
+	}
+
+	feature := ghPullRequest{
+		Title:       "add the thing",
+		Number:      1,
+		MergedAt:    at(1),
+		MergeCommit: "1111111111111111111111111111111111111111",
+	}
+
+	fix := ghPullRequest{
+		Title:       "fix the other thing",
+		Number:      2,
+		MergedAt:    at(2),
+		MergeCommit: "2222222222222222222222222222222222222222",
+	}
+
+	revertByBody := ghPullRequest{
+		Title:       "undo it",
+		Body:        "Reverts anchore/chronicle#1\n\nthis broke everything",
+		Number:      3,
+		MergedAt:    at(3),
+		MergeCommit: "3333333333333333333333333333333333333333",
+	}
+
+	revertByTitle := ghPullRequest{
+		Title:       `Revert "fix the other thing"`,
+		Number:      4,
+		MergedAt:    at(4),
+		MergeCommit: "4444444444444444444444444444444444444444",
+	}
+
+	revertOfMissing := ghPullRequest{
+		Title:       `Revert "something from a previous release"`,
+		Number:      5,
+		MergedAt:    at(5),
+		MergeCommit: "5555555555555555555555555555555555555555",
+	}
+
+	revertOfRevert := ghPullRequest{
+		Title:       `Revert "Revert "fix the other thing""`,
+		Number:      6,
+		MergedAt:    at(6),
+		MergeCommit: "6666666666666666666666666666666666666666",
+	}
+
+	reland := ghPullRequest{
+		Title:       "Reland: add the thing",
+		Number:      7,
+		MergedAt:    at(7),
+		MergeCommit: "7777777777777777777777777777777777777777",
+	}
+
+	tests := []struct {
+		name    string
+		prs     []ghPullRequest
+		commits []git.Commit
+		want    []*revertChain
+	}{
+		{
+			name: "no reverts",
+			prs:  []ghPullRequest{feature, fix},
+		},
+		{
+			name: "revert PRs by body and title",
+			prs:  []ghPullRequest{feature, fix, revertByBody, revertByTitle},
+			want: []*revertChain{
+				{Original: feature, Chain: []ghPullRequest{revertByBody}},
+				{Original: fix, Chain: []ghPullRequest{revertByTitle}},
+			},
+		},
+		{
+			name: "revert of a PR outside of the release is kept",
+			prs:  []ghPullRequest{feature, revertOfMissing},
+		},
+		{
+			name: "revert commit without a PR",
+			prs:  []ghPullRequest{feature, fix, revertByBody},
+			commits: []git.Commit{
+				{Hash: "8888888888888888888888888888888888888888", Message: "Revert \"fix the other thing\"\n\nThis reverts commit 2222222."},
+				// the merge commit for a revert PR is not considered as a separate revert
+				{Hash: revertByBody.MergeCommit, Message: "Reverts anchore/chronicle#1"},
+			},
+			want: []*revertChain{
+				{Original: feature, Chain: []ghPullRequest{revertByBody}},
+				{Original: fix, RevertCommits: []string{"8888888888888888888888888888888888888888"}},
+			},
+		},
+		{
+			name: "revert of a revert re-lands the change",
+			// note: out of merge order
+			prs: []ghPullRequest{revertOfRevert, fix, revertByTitle},
+			want: []*revertChain{
+				{Original: fix, Chain: []ghPullRequest{revertByTitle, revertOfRevert}, Landed: true},
+			},
+		},
+		{
+			name: "re-land by title",
+			prs:  []ghPullRequest{feature, revertByBody, reland},
+			want: []*revertChain{
+				{Original: feature, Chain: []ghPullRequest{revertByBody, reland}, Landed: true},
+			},
+		},
+		{
+			name: "re-land of a change that was never reverted is kept",
+			prs:  []ghPullRequest{feature, reland},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, findRevertChains(tt.prs, tt.commits))
+		})
+	}
+}
+
+func Test_resolveRevertChains(t *testing.T) {
+	feature := ghPullRequest{Number: 1, Title: "add the thing", Labels: []string{"enhancement"}}
+	fix := ghPullRequest{Number: 2, Title: "fix the other thing"}
+	revert := ghPullRequest{Number: 3, Title: `Revert "add the thing"`}
+	reland := ghPullRequest{Number: 4, Title: `Reapply "add the thing"`, URL: "reland-url", Author: "someone"}
+	revertFix := ghPullRequest{Number: 5, Title: `Revert "fix the other thing"`}
+	other := ghPullRequest{Number: 6, Title: "something else"}
+
+	chains := []*revertChain{
+		{Original: feature, Chain: []ghPullRequest{revert, reland}, Landed: true},
+		{Original: fix, Chain: []ghPullRequest{revertFix}},
+	}
+
+	assert.Equal(t, []ghPullRequest{
+		{Number: 4, Title: "add the thing", Labels: []string{"enhancement"}, URL: "reland-url", Author: "someone"},
+		other,
+	}, resolveRevertChains([]ghPullRequest{feature, fix, revert, reland, revertFix, other}, chains))
+}
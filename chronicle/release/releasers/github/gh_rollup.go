@@ -0,0 +1,280 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// ghIssueRef is a minimal reference to an issue that tracks other issues within a task list (e.g. an epic).
+type ghIssueRef struct {
+	Number int
+	Title  string
+	URL    string
+}
+
+// findRollupParent returns the ancestor of the given issue to roll up to, walking up to the given number of levels of
+// task list relationships (stopping early at the top-most ancestor).
+func findRollupParent(number int, parents map[int]ghIssueRef, depth int) (ghIssueRef, bool) {
+	var result ghIssueRef
+	var found bool
+	seen := map[int]bool{number: true}
+	for level := 0; level < depth; level++ {
+		parent, ok := parents[number]
+		if !ok || seen[parent.Number] {
+			break
+		}
+		seen[parent.Number] = true
+		result, found = parent, true
+		number = parent.Number
+	}
+	return result, found
+}
+
+// rollupChanges nests issue changes under the parent issue that tracks them within a task list. When the parent issue
+// is itself a change within the release then the children are nested under that change, otherwise a new change is
+// created for the parent (within each changelog section that the children are in).
+func rollupChanges(changes []change.Change, parents map[int]ghIssueRef, depth int) []change.Change {
+	if depth <= 0 || len(parents) == 0 {
+		return changes
+	}
+
+	issueChanges := make(map[int]bool)
+	for _, c := range changes {
+		if issue, ok := c.Entry.(ghIssue); ok {
+			issueChanges[issue.Number] = true
+		}
+	}
+
+	type group struct {
+		parent   ghIssueRef
+		types    []change.Type
+		children []change.Change
+	}
+
+	var groups []*group
+	groupsByKey := make(map[string]*group)
+	childrenByParent := make(map[int][]change.Change)
+	var results []change.Change
+
+	for _, c := range changes {
+		issue, ok := c.Entry.(ghIssue)
+		if !ok {
+			results = append(results, c)
+			continue
+		}
+
+		parent, ok := findRollupParent(issue.Number, parents, depth)
+		if !ok {
+			results = append(results, c)
+			continue
+		}
+
+		log.Tracef("issue #%d rolled up under issue #%d", issue.Number, parent.Number)
+
+		if issueChanges[parent.Number] {
+			childrenByParent[parent.Number] = append(childrenByParent[parent.Number], c)
+			continue
+		}
+
+		key := fmt.Sprintf("%d:%s", parent.Number, typeNames(c.ChangeTypes))
+		g, ok := groupsByKey[key]
+		if !ok {
+			g = &group{parent: parent, types: c.ChangeTypes}
+			groupsByKey[key] = g
+			groups = append(groups, g)
+		}
+		g.children = append(g.children, c)
+	}
+
+	// attach children to the parent changes that are already within the release (which may have been rolled up too),
+	// descending into each child so that the children of a nested change are not lost
+	var withChildren func(c change.Change, ancestors map[int]bool) change.Change
+	withChildren = func(c change.Change, ancestors map[int]bool) change.Change {
+		issue, ok := c.Entry.(ghIssue)
+		if !ok || ancestors[issue.Number] || len(childrenByParent[issue.Number]) == 0 {
+			return c
+		}
+		ancestors[issue.Number] = true
+		defer delete(ancestors, issue.Number)
+
+		children := append([]change.Change(nil), c.Children...)
+		for _, child := range childrenByParent[issue.Number] {
+			children = append(children, withChildren(child, ancestors))
+		}
+		c.Children = children
+		return c
+	}
+
+	for idx := range results {
+		results[idx] = withChildren(results[idx], make(map[int]bool))
+	}
+
+	for _, g := range groups {
+		for idx := range g.children {
+			g.children[idx] = withChildren(g.children[idx], make(map[int]bool))
+		}
+		ids := []string{fmt.Sprintf("github-issue:%d", g.parent.Number)}
+		for _, c := range g.children {
+			ids = append(ids, c.Identities...)
+		}
+		results = append(results, change.Change{
+			Text:        g.parent.Title,
+			ChangeTypes: g.types,
+			Timestamp:   g.children[len(g.children)-1].Timestamp,
+			References: []change.Reference{
+				{
+					Text: fmt.Sprintf("Issue #%d", g.parent.Number),
+					URL:  g.parent.URL,
+				},
+			},
+			EntryType:  "githubIssueRollup",
+			Entry:      g.parent,
+			Children:   g.children,
+			Identities: ids,
+		})
+	}
+
+	return results
+}
+
+func typeNames(types []change.Type) string {
+	var names []string
+	for _, t := range types {
+		names = append(names, t.Name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+// fetchIssueParents returns the issue tracking each closed issue within a task list (keyed by the child issue number),
+// along with the parents of those issues up to the given depth.
+func fetchIssueParents(client *githubv4.Client, user, repo string, depth int) (map[int]ghIssueRef, error) {
+	parents, err := fetchClosedIssueParents(client, user, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	// parents of parents may be open issues, so they must be looked up individually
+	queried := make(map[int]bool)
+	for level := 1; level < depth; level++ {
+		var missing []int
+		for _, p := range parents {
+			if _, ok := parents[p.Number]; !ok && !queried[p.Number] {
+				missing = append(missing, p.Number)
+				queried[p.Number] = true
+			}
+		}
+		if len(missing) == 0 {
+			break
+		}
+		sort.Ints(missing)
+		for _, number := range missing {
+			parent, err := fetchIssueParent(client, user, repo, number)
+			if err != nil {
+				return nil, err
+			}
+			if parent != nil {
+				parents[number] = *parent
+			}
+		}
+	}
+
+	return parents, nil
+}
+
+func fetchClosedIssueParents(client *githubv4.Client, user, repo string) (map[int]ghIssueRef, error) {
+	parents := make(map[int]ghIssueRef)
+
+	var query struct {
+		Repository struct {
+			Issues struct {
+				PageInfo struct {
+					EndCursor   githubv4.String
+					HasNextPage bool
+				}
+				Nodes []struct {
+					Number          githubv4.Int
+					TrackedInIssues struct {
+						Nodes []struct {
+							Number githubv4.Int
+							Title  githubv4.String
+							URL    githubv4.String
+						}
+					} `graphql:"trackedInIssues(first:1)"`
+				}
+			} `graphql:"issues(first:100, states:CLOSED, after:$issuesCursor)"`
+		} `graphql:"repository(owner:$repositoryOwner, name:$repositoryName)"`
+	}
+	variables := map[string]interface{}{
+		"repositoryOwner": githubv4.String(user),
+		"repositoryName":  githubv4.String(repo),
+		"issuesCursor":    (*githubv4.String)(nil), // Null after argument to get first page.
+	}
+
+	for {
+		err := client.Query(context.Background(), &query, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, iNode := range query.Repository.Issues.Nodes {
+			for _, p := range iNode.TrackedInIssues.Nodes {
+				parents[int(iNode.Number)] = ghIssueRef{
+					Number: int(p.Number),
+					Title:  string(p.Title),
+					URL:    string(p.URL),
+				}
+			}
+		}
+
+		if !query.Repository.Issues.PageInfo.HasNextPage {
+			break
+		}
+		variables["issuesCursor"] = githubv4.NewString(query.Repository.Issues.PageInfo.EndCursor)
+	}
+
+	return parents, nil
+}
+
+func fetchIssueParent(client *githubv4.Client, user, repo string, number int) (*ghIssueRef, error) {
+	var query struct {
+		Repository struct {
+			Issue struct {
+				TrackedInIssues struct {
+					Nodes []struct {
+						Number githubv4.Int
+						Title  githubv4.String
+						URL    githubv4.String
+					}
+				} `graphql:"trackedInIssues(first:1)"`
+			} `graphql:"issue(number:$issueNumber)"`
+		} `graphql:"repository(owner:$repositoryOwner, name:$repositoryName)"`
+	}
+	variables := map[string]interface{}{
+		"repositoryOwner": githubv4.String(user),
+		"repositoryName":  githubv4.String(repo),
+		"issueNumber":     githubv4.Int(number),
+	}
+
+	err := client.Query(context.Background(), &query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := query.Repository.Issue.TrackedInIssues.Nodes
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	return &ghIssueRef{
+		Number: int(nodes[0].Number),
+		Title:  string(nodes[0].Title),
+		URL:    string(nodes[0].URL),
+	}, nil
+}
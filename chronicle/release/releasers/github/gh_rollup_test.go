@@ -0,0 +1,166 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func Test_findRollupParent(t *testing.T) {
+	epic := ghIssueRef{Number: 1, Title: "epic"}
+	feature := ghIssueRef{Number: 2, Title: "feature"}
+	parents := map[int]ghIssueRef{
+		2: epic,
+		3: feature,
+		// cycles should not loop forever
+		5: {Number: 6},
+		6: {Number: 5},
+	}
+
+	tests := []struct {
+		name   string
+		number int
+		depth  int
+		want   ghIssueRef
+		found  bool
+	}{
+		{
+			name:   "no parent",
+			number: 4,
+			depth:  2,
+		},
+		{
+			name:   "direct parent",
+			number: 3,
+			depth:  1,
+			want:   feature,
+			found:  true,
+		},
+		{
+			name:   "grandparent",
+			number: 3,
+			depth:  2,
+			want:   epic,
+			found:  true,
+		},
+		{
+			name:   "stops at top-most ancestor",
+			number: 3,
+			depth:  5,
+			want:   epic,
+			found:  true,
+		},
+		{
+			name:   "cycle",
+			number: 5,
+			depth:  5,
+			want:   ghIssueRef{Number: 6},
+			found:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := findRollupParent(tt.number, parents, tt.depth)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_rollupChanges(t *testing.T) {
+	bug := change.NewType("bug-fix", change.SemVerPatch)
+	feature := change.NewType("added-feature", change.SemVerMinor)
+
+	epic := ghIssueRef{Number: 1, Title: "the epic", URL: "issue-1-url"}
+
+	parentChange := change.Change{Text: "parent", ChangeTypes: []change.Type{feature}, Entry: ghIssue{Number: 2}}
+	child1 := change.Change{Text: "child 1", ChangeTypes: []change.Type{feature}, Entry: ghIssue{Number: 3}, Identities: []string{"github-issue:3"}}
+	child2 := change.Change{Text: "child 2", ChangeTypes: []change.Type{feature}, Entry: ghIssue{Number: 4}, Identities: []string{"github-issue:4"}}
+	child3 := change.Change{Text: "child 3", ChangeTypes: []change.Type{bug}, Entry: ghIssue{Number: 5}, Identities: []string{"github-issue:5"}}
+	child4 := change.Change{Text: "child 4", ChangeTypes: []change.Type{bug}, Entry: ghIssue{Number: 6}}
+	orphan := change.Change{Text: "orphan", ChangeTypes: []change.Type{bug}, Entry: ghIssue{Number: 7}}
+	pr := change.Change{Text: "pr", ChangeTypes: []change.Type{bug}, Entry: ghPullRequest{Number: 8}}
+
+	parents := map[int]ghIssueRef{
+		3: epic,
+		4: epic,
+		5: epic,
+		6: {Number: 2, Title: "parent"},
+	}
+
+	got := rollupChanges([]change.Change{parentChange, child1, child2, child3, child4, orphan, pr}, parents, 1)
+
+	parentWithChild := parentChange
+	parentWithChild.Children = []change.Change{child4}
+
+	assert.Equal(t, []change.Change{
+		parentWithChild,
+		orphan,
+		pr,
+		{
+			Text:        "the epic",
+			ChangeTypes: []change.Type{feature},
+			References:  []change.Reference{{Text: "Issue #1", URL: "issue-1-url"}},
+			EntryType:   "githubIssueRollup",
+			Entry:       epic,
+			Children:    []change.Change{child1, child2},
+			Identities:  []string{"github-issue:1", "github-issue:3", "github-issue:4"},
+		},
+		{
+			Text:        "the epic",
+			ChangeTypes: []change.Type{bug},
+			References:  []change.Reference{{Text: "Issue #1", URL: "issue-1-url"}},
+			EntryType:   "githubIssueRollup",
+			Entry:       epic,
+			Children:    []change.Change{child3},
+			Identities:  []string{"github-issue:1", "github-issue:5"},
+		},
+	}, got)
+
+	// disabled
+	assert.Len(t, rollupChanges([]change.Change{child1, child2}, parents, 0), 2)
+}
+
+func Test_rollupChanges_chain(t *testing.T) {
+	feature := change.NewType("added-feature", change.SemVerMinor)
+
+	// issue #3 is tracked by #2, which is tracked by #1 (all within the release)
+	issue1 := change.Change{Text: "issue 1", ChangeTypes: []change.Type{feature}, Entry: ghIssue{Number: 1}}
+	issue2 := change.Change{Text: "issue 2", ChangeTypes: []change.Type{feature}, Entry: ghIssue{Number: 2}}
+	issue3 := change.Change{Text: "issue 3", ChangeTypes: []change.Type{feature}, Entry: ghIssue{Number: 3}}
+
+	parents := map[int]ghIssueRef{
+		2: {Number: 1, Title: "issue 1"},
+		3: {Number: 2, Title: "issue 2"},
+	}
+
+	withChildren := func(c change.Change, children ...change.Change) change.Change {
+		c.Children = children
+		return c
+	}
+
+	tests := []struct {
+		name  string
+		depth int
+		want  []change.Change
+	}{
+		{
+			name:  "depth 1 nests each issue under its parent",
+			depth: 1,
+			want:  []change.Change{withChildren(issue1, withChildren(issue2, issue3))},
+		},
+		{
+			name:  "depth 2 nests every issue under the top-most parent",
+			depth: 2,
+			want:  []change.Change{withChildren(issue1, issue2, issue3)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rollupChanges([]change.Change{issue1, issue2, issue3}, parents, tt.depth)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
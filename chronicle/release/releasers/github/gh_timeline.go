@@ -0,0 +1,115 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// linkIssuesByTimeline corrects the linked issues for each PR based on the PR that actually closed each issue (as
+// recorded in the issue timeline) instead of the closing references declared on the PR. Issues that were closed by a
+// PR are linked only to that PR, while issues closed by other means (e.g. manually or by a commit) are left as-is.
+func linkIssuesByTimeline(prs []ghPullRequest, issues []ghIssue, closers map[int]int) []ghPullRequest {
+	results := make([]ghPullRequest, 0, len(prs))
+	for _, pr := range prs {
+		var linked []ghIssue
+		hasIssue := make(map[int]bool)
+		for _, issue := range pr.LinkedIssues {
+			closer, ok := closers[issue.Number]
+			if ok && closer != pr.Number {
+				log.Tracef("PR #%d unlinked from issue #%d: issue was closed by PR #%d", pr.Number, issue.Number, closer)
+				continue
+			}
+			linked = append(linked, issue)
+			hasIssue[issue.Number] = true
+		}
+
+		for _, issue := range issues {
+			if closers[issue.Number] != pr.Number || hasIssue[issue.Number] {
+				continue
+			}
+			log.Tracef("PR #%d linked to issue #%d: issue was closed by the PR", pr.Number, issue.Number)
+			linked = append(linked, issue)
+		}
+
+		pr.LinkedIssues = linked
+		results = append(results, pr)
+	}
+	return results
+}
+
+// fetchIssueClosers returns the PR number that closed each closed issue (keyed by issue number), as recorded by the
+// closed event in the issue timeline. Issues that were not closed by a PR are not included. Like fetchClosedIssues,
+// issues are fetched most recently updated first, stopping once reaching issues not updated since the given time.
+// nolint:funlen
+func fetchIssueClosers(client *githubv4.Client, user, repo string, since *time.Time, paging pagination) (map[int]int, error) {
+	closers := make(map[int]int)
+
+	{
+		var query struct {
+			Repository struct {
+				Issues struct {
+					PageInfo struct {
+						EndCursor   githubv4.String
+						HasNextPage bool
+					}
+					Nodes []struct {
+						Number        githubv4.Int
+						UpdatedAt     githubv4.DateTime
+						TimelineItems struct {
+							Nodes []struct {
+								ClosedEvent struct {
+									Closer struct {
+										PullRequest struct {
+											Number githubv4.Int
+										} `graphql:"... on PullRequest"`
+									}
+								} `graphql:"... on ClosedEvent"`
+							}
+						} `graphql:"timelineItems(itemTypes:[CLOSED_EVENT], last:1)"`
+					}
+				} `graphql:"issues(first:$pageSize, states:CLOSED, after:$issuesCursor, orderBy:{field:UPDATED_AT, direction:DESC}, filterBy:{since:$issuesSince})"`
+			} `graphql:"repository(owner:$repositoryOwner, name:$repositoryName)"`
+		}
+		variables := map[string]interface{}{
+			"repositoryOwner": githubv4.String(user),
+			"repositoryName":  githubv4.String(repo),
+			"issuesCursor":    (*githubv4.String)(nil), // Null after argument to get first page.
+			"issuesSince":     (*githubv4.DateTime)(nil),
+			"pageSize":        paging.size(),
+		}
+		if since != nil {
+			variables["issuesSince"] = githubv4.NewDateTime(githubv4.DateTime{Time: *since})
+		}
+
+		for pages := 1; ; pages++ {
+			err := client.Query(context.Background(), &query, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			var reachedBoundary bool
+			for _, iNode := range query.Repository.Issues.Nodes {
+				if updatedBefore(iNode.UpdatedAt.Time, since) {
+					reachedBoundary = true
+					break
+				}
+				for _, event := range iNode.TimelineItems.Nodes {
+					if number := int(event.ClosedEvent.Closer.PullRequest.Number); number != 0 {
+						closers[int(iNode.Number)] = number
+					}
+				}
+			}
+
+			if reachedBoundary || !query.Repository.Issues.PageInfo.HasNextPage || paging.exhausted(pages, "issue timelines") {
+				break
+			}
+			variables["issuesCursor"] = githubv4.NewString(query.Repository.Issues.PageInfo.EndCursor)
+		}
+	}
+
+	return closers, nil
+}
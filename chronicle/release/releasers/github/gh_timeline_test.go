@@ -0,0 +1,146 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+func Test_linkIssuesByTimeline(t *testing.T) {
+	issue1 := ghIssue{Number: 1, Title: "issue 1", Closed: true}
+	issue2 := ghIssue{Number: 2, Title: "issue 2", Closed: true}
+	issue3 := ghIssue{Number: 3, Title: "issue 3", Closed: true}
+
+	tests := []struct {
+		name    string
+		prs     []ghPullRequest
+		issues  []ghIssue
+		closers map[int]int
+		want    map[int][]int
+	}{
+		{
+			name: "no closers keeps existing links",
+			prs: []ghPullRequest{
+				{Number: 10, LinkedIssues: []ghIssue{issue1}},
+			},
+			issues: []ghIssue{issue1},
+			want: map[int][]int{
+				10: {1},
+			},
+		},
+		{
+			name: "link issue closed by PR without closing reference",
+			prs: []ghPullRequest{
+				{Number: 10},
+			},
+			issues:  []ghIssue{issue1, issue2},
+			closers: map[int]int{2: 10},
+			want: map[int][]int{
+				10: {2},
+			},
+		},
+		{
+			name: "unlink issue closed by another PR",
+			prs: []ghPullRequest{
+				{Number: 10, LinkedIssues: []ghIssue{issue1, issue3}},
+				{Number: 11, LinkedIssues: []ghIssue{issue1}},
+			},
+			issues:  []ghIssue{issue1, issue3},
+			closers: map[int]int{1: 11},
+			want: map[int][]int{
+				10: {3},
+				11: {1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make(map[int][]int)
+			for _, pr := range linkIssuesByTimeline(tt.prs, tt.issues, tt.closers) {
+				var numbers []int
+				for _, issue := range pr.LinkedIssues {
+					numbers = append(numbers, issue.Number)
+				}
+				got[pr.Number] = numbers
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_fetchIssueClosers(t *testing.T) {
+	since := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		since        *time.Time
+		maxPages     int
+		wantClosers  map[int]int
+		wantRequests int
+		wantWarnings int
+	}{
+		{
+			name:         "stops at issues not updated since the start of the release",
+			since:        &since,
+			wantClosers:  map[int]int{1: 101, 2: 102},
+			wantRequests: 3,
+		},
+		{
+			name:         "stops at the page limit",
+			maxPages:     2,
+			wantClosers:  map[int]int{1: 101, 2: 102},
+			wantRequests: 2,
+			wantWarnings: 1,
+		},
+		{
+			name:         "without a start time every issue is considered",
+			maxPages:     4,
+			wantClosers:  map[int]int{1: 101, 2: 102, 3: 103, 4: 104},
+			wantRequests: 4,
+			wantWarnings: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req struct {
+					Query     string                 `json:"query"`
+					Variables map[string]interface{} `json:"variables"`
+				}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Contains(t, req.Query, "orderBy:{field:UPDATED_AT, direction:DESC}, filterBy:{since:$issuesSince}")
+				requests = append(requests, req.Variables)
+
+				// each page has a single issue, each updated a day before the last (from the third, before the release)
+				page := len(requests)
+				updated := since.Add(time.Duration(3-page) * 24 * time.Hour).Add(-time.Hour)
+				fmt.Fprintf(w, `{"data": {"repository": {"issues": {
+					"pageInfo": {"endCursor": "cursor-%d", "hasNextPage": true},
+					"nodes": [{"number": %d, "updatedAt": %q, "timelineItems": {"nodes": [{"closer": {"number": %d}}]}}]
+				}}}}`, page, page, updated.Format(time.RFC3339), 100+page)
+			}))
+			t.Cleanup(server.Close)
+
+			warnings := &release.Warnings{}
+			client := githubv4.NewEnterpriseClient(server.URL, server.Client())
+
+			closers, err := fetchIssueClosers(client, "anchore", "chronicle", tt.since, pagination{PageSize: 25, MaxPages: tt.maxPages, Warnings: warnings})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantClosers, closers)
+			require.Len(t, requests, tt.wantRequests)
+			assert.Equal(t, float64(25), requests[0]["pageSize"])
+			assert.Len(t, warnings.List(), tt.wantWarnings)
+		})
+	}
+}
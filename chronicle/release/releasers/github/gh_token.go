@@ -0,0 +1,148 @@
+package github
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// tokenEnvVars are the environment variables checked for a github token, in order.
+var tokenEnvVars = []string{"GITHUB_TOKEN", "GH_TOKEN"}
+
+// tokenResolver finds a github token from (in order): the explicit token, the token file, the token environment
+// variables, and the credentials stored by the gh CLI (which may be within the system keyring).
+type tokenResolver struct {
+	getenv   func(string) string
+	readFile func(string) ([]byte, error)
+	ghToken  func(host string) (string, error)
+}
+
+var defaultTokenResolver = tokenResolver{
+	getenv:   os.Getenv,
+	readFile: os.ReadFile,
+	ghToken:  ghCLIToken,
+}
+
+// ResolveToken returns the github token for the given host from the first source that provides one: the explicit
+// token, the given token file, the GITHUB_TOKEN or GH_TOKEN environment variables, or the gh CLI stored credentials.
+// When no token is found the error describes each source that was tried.
+func ResolveToken(host, token, tokenFile string) (string, error) {
+	return defaultTokenResolver.resolve(host, token, tokenFile)
+}
+
+func (r tokenResolver) resolve(host, token, tokenFile string) (string, error) {
+	if host == "" {
+		host = defaultHost
+	}
+
+	var tried []string
+
+	if token = strings.TrimSpace(token); token != "" {
+		log.Debug("using github token from config")
+		return token, nil
+	}
+	tried = append(tried, "github.token config (not set)")
+
+	if tokenFile != "" {
+		contents, err := r.readFile(tokenFile)
+		if err != nil {
+			// an explicitly configured file that cannot be read is a misconfiguration, not a missing token
+			return "", fmt.Errorf("unable to read github token file %q: %w", tokenFile, err)
+		}
+		if token = strings.TrimSpace(string(contents)); token != "" {
+			log.WithFields("path", tokenFile).Debug("using github token from file")
+			return token, nil
+		}
+		tried = append(tried, fmt.Sprintf("github.token-file %q (empty)", tokenFile))
+	} else {
+		tried = append(tried, "github.token-file config (not set)")
+	}
+
+	for _, name := range tokenEnvVars {
+		if token = strings.TrimSpace(r.getenv(name)); token != "" {
+			log.Debugf("using github token from %s env var", name)
+			return token, nil
+		}
+		tried = append(tried, fmt.Sprintf("%s env var (not set)", name))
+	}
+
+	token, err := r.ghToken(host)
+	if err == nil && token != "" {
+		log.WithFields("host", host).Debug("using github token from gh CLI credentials")
+		return token, nil
+	}
+	if err == nil {
+		err = fmt.Errorf("no credentials stored")
+	}
+	tried = append(tried, fmt.Sprintf("gh CLI credentials for %q (%v)", host, err))
+
+	return "", fmt.Errorf("no github token found, tried: %s", strings.Join(tried, ", "))
+}
+
+// ghCLIToken returns the token stored by the gh CLI for the given host. The gh CLI itself is preferred since it may
+// store the token within the system keyring, otherwise the gh hosts file is read directly.
+func ghCLIToken(host string) (string, error) {
+	if path, err := exec.LookPath("gh"); err == nil {
+		var stdout, stderr bytes.Buffer
+		cmd := exec.Command(path, "auth", "token", "--hostname", host)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return "", fmt.Errorf("gh auth token: %s", strings.TrimSpace(stderr.String()))
+		}
+		return strings.TrimSpace(stdout.String()), nil
+	}
+
+	token, err := ghHostsFileToken(ghConfigDir(os.Getenv), host)
+	if err != nil {
+		return "", fmt.Errorf("gh not installed and %w", err)
+	}
+	return token, nil
+}
+
+// ghConfigDir returns the directory that the gh CLI stores its configuration within.
+func ghConfigDir(getenv func(string) string) string {
+	if dir := getenv("GH_CONFIG_DIR"); dir != "" {
+		return dir
+	}
+	if dir := getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "gh")
+	}
+	if dir := getenv("AppData"); dir != "" {
+		return filepath.Join(dir, "GitHub CLI")
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".config", "gh")
+}
+
+// ghHostsFileToken returns the token for the given host within the gh CLI hosts file (e.g. ~/.config/gh/hosts.yml).
+func ghHostsFileToken(dir, host string) (string, error) {
+	contents, err := os.ReadFile(filepath.Join(dir, "hosts.yml"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("no gh hosts file found")
+		}
+		return "", fmt.Errorf("unable to read gh hosts file: %w", err)
+	}
+
+	var hosts map[string]struct {
+		OAuthToken string `yaml:"oauth_token"`
+	}
+	if err := yaml.Unmarshal(contents, &hosts); err != nil {
+		return "", fmt.Errorf("unable to parse gh hosts file: %w", err)
+	}
+
+	token := hosts[host].OAuthToken
+	if token == "" {
+		return "", fmt.Errorf("no token for %q within the gh hosts file", host)
+	}
+	return token, nil
+}
@@ -0,0 +1,132 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_tokenResolver(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("file-token\n"), 0600))
+
+	emptyFile := filepath.Join(t.TempDir(), "empty")
+	require.NoError(t, os.WriteFile(emptyFile, nil, 0600))
+
+	tests := []struct {
+		name      string
+		token     string
+		tokenFile string
+		env       map[string]string
+		gh        string
+		ghErr     error
+		want      string
+		wantErr   string
+	}{
+		{
+			name:      "explicit token takes precedence",
+			token:     "config-token",
+			tokenFile: tokenFile,
+			env:       map[string]string{"GITHUB_TOKEN": "env-token"},
+			gh:        "gh-token",
+			want:      "config-token",
+		},
+		{
+			name:      "token file",
+			tokenFile: tokenFile,
+			env:       map[string]string{"GITHUB_TOKEN": "env-token"},
+			want:      "file-token",
+		},
+		{
+			name: "GITHUB_TOKEN before GH_TOKEN",
+			env:  map[string]string{"GITHUB_TOKEN": "github-token", "GH_TOKEN": "gh-env-token"},
+			want: "github-token",
+		},
+		{
+			name: "GH_TOKEN",
+			env:  map[string]string{"GH_TOKEN": "gh-env-token"},
+			want: "gh-env-token",
+		},
+		{
+			name: "gh CLI",
+			gh:   "gh-token",
+			want: "gh-token",
+		},
+		{
+			name:      "unreadable token file",
+			tokenFile: filepath.Join(t.TempDir(), "missing"),
+			gh:        "gh-token",
+			wantErr:   "unable to read github token file",
+		},
+		{
+			name:      "no token found",
+			tokenFile: emptyFile,
+			ghErr:     fmt.Errorf("not logged in"),
+			wantErr: fmt.Sprintf(`no github token found, tried: github.token config (not set), github.token-file %q (empty), `+
+				`GITHUB_TOKEN env var (not set), GH_TOKEN env var (not set), gh CLI credentials for "github.com" (not logged in)`, emptyFile),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := tokenResolver{
+				getenv: func(name string) string {
+					return tt.env[name]
+				},
+				readFile: os.ReadFile,
+				ghToken: func(host string) (string, error) {
+					assert.Equal(t, "github.com", host)
+					return tt.gh, tt.ghErr
+				},
+			}
+
+			got, err := r.resolve("", tt.token, tt.tokenFile)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_ghHostsFileToken(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "hosts.yml"), []byte(`github.com:
+    user: someone
+    oauth_token: gho_1234
+    git_protocol: https
+github.example.com:
+    user: someone
+`), 0600))
+
+	token, err := ghHostsFileToken(dir, "github.com")
+	require.NoError(t, err)
+	assert.Equal(t, "gho_1234", token)
+
+	_, err = ghHostsFileToken(dir, "github.example.com")
+	assert.ErrorContains(t, err, "no token for")
+
+	_, err = ghHostsFileToken(t.TempDir(), "github.com")
+	assert.ErrorContains(t, err, "no gh hosts file found")
+}
+
+func Test_ghConfigDir(t *testing.T) {
+	env := map[string]string{
+		"GH_CONFIG_DIR":   "/gh",
+		"XDG_CONFIG_HOME": "/xdg",
+	}
+	getenv := func(name string) string {
+		return env[name]
+	}
+
+	assert.Equal(t, "/gh", ghConfigDir(getenv))
+
+	delete(env, "GH_CONFIG_DIR")
+	assert.Equal(t, filepath.Join("/xdg", "gh"), ghConfigDir(getenv))
+}
@@ -0,0 +1,48 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+)
+
+const (
+	// TimestampWindow selects the PRs merged and issues closed between the timestamps of the release tags (the default).
+	TimestampWindow = "timestamp"
+	// ReachabilityWindow selects the PRs whose merge commit is reachable from the end of the release but not from the
+	// start (per the local git history), along with the issues closed by those PRs, irrespective of timestamps. This
+	// correctly classifies backports and PRs merged after the release was tagged.
+	ReachabilityWindow = "reachability"
+)
+
+// ParseChangeWindow validates how the changes within a release are determined.
+func ParseChangeWindow(value string) (string, error) {
+	switch v := strings.ToLower(strings.TrimSpace(value)); v {
+	case "", TimestampWindow:
+		return TimestampWindow, nil
+	case ReachabilityWindow:
+		return ReachabilityWindow, nil
+	}
+	return "", fmt.Errorf("unsupported change window %q (options: %s, %s)", value, TimestampWindow, ReachabilityWindow)
+}
+
+// issuesClosedWithinCommits keeps the issues closed by a merged PR whose merge commit is one of the given commits (the
+// commits within the release). Issues that were not closed by a PR have no known closing commit, so are excluded.
+func issuesClosedWithinCommits(allMergedPRs []ghPullRequest, commits []string) issueFilter {
+	commitSet := strset.New(commits...)
+	return func(issue ghIssue) bool {
+		linked := getLinkedPRs(allMergedPRs, issue)
+		for _, pr := range linked {
+			if commitSet.Has(pr.MergeCommit) {
+				return true
+			}
+		}
+		if len(linked) == 0 {
+			traceExcluded(issueSubject(issue.Number), "not closed by a PR (the closing commit is unknown)")
+		} else {
+			traceExcluded(issueSubject(issue.Number), "not closed by a PR merged within the release")
+		}
+		return false
+	}
+}
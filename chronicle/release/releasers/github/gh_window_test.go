@@ -0,0 +1,48 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChangeWindow(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr require.ErrorAssertionFunc
+	}{
+		{value: "", want: TimestampWindow},
+		{value: "timestamp", want: TimestampWindow},
+		{value: " Reachability ", want: ReachabilityWindow},
+		{value: "graph", wantErr: require.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			got, err := ParseChangeWindow(tt.value)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_issuesClosedWithinCommits(t *testing.T) {
+	withinRelease := ghIssue{Number: 1, URL: "issue-1-url"}
+	closedByLaterPR := ghIssue{Number: 2, URL: "issue-2-url"}
+	closedManually := ghIssue{Number: 3, URL: "issue-3-url"}
+
+	prs := []ghPullRequest{
+		{Number: 10, MergeCommit: "abc", LinkedIssues: []ghIssue{withinRelease}},
+		{Number: 11, MergeCommit: "def", LinkedIssues: []ghIssue{closedByLaterPR}},
+	}
+
+	filter := issuesClosedWithinCommits(prs, []string{"abc", "123"})
+
+	assert.True(t, filter(withinRelease))
+	assert.False(t, filter(closedByLaterPR))
+	assert.False(t, filter(closedManually))
+}
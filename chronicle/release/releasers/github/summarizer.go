@@ -2,8 +2,11 @@ package github
 
 import (
 	"fmt"
-	"net/url"
+	"net/http"
 	"strings"
+	"time"
+
+	"github.com/shurcooL/githubv4"
 
 	"github.com/anchore/chronicle/chronicle/release"
 	"github.com/anchore/chronicle/chronicle/release/change"
@@ -18,10 +21,15 @@ const (
 )
 
 var _ release.Summarizer = (*Summarizer)(nil)
+var _ release.DraftSummarizer = (*Summarizer)(nil)
+var _ release.ReleaseLister = (*Summarizer)(nil)
 
 type Config struct {
-	Host                            string
+	Host                            string // the github host used for all web URLs (e.g. github.com or a GitHub Enterprise Server host)
+	APIURL                          string // the REST API base URL (e.g. https://api.github.com or https://github.example.com/api/v3)
 	IncludeIssuePRAuthors           bool
+	OmitPRAuthors                   bool // do not credit the author of each PR entry (as a reference)
+	IncludeIssueAssignees           bool // credit the assignees of each issue entry (as references)
 	IncludeIssues                   bool
 	IncludeIssuePRs                 bool
 	IncludeIssuesClosedAsNotPlanned bool
@@ -29,16 +37,55 @@ type Config struct {
 	IncludeUnlabeledIssues          bool
 	IncludeUnlabeledPRs             bool
 	ExcludeLabels                   []string
+	ExcludeAuthors                  []string // remove the changes authored by any of these logins or globs (e.g. "dependabot[bot]" or "*-bot")
+	IncludeAuthors                  []string // when given, only keep the changes authored by any of these logins or globs
 	ChangeTypesByLabel              change.TypeSet
 	IssuesRequireLinkedPR           bool
 	ConsiderPRMergeCommits          bool
+	ChangeWindow                    string // how the changes within the release are determined: "timestamp" (default) or "reachability"
+	AssociateCommits                bool   // resolve the commits within the release to the PRs they originate from (with ConsiderPRMergeCommits)
+	CancelReverts                   bool
+	LinkIssuesByTimeline            bool
+	ClusterIssuePRs                 bool
+	RollupDepth                     int
+	IncrementalFetch                bool              // only fetch the PRs and issues updated since the start of the release
+	ReleaseNoteBlock                string            // the info string of a fenced block within a PR body whose content replaces the title (e.g. "release-note")
+	ReleaseNoteHeading              string            // the markdown heading within a PR body whose section replaces the title (e.g. "Release Notes")
+	Token                           string            // an explicit github token (see ResolveToken for the other sources of a token)
+	TokenFile                       string            // the path to a file containing a github token
+	MaxRateLimitWait                time.Duration     // the total time to wait on rate limits before failing
+	PageSize                        int               // the number of PRs or issues fetched per request (1-100)
+	MaxPages                        int               // the maximum number of pages of PRs or issues to fetch (0 for no limit)
+	ExposeRaw                       bool              // attach the REST API payload of each issue and PR to the changes (see change.Change.Raw)
+	App                             AppAuth           // authenticate as a GitHub App installation instead of with GITHUB_TOKEN
+	PreferTitleFrom                 string            // which entry to keep when both an issue and the PR that closed it are in the changelog: "issue" (default) or "pr"
+	CacheDir                        string            // the directory to cache API responses in (empty to disable caching)
+	CacheTTL                        time.Duration     // how long a cached response is used before it is revalidated (or re-fetched); a positive TTL may serve stale GraphQL responses
+	Milestone                       string            // select changes by the title of a milestone ("{version}" is replaced with the release version)
+	MilestoneMode                   string            // how the milestone selects changes: "replace" (default) ignores the release tags, "filter" narrows the changes within the release tags
+	Warnings                        *release.Warnings // collects the changes that were skipped and the results that were cut short (optional)
+	Discussions                     Discussions       // the discussions (e.g. announcements) to include in the changelog (optional)
+	Advisories                      Advisories        // the security advisories to include in the changelog (optional)
+	DependencyUpdates               DependencyUpdates // collapse the PRs from dependency update bots into a single entry (optional)
+	TargetBranch                    string            // only keep the PRs merged into this branch, either the name or a glob (e.g. "main" or "release/*") (optional)
+	Paths                           []string          // only keep the PRs that changed files matching any of these globs (e.g. "services/api/**"), for a changelog of one component of a monorepo (optional)
+	HTTPClient                      *http.Client      // the client that API requests are made with, e.g. for a proxy or instrumentation (optional)
+	Record                          *Bundle           // add every API response to this bundle, for replaying the run without network access (optional)
+	Replay                          *Bundle           // serve every API response from this bundle instead of making requests, e.g. on an air-gapped machine (optional)
+	Clock                           release.Clock     // the source of the current time for expiring cached responses (the system clock when nil). Rate limit waits and app tokens always use the system clock.
 }
 
+// Summarizer builds changes from the merged PRs and closed issues of a github repo. A summarizer is safe for concurrent
+// use: all state for a set of changes is local to the call, and the rate limit of the github API is tracked per
+// summarizer (shared by all of its requests), so separate summarizers (e.g. one per tenant or token) do not affect each
+// other.
 type Summarizer struct {
-	git      git.Interface
-	userName string
-	repoName string
-	config   Config
+	git        git.Interface
+	client     *githubv4.Client
+	httpClient *http.Client // for REST API requests
+	userName   string
+	repoName   string
+	config     Config
 }
 
 func NewSummarizer(gitter git.Interface, config Config) (*Summarizer, error) {
@@ -52,18 +99,37 @@ func NewSummarizer(gitter git.Interface, config Config) (*Summarizer, error) {
 		return nil, fmt.Errorf("failed to extract owner and repo from %q", repoURL)
 	}
 
-	log.WithFields("owner", user, "repo", repo).Debug("github summarizer")
+	if config.Host == "" {
+		config.Host = defaultHost
+	}
+
+	if remoteHost := extractGithubHost(repoURL); remoteHost != "" && remoteHost != config.Host {
+		log.Warnf("git remote host %q does not match the configured github host %q (set github.host for GitHub Enterprise Server)", remoteHost, config.Host)
+	}
+
+	if config.APIURL == "" {
+		config.APIURL = DefaultAPIURL(config.Host)
+	}
+
+	log.WithFields("owner", user, "repo", repo, "host", config.Host, "api", config.APIURL).Debug("github summarizer")
+
+	httpClient, err := newHTTPClient(config, user, repo)
+	if err != nil {
+		return nil, err
+	}
 
 	return &Summarizer{
-		git:      gitter,
-		userName: user,
-		repoName: repo,
-		config:   config,
+		git:        gitter,
+		client:     newGraphQLClient(config.APIURL, httpClient),
+		httpClient: httpClient,
+		userName:   user,
+		repoName:   repo,
+		config:     config,
 	}, nil
 }
 
 func (s *Summarizer) Release(ref string) (*release.Release, error) {
-	targetRelease, err := fetchRelease(s.userName, s.repoName, ref)
+	targetRelease, err := fetchRelease(s.client, s.userName, s.repoName, ref)
 	if err != nil {
 		return nil, err
 	}
@@ -71,8 +137,10 @@ func (s *Summarizer) Release(ref string) (*release.Release, error) {
 		return nil, nil
 	}
 	return &release.Release{
-		Version: targetRelease.Tag,
-		Date:    targetRelease.Date,
+		Version:    targetRelease.Tag,
+		Date:       targetRelease.Date,
+		Draft:      targetRelease.IsDraft,
+		Prerelease: targetRelease.IsPrerelease,
 	}, nil
 }
 
@@ -84,8 +152,16 @@ func (s *Summarizer) ChangesURL(sinceRef, untilRef string) string {
 	return fmt.Sprintf("https://%s/%s/%s/compare/%s...%s", s.config.Host, s.userName, s.repoName, sinceRef, untilRef)
 }
 
+func (s *Summarizer) pagination() pagination {
+	return pagination{
+		PageSize: s.config.PageSize,
+		MaxPages: s.config.MaxPages,
+		Warnings: s.config.Warnings,
+	}
+}
+
 func (s *Summarizer) LastRelease() (*release.Release, error) {
-	releases, err := fetchAllReleases(s.userName, s.repoName)
+	releases, err := fetchAllReleases(s.client, s.userName, s.repoName)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch all releases: %v", err)
 	}
@@ -99,11 +175,60 @@ func (s *Summarizer) LastRelease() (*release.Release, error) {
 	return nil, fmt.Errorf("unable to find latest release")
 }
 
+// Releases returns the published (that is, non-draft) releases, oldest first, excluding the release for the tag at HEAD.
+func (s *Summarizer) Releases() ([]release.Release, error) {
+	releases, err := fetchAllReleases(s.client, s.userName, s.repoName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch all releases: %v", err)
+	}
+
+	// a release for the tag at HEAD is the release being described, so cannot be where the changelog starts from
+	headTag, err := s.git.HeadTag()
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine head tag: %w", err)
+	}
+
+	results := []release.Release{}
+	for _, r := range releases {
+		if r.IsDraft {
+			continue
+		}
+		if headTag != "" && r.Tag == headTag {
+			log.Tracef("skipping release=%q as a last release candidate: tag is at HEAD", headTag)
+			continue
+		}
+		results = append(results, release.Release{
+			Version:    r.Tag,
+			Date:       r.Date,
+			Prerelease: r.IsPrerelease,
+		})
+	}
+	return results, nil
+}
+
+func (s *Summarizer) LatestDraftRelease() (*release.Release, error) {
+	releases, err := fetchAllReleases(s.client, s.userName, s.repoName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch all releases: %v", err)
+	}
+	draft := latestDraftRelease(releases)
+	if draft == nil {
+		return nil, nil
+	}
+	return &release.Release{
+		Version: draft.Tag,
+		Date:    draft.CreatedAt,
+		Draft:   true,
+	}, nil
+}
+
 // nolint:funlen
 func (s *Summarizer) Changes(sinceRef, untilRef string) ([]change.Change, error) {
 	var changes []change.Change
 	var err error
 
+	config := s.config
+
 	var includeStart, includeEnd bool
 
 	var sinceTag *git.Tag
@@ -134,9 +259,39 @@ func (s *Summarizer) Changes(sinceRef, untilRef string) ([]change.Change, error)
 		includeEnd = true
 	}
 
+	// discussions and advisories are not part of the git history, so are always selected by the timestamps of the
+	// release tags
+	var publishedSince, publishedUntil *time.Time
+	if sinceTag != nil {
+		publishedSince = &sinceTag.Timestamp
+	}
+	if untilTag != nil {
+		publishedUntil = &untilTag.Timestamp
+	}
+
+	milestone := resolveMilestone(config.Milestone, untilHash)
+	if milestone != "" {
+		log.WithFields("milestone", milestone, "mode", config.MilestoneMode).Debug("selecting changes by milestone")
+		if config.MilestoneMode != MilestoneFiltersRange {
+			// the milestone alone determines what is in the release, so the tags (and the commits between them) are
+			// not considered
+			sinceTag, untilTag = nil, nil
+			config.ConsiderPRMergeCommits = false
+			config.CancelReverts = false
+			config.ChangeWindow = TimestampWindow
+		}
+	}
+
+	byReachability := config.ChangeWindow == ReachabilityWindow
+	if byReachability {
+		// PRs are selected only by whether their merge commit is within the release (see applyPRFilters)
+		config.ConsiderPRMergeCommits = true
+	}
+
 	var includeCommits []string
-	if s.config.ConsiderPRMergeCommits {
-		includeCommits, err = s.git.CommitsBetween(git.Range{
+	var commitLog []git.Commit
+	if config.ConsiderPRMergeCommits || config.CancelReverts {
+		commitLog, err = s.git.CommitLogBetween(git.Range{
 			SinceRef:     sinceHash,
 			UntilRef:     untilHash,
 			IncludeStart: includeStart,
@@ -146,51 +301,170 @@ func (s *Summarizer) Changes(sinceRef, untilRef string) ([]change.Change, error)
 			return nil, fmt.Errorf("unable to fetch commit range: %v", err)
 		}
 
+		for _, c := range commitLog {
+			includeCommits = append(includeCommits, c.Hash)
+		}
+
 		log.Debugf("release comprised of %d commits", len(includeCommits))
 		logCommits(includeCommits)
 	}
 
-	allMergedPRs, err := fetchMergedPRs(s.userName, s.repoName)
+	// PRs and issues are fetched most recently updated first, so when possible stop fetching once reaching entities
+	// that have not been updated since the start of the release
+	var fetchSince *time.Time
+	if config.IncrementalFetch && sinceTag != nil {
+		fetchSince = &sinceTag.Timestamp
+	}
+
+	allMergedPRs, err := fetchMergedPRs(s.client, s.userName, s.repoName, fetchSince, s.pagination())
 	if err != nil {
 		return nil, err
 	}
 
 	log.Debugf("total merged PRs discovered: %d", len(allMergedPRs))
 
-	if s.config.IncludePRs {
-		changes = append(changes, changesFromStandardPRFilters(s.config, allMergedPRs, sinceTag, untilTag, includeCommits)...)
+	if config.TargetBranch != "" {
+		allMergedPRs, _ = filterPRs(allMergedPRs, prsMergedInto(config.TargetBranch))
+		log.Debugf("merged PRs into %q: %d", config.TargetBranch, len(allMergedPRs))
 	}
 
-	allClosedIssues, err := fetchClosedIssues(s.userName, s.repoName)
-	if err != nil {
-		return nil, err
+	var allClosedIssues []ghIssue
+	if s.needsClosedIssues() {
+		allClosedIssues, err = fetchClosedIssues(s.client, s.userName, s.repoName, fetchSince, s.pagination())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if config.LinkIssuesByTimeline {
+		closers, err := fetchIssueClosers(s.client, s.userName, s.repoName, fetchSince, s.pagination())
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch issue timelines: %w", err)
+		}
+		allMergedPRs = linkIssuesByTimeline(allMergedPRs, allClosedIssues, closers)
+	}
+
+	if config.AssociateCommits && config.ConsiderPRMergeCommits {
+		associated, err := associateCommits(allMergedPRs, includeCommits, newCommitPRFetcher(s.httpClient, config.APIURL, s.userName, s.repoName).mergedPRs)
+		if err != nil {
+			return nil, fmt.Errorf("unable to associate commits with PRs: %w", err)
+		}
+		includeCommits = append(includeCommits, associated...)
 	}
 
-	if !s.config.IncludeIssuesClosedAsNotPlanned {
+	if byReachability {
+		// the commits within the release (from the local git history) determine what is in the release instead of
+		// the timestamps of the release tags
+		allClosedIssues = filterIssues(allClosedIssues, issuesClosedWithinCommits(allMergedPRs, includeCommits))
+		sinceTag, untilTag = nil, nil
+	}
+
+	if milestone != "" {
+		allMergedPRs, _ = filterPRs(allMergedPRs, prsInMilestone(milestone))
+		allClosedIssues = filterIssues(allClosedIssues, issuesInMilestone(milestone))
+		if len(allMergedPRs) == 0 && len(allClosedIssues) == 0 {
+			log.Warnf("no merged PRs or closed issues found in milestone %q", milestone)
+		}
+	}
+
+	if config.CancelReverts {
+		allMergedPRs = cancelRevertedPRs(config, allMergedPRs, sinceTag, untilTag, includeCommits, commitLog)
+	}
+
+	if len(config.Paths) > 0 {
+		// only the files of the PRs within the release are looked up (the remaining PRs are filtered out regardless)
+		candidates := applyPRFilters(allMergedPRs, config, sinceTag, untilTag, includeCommits)
+		touching, err := prsTouchingPaths(config.Paths, candidates, newPRFilesFetcher(s.httpClient, config.APIURL, s.userName, s.repoName).files)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch the files changed by PRs: %w", err)
+		}
+		allMergedPRs, _ = filterPRs(allMergedPRs, prsWithNumbers(touching))
+		// note: issues are not associated with files, so only the issues linked to the remaining PRs are kept
+		allClosedIssues = filterIssues(allClosedIssues, issuesLinkedToPRs(allMergedPRs))
+	}
+
+	if config.IncludePRs {
+		changes = append(changes, changesFromStandardPRFilters(config, allMergedPRs, sinceTag, untilTag, includeCommits)...)
+	}
+
+	if !config.IncludeIssuesClosedAsNotPlanned {
 		allClosedIssues = filterIssues(allClosedIssues, excludeIssuesNotPlanned(allMergedPRs))
 	}
 
 	log.Debugf("total closed issues discovered: %d", len(allClosedIssues))
 
-	if s.config.IncludeIssues {
-		if s.config.IssuesRequireLinkedPR {
-			changes = append(changes, changesFromIssuesLinkedToPrs(s.config, allMergedPRs, sinceTag, untilTag, includeCommits)...)
+	if config.IncludeIssues {
+		if config.IssuesRequireLinkedPR {
+			changes = append(changes, changesFromIssuesLinkedToPrs(config, allMergedPRs, sinceTag, untilTag, includeCommits)...)
 		} else {
-			changes = append(changes, changesFromIssues(s.config, allMergedPRs, allClosedIssues, sinceTag, untilTag)...)
+			changes = append(changes, changesFromIssues(config, allMergedPRs, allClosedIssues, sinceTag, untilTag)...)
 		}
 	}
 
-	if s.config.IncludeUnlabeledIssues {
-		changes = append(changes, changesFromUnlabeledIssues(s.config, allMergedPRs, allClosedIssues, sinceTag, untilTag)...)
+	if config.IncludeUnlabeledIssues {
+		changes = append(changes, changesFromUnlabeledIssues(config, allMergedPRs, allClosedIssues, sinceTag, untilTag)...)
+	}
+
+	if config.IncludeUnlabeledPRs {
+		changes = append(changes, changesFromUnlabeledPRs(config, allMergedPRs, sinceTag, untilTag, includeCommits)...)
 	}
 
-	if s.config.IncludeUnlabeledPRs {
-		changes = append(changes, changesFromUnlabeledPRs(s.config, allMergedPRs, sinceTag, untilTag, includeCommits)...)
+	changes = dedupeLinkedChanges(changes, config.PreferTitleFrom)
+
+	if config.DependencyUpdates.Group {
+		changes = groupDependencyUpdates(config.DependencyUpdates, changes)
+	}
+
+	if config.Discussions.Enabled() {
+		allDiscussions, err := fetchDiscussions(s.client, s.userName, s.repoName, publishedSince, s.pagination())
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch discussions: %w", err)
+		}
+		log.Debugf("total discussions discovered: %d", len(allDiscussions))
+		changes = append(changes, createChangesFromDiscussions(config, selectDiscussions(config.Discussions, allDiscussions, publishedSince, publishedUntil))...)
+	}
+
+	if config.Advisories.Include {
+		allAdvisories, err := newAdvisoryFetcher(s.httpClient, config.APIURL, s.userName, s.repoName).published(publishedSince, s.pagination())
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch security advisories: %w", err)
+		}
+		log.Debugf("total security advisories discovered: %d", len(allAdvisories))
+		changes = append(changes, createChangesFromAdvisories(config, selectAdvisories(allAdvisories, publishedSince, publishedUntil))...)
+	}
+
+	if config.RollupDepth > 0 {
+		parents, err := fetchIssueParents(s.client, s.userName, s.repoName, config.RollupDepth)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch tracking issues: %w", err)
+		}
+		changes = rollupChanges(changes, parents, config.RollupDepth)
+	}
+
+	if config.ExposeRaw {
+		if err := newRawFetcher(s.httpClient, config.APIURL, s.userName, s.repoName).attach(changes); err != nil {
+			return nil, fmt.Errorf("unable to fetch raw API payloads: %w", err)
+		}
 	}
 
 	return changes, nil
 }
 
+// needsClosedIssues indicates if the closed issues of the repo are used, otherwise only the issues linked to PRs are
+// considered (which are fetched along with the PRs).
+func (s *Summarizer) needsClosedIssues() bool {
+	return (s.config.IncludeIssues && !s.config.IssuesRequireLinkedPR) || s.config.IncludeUnlabeledIssues || s.config.LinkIssuesByTimeline
+}
+
+// cancelRevertedPRs removes PRs that were reverted within the release, along with the PRs that reverted them. Changes
+// that were reverted and then re-landed are shown once, referencing the final landing PR.
+func cancelRevertedPRs(config Config, allMergedPRs []ghPullRequest, sinceTag, untilTag *git.Tag, includeCommits []string, commitLog []git.Commit) []ghPullRequest {
+	candidates := applyPRFilters(allMergedPRs, config, sinceTag, untilTag, includeCommits)
+	chains := findRevertChains(candidates, commitLog)
+	logRevertChains(chains)
+	return resolveRevertChains(allMergedPRs, chains)
+}
+
 func logCommits(commits []string) {
 	for idx, commit := range commits {
 		var branch = treeBranch
@@ -204,12 +478,15 @@ func logCommits(commits []string) {
 func issuesExtractedFromPRs(config Config, allMergedPRs []ghPullRequest, sinceTag, untilTag *git.Tag, includeCommits []string) []ghIssue {
 	// this represents the traits we wish to filter down to (not out).
 	prFilters := []prFilter{
-		prsAfter(sinceTag.Timestamp.UTC()),
 		// PRs with these labels should explicitly be used in the changelog directly (not the corresponding linked issue)
 		prsWithoutLabel(config.ChangeTypesByLabel.Names()...),
 		prsWithClosedLinkedIssue(),
 	}
 
+	if sinceTag != nil {
+		prFilters = append([]prFilter{prsAfter(sinceTag.Timestamp.UTC())}, prFilters...)
+	}
+
 	if untilTag != nil {
 		prFilters = append(prFilters, prsAtOrBefore(untilTag.Timestamp.UTC()))
 	}
@@ -219,9 +496,13 @@ func issuesExtractedFromPRs(config Config, allMergedPRs []ghPullRequest, sinceTa
 
 	// this represents the traits we wish to filter down to (not out).
 	issueFilters := []issueFilter{
-		issuesAfter(sinceTag.Timestamp),
 		issuesWithLabel(config.ChangeTypesByLabel.Names()...),
 		issuesWithoutLabel(config.ExcludeLabels...),
+		issuesWithAllowedAuthor(config),
+	}
+
+	if sinceTag != nil {
+		issueFilters = append([]issueFilter{issuesAfter(sinceTag.Timestamp)}, issueFilters...)
 	}
 
 	if untilTag != nil {
@@ -249,7 +530,8 @@ func uniqueIssuesFromPRs(prs []ghPullRequest) []ghIssue {
 func changesFromStandardPRFilters(config Config, allMergedPRs []ghPullRequest, sinceTag, untilTag *git.Tag, includeCommits []string) []change.Change {
 	includedPRs := applyStandardPRFilters(allMergedPRs, config, sinceTag, untilTag, includeCommits)
 
-	includedPRs, _ = filterPRs(includedPRs, prsWithChangeTypes(config))
+	includedPRs, removedPRs := filterPRs(includedPRs, prsWithChangeTypes(config))
+	warnSkippedPRs(config, removedPRs)
 
 	log.Debugf("PRs contributing to changelog: %d", len(includedPRs))
 	logPRs(includedPRs)
@@ -257,6 +539,22 @@ func changesFromStandardPRFilters(config Config, allMergedPRs []ghPullRequest, s
 	return createChangesFromPRs(config, includedPRs)
 }
 
+// warnSkippedPRs raises a warning for the PRs within the release that are left out of the changelog since they have no
+// change type label (unless they are included as unlabeled changes instead).
+func warnSkippedPRs(config Config, removed []ghPullRequest) {
+	var numbers []string
+	for _, pr := range removed {
+		if config.IncludeUnlabeledPRs && len(pr.Labels) == 0 {
+			continue
+		}
+		numbers = append(numbers, fmt.Sprintf("#%d", pr.Number))
+	}
+	if len(numbers) == 0 {
+		return
+	}
+	config.Warnings.Add(release.SkippedChangeWarning, "skipped %d merged PR(s) without a change type label: %s", len(numbers), strings.Join(numbers, ", "))
+}
+
 func createChangesFromPRs(config Config, prs []ghPullRequest) []change.Change {
 	var summaries []change.Change
 	for _, pr := range prs {
@@ -265,28 +563,99 @@ func createChangesFromPRs(config Config, prs []ghPullRequest) []change.Change {
 		if len(changeTypes) == 0 {
 			changeTypes = change.UnknownTypes
 		}
+		traceIncluded(prSubject(pr.Number), "as %s", typeNames(changeTypes))
+
+		references := []change.Reference{
+			{
+				Text: fmt.Sprintf("PR #%d", pr.Number),
+				URL:  pr.URL,
+			},
+		}
+		if !config.OmitPRAuthors && pr.Author != "" {
+			references = append(references, authorReference(config, pr.Author, pr.AuthorIsBot))
+		}
+		authors := prAuthors(config, pr)
+
+		// large PRs may declare several entries within the PR body, each with their own change type
+		if entries := parseChangelogEntries(pr.Body); len(entries) > 0 {
+			log.Tracef("PR #%d split into %d changelog entries", pr.Number, len(entries))
+			for _, entry := range entries {
+				// note: each entry has its own copy of the references and authors, so that modifying one entry (e.g.
+				// when combining changes) never affects the other entries of the PR
+				summaries = append(summaries, change.Change{
+					Text:        entry.Text,
+					ChangeTypes: changeTypesForEntry(config, entry),
+					Timestamp:   pr.MergedAt,
+					References:  append([]change.Reference(nil), references...),
+					EntryType:   "githubPR",
+					Entry:       pr,
+					Identities:  prIdentities(pr),
+					Authors:     append([]change.Reference(nil), authors...),
+				})
+			}
+			continue
+		}
 
 		summaries = append(summaries, change.Change{
-			Text:        pr.Title,
+			Text:        prText(config, pr),
 			ChangeTypes: changeTypes,
 			Timestamp:   pr.MergedAt,
-			References: []change.Reference{
-				{
-					Text: fmt.Sprintf("PR #%d", pr.Number),
-					URL:  pr.URL,
-				},
-				{
-					Text: pr.Author,
-					URL:  fmt.Sprintf("https://%s/%s", config.Host, pr.Author),
-				},
-			},
-			EntryType: "githubPR",
-			Entry:     pr,
+			References:  references,
+			EntryType:   "githubPR",
+			Entry:       pr,
+			Identities:  prIdentities(pr),
+			Authors:     authors,
 		})
 	}
 	return summaries
 }
 
+// prAuthors returns the author of a PR (as the people who made a change derived from the PR).
+func prAuthors(config Config, prs ...ghPullRequest) (authors []change.Reference) {
+	for _, pr := range prs {
+		if pr.Author == "" {
+			continue
+		}
+		authors = append(authors, authorReference(config, pr.Author, pr.AuthorIsBot))
+	}
+	return authors
+}
+
+// prText returns the release note declared within the PR body, falling back to the PR title.
+func prText(config Config, pr ghPullRequest) string {
+	if note := parseReleaseNote(pr.Body, config.ReleaseNoteBlock, config.ReleaseNoteHeading); note != "" {
+		log.Tracef("using release note from PR #%d body", pr.Number)
+		return note
+	}
+	return pr.Title
+}
+
+// issueText returns the issue title, unless the issue is implemented by a single PR with a release note, in which case
+// the release note is used (clustered issues keep their title since each PR is listed beneath it).
+func issueText(config Config, issue ghIssue, linkedPRs []ghPullRequest, clustered bool) string {
+	if clustered || len(linkedPRs) != 1 {
+		return issue.Title
+	}
+	if note := parseReleaseNote(linkedPRs[0].Body, config.ReleaseNoteBlock, config.ReleaseNoteHeading); note != "" {
+		log.Tracef("using release note from PR #%d body for issue #%d", linkedPRs[0].Number, issue.Number)
+		return note
+	}
+	return issue.Title
+}
+
+// prIdentities returns the identities of the entities a PR change was derived from (the PR, its merge commit, and
+// any linked issues).
+func prIdentities(pr ghPullRequest) []string {
+	ids := []string{fmt.Sprintf("github-pr:%d", pr.Number)}
+	if pr.MergeCommit != "" {
+		ids = append(ids, "commit:"+pr.MergeCommit)
+	}
+	for _, issue := range pr.LinkedIssues {
+		ids = append(ids, fmt.Sprintf("github-issue:%d", issue.Number))
+	}
+	return ids
+}
+
 func logPRs(prs []ghPullRequest) {
 	for idx, pr := range prs {
 		var branch = treeBranch
@@ -336,6 +705,7 @@ func changesFromUnlabeledPRs(config Config, allMergedPRs []ghPullRequest, sinceT
 	filters := []prFilter{
 		prsWithoutLabels(),
 		prsWithoutLinkedIssues(),
+		prsWithAllowedAuthor(config),
 	}
 
 	filters = append(filters, standardChronologicalPrFilters(config, sinceTag, untilTag, includeCommits)...)
@@ -351,7 +721,7 @@ func changesFromUnlabeledIssues(config Config, allMergedPRs []ghPullRequest, all
 	// this represents the traits we wish to filter down to (not out).
 	filters := standardChronologicalIssueFilters(sinceTag, untilTag)
 
-	filters = append(filters, issuesWithoutLabels())
+	filters = append(filters, issuesWithoutLabels(), issuesWithAllowedAuthor(config))
 
 	filteredIssues := filterIssues(allIssues, filters...)
 
@@ -367,6 +737,7 @@ func createChangesFromIssues(config Config, allMergedPRs []ghPullRequest, issues
 		if len(changeTypes) == 0 {
 			changeTypes = change.UnknownTypes
 		}
+		traceIncluded(issueSubject(issue.Number), "as %s", typeNames(changeTypes))
 
 		references := []change.Reference{
 			{
@@ -374,36 +745,78 @@ func createChangesFromIssues(config Config, allMergedPRs []ghPullRequest, issues
 				URL:  issue.URL,
 			},
 		}
+		if config.IncludeIssueAssignees {
+			for _, assignee := range issue.Assignees {
+				references = append(references, authorReference(config, assignee, false))
+			}
+		}
 
-		if config.IncludeIssuePRs || config.IncludeIssuePRAuthors {
-			for _, pr := range getLinkedPRs(allMergedPRs, issue) {
-				if config.IncludeIssuePRs {
-					references = append(references, change.Reference{
-						Text: fmt.Sprintf("PR #%d", pr.Number),
-						URL:  pr.URL,
-					})
-				}
-				if config.IncludeIssuePRAuthors && pr.Author != "" {
-					references = append(references, change.Reference{
-						Text: pr.Author,
-						URL:  fmt.Sprintf("https://%s/%s", config.Host, pr.Author),
-					})
-				}
+		// excluded PRs (by label or author) are neither listed nor referenced, even when the issue they implement is included
+		linkedPRs, _ := filterPRs(getLinkedPRs(allMergedPRs, issue), prsWithoutLabel(config.ExcludeLabels...), prsWithAllowedAuthor(config))
+
+		var children []change.Change
+		if config.ClusterIssuePRs && len(linkedPRs) > 1 {
+			// the issue becomes the parent entry, with each PR that implements it listed beneath it
+			log.Tracef("issue #%d clustered with %d PRs", issue.Number, len(linkedPRs))
+			for _, pr := range linkedPRs {
+				children = append(children, change.Change{
+					Text:        prText(config, pr),
+					ChangeTypes: changeTypes,
+					Timestamp:   pr.MergedAt,
+					References:  issuePRReferences(config, pr),
+					EntryType:   "githubPR",
+					Entry:       pr,
+					Authors:     prAuthors(config, pr),
+				})
+			}
+		} else {
+			for _, pr := range linkedPRs {
+				references = append(references, issuePRReferences(config, pr)...)
 			}
 		}
 
 		changes = append(changes, change.Change{
-			Text:        issue.Title,
+			Text:        issueText(config, issue, linkedPRs, len(children) > 0),
 			ChangeTypes: changeTypes,
 			Timestamp:   issue.ClosedAt,
 			References:  references,
 			EntryType:   "githubIssue",
 			Entry:       issue,
+			Children:    children,
+			Identities:  issueIdentities(issue, linkedPRs),
+			Authors:     prAuthors(config, linkedPRs...),
 		})
 	}
 	return changes
 }
 
+// issueIdentities returns the identities of the entities an issue change was derived from (the issue, along with
+// the PRs and merge commits that implemented it).
+func issueIdentities(issue ghIssue, linkedPRs []ghPullRequest) []string {
+	ids := []string{fmt.Sprintf("github-issue:%d", issue.Number)}
+	for _, pr := range linkedPRs {
+		ids = append(ids, fmt.Sprintf("github-pr:%d", pr.Number))
+		if pr.MergeCommit != "" {
+			ids = append(ids, "commit:"+pr.MergeCommit)
+		}
+	}
+	return ids
+}
+
+// issuePRReferences returns the references for a PR linked to an issue entry.
+func issuePRReferences(config Config, pr ghPullRequest) (references []change.Reference) {
+	if config.IncludeIssuePRs {
+		references = append(references, change.Reference{
+			Text: fmt.Sprintf("PR #%d", pr.Number),
+			URL:  pr.URL,
+		})
+	}
+	if config.IncludeIssuePRAuthors && pr.Author != "" {
+		references = append(references, authorReference(config, pr.Author, pr.AuthorIsBot))
+	}
+	return references
+}
+
 func getLinkedPRs(allMergedPRs []ghPullRequest, issue ghIssue) (linked []ghPullRequest) {
 	for _, pr := range allMergedPRs {
 		for _, linkedIssue := range pr.LinkedIssues {
@@ -415,35 +828,22 @@ func getLinkedPRs(allMergedPRs []ghPullRequest, issue ghIssue) (linked []ghPullR
 	return linked
 }
 
+// extractGithubUserAndRepo returns the owner and repo name from the given remote URL, for scp-like addresses (e.g.
+// git@github.com:anchore/chronicle.git) and URLs with any scheme, user, or port (e.g. https://github.com/anchore/chronicle
+// or ssh://git@github.example.com:7999/anchore/chronicle.git), with or without the ".git" suffix.
 func extractGithubUserAndRepo(u string) (string, string) {
-	switch {
-	// e.g. git@github.com:anchore/chronicle.git
-	case strings.HasPrefix(u, "git@"):
-		fields := strings.Split(u, ":")
-		pair := strings.Split(fields[len(fields)-1], "/")
-
-		if len(pair) != 2 {
-			return "", ""
-		}
-
-		return pair[0], strings.TrimSuffix(pair[1], ".git")
-
-	// https://github.com/anchore/chronicle.git
-	case strings.HasPrefix(u, "https://") || strings.HasPrefix(u, "http://"):
-		urlObj, err := url.Parse(u)
-		if err != nil {
-			return "", ""
-		}
+	p := strings.TrimSuffix(git.RemotePath(u), ".git")
 
-		fields := strings.Split(strings.TrimPrefix(urlObj.Path, "/"), "/")
-
-		if len(fields) != 2 {
-			return "", ""
-		}
-
-		return fields[0], strings.TrimSuffix(fields[1], ".git")
+	fields := strings.Split(p, "/")
+	if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+		return "", ""
 	}
-	return "", ""
+	return fields[0], fields[1]
+}
+
+// extractGithubHost returns the host (without any port) of the given remote URL.
+func extractGithubHost(u string) string {
+	return git.RemoteHost(u)
 }
 
 func standardIssueFilters(config Config, sinceTag, untilTag *git.Tag) []issueFilter {
@@ -451,6 +851,7 @@ func standardIssueFilters(config Config, sinceTag, untilTag *git.Tag) []issueFil
 	filters := []issueFilter{
 		issuesWithLabel(config.ChangeTypesByLabel.Names()...),
 		issuesWithoutLabel(config.ExcludeLabels...),
+		issuesWithAllowedAuthor(config),
 	}
 
 	filters = append(filters, standardChronologicalIssueFilters(sinceTag, untilTag)...)
@@ -472,15 +873,19 @@ func standardChronologicalIssueFilters(sinceTag, untilTag *git.Tag) (filters []i
 
 func standardQualitativePrFilters(config Config) []prFilter {
 	// this represents the traits we wish to filter down to (not out).
-	return []prFilter{
+	filters := []prFilter{
 		prsWithLabel(config.ChangeTypesByLabel.Names()...),
 		prsWithoutLabel(config.ExcludeLabels...),
-		// Merged PRs linked to closed issues should be hidden so that the closed issue title takes precedence over the pr title
-		prsWithoutClosedLinkedIssue(),
+		prsWithAllowedAuthor(config),
 		// Merged PRs with open issues indicates a partial implementation. When the last PR is merged for the issue
 		// then the feature should be included (by the pr, not the set of PRs)
 		prsWithoutOpenLinkedIssue(),
 	}
+	if config.PreferTitleFrom != PreferPRTitle {
+		// Merged PRs linked to closed issues should be hidden so that the closed issue title takes precedence over the pr title
+		filters = append(filters, prsWithoutClosedLinkedIssue())
+	}
+	return filters
 }
 
 func standardChronologicalPrFilters(config Config, sinceTag, untilTag *git.Tag, commits []string) []prFilter {
@@ -2,13 +2,20 @@ package github
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/anchore/chronicle/chronicle/release"
 	"github.com/anchore/chronicle/chronicle/release/change"
 	"github.com/anchore/chronicle/internal/git"
 )
@@ -35,6 +42,47 @@ func Test_extractGithubUserAndRepo(t *testing.T) {
 			user: "someone",
 			repo: "project",
 		},
+		{
+			url:  "git@github.example.com:someone/project.git",
+			user: "someone",
+			repo: "project",
+		},
+		{
+			url:  "ssh://git@github.example.com:7999/someone/project.git",
+			user: "someone",
+			repo: "project",
+		},
+		{
+			url:  "https://github.com/someone/project",
+			user: "someone",
+			repo: "project",
+		},
+		{
+			url:  "https://github.example.com:8443/someone/project/",
+			user: "someone",
+			repo: "project",
+		},
+		{
+			url:  "ssh://git@github.com/someone/project",
+			user: "someone",
+			repo: "project",
+		},
+		{
+			url:  "org-123@github.com:someone/project.git",
+			user: "someone",
+			repo: "project",
+		},
+		{
+			url:  "github.com:someone/project",
+			user: "someone",
+			repo: "project",
+		},
+		{
+			url: "https://github.com/someone",
+		},
+		{
+			url: "/some/local/path",
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.url, func(t *testing.T) {
@@ -45,6 +93,61 @@ func Test_extractGithubUserAndRepo(t *testing.T) {
 	}
 }
 
+func Test_extractGithubHost(t *testing.T) {
+	tests := []struct {
+		url  string
+		host string
+	}{
+		{
+			url:  "git@github.com:someone/project.git",
+			host: "github.com",
+		},
+		{
+			url:  "https://github.example.com/someone/project.git",
+			host: "github.example.com",
+		},
+		{
+			url:  "ssh://git@github.example.com:7999/someone/project.git",
+			host: "github.example.com",
+		},
+		{
+			url:  "/some/local/path",
+			host: "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.url, func(t *testing.T) {
+			assert.Equal(t, test.host, extractGithubHost(test.url))
+		})
+	}
+}
+
+func Test_APIURLs(t *testing.T) {
+	tests := []struct {
+		host       string
+		apiURL     string
+		graphQLURL string
+	}{
+		{
+			host:       "github.com",
+			apiURL:     "https://api.github.com",
+			graphQLURL: "https://api.github.com/graphql",
+		},
+		{
+			host:       "github.example.com",
+			apiURL:     "https://github.example.com/api/v3",
+			graphQLURL: "https://github.example.com/api/graphql",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.host, func(t *testing.T) {
+			assert.Equal(t, test.apiURL, DefaultAPIURL(test.host))
+			assert.Equal(t, test.graphQLURL, graphQLURL(test.apiURL))
+			assert.Equal(t, test.graphQLURL, graphQLURL(test.apiURL+"/"))
+		})
+	}
+}
+
 func Test_issueFilters(t *testing.T) {
 	patch := change.NewType("patch", change.SemVerPatch)
 	feature := change.NewType("added-feature", change.SemVerMinor)
@@ -890,8 +993,10 @@ func Test_createChangesFromIssues(t *testing.T) {
 							URL:  "https://some-host/some-author-2",
 						},
 					},
-					EntryType: "githubIssue",
-					Entry:     issue1,
+					EntryType:  "githubIssue",
+					Entry:      issue1,
+					Identities: []string{"github-issue:1", "github-pr:1", "github-pr:2"},
+					Authors:    []change.Reference{{Text: "some-author-1", URL: "https://some-host/some-author-1"}, {Text: "some-author-2", URL: "https://some-host/some-author-2"}},
 				},
 				{
 					Text:        "Issue 2",
@@ -911,8 +1016,10 @@ func Test_createChangesFromIssues(t *testing.T) {
 							URL:  "https://some-host/some-author-2",
 						},
 					},
-					EntryType: "githubIssue",
-					Entry:     issue2,
+					EntryType:  "githubIssue",
+					Entry:      issue2,
+					Identities: []string{"github-issue:2", "github-pr:2"},
+					Authors:    []change.Reference{{Text: "some-author-2", URL: "https://some-host/some-author-2"}},
 				},
 				{
 					Text:        "Issue 3 no PRs",
@@ -924,8 +1031,106 @@ func Test_createChangesFromIssues(t *testing.T) {
 							URL:  "issue-3-url",
 						},
 					},
-					EntryType: "githubIssue",
-					Entry:     issue3,
+					EntryType:  "githubIssue",
+					Entry:      issue3,
+					Identities: []string{"github-issue:3"},
+				},
+			},
+		},
+		{
+			name: "clusters PRs under issues with multiple PRs",
+			config: Config{
+				IncludeIssuePRAuthors: true,
+				IncludeIssuePRs:       true,
+				ClusterIssuePRs:       true,
+				ChangeTypesByLabel:    changeTypeSet,
+				Host:                  "some-host",
+			},
+			inputPrs: []ghPullRequest{
+				prWithLinkedIssues1,
+				prWithLinkedIssues2,
+			},
+			issues: []ghIssue{
+				issue1,
+				issue2,
+			},
+			expectedChanges: []change.Change{
+				{
+					Text:        "Issue 1",
+					ChangeTypes: []change.Type{patch},
+					Timestamp:   timeStart,
+					References: []change.Reference{
+						{
+							Text: "Issue #1",
+							URL:  "issue-1-url",
+						},
+					},
+					EntryType:  "githubIssue",
+					Entry:      issue1,
+					Identities: []string{"github-issue:1", "github-pr:1", "github-pr:2"},
+					Authors:    []change.Reference{{Text: "some-author-1", URL: "https://some-host/some-author-1"}, {Text: "some-author-2", URL: "https://some-host/some-author-2"}},
+					Children: []change.Change{
+						{
+							Text:        "pr 1 with linked issues",
+							ChangeTypes: []change.Type{patch},
+							Timestamp:   timeStart,
+							References: []change.Reference{
+								{
+									Text: "PR #1",
+									URL:  "pr-1-url",
+								},
+								{
+									Text: "some-author-1",
+									URL:  "https://some-host/some-author-1",
+								},
+							},
+							EntryType: "githubPR",
+							Entry:     prWithLinkedIssues1,
+							Authors:   []change.Reference{{Text: "some-author-1", URL: "https://some-host/some-author-1"}},
+						},
+						{
+							Text:        "pr 2 with linked issues",
+							ChangeTypes: []change.Type{patch},
+							Timestamp:   timeStart,
+							References: []change.Reference{
+								{
+									Text: "PR #2",
+									URL:  "pr-2-url",
+								},
+								{
+									Text: "some-author-2",
+									URL:  "https://some-host/some-author-2",
+								},
+							},
+							EntryType: "githubPR",
+							Entry:     prWithLinkedIssues2,
+							Authors:   []change.Reference{{Text: "some-author-2", URL: "https://some-host/some-author-2"}},
+						},
+					},
+				},
+				{
+					// a single PR is not worth clustering
+					Text:        "Issue 2",
+					ChangeTypes: []change.Type{patch},
+					Timestamp:   timeStart,
+					References: []change.Reference{
+						{
+							Text: "Issue #2",
+							URL:  "issue-2-url",
+						},
+						{
+							Text: "PR #2",
+							URL:  "pr-2-url",
+						},
+						{
+							Text: "some-author-2",
+							URL:  "https://some-host/some-author-2",
+						},
+					},
+					EntryType:  "githubIssue",
+					Entry:      issue2,
+					Identities: []string{"github-issue:2", "github-pr:2"},
+					Authors:    []change.Reference{{Text: "some-author-2", URL: "https://some-host/some-author-2"}},
 				},
 			},
 		},
@@ -1018,8 +1223,10 @@ func Test_changesFromUnlabeledPRs(t *testing.T) {
 							URL:  "https://some-host/some-author",
 						},
 					},
-					EntryType: "githubPR",
-					Entry:     prWithoutLabels,
+					EntryType:  "githubPR",
+					Entry:      prWithoutLabels,
+					Identities: []string{"github-pr:6"},
+					Authors:    []change.Reference{{Text: "some-author", URL: "https://some-host/some-author"}},
 				},
 				{
 					Text:        "pr without labels 2",
@@ -1035,8 +1242,10 @@ func Test_changesFromUnlabeledPRs(t *testing.T) {
 							URL:  "https://some-host/some-author-2",
 						},
 					},
-					EntryType: "githubPR",
-					Entry:     prWithoutLabels2,
+					EntryType:  "githubPR",
+					Entry:      prWithoutLabels2,
+					Identities: []string{"github-pr:7"},
+					Authors:    []change.Reference{{Text: "some-author-2", URL: "https://some-host/some-author-2"}},
 				},
 			},
 		},
@@ -1152,8 +1361,10 @@ func Test_changesFromUnlabeledIssues(t *testing.T) {
 							URL:  "https://some-host/pr-1-author",
 						},
 					},
-					EntryType: "githubIssue",
-					Entry:     issueWithoutLabels,
+					EntryType:  "githubIssue",
+					Entry:      issueWithoutLabels,
+					Identities: []string{"github-issue:6", "github-pr:1"},
+					Authors:    []change.Reference{{Text: "pr-1-author", URL: "https://some-host/pr-1-author"}},
 				},
 				{
 					Text:        "issue without labels 2",
@@ -1165,8 +1376,9 @@ func Test_changesFromUnlabeledIssues(t *testing.T) {
 							URL:  "some-url-2",
 						},
 					},
-					EntryType: "githubIssue",
-					Entry:     issueWithoutLabels2,
+					EntryType:  "githubIssue",
+					Entry:      issueWithoutLabels2,
+					Identities: []string{"github-issue:7"},
 				},
 			},
 		},
@@ -1188,3 +1400,245 @@ func toJson(t *testing.T, changes []change.Change) string {
 	require.NoError(t, err)
 	return string(out)
 }
+
+func Test_updatedBefore(t *testing.T) {
+	since := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+	assert.False(t, updatedBefore(since.Add(-time.Hour), nil), "no boundary")
+	assert.False(t, updatedBefore(time.Time{}, &since), "unknown update time")
+	assert.False(t, updatedBefore(since, &since), "updated at the boundary")
+	assert.False(t, updatedBefore(since.Add(time.Hour), &since), "updated after the boundary")
+	assert.True(t, updatedBefore(since.Add(-time.Hour), &since), "updated before the boundary")
+}
+
+func TestSummarizer_needsClosedIssues(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		want   bool
+	}{
+		{
+			name:   "only PRs",
+			config: Config{IncludePRs: true},
+			want:   false,
+		},
+		{
+			name:   "issues",
+			config: Config{IncludeIssues: true},
+			want:   true,
+		},
+		{
+			name:   "only issues linked to PRs",
+			config: Config{IncludeIssues: true, IssuesRequireLinkedPR: true},
+			want:   false,
+		},
+		{
+			name:   "unlabeled issues",
+			config: Config{IncludeUnlabeledIssues: true},
+			want:   true,
+		},
+		{
+			name:   "timeline linking",
+			config: Config{LinkIssuesByTimeline: true},
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Summarizer{config: tt.config}
+			assert.Equal(t, tt.want, s.needsClosedIssues())
+		})
+	}
+}
+
+func Test_createChangesFromIssues_excludedPRs(t *testing.T) {
+	issue := ghIssue{
+		Title:  "Issue 1",
+		Number: 1,
+		URL:    "issue-1-url",
+		Labels: []string{"bug"},
+	}
+
+	included := ghPullRequest{
+		Title:        "the fix",
+		Number:       2,
+		URL:          "pr-2-url",
+		LinkedIssues: []ghIssue{issue},
+	}
+
+	excluded := ghPullRequest{
+		Title:        "an unrelated cleanup",
+		Number:       3,
+		URL:          "pr-3-url",
+		Labels:       []string{"changelog/ignore"},
+		LinkedIssues: []ghIssue{issue},
+	}
+
+	config := Config{
+		IncludeIssuePRs:    true,
+		ExcludeLabels:      []string{"changelog/ignore"},
+		ChangeTypesByLabel: change.TypeSet{"bug": change.NewType("bug", change.SemVerPatch)},
+	}
+
+	changes := createChangesFromIssues(config, []ghPullRequest{included, excluded}, []ghIssue{issue})
+	require.Len(t, changes, 1)
+	assert.Equal(t, []change.Reference{
+		{Text: "Issue #1", URL: "issue-1-url"},
+		{Text: "PR #2", URL: "pr-2-url"},
+	}, changes[0].References)
+	assert.Equal(t, []string{"github-issue:1", "github-pr:2"}, changes[0].Identities)
+
+	config.ClusterIssuePRs = true
+	changes = createChangesFromIssues(config, []ghPullRequest{included, excluded}, []ghIssue{issue})
+	require.Len(t, changes, 1)
+	assert.Empty(t, changes[0].Children, "a single remaining PR is not clustered")
+}
+
+func Test_authorReferences(t *testing.T) {
+	issue := ghIssue{
+		Title:     "Issue 1",
+		Number:    1,
+		URL:       "issue-1-url",
+		Labels:    []string{"bug"},
+		Assignees: []string{"alice", "bob"},
+	}
+
+	pr := ghPullRequest{
+		Title:       "bump the thing",
+		Number:      2,
+		URL:         "pr-2-url",
+		Author:      "dependabot",
+		AuthorIsBot: true,
+		Labels:      []string{"bug"},
+	}
+
+	config := Config{
+		Host:                  "github.com",
+		IncludeIssueAssignees: true,
+		ChangeTypesByLabel:    change.TypeSet{"bug": change.NewType("bug", change.SemVerPatch)},
+	}
+
+	issueChanges := createChangesFromIssues(config, nil, []ghIssue{issue})
+	require.Len(t, issueChanges, 1)
+	assert.Equal(t, []change.Reference{
+		{Text: "Issue #1", URL: "issue-1-url"},
+		{Text: "alice", URL: "https://github.com/alice"},
+		{Text: "bob", URL: "https://github.com/bob"},
+	}, issueChanges[0].References)
+
+	prChanges := createChangesFromPRs(config, []ghPullRequest{pr})
+	require.Len(t, prChanges, 1)
+	assert.Equal(t, []change.Reference{
+		{Text: "PR #2", URL: "pr-2-url"},
+		{Text: "dependabot[bot]", URL: "https://github.com/apps/dependabot"},
+	}, prChanges[0].References)
+
+	config.OmitPRAuthors = true
+	prChanges = createChangesFromPRs(config, []ghPullRequest{pr})
+	require.Len(t, prChanges, 1)
+	assert.Equal(t, []change.Reference{
+		{Text: "PR #2", URL: "pr-2-url"},
+	}, prChanges[0].References)
+}
+
+func Test_warnSkippedPRs(t *testing.T) {
+	removed := []ghPullRequest{
+		{Number: 1},
+		{Number: 2, Labels: []string{"documentation"}},
+	}
+
+	warnings := &release.Warnings{}
+	warnSkippedPRs(Config{Warnings: warnings}, removed)
+	assert.Equal(t, []release.Warning{
+		{Kind: release.SkippedChangeWarning, Message: "skipped 2 merged PR(s) without a change type label: #1, #2"},
+	}, warnings.List())
+
+	// unlabeled PRs are not skipped when they are included as unlabeled changes
+	warnings = &release.Warnings{}
+	warnSkippedPRs(Config{Warnings: warnings, IncludeUnlabeledPRs: true}, removed)
+	assert.Equal(t, []release.Warning{
+		{Kind: release.SkippedChangeWarning, Message: "skipped 1 merged PR(s) without a change type label: #2"},
+	}, warnings.List())
+}
+
+func newReleasesServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "release(tagName") {
+			fmt.Fprint(w, `{"data": {"repository": {"release": {
+				"tagName": "v0.3.0-rc.1", "isDraft": false, "isPrerelease": true,
+				"publishedAt": "2023-03-01T00:00:00Z", "createdAt": "2023-03-01T00:00:00Z"
+			}}}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"repository": {"releases": {
+			"pageInfo": {"hasNextPage": false},
+			"edges": [
+				{"node": {"tagName": "v0.2.0", "isPrerelease": false, "publishedAt": "2023-02-01T00:00:00Z", "createdAt": "2023-02-01T00:00:00Z"}},
+				{"node": {"tagName": "v0.3.0-rc.1", "isPrerelease": true, "publishedAt": "2023-03-01T00:00:00Z", "createdAt": "2023-03-01T00:00:00Z"}},
+				{"node": {"tagName": "v0.3.0", "isPrerelease": false, "publishedAt": "2023-04-01T00:00:00Z", "createdAt": "2023-04-01T00:00:00Z"}},
+				{"node": {"tagName": "v0.4.0", "isDraft": true, "createdAt": "2023-05-01T00:00:00Z"}}
+			]
+		}}}}`)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSummarizer_Releases(t *testing.T) {
+	server := newReleasesServer(t)
+
+	tests := []struct {
+		name    string
+		headTag string
+		want    []release.Release
+	}{
+		{
+			name: "pre-releases are flagged",
+			want: []release.Release{
+				{Version: "v0.2.0", Date: time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)},
+				{Version: "v0.3.0-rc.1", Date: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC), Prerelease: true},
+				{Version: "v0.3.0", Date: time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name:    "the release being described (tagged at HEAD) is excluded",
+			headTag: "v0.3.0",
+			want: []release.Release{
+				{Version: "v0.2.0", Date: time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)},
+				{Version: "v0.3.0-rc.1", Date: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC), Prerelease: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Summarizer{
+				git:      git.MockInterface{MockHeadTag: tt.headTag},
+				client:   githubv4.NewEnterpriseClient(server.URL, server.Client()),
+				userName: "anchore",
+				repoName: "chronicle",
+			}
+
+			got, err := s.Releases()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSummarizer_Release_prerelease(t *testing.T) {
+	server := newReleasesServer(t)
+	s := &Summarizer{
+		client:   githubv4.NewEnterpriseClient(server.URL, server.Client()),
+		userName: "anchore",
+		repoName: "chronicle",
+	}
+
+	got, err := s.Release("v0.3.0-rc.1")
+	require.NoError(t, err)
+	assert.Equal(t, &release.Release{
+		Version:    "v0.3.0-rc.1",
+		Date:       time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+		Prerelease: true,
+	}, got)
+}
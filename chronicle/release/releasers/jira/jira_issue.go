@@ -0,0 +1,121 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// jira serializes timestamps in a form that is close to, but not quite, RFC3339 (there is no colon in the zone offset)
+const jiraTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+var issueKeyPattern = regexp.MustCompile(`\b([A-Z][A-Z0-9_]+-[1-9][0-9]*)\b`)
+
+type jiraIssue struct {
+	Key        string
+	Summary    string
+	IssueType  string
+	Resolution string
+	ResolvedAt time.Time
+	Labels     []string
+	URL        string
+}
+
+// extractIssueKeys returns all unique issue keys for the given project found within the given text blobs (in order of appearance).
+func extractIssueKeys(project string, texts ...string) []string {
+	seen := strset.New()
+	var keys []string
+	for _, text := range texts {
+		for _, key := range issueKeyPattern.FindAllString(text, -1) {
+			if project != "" && !strings.HasPrefix(key, project+"-") {
+				continue
+			}
+			if seen.Has(key) {
+				continue
+			}
+			seen.Add(key)
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// fetchIssue returns the issue for the given key. If the issue does not exist then nil is returned (without an error).
+func fetchIssue(client *http.Client, host, user, token, key string) (*jiraIssue, error) {
+	u := fmt.Sprintf("%s/rest/api/2/issue/%s?fields=%s", host, url.PathEscape(key), url.QueryEscape("summary,issuetype,resolution,resolutiondate,labels"))
+
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request for issue=%q: %w", key, err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	if user != "" {
+		req.SetBasicAuth(user, token)
+	} else if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch issue=%q: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		log.Tracef("issue %s not found (or not visible with the given credentials)", key)
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("HTTP %d on fetching issue=%q: %s", resp.StatusCode, key, resp.Status)
+	}
+
+	var doc struct {
+		Key    string `json:"key"`
+		Fields struct {
+			Summary   string `json:"summary"`
+			IssueType struct {
+				Name string `json:"name"`
+			} `json:"issuetype"`
+			Resolution *struct {
+				Name string `json:"name"`
+			} `json:"resolution"`
+			ResolutionDate string   `json:"resolutiondate"`
+			Labels         []string `json:"labels"`
+		} `json:"fields"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("unable to parse issue=%q: %w", key, err)
+	}
+
+	issue := jiraIssue{
+		Key:       doc.Key,
+		Summary:   doc.Fields.Summary,
+		IssueType: doc.Fields.IssueType.Name,
+		Labels:    doc.Fields.Labels,
+		URL:       fmt.Sprintf("%s/browse/%s", host, doc.Key),
+	}
+
+	if doc.Fields.Resolution != nil {
+		issue.Resolution = doc.Fields.Resolution.Name
+	}
+
+	if doc.Fields.ResolutionDate != "" {
+		issue.ResolvedAt, err = time.Parse(jiraTimeLayout, doc.Fields.ResolutionDate)
+		if err != nil {
+			log.Debugf("unable to parse resolution date for issue=%q: %+v", key, err)
+		}
+	}
+
+	return &issue, nil
+}
@@ -0,0 +1,205 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/tags"
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+var _ release.Summarizer = (*Summarizer)(nil)
+
+type Config struct {
+	Host                   string         // the base URL of the Jira instance (e.g. https://example.atlassian.net)
+	Project                string         // only issue keys from this project are considered (e.g. "CHR")
+	ExcludeResolutions     []string       // resolved issues with any of these resolutions are not considered (e.g. "Won't Do")
+	ChangeTypesByIssueType change.TypeSet // the change type for each Jira issue type name (e.g. "Bug")
+	HTTPClient             *http.Client   // the client that API requests are made with, e.g. for a proxy or instrumentation (optional)
+	User                   string         // the user to authenticate as with the Token (optional, the Token is sent as a bearer token without a user)
+	Token                  string         // the API token (or personal access token) to authenticate with (optional)
+}
+
+// Summarizer resolves Jira issue keys found within the commit messages of a release (which includes PR titles for
+// squash and merge commits) against a Jira project. Releases are determined by the semver tags in the local repo.
+type Summarizer struct {
+	tags.Releaser
+	git    git.Interface
+	client *http.Client
+	config Config
+}
+
+func NewSummarizer(gitter git.Interface, config Config) (*Summarizer, error) {
+	if config.Host == "" {
+		return nil, fmt.Errorf("no jira host configured")
+	}
+	config.Host = strings.TrimSuffix(config.Host, "/")
+
+	log.WithFields("host", config.Host, "project", config.Project).Debug("jira summarizer")
+
+	client := config.HTTPClient
+	if client == nil {
+		client = &http.Client{}
+	}
+
+	return &Summarizer{
+		Releaser: tags.NewReleaser(gitter),
+		git:      gitter,
+		client:   client,
+		config:   config,
+	}, nil
+}
+
+func (s *Summarizer) ReferenceURL(tag string) string {
+	if s.config.Project == "" {
+		return ""
+	}
+	jql := fmt.Sprintf("project = %s AND fixVersion = %q", s.config.Project, tag)
+	return fmt.Sprintf("%s/issues/?jql=%s", s.config.Host, url.QueryEscape(jql))
+}
+
+func (s *Summarizer) ChangesURL(_, _ string) string {
+	// jira has no notion of source changes between two references
+	return ""
+}
+
+func (s *Summarizer) Changes(sinceRef, untilRef string) ([]change.Change, error) {
+	var err error
+	if untilRef == "" {
+		untilRef, err = s.git.HeadTagOrCommit()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	commits, err := s.git.CommitLogBetween(git.Range{
+		SinceRef:     sinceRef,
+		UntilRef:     untilRef,
+		IncludeStart: false,
+		IncludeEnd:   true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch commit range: %w", err)
+	}
+
+	log.Debugf("release comprised of %d commits", len(commits))
+
+	var messages []string
+	commitsByKey := make(map[string][]string)
+	for _, c := range commits {
+		messages = append(messages, c.Message)
+		for _, key := range extractIssueKeys(s.config.Project, c.Message) {
+			commitsByKey[key] = append(commitsByKey[key], c.Hash)
+		}
+	}
+
+	keys := extractIssueKeys(s.config.Project, messages...)
+
+	log.Debugf("jira issue keys referenced: %d", len(keys))
+
+	var issues []jiraIssue
+	for _, key := range keys {
+		issue, err := fetchIssue(s.client, s.config.Host, s.config.User, s.config.Token, key)
+		if err != nil {
+			return nil, err
+		}
+		if issue == nil {
+			continue
+		}
+		issues = append(issues, *issue)
+	}
+
+	issues = filterIssues(issues, issuesResolved(), issuesWithoutResolution(s.config.ExcludeResolutions...), issuesWithChangeTypes(s.config))
+
+	log.Debugf("issues contributing to changelog: %d", len(issues))
+
+	return createChangesFromIssues(s.config, issues, commitsByKey), nil
+}
+
+type issueFilter func(issue jiraIssue) bool
+
+func filterIssues(issues []jiraIssue, filters ...issueFilter) []jiraIssue {
+	results := make([]jiraIssue, 0, len(issues))
+
+issueLoop:
+	for _, i := range issues {
+		for _, f := range filters {
+			if !f(i) {
+				continue issueLoop
+			}
+		}
+		results = append(results, i)
+	}
+
+	return results
+}
+
+func issuesResolved() issueFilter {
+	return func(issue jiraIssue) bool {
+		keep := issue.Resolution != ""
+		if !keep {
+			log.Tracef("issue %s filtered out: not resolved", issue.Key)
+		}
+		return keep
+	}
+}
+
+func issuesWithoutResolution(resolutions ...string) issueFilter {
+	set := strset.New()
+	for _, r := range resolutions {
+		set.Add(strings.ToLower(r))
+	}
+	return func(issue jiraIssue) bool {
+		if set.Has(strings.ToLower(issue.Resolution)) {
+			log.Tracef("issue %s filtered out: has resolution %q", issue.Key, issue.Resolution)
+			return false
+		}
+		return true
+	}
+}
+
+func issuesWithChangeTypes(config Config) issueFilter {
+	return func(issue jiraIssue) bool {
+		keep := len(config.ChangeTypesByIssueType.ChangeTypes(issue.IssueType)) > 0
+		if !keep {
+			log.Tracef("issue %s filtered out: no change type for issue type %q", issue.Key, issue.IssueType)
+		}
+		return keep
+	}
+}
+
+func createChangesFromIssues(config Config, issues []jiraIssue, commitsByKey map[string][]string) (changes []change.Change) {
+	for _, issue := range issues {
+		changes = append(changes, change.Change{
+			Text:        issue.Summary,
+			ChangeTypes: config.ChangeTypesByIssueType.ChangeTypes(issue.IssueType),
+			Timestamp:   issue.ResolvedAt,
+			References: []change.Reference{
+				{
+					Text: issue.Key,
+					URL:  issue.URL,
+				},
+			},
+			EntryType:  "jiraIssue",
+			Entry:      issue,
+			Identities: issueIdentities(issue.Key, commitsByKey[issue.Key]),
+		})
+	}
+	return changes
+}
+
+// issueIdentities returns the identities of the issue and the commits that referenced it.
+func issueIdentities(key string, commits []string) []string {
+	ids := []string{"jira:" + key}
+	for _, c := range commits {
+		ids = append(ids, "commit:"+c)
+	}
+	return ids
+}
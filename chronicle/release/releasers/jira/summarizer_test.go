@@ -0,0 +1,115 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal/git"
+)
+
+func Test_extractIssueKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		project string
+		texts   []string
+		want    []string
+	}{
+		{
+			name:    "squash commit with PR number",
+			project: "CHR",
+			texts:   []string{"CHR-12: add the thing (#45)"},
+			want:    []string{"CHR-12"},
+		},
+		{
+			name:    "ignore keys from other projects",
+			project: "CHR",
+			texts:   []string{"CHR-12 and OPS-4", "fixes CHR-13"},
+			want:    []string{"CHR-12", "CHR-13"},
+		},
+		{
+			name:    "any project when none is configured",
+			project: "",
+			texts:   []string{"CHR-12 and OPS-4"},
+			want:    []string{"CHR-12", "OPS-4"},
+		},
+		{
+			name:    "deduplicate keys",
+			project: "CHR",
+			texts:   []string{"Merge pull request #3 from x/CHR-12\n\nCHR-12 add the thing", "CHR-12 follow up"},
+			want:    []string{"CHR-12"},
+		},
+		{
+			name:    "not a key",
+			project: "CHR",
+			texts:   []string{"bump UTF-8 handling and sha-256", "CHR-0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, extractIssueKeys(tt.project, tt.texts...))
+		})
+	}
+}
+
+func TestSummarizer_Changes(t *testing.T) {
+	issues := map[string]string{
+		"CHR-1": `{"key": "CHR-1", "fields": {"summary": "fix the bug", "issuetype": {"name": "Bug"}, "resolution": {"name": "Done"}, "resolutiondate": "2021-09-16T19:34:00.000+0000"}}`,
+		"CHR-2": `{"key": "CHR-2", "fields": {"summary": "add the feature", "issuetype": {"name": "Story"}, "resolution": {"name": "Done"}, "resolutiondate": "2021-09-17T19:34:00.000+0000"}}`,
+		"CHR-3": `{"key": "CHR-3", "fields": {"summary": "still open", "issuetype": {"name": "Bug"}, "resolution": null}}`,
+		"CHR-4": `{"key": "CHR-4", "fields": {"summary": "not doing this", "issuetype": {"name": "Bug"}, "resolution": {"name": "Won't Do"}, "resolutiondate": "2021-09-17T19:34:00.000+0000"}}`,
+		"CHR-5": `{"key": "CHR-5", "fields": {"summary": "unmapped type", "issuetype": {"name": "Task"}, "resolution": {"name": "Done"}, "resolutiondate": "2021-09-17T19:34:00.000+0000"}}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/rest/api/2/issue/")
+		body, ok := issues[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, body)
+	}))
+	defer server.Close()
+
+	bug := change.NewType("bug-fix", change.SemVerPatch)
+	feature := change.NewType("added-feature", change.SemVerMinor)
+
+	s, err := NewSummarizer(git.MockInterface{
+		MockHeadOrTagCommit: "abc",
+		MockCommitLog: []git.Commit{
+			{Message: "CHR-1 fix the bug (#1)"},
+			{Message: "CHR-2 add the feature (#2)"},
+			{Message: "CHR-3, CHR-4, CHR-5, CHR-99"},
+		},
+	}, Config{
+		Host:               server.URL + "/",
+		Project:            "CHR",
+		ExcludeResolutions: []string{"won't do"},
+		ChangeTypesByIssueType: change.TypeSet{
+			"Bug":   bug,
+			"Story": feature,
+		},
+	})
+	require.NoError(t, err)
+
+	changes, err := s.Changes("v0.1.0", "")
+	require.NoError(t, err)
+
+	require.Len(t, changes, 2)
+
+	assert.Equal(t, "fix the bug", changes[0].Text)
+	assert.Equal(t, []change.Type{bug}, changes[0].ChangeTypes)
+	assert.Equal(t, time.Date(2021, time.September, 16, 19, 34, 0, 0, time.UTC), changes[0].Timestamp.UTC())
+	assert.Equal(t, []change.Reference{{Text: "CHR-1", URL: server.URL + "/browse/CHR-1"}}, changes[0].References)
+
+	assert.Equal(t, "add the feature", changes[1].Text)
+	assert.Equal(t, []change.Type{feature}, changes[1].ChangeTypes)
+}
@@ -0,0 +1,139 @@
+package keepachangelog
+
+import (
+	"bufio"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	datePattern          = regexp.MustCompile(`\d{4}-\d{2}-\d{2}`)
+	linkDefinitionPrefix = regexp.MustCompile(`^\[(?P<name>[^\]]+)\]:\s+(?P<url>\S+)`)
+)
+
+// changelogDocument is the parsed form of a hand-written changelog in the "Keep a Changelog" style
+// (https://keepachangelog.com), or a changelog previously rendered by chronicle.
+type changelogDocument struct {
+	Releases []changelogRelease // newest first (as written)
+	Links    map[string]string  // link reference definitions by name (e.g. "1.0.0" or "Unreleased")
+}
+
+type changelogRelease struct {
+	Version    string
+	Date       time.Time
+	Unreleased bool
+	Entries    []changelogEntry
+}
+
+type changelogEntry struct {
+	Section  string // the section heading the entry was found under (e.g. "Added" or "Bug Fixes")
+	Text     string
+	Children []changelogEntry
+}
+
+// parseChangelog parses all "## <version>" release headings, "### <section>" headings, and bullet entries within the
+// given markdown document. Nested bullets are kept as the children of the preceding entry.
+// nolint:funlen
+func parseChangelog(contents string) changelogDocument {
+	doc := changelogDocument{
+		Links: make(map[string]string),
+	}
+
+	var current *changelogRelease
+	var section string
+	var lastEntry *changelogEntry
+
+	scanner := bufio.NewScanner(strings.NewReader(contents))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t")
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(line, "## "):
+			if current != nil {
+				doc.Releases = append(doc.Releases, *current)
+			}
+			current = parseReleaseHeading(strings.TrimPrefix(line, "## "))
+			section = ""
+			lastEntry = nil
+
+		case strings.HasPrefix(line, "### "):
+			section = strings.TrimSpace(strings.TrimPrefix(line, "### "))
+			lastEntry = nil
+
+		case linkDefinitionPrefix.MatchString(line):
+			match := linkDefinitionPrefix.FindStringSubmatch(line)
+			doc.Links[match[1]] = match[2]
+
+		case current == nil || trimmed == "":
+			lastEntry = nil
+
+		case isBullet(line):
+			current.Entries = append(current.Entries, changelogEntry{
+				Section: section,
+				Text:    bulletText(trimmed),
+			})
+			lastEntry = &current.Entries[len(current.Entries)-1]
+
+		case isBullet(trimmed) && lastEntry != nil:
+			// a nested bullet (only a single level of nesting is kept)
+			lastEntry.Children = append(lastEntry.Children, changelogEntry{
+				Section: section,
+				Text:    bulletText(trimmed),
+			})
+
+		case lastEntry != nil:
+			// a wrapped line continuing the last entry (or the last nested entry)
+			target := lastEntry
+			if len(lastEntry.Children) > 0 {
+				target = &lastEntry.Children[len(lastEntry.Children)-1]
+			}
+			target.Text += " " + trimmed
+		}
+	}
+
+	if current != nil {
+		doc.Releases = append(doc.Releases, *current)
+	}
+
+	return doc
+}
+
+// parseReleaseHeading parses headings such as "[1.0.0] - 2023-03-01", "1.0.0 (2023-03-01)",
+// "[v1.0.0](https://...) (2023-03-01)", or "[Unreleased]".
+func parseReleaseHeading(heading string) *changelogRelease {
+	heading = strings.TrimSpace(heading)
+
+	var version, rest string
+	if strings.HasPrefix(heading, "[") && strings.Contains(heading, "]") {
+		end := strings.Index(heading, "]")
+		version = heading[1:end]
+		rest = heading[end+1:]
+	} else {
+		fields := strings.Fields(heading)
+		version = fields[0]
+		rest = strings.TrimPrefix(heading, version)
+	}
+
+	r := changelogRelease{
+		Version:    version,
+		Unreleased: strings.EqualFold(version, "unreleased"),
+	}
+
+	if date := datePattern.FindString(rest); date != "" {
+		if t, err := time.Parse("2006-01-02", date); err == nil {
+			r.Date = t
+		}
+	}
+
+	return &r
+}
+
+func isBullet(line string) bool {
+	return strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "* ")
+}
+
+func bulletText(line string) string {
+	return strings.TrimSpace(line[2:])
+}
@@ -0,0 +1,160 @@
+package keepachangelog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+var _ release.Summarizer = (*Summarizer)(nil)
+
+type Config struct {
+	RepoPath             string         // the root of the git repo
+	Path                 string         // the changelog file, relative to the repo root (e.g. "CHANGELOG.md")
+	ChangeTypesBySection change.TypeSet // the change type for each (lowercase) section heading (e.g. "added" or "fixed")
+}
+
+// Summarizer sources releases and changes from an existing hand-written changelog file (in the "Keep a Changelog"
+// style), which allows previously written history to be merged with (or regenerated by) chronicle. Each release
+// heading within the file is considered to be a release.
+type Summarizer struct {
+	config Config
+	doc    changelogDocument
+}
+
+func NewSummarizer(config Config) (*Summarizer, error) {
+	if config.Path == "" {
+		return nil, fmt.Errorf("no changelog file configured")
+	}
+
+	path := filepath.Join(config.RepoPath, config.Path)
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read changelog %q: %w", path, err)
+	}
+
+	doc := parseChangelog(string(contents))
+
+	log.WithFields("path", config.Path, "releases", len(doc.Releases)).Debug("keep-a-changelog summarizer")
+
+	return &Summarizer{
+		config: config,
+		doc:    doc,
+	}, nil
+}
+
+func (s *Summarizer) LastRelease() (*release.Release, error) {
+	for _, r := range s.doc.Releases {
+		if r.Unreleased {
+			continue
+		}
+		return &release.Release{
+			Version: r.Version,
+			Date:    r.Date,
+		}, nil
+	}
+	return nil, nil
+}
+
+func (s *Summarizer) Release(ref string) (*release.Release, error) {
+	r := s.findRelease(ref)
+	if r == nil {
+		return nil, nil
+	}
+	return &release.Release{
+		Version: r.Version,
+		Date:    r.Date,
+	}, nil
+}
+
+func (s *Summarizer) ReferenceURL(tag string) string {
+	return s.link(tag)
+}
+
+func (s *Summarizer) ChangesURL(_, untilRef string) string {
+	// by convention the link definition for a release points to the comparison with the previous release
+	if untilRef == "" {
+		return s.link("Unreleased")
+	}
+	return s.link(untilRef)
+}
+
+func (s *Summarizer) link(ref string) string {
+	for name, url := range s.doc.Links {
+		if sameVersion(name, ref) {
+			return url
+		}
+	}
+	return ""
+}
+
+// Changes returns the entries of all releases after the since ref up to and including the until ref. When there is no
+// until ref, the unreleased entries are included as well.
+func (s *Summarizer) Changes(sinceRef, untilRef string) ([]change.Change, error) {
+	if sinceRef != "" && s.findRelease(sinceRef) == nil {
+		log.Debugf("release %q not found in changelog, considering all releases", sinceRef)
+	}
+
+	collecting := untilRef == ""
+
+	var changes []change.Change
+	for _, r := range s.doc.Releases {
+		if sinceRef != "" && !r.Unreleased && sameVersion(r.Version, sinceRef) {
+			break
+		}
+		if !collecting && !r.Unreleased && sameVersion(r.Version, untilRef) {
+			collecting = true
+		}
+		if !collecting {
+			continue
+		}
+		for _, e := range r.Entries {
+			changes = append(changes, s.toChange(r, e))
+		}
+	}
+
+	log.Debugf("changelog entries contributing to changelog: %d", len(changes))
+
+	return changes, nil
+}
+
+func (s *Summarizer) toChange(r changelogRelease, e changelogEntry) change.Change {
+	var children change.Changes
+	for _, child := range e.Children {
+		children = append(children, s.toChange(r, child))
+	}
+
+	changeTypes := s.config.ChangeTypesBySection.ChangeTypes(strings.ToLower(e.Section))
+	if len(changeTypes) == 0 {
+		changeTypes = change.UnknownTypes
+	}
+
+	return change.Change{
+		Text:        e.Text,
+		ChangeTypes: changeTypes,
+		Timestamp:   r.Date,
+		EntryType:   "keepachangelog",
+		Entry:       e,
+		Children:    children,
+	}
+}
+
+func (s *Summarizer) findRelease(ref string) *changelogRelease {
+	for i, r := range s.doc.Releases {
+		if !r.Unreleased && sameVersion(r.Version, ref) {
+			return &s.doc.Releases[i]
+		}
+	}
+	return nil
+}
+
+// sameVersion indicates if both refer to the same version, irrespective of a "v" prefix (changelogs commonly omit the
+// prefix used by tags).
+func sameVersion(a, b string) bool {
+	return strings.EqualFold(strings.TrimPrefix(a, "v"), strings.TrimPrefix(b, "v"))
+}
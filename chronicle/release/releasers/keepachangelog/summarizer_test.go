@@ -0,0 +1,211 @@
+package keepachangelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+var (
+	addedType    = change.NewType("added-feature", change.SemVerMinor)
+	fixType      = change.NewType("bug-fix", change.SemVerPatch)
+	securityType = change.NewType("security-fixes", change.SemVerPatch)
+	removedType  = change.NewType("removed-feature", change.SemVerMajor)
+)
+
+func newTestSummarizer(t *testing.T) *Summarizer {
+	t.Helper()
+	s, err := NewSummarizer(Config{
+		RepoPath: "test-fixtures",
+		Path:     "CHANGELOG.md",
+		ChangeTypesBySection: change.TypeSet{
+			"added":    addedType,
+			"fixed":    fixType,
+			"security": securityType,
+			"removed":  removedType,
+		},
+	})
+	require.NoError(t, err)
+	return s
+}
+
+func Test_parseReleaseHeading(t *testing.T) {
+	tests := []struct {
+		heading string
+		want    changelogRelease
+	}{
+		{
+			heading: "[1.0.0] - 2023-03-01",
+			want:    changelogRelease{Version: "1.0.0", Date: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			heading: "1.0.0 (2023-03-01)",
+			want:    changelogRelease{Version: "1.0.0", Date: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			heading: "[v0.19.1](https://github.com/anchore/syft/tree/v0.19.1) (2021-09-16)",
+			want:    changelogRelease{Version: "v0.19.1", Date: time.Date(2021, time.September, 16, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			heading: "[Unreleased]",
+			want:    changelogRelease{Version: "Unreleased", Unreleased: true},
+		},
+		{
+			heading: "v2.0.0",
+			want:    changelogRelease{Version: "v2.0.0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.heading, func(t *testing.T) {
+			assert.Equal(t, &tt.want, parseReleaseHeading(tt.heading))
+		})
+	}
+}
+
+func Test_parseChangelog_chronicleOutput(t *testing.T) {
+	// a changelog previously rendered by chronicle can be parsed as well
+	doc := parseChangelog(`# Changelog
+
+## [v0.19.1](https://github.com/anchore/syft/tree/v0.19.1) (2021-09-16)
+
+[Full Changelog](https://github.com/anchore/syft/compare/v0.19.0...v0.19.1)
+
+### Bug Fixes
+
+- Redirect cursor hide/show to stderr [[456](https://github.com/anchore/syft/pull/456)]
+
+### Added Features
+
+- another added feature
+  - first part of the feature [[PR #457](https://github.com/anchore/syft/pull/457)]
+`)
+
+	require.Len(t, doc.Releases, 1)
+	assert.Equal(t, "v0.19.1", doc.Releases[0].Version)
+	assert.Equal(t, []changelogEntry{
+		{
+			Section: "Bug Fixes",
+			Text:    "Redirect cursor hide/show to stderr [[456](https://github.com/anchore/syft/pull/456)]",
+		},
+		{
+			Section: "Added Features",
+			Text:    "another added feature",
+			Children: []changelogEntry{
+				{
+					Section: "Added Features",
+					Text:    "first part of the feature [[PR #457](https://github.com/anchore/syft/pull/457)]",
+				},
+			},
+		},
+	}, doc.Releases[0].Entries)
+}
+
+func TestSummarizer_Releases(t *testing.T) {
+	s := newTestSummarizer(t)
+
+	last, err := s.LastRelease()
+	require.NoError(t, err)
+	assert.Equal(t, &release.Release{Version: "1.1.0", Date: time.Date(2023, time.March, 5, 0, 0, 0, 0, time.UTC)}, last)
+
+	// tags commonly have a "v" prefix while changelog versions do not
+	r, err := s.Release("v1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, &release.Release{Version: "1.0.0", Date: time.Date(2023, time.January, 10, 0, 0, 0, 0, time.UTC)}, r)
+
+	r, err = s.Release("v9.9.9")
+	require.NoError(t, err)
+	assert.Nil(t, r)
+
+	assert.Equal(t, "https://github.com/org/repo/compare/v0.1.0...v1.0.0", s.ReferenceURL("v1.0.0"))
+	assert.Equal(t, "https://github.com/org/repo/compare/v1.0.0...v1.1.0", s.ChangesURL("1.0.0", "1.1.0"))
+	assert.Equal(t, "https://github.com/org/repo/compare/v1.1.0...HEAD", s.ChangesURL("1.1.0", ""))
+}
+
+func TestSummarizer_Changes(t *testing.T) {
+	tests := []struct {
+		name     string
+		sinceRef string
+		untilRef string
+		want     []string
+	}{
+		{
+			name:     "unreleased",
+			sinceRef: "v1.1.0",
+			want:     []string{"Something not yet released"},
+		},
+		{
+			name:     "single release",
+			sinceRef: "v1.0.0",
+			untilRef: "v1.1.0",
+			want: []string{
+				"A new flag for the thing",
+				"Support for the other thing",
+				"A long fix that is wrapped over two lines",
+			},
+		},
+		{
+			name:     "several releases",
+			sinceRef: "0.1.0",
+			untilRef: "1.1.0",
+			want: []string{
+				"A new flag for the thing",
+				"Support for the other thing",
+				"A long fix that is wrapped over two lines",
+				"Patched the thing",
+				"The old flag",
+				"Something in an unexpected section",
+			},
+		},
+		{
+			name:     "entire history",
+			untilRef: "1.0.0",
+			want: []string{
+				"Patched the thing",
+				"The old flag",
+				"Something in an unexpected section",
+				"The first release",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestSummarizer(t)
+			changes, err := s.Changes(tt.sinceRef, tt.untilRef)
+			require.NoError(t, err)
+
+			var got []string
+			for _, c := range changes {
+				got = append(got, c.Text)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSummarizer_Changes_types(t *testing.T) {
+	s := newTestSummarizer(t)
+	changes, err := s.Changes("0.1.0", "1.1.0")
+	require.NoError(t, err)
+	require.Len(t, changes, 6)
+
+	assert.Equal(t, []change.Type{addedType}, changes[1].ChangeTypes)
+	assert.Equal(t, time.Date(2023, time.March, 5, 0, 0, 0, 0, time.UTC), changes[1].Timestamp)
+	require.Len(t, changes[1].Children, 1)
+	assert.Equal(t, "including the nested part", changes[1].Children[0].Text)
+
+	assert.Equal(t, []change.Type{fixType}, changes[2].ChangeTypes)
+	assert.Equal(t, []change.Type{securityType}, changes[3].ChangeTypes)
+	assert.Equal(t, []change.Type{removedType}, changes[4].ChangeTypes)
+	// entries from unmapped sections are kept
+	assert.Equal(t, change.UnknownTypes, changes[5].ChangeTypes)
+}
+
+func TestNewSummarizer_missingFile(t *testing.T) {
+	_, err := NewSummarizer(Config{RepoPath: "test-fixtures", Path: "missing.md"})
+	require.ErrorContains(t, err, "unable to read changelog")
+}
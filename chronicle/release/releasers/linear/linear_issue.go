@@ -0,0 +1,148 @@
+package linear
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// linear identifiers are case-insensitive within branch names (e.g. "eng-123-add-the-thing"), which is how they
+// typically show up within merge commits.
+var issueIdentifierPattern = regexp.MustCompile(`(?i)\b([a-z][a-z0-9]*-[1-9][0-9]*)\b`)
+
+const issueQuery = `query Issue($id: String!) {
+  issue(id: $id) {
+    identifier
+    title
+    url
+    completedAt
+    state { type }
+    project { name }
+    labels { nodes { name } }
+  }
+}`
+
+type linearIssue struct {
+	Identifier  string
+	Title       string
+	URL         string
+	CompletedAt time.Time
+	Completed   bool
+	Project     string
+	Labels      []string
+}
+
+// extractIssueIdentifiers returns all unique issue identifiers for the given team keys found within the given text blobs (in order of appearance).
+func extractIssueIdentifiers(teams []string, texts ...string) []string {
+	teamSet := strset.New()
+	for _, t := range teams {
+		teamSet.Add(strings.ToUpper(t))
+	}
+
+	seen := strset.New()
+	var ids []string
+	for _, text := range texts {
+		for _, id := range issueIdentifierPattern.FindAllString(text, -1) {
+			id = strings.ToUpper(id)
+			team := id[:strings.LastIndex(id, "-")]
+			if !teamSet.Has(team) || seen.Has(id) {
+				continue
+			}
+			seen.Add(id)
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// fetchIssue returns the issue for the given identifier. If the issue does not exist then nil is returned (without an error).
+// nolint:funlen
+func fetchIssue(client *http.Client, apiURL, apiKey, id string) (*linearIssue, error) {
+	reqBody, err := json.Marshal(map[string]interface{}{
+		"query":     issueQuery,
+		"variables": map[string]string{"id": id},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, apiURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request for issue=%q: %w", id, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if apiKey != "" {
+		req.Header.Set("Authorization", apiKey)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch issue=%q: %w", id, err)
+	}
+	defer resp.Body.Close()
+
+	var doc struct {
+		Data struct {
+			Issue *struct {
+				Identifier  string     `json:"identifier"`
+				Title       string     `json:"title"`
+				URL         string     `json:"url"`
+				CompletedAt *time.Time `json:"completedAt"`
+				State       struct {
+					Type string `json:"type"`
+				} `json:"state"`
+				Project *struct {
+					Name string `json:"name"`
+				} `json:"project"`
+				Labels struct {
+					Nodes []struct {
+						Name string `json:"name"`
+					} `json:"nodes"`
+				} `json:"labels"`
+			} `json:"issue"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("unable to parse issue=%q (HTTP %d): %w", id, resp.StatusCode, err)
+	}
+
+	if doc.Data.Issue == nil {
+		if len(doc.Errors) > 0 && resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("HTTP %d on fetching issue=%q: %s", resp.StatusCode, id, doc.Errors[0].Message)
+		}
+		// linear reports missing entities as a field error with partial (null) data
+		log.Tracef("issue %s not found (or not visible with the given credentials)", id)
+		return nil, nil
+	}
+
+	n := doc.Data.Issue
+	issue := linearIssue{
+		Identifier: n.Identifier,
+		Title:      n.Title,
+		URL:        n.URL,
+		Completed:  n.State.Type == "completed",
+	}
+	if n.CompletedAt != nil {
+		issue.CompletedAt = *n.CompletedAt
+	}
+	if n.Project != nil {
+		issue.Project = n.Project.Name
+	}
+	for _, l := range n.Labels.Nodes {
+		issue.Labels = append(issue.Labels, l.Name)
+	}
+
+	return &issue, nil
+}
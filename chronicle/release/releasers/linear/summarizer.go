@@ -0,0 +1,163 @@
+package linear
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/tags"
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+const DefaultAPIURL = "https://api.linear.app/graphql"
+
+var _ release.Summarizer = (*Summarizer)(nil)
+
+type Config struct {
+	APIURL               string         // the linear GraphQL endpoint
+	Teams                []string       // only issue identifiers from these team keys are considered (e.g. "ENG")
+	ChangeTypesByLabel   change.TypeSet // the change type for each linear label name
+	ChangeTypesByProject change.TypeSet // the change type for each linear project name (used when no label matches)
+	HTTPClient           *http.Client   // the client that API requests are made with, e.g. for a proxy or instrumentation (optional)
+	APIKey               string         // the linear API key to authenticate with
+}
+
+// Summarizer resolves linear issues attached to the merged PRs of a release. Linear attaches issues to PRs by the
+// issue identifier within the PR branch name or title, both of which are found within the commit messages of the
+// release (merge and squash commits). Releases are determined by the semver tags in the local repo.
+type Summarizer struct {
+	tags.Releaser
+	git    git.Interface
+	client *http.Client
+	config Config
+}
+
+func NewSummarizer(gitter git.Interface, config Config) (*Summarizer, error) {
+	if len(config.Teams) == 0 {
+		return nil, fmt.Errorf("no linear team keys configured")
+	}
+	if config.APIURL == "" {
+		config.APIURL = DefaultAPIURL
+	}
+
+	log.WithFields("teams", config.Teams).Debug("linear summarizer")
+
+	client := config.HTTPClient
+	if client == nil {
+		client = &http.Client{}
+	}
+
+	return &Summarizer{
+		Releaser: tags.NewReleaser(gitter),
+		git:      gitter,
+		client:   client,
+		config:   config,
+	}, nil
+}
+
+func (s *Summarizer) ReferenceURL(_ string) string {
+	// linear has no notion of a release
+	return ""
+}
+
+func (s *Summarizer) ChangesURL(_, _ string) string {
+	// linear has no notion of source changes between two references
+	return ""
+}
+
+func (s *Summarizer) Changes(sinceRef, untilRef string) ([]change.Change, error) {
+	var err error
+	if untilRef == "" {
+		untilRef, err = s.git.HeadTagOrCommit()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	commits, err := s.git.CommitLogBetween(git.Range{
+		SinceRef:     sinceRef,
+		UntilRef:     untilRef,
+		IncludeStart: false,
+		IncludeEnd:   true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch commit range: %w", err)
+	}
+
+	log.Debugf("release comprised of %d commits", len(commits))
+
+	var messages []string
+	commitsByID := make(map[string][]string)
+	for _, c := range commits {
+		messages = append(messages, c.Message)
+		for _, id := range extractIssueIdentifiers(s.config.Teams, c.Message) {
+			commitsByID[id] = append(commitsByID[id], c.Hash)
+		}
+	}
+
+	ids := extractIssueIdentifiers(s.config.Teams, messages...)
+
+	log.Debugf("linear issues referenced: %d", len(ids))
+
+	var changes []change.Change
+	for _, id := range ids {
+		issue, err := fetchIssue(s.client, s.config.APIURL, s.config.APIKey, id)
+		if err != nil {
+			return nil, err
+		}
+		if issue == nil {
+			continue
+		}
+
+		if !issue.Completed {
+			log.Tracef("issue %s filtered out: not completed", issue.Identifier)
+			continue
+		}
+
+		changeTypes := s.changeTypes(*issue)
+		if len(changeTypes) == 0 {
+			log.Tracef("issue %s filtered out: no change types", issue.Identifier)
+			continue
+		}
+
+		changes = append(changes, change.Change{
+			Text:        issue.Title,
+			ChangeTypes: changeTypes,
+			Timestamp:   issue.CompletedAt,
+			References: []change.Reference{
+				{
+					Text: issue.Identifier,
+					URL:  issue.URL,
+				},
+			},
+			EntryType:  "linearIssue",
+			Entry:      *issue,
+			Identities: issueIdentities(issue.Identifier, commitsByID[id]),
+		})
+	}
+
+	log.Debugf("issues contributing to changelog: %d", len(changes))
+
+	return changes, nil
+}
+
+func (s *Summarizer) changeTypes(issue linearIssue) []change.Type {
+	if ty := s.config.ChangeTypesByLabel.ChangeTypes(issue.Labels...); len(ty) > 0 {
+		return ty
+	}
+	if issue.Project != "" {
+		return s.config.ChangeTypesByProject.ChangeTypes(issue.Project)
+	}
+	return nil
+}
+
+// issueIdentities returns the identities of the issue and the commits that referenced it.
+func issueIdentities(identifier string, commits []string) []string {
+	ids := []string{"linear:" + identifier}
+	for _, c := range commits {
+		ids = append(ids, "commit:"+c)
+	}
+	return ids
+}
@@ -0,0 +1,95 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal/git"
+)
+
+func Test_extractIssueIdentifiers(t *testing.T) {
+	tests := []struct {
+		name  string
+		teams []string
+		texts []string
+		want  []string
+	}{
+		{
+			name:  "branch name within merge commit",
+			teams: []string{"ENG"},
+			texts: []string{"Merge pull request #12 from org/eng-123-add-the-thing\n\nAdd the thing"},
+			want:  []string{"ENG-123"},
+		},
+		{
+			name:  "ignore other teams and non-identifiers",
+			teams: []string{"eng", "OPS"},
+			texts: []string{"ENG-1 and OPS-2 (utf-8, sha-256, web-3)", "eng-1 again"},
+			want:  []string{"ENG-1", "OPS-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, extractIssueIdentifiers(tt.teams, tt.texts...))
+		})
+	}
+}
+
+func TestSummarizer_Changes(t *testing.T) {
+	issues := map[string]string{
+		"ENG-1": `{"identifier": "ENG-1", "title": "fix the bug", "url": "https://linear.app/org/issue/ENG-1", "completedAt": "2021-09-16T19:34:00.000Z", "state": {"type": "completed"}, "labels": {"nodes": [{"name": "Bug"}]}}`,
+		"ENG-2": `{"identifier": "ENG-2", "title": "add the feature", "url": "https://linear.app/org/issue/ENG-2", "completedAt": "2021-09-17T19:34:00.000Z", "state": {"type": "completed"}, "project": {"name": "Big Feature"}, "labels": {"nodes": []}}`,
+		"ENG-3": `{"identifier": "ENG-3", "title": "in progress", "url": "https://linear.app/org/issue/ENG-3", "state": {"type": "started"}, "labels": {"nodes": [{"name": "Bug"}]}}`,
+		"ENG-4": `{"identifier": "ENG-4", "title": "unmapped", "url": "https://linear.app/org/issue/ENG-4", "completedAt": "2021-09-17T19:34:00.000Z", "state": {"type": "completed"}, "labels": {"nodes": [{"name": "Chore"}]}}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]string `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		body, ok := issues[req.Variables["id"]]
+		if !ok {
+			fmt.Fprint(w, `{"data": {"issue": null}, "errors": [{"message": "Entity not found"}]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"data": {"issue": %s}}`, body)
+	}))
+	defer server.Close()
+
+	bug := change.NewType("bug-fix", change.SemVerPatch)
+	feature := change.NewType("added-feature", change.SemVerMinor)
+
+	s, err := NewSummarizer(git.MockInterface{
+		MockHeadOrTagCommit: "abc",
+		MockCommitLog: []git.Commit{
+			{Message: "Merge pull request #1 from org/eng-1-fix-the-bug"},
+			{Message: "ENG-2 add the feature (#2)"},
+			{Message: "ENG-3, ENG-4, ENG-99"},
+		},
+	}, Config{
+		APIURL:               server.URL,
+		Teams:                []string{"ENG"},
+		ChangeTypesByLabel:   change.TypeSet{"Bug": bug},
+		ChangeTypesByProject: change.TypeSet{"Big Feature": feature},
+	})
+	require.NoError(t, err)
+
+	changes, err := s.Changes("v0.1.0", "")
+	require.NoError(t, err)
+
+	require.Len(t, changes, 2)
+
+	assert.Equal(t, "fix the bug", changes[0].Text)
+	assert.Equal(t, []change.Type{bug}, changes[0].ChangeTypes)
+	assert.Equal(t, []change.Reference{{Text: "ENG-1", URL: "https://linear.app/org/issue/ENG-1"}}, changes[0].References)
+
+	assert.Equal(t, "add the feature", changes[1].Text)
+	assert.Equal(t, []change.Type{feature}, changes[1].ChangeTypes)
+}
@@ -0,0 +1,48 @@
+package plugin
+
+import "time"
+
+// The plugin protocol is a single JSON request written to the stdin of the plugin command and a single JSON response
+// read from its stdout. The plugin command is invoked once per request from the root of the git repo.
+const (
+	MethodLastRelease  = "last-release"
+	MethodRelease      = "release"
+	MethodChanges      = "changes"
+	MethodReferenceURL = "reference-url"
+	MethodChangesURL   = "changes-url"
+)
+
+// Request is the message sent to the plugin command.
+type Request struct {
+	Method   string `json:"method"`
+	Ref      string `json:"ref,omitempty"`       // used by the "release" and "reference-url" methods
+	SinceRef string `json:"since-ref,omitempty"` // used by the "changes" and "changes-url" methods
+	UntilRef string `json:"until-ref,omitempty"` // used by the "changes" and "changes-url" methods
+}
+
+// Response is the message expected back from the plugin command. Only the field relevant to the requested method
+// needs to be set; a non-empty error indicates the request failed.
+type Response struct {
+	Error   string   `json:"error,omitempty"`
+	Release *Release `json:"release,omitempty"` // null when the release does not exist
+	Changes []Change `json:"changes,omitempty"`
+	URL     string   `json:"url,omitempty"`
+}
+
+type Release struct {
+	Version string    `json:"version"`
+	Date    time.Time `json:"date"`
+}
+
+type Change struct {
+	Text        string      `json:"text"`
+	ChangeTypes []string    `json:"change-types"` // names of the change types configured under "plugin.changes"
+	Timestamp   time.Time   `json:"timestamp"`
+	References  []Reference `json:"references,omitempty"`
+	Identities  []string    `json:"identities,omitempty"`
+}
+
+type Reference struct {
+	Text string `json:"text"`
+	URL  string `json:"url,omitempty"`
+}
@@ -0,0 +1,174 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+var _ release.Summarizer = (*Summarizer)(nil)
+
+type Config struct {
+	Command     string         // the plugin executable
+	Args        []string       // any arguments to pass to the plugin executable
+	Dir         string         // the directory to run the plugin from (the git repo root)
+	ChangeTypes change.TypeSet // the change type for each change type name the plugin may return
+}
+
+// runner invokes the plugin with the given request, returning the raw response.
+type runner func(request []byte) ([]byte, error)
+
+// Summarizer delegates all summarization to an external command that speaks JSON over stdin/stdout (see Request and
+// Response for the shape of each message).
+type Summarizer struct {
+	config Config
+	run    runner
+}
+
+func NewSummarizer(config Config) (*Summarizer, error) {
+	if config.Command == "" {
+		return nil, fmt.Errorf("no plugin command configured")
+	}
+
+	log.WithFields("command", config.Command, "args", config.Args).Debug("plugin summarizer")
+
+	return &Summarizer{
+		config: config,
+		run:    execRunner(config),
+	}, nil
+}
+
+func execRunner(config Config) runner {
+	return func(request []byte) ([]byte, error) {
+		cmd := exec.Command(config.Command, config.Args...)
+		cmd.Dir = config.Dir
+		cmd.Stdin = bytes.NewReader(request)
+
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+
+		err := cmd.Run()
+		if stderr.Len() > 0 {
+			log.WithFields("command", config.Command).Debug(strings.TrimSpace(stderr.String()))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("plugin command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		return stdout.Bytes(), nil
+	}
+}
+
+func (s *Summarizer) call(request Request) (*Response, error) {
+	reqBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithFields("method", request.Method).Trace("invoking plugin")
+
+	respBytes, err := s.run(reqBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp Response
+	if err := json.Unmarshal(respBytes, &resp); err != nil {
+		return nil, fmt.Errorf("unable to parse plugin response for %q: %w", request.Method, err)
+	}
+
+	if resp.Error != "" {
+		return nil, fmt.Errorf("plugin failed to handle %q: %s", request.Method, resp.Error)
+	}
+
+	return &resp, nil
+}
+
+func (s *Summarizer) LastRelease() (*release.Release, error) {
+	resp, err := s.call(Request{Method: MethodLastRelease})
+	if err != nil {
+		return nil, err
+	}
+	return toRelease(resp.Release), nil
+}
+
+func (s *Summarizer) Release(ref string) (*release.Release, error) {
+	resp, err := s.call(Request{Method: MethodRelease, Ref: ref})
+	if err != nil {
+		return nil, err
+	}
+	return toRelease(resp.Release), nil
+}
+
+func (s *Summarizer) ReferenceURL(tag string) string {
+	resp, err := s.call(Request{Method: MethodReferenceURL, Ref: tag})
+	if err != nil {
+		log.Warnf("unable to get reference URL from plugin: %+v", err)
+		return ""
+	}
+	return resp.URL
+}
+
+func (s *Summarizer) ChangesURL(sinceRef, untilRef string) string {
+	resp, err := s.call(Request{Method: MethodChangesURL, SinceRef: sinceRef, UntilRef: untilRef})
+	if err != nil {
+		log.Warnf("unable to get changes URL from plugin: %+v", err)
+		return ""
+	}
+	return resp.URL
+}
+
+func (s *Summarizer) Changes(sinceRef, untilRef string) ([]change.Change, error) {
+	resp, err := s.call(Request{Method: MethodChanges, SinceRef: sinceRef, UntilRef: untilRef})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("changes from plugin: %d", len(resp.Changes))
+
+	var changes []change.Change
+	for _, c := range resp.Changes {
+		var refs []change.Reference
+		for _, r := range c.References {
+			refs = append(refs, change.Reference{
+				Text: r.Text,
+				URL:  r.URL,
+			})
+		}
+
+		changes = append(changes, change.Change{
+			Text:        c.Text,
+			ChangeTypes: s.changeTypes(c.ChangeTypes),
+			Timestamp:   c.Timestamp,
+			References:  refs,
+			EntryType:   "plugin",
+			Entry:       c,
+			Identities:  c.Identities,
+		})
+	}
+	return changes, nil
+}
+
+func (s *Summarizer) changeTypes(names []string) []change.Type {
+	types := s.config.ChangeTypes.ChangeTypes(names...)
+	if len(types) == 0 {
+		return change.UnknownTypes
+	}
+	return types
+}
+
+func toRelease(r *Release) *release.Release {
+	if r == nil || r.Version == "" {
+		return nil
+	}
+	return &release.Release{
+		Version: r.Version,
+		Date:    r.Date,
+	}
+}
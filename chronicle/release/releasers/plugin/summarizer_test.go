@@ -0,0 +1,110 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func fakeRunner(t *testing.T, responses map[string]string) runner {
+	return func(request []byte) ([]byte, error) {
+		var req Request
+		require.NoError(t, json.Unmarshal(request, &req))
+		resp, ok := responses[req.Method]
+		if !ok {
+			return nil, fmt.Errorf("unexpected method: %q", req.Method)
+		}
+		return []byte(resp), nil
+	}
+}
+
+func TestSummarizer(t *testing.T) {
+	bug := change.NewType("bug-fix", change.SemVerPatch)
+
+	s := Summarizer{
+		config: Config{
+			ChangeTypes: change.TypeSet{"bug-fix": bug},
+		},
+		run: fakeRunner(t, map[string]string{
+			MethodLastRelease:  `{"release": {"version": "v0.1.0", "date": "2021-09-16T19:34:00Z"}}`,
+			MethodRelease:      `{"release": null}`,
+			MethodReferenceURL: `{"url": "https://tracker.example.com/releases/v0.2.0"}`,
+			MethodChangesURL:   `{"error": "not supported"}`,
+			MethodChanges: `{"changes": [
+				{"text": "fix the bug", "change-types": ["bug-fix"], "timestamp": "2021-09-17T19:34:00Z", "references": [{"text": "TRK-1", "url": "https://tracker.example.com/TRK-1"}], "identities": ["tracker:TRK-1"]},
+				{"text": "something else", "change-types": ["unconfigured"], "timestamp": "2021-09-18T19:34:00Z"}
+			]}`,
+		}),
+	}
+
+	last, err := s.LastRelease()
+	require.NoError(t, err)
+	assert.Equal(t, &release.Release{Version: "v0.1.0", Date: time.Date(2021, time.September, 16, 19, 34, 0, 0, time.UTC)}, last)
+
+	missing, err := s.Release("v0.2.0")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	assert.Equal(t, "https://tracker.example.com/releases/v0.2.0", s.ReferenceURL("v0.2.0"))
+	assert.Equal(t, "", s.ChangesURL("v0.1.0", "v0.2.0"))
+
+	changes, err := s.Changes("v0.1.0", "")
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+
+	assert.Equal(t, "fix the bug", changes[0].Text)
+	assert.Equal(t, []change.Type{bug}, changes[0].ChangeTypes)
+	assert.Equal(t, []change.Reference{{Text: "TRK-1", URL: "https://tracker.example.com/TRK-1"}}, changes[0].References)
+	assert.Equal(t, []string{"tracker:TRK-1"}, changes[0].Identities)
+
+	assert.Equal(t, change.UnknownTypes, changes[1].ChangeTypes)
+}
+
+func TestSummarizer_errorResponse(t *testing.T) {
+	s := Summarizer{
+		run: fakeRunner(t, map[string]string{
+			MethodChanges: `{"error": "tracker is down"}`,
+		}),
+	}
+
+	_, err := s.Changes("v0.1.0", "")
+	require.ErrorContains(t, err, "tracker is down")
+}
+
+// TestHelperPluginProcess is not a real test, it is invoked as the plugin command by TestSummarizer_exec.
+func TestHelperPluginProcess(t *testing.T) {
+	if os.Getenv("CHRONICLE_TEST_PLUGIN") != "1" {
+		return
+	}
+	var req Request
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		os.Exit(1)
+	}
+	fmt.Printf(`{"url": "https://example.com/%s/%s..%s"}`, req.Method, req.SinceRef, req.UntilRef)
+	os.Exit(0)
+}
+
+func TestSummarizer_exec(t *testing.T) {
+	t.Setenv("CHRONICLE_TEST_PLUGIN", "1")
+
+	s, err := NewSummarizer(Config{
+		Command: os.Args[0],
+		Args:    []string{"-test.run=TestHelperPluginProcess"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://example.com/changes-url/v0.1.0..v0.2.0", s.ChangesURL("v0.1.0", "v0.2.0"))
+}
+
+func TestNewSummarizer_noCommand(t *testing.T) {
+	_, err := NewSummarizer(Config{})
+	require.Error(t, err)
+}
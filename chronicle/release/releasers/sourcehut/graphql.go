@@ -0,0 +1,54 @@
+package sourcehut
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// query makes a GraphQL request to the API of a sourcehut service (authenticated with the given token when set),
+// decoding the data of the response into the given value.
+func query(client *http.Client, apiURL, token, q string, variables map[string]interface{}, data interface{}, what string) error {
+	reqBody, err := json.Marshal(map[string]interface{}{
+		"query":     q,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, apiURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return fmt.Errorf("unable to create request for %s: %w", what, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to fetch %s: %w", what, err)
+	}
+	defer resp.Body.Close()
+
+	var doc struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return fmt.Errorf("unable to parse %s (HTTP %d): %w", what, resp.StatusCode, err)
+	}
+
+	if len(doc.Errors) > 0 {
+		return fmt.Errorf("unable to fetch %s (HTTP %d): %s", what, resp.StatusCode, doc.Errors[0].Message)
+	}
+
+	if err := json.Unmarshal(doc.Data, data); err != nil {
+		return fmt.Errorf("unable to parse %s: %w", what, err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package sourcehut
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/anchore/chronicle/internal"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+const patchsetsQuery = `query Patchsets($owner: String!, $list: String!, $cursor: Cursor) {
+  user(username: $owner) {
+    list(name: $list) {
+      patches(cursor: $cursor) {
+        results {
+          id
+          subject
+          status
+          updated
+          submitter { canonicalName }
+        }
+        cursor
+      }
+    }
+  }
+}`
+
+type listsPatchset struct {
+	ID      int
+	Subject string
+	Status  string
+	// Updated is the last time the patchset was changed, which is typically when it was marked as applied.
+	Updated   time.Time
+	Submitter string // the canonical name of the submitter (e.g. "~someone" or an email address)
+}
+
+// selectPatchsets returns the applied patchsets that were updated after the since time and at or before the until time
+// (either may be nil for an open-ended window).
+func selectPatchsets(patchsets []listsPatchset, since, until *time.Time) []listsPatchset {
+	var selected []listsPatchset
+	for _, p := range patchsets {
+		switch {
+		case p.Status != "APPLIED":
+			log.Tracef("patchset #%d filtered out: not applied (status %s)", p.ID, p.Status)
+			continue
+		case since != nil && !p.Updated.After(*since):
+			log.Tracef("patchset #%d filtered out: applied at or before %s (updated %s)", p.ID, internal.FormatDateTime(*since), internal.FormatDateTime(p.Updated))
+			continue
+		case until != nil && p.Updated.After(*until):
+			log.Tracef("patchset #%d filtered out: applied after %s (updated %s)", p.ID, internal.FormatDateTime(*until), internal.FormatDateTime(p.Updated))
+			continue
+		}
+		selected = append(selected, p)
+	}
+	return selected
+}
+
+// fetchPatchsets returns all patchsets sent to the given lists.sr.ht mailing list.
+func fetchPatchsets(client *http.Client, apiURL, token, owner, list string) ([]listsPatchset, error) {
+	var allPatchsets []listsPatchset
+	var cursor *string
+	for {
+		var data struct {
+			User *struct {
+				List *struct {
+					Patches struct {
+						Results []struct {
+							ID        int       `json:"id"`
+							Subject   string    `json:"subject"`
+							Status    string    `json:"status"`
+							Updated   time.Time `json:"updated"`
+							Submitter struct {
+								CanonicalName string `json:"canonicalName"`
+							} `json:"submitter"`
+						} `json:"results"`
+						Cursor *string `json:"cursor"`
+					} `json:"patches"`
+				} `json:"list"`
+			} `json:"user"`
+		}
+
+		err := query(client, apiURL, token, patchsetsQuery, map[string]interface{}{
+			"owner":  owner,
+			"list":   list,
+			"cursor": cursor,
+		}, &data, "patchsets")
+		if err != nil {
+			return nil, err
+		}
+
+		if data.User == nil || data.User.List == nil {
+			return nil, fmt.Errorf("unable to find mailing list ~%s/%s", owner, list)
+		}
+
+		patches := data.User.List.Patches
+		for _, p := range patches.Results {
+			allPatchsets = append(allPatchsets, listsPatchset{
+				ID:        p.ID,
+				Subject:   p.Subject,
+				Status:    p.Status,
+				Updated:   p.Updated,
+				Submitter: p.Submitter.CanonicalName,
+			})
+		}
+
+		if patches.Cursor == nil {
+			break
+		}
+		cursor = patches.Cursor
+	}
+
+	return allPatchsets, nil
+}
@@ -0,0 +1,275 @@
+package sourcehut
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/tags"
+	"github.com/anchore/chronicle/internal"
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+var _ release.Summarizer = (*Summarizer)(nil)
+
+type Config struct {
+	Host               string         // the sourcehut host (e.g. "sr.ht"), where services are subdomains (e.g. "git.sr.ht" and "todo.sr.ht")
+	Tracker            string         // the todo.sr.ht tracker name (defaults to the repo name)
+	IncludeResolutions []string       // only resolved tickets with any of these resolutions are considered (e.g. "FIXED", all resolutions when empty)
+	ChangeTypesByLabel change.TypeSet // the change type for each ticket label
+	List               string         // the lists.sr.ht mailing list whose applied patchsets are included (disabled when empty)
+	PatchChangeType    change.Type    // the change type for applied patchsets
+	HTTPClient         *http.Client   // the client that API requests are made with, e.g. for a proxy or instrumentation (optional)
+	Token              string         // the personal access token to authenticate with (optional)
+}
+
+// Summarizer builds changes from resolved todo.sr.ht tickets (and optionally the patchsets applied from a lists.sr.ht
+// mailing list) between two tags. Releases are determined by the semver tags in the local repo.
+type Summarizer struct {
+	tags.Releaser
+	git       git.Interface
+	client    *http.Client
+	ownerName string
+	repoName  string
+	config    Config
+}
+
+func NewSummarizer(gitter git.Interface, config Config) (*Summarizer, error) {
+	repoURL, err := gitter.RemoteURL()
+	if err != nil {
+		return nil, err
+	}
+
+	owner, repo := extractSourcehutOwnerAndRepo(repoURL)
+	if owner == "" || repo == "" {
+		return nil, fmt.Errorf("failed to extract owner and repo from %q", repoURL)
+	}
+
+	if config.Tracker == "" {
+		config.Tracker = repo
+	}
+
+	log.WithFields("owner", owner, "repo", repo, "tracker", config.Tracker).Debug("sourcehut summarizer")
+
+	client := config.HTTPClient
+	if client == nil {
+		client = &http.Client{}
+	}
+
+	return &Summarizer{
+		Releaser:  tags.NewReleaser(gitter),
+		git:       gitter,
+		client:    client,
+		ownerName: owner,
+		repoName:  repo,
+		config:    config,
+	}, nil
+}
+
+func (s *Summarizer) ReferenceURL(tag string) string {
+	return fmt.Sprintf("https://git.%s/~%s/%s/refs/%s", s.config.Host, s.ownerName, s.repoName, url.PathEscape(tag))
+}
+
+func (s *Summarizer) ChangesURL(_, untilRef string) string {
+	// git.sr.ht has no compare view, the closest is the log starting from the end of the release
+	if untilRef == "" {
+		return fmt.Sprintf("https://git.%s/~%s/%s/log", s.config.Host, s.ownerName, s.repoName)
+	}
+	return fmt.Sprintf("https://git.%s/~%s/%s/log/%s", s.config.Host, s.ownerName, s.repoName, url.PathEscape(untilRef))
+}
+
+func (s *Summarizer) ticketURL(id int) string {
+	return fmt.Sprintf("https://todo.%s/~%s/%s/%d", s.config.Host, s.ownerName, s.config.Tracker, id)
+}
+
+func (s *Summarizer) patchsetURL(id int) string {
+	return fmt.Sprintf("https://lists.%s/~%s/%s/patches/%d", s.config.Host, s.ownerName, s.config.List, id)
+}
+
+func (s *Summarizer) Changes(sinceRef, untilRef string) ([]change.Change, error) {
+	var since, until *time.Time
+	if sinceRef != "" {
+		sinceTag, err := s.git.SearchForTag(sinceRef)
+		if err != nil {
+			return nil, err
+		}
+		since = &sinceTag.Timestamp
+	}
+
+	if untilRef != "" {
+		untilTag, err := s.git.SearchForTag(untilRef)
+		if err != nil {
+			return nil, err
+		}
+		until = &untilTag.Timestamp
+	}
+
+	tickets, err := fetchTickets(s.client, fmt.Sprintf("https://todo.%s/query", s.config.Host), s.config.Token, s.ownerName, s.config.Tracker)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("total tickets discovered: %d", len(tickets))
+
+	tickets = filterTickets(tickets, ticketsResolved(s.config.IncludeResolutions...), ticketsWithinRange(since, until), ticketsWithChangeTypes(s.config))
+
+	log.Debugf("tickets contributing to changelog: %d", len(tickets))
+
+	var changes []change.Change
+	for _, t := range tickets {
+		changes = append(changes, change.Change{
+			Text:        t.Title,
+			ChangeTypes: s.config.ChangeTypesByLabel.ChangeTypes(t.Labels...),
+			Timestamp:   t.Updated,
+			References: []change.Reference{
+				{
+					Text: fmt.Sprintf("#%d", t.ID),
+					URL:  s.ticketURL(t.ID),
+				},
+			},
+			EntryType:  "sourcehutTicket",
+			Entry:      t,
+			Identities: []string{fmt.Sprintf("sourcehut-ticket:%d", t.ID)},
+		})
+	}
+
+	if s.config.List != "" {
+		patchChanges, err := s.patchsetChanges(since, until)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, patchChanges...)
+	}
+
+	return changes, nil
+}
+
+// patchsetChanges returns a change for each patchset applied from the mailing list within the given range.
+func (s *Summarizer) patchsetChanges(since, until *time.Time) ([]change.Change, error) {
+	patchsets, err := fetchPatchsets(s.client, fmt.Sprintf("https://lists.%s/query", s.config.Host), s.config.Token, s.ownerName, s.config.List)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("total patchsets discovered: %d", len(patchsets))
+
+	var changes []change.Change
+	for _, p := range selectPatchsets(patchsets, since, until) {
+		changes = append(changes, change.Change{
+			Text:        p.Subject,
+			ChangeTypes: []change.Type{s.config.PatchChangeType},
+			Timestamp:   p.Updated,
+			References: []change.Reference{
+				{
+					Text: fmt.Sprintf("patchset #%d", p.ID),
+					URL:  s.patchsetURL(p.ID),
+				},
+			},
+			EntryType:  "sourcehutPatchset",
+			Entry:      p,
+			Identities: []string{fmt.Sprintf("sourcehut-patchset:%d", p.ID)},
+		})
+	}
+
+	log.Debugf("patchsets contributing to changelog: %d", len(changes))
+
+	return changes, nil
+}
+
+type ticketFilter func(ticket todoTicket) bool
+
+func filterTickets(tickets []todoTicket, filters ...ticketFilter) []todoTicket {
+	results := make([]todoTicket, 0, len(tickets))
+
+ticketLoop:
+	for _, t := range tickets {
+		for _, f := range filters {
+			if !f(t) {
+				continue ticketLoop
+			}
+		}
+		results = append(results, t)
+	}
+
+	return results
+}
+
+func ticketsResolved(resolutions ...string) ticketFilter {
+	set := strset.New()
+	for _, r := range resolutions {
+		set.Add(strings.ToUpper(r))
+	}
+	return func(ticket todoTicket) bool {
+		if ticket.Status != "RESOLVED" {
+			log.Tracef("ticket #%d filtered out: not resolved (status %s)", ticket.ID, ticket.Status)
+			return false
+		}
+		// note: when no resolutions are given every resolved ticket is considered
+		if !set.IsEmpty() && !set.Has(ticket.Resolution) {
+			log.Tracef("ticket #%d filtered out: has resolution %s", ticket.ID, ticket.Resolution)
+			return false
+		}
+		return true
+	}
+}
+
+func ticketsWithinRange(since, until *time.Time) ticketFilter {
+	return func(ticket todoTicket) bool {
+		if since != nil && !ticket.Updated.After(*since) {
+			log.Tracef("ticket #%d filtered out: resolved before %s (updated %s)", ticket.ID, internal.FormatDateTime(*since), internal.FormatDateTime(ticket.Updated))
+			return false
+		}
+		if until != nil && ticket.Updated.After(*until) {
+			log.Tracef("ticket #%d filtered out: resolved after %s (updated %s)", ticket.ID, internal.FormatDateTime(*until), internal.FormatDateTime(ticket.Updated))
+			return false
+		}
+		return true
+	}
+}
+
+func ticketsWithChangeTypes(config Config) ticketFilter {
+	return func(ticket todoTicket) bool {
+		keep := len(config.ChangeTypesByLabel.ChangeTypes(ticket.Labels...)) > 0
+		if !keep {
+			log.Tracef("ticket #%d filtered out: no change types", ticket.ID)
+		}
+		return keep
+	}
+}
+
+func extractSourcehutOwnerAndRepo(u string) (string, string) {
+	var p string
+	switch {
+	// e.g. git@git.sr.ht:~someone/project
+	case strings.HasPrefix(u, "git@"):
+		fields := strings.SplitN(u, ":", 2)
+		if len(fields) != 2 {
+			return "", ""
+		}
+		p = fields[1]
+
+	// e.g. https://git.sr.ht/~someone/project
+	case strings.HasPrefix(u, "https://") || strings.HasPrefix(u, "http://"):
+		urlObj, err := url.Parse(u)
+		if err != nil {
+			return "", ""
+		}
+		p = urlObj.Path
+	default:
+		return "", ""
+	}
+
+	fields := strings.Split(strings.Trim(p, "/"), "/")
+	if len(fields) != 2 || !strings.HasPrefix(fields[0], "~") {
+		return "", ""
+	}
+
+	return strings.TrimPrefix(fields[0], "~"), strings.TrimSuffix(fields[1], ".git")
+}
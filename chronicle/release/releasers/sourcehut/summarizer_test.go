@@ -0,0 +1,269 @@
+package sourcehut
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_extractSourcehutOwnerAndRepo(t *testing.T) {
+	tests := []struct {
+		url   string
+		owner string
+		repo  string
+	}{
+		{
+			url:   "git@git.sr.ht:~someone/project",
+			owner: "someone",
+			repo:  "project",
+		},
+		{
+			url:   "https://git.sr.ht/~someone/project",
+			owner: "someone",
+			repo:  "project",
+		},
+		{
+			url:   "https://git.sr.ht/~someone/project.git",
+			owner: "someone",
+			repo:  "project",
+		},
+		{
+			// not a sourcehut user path
+			url: "https://github.com/anchore/chronicle",
+		},
+		{
+			url: "ssh://git.sr.ht/~someone",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			owner, repo := extractSourcehutOwnerAndRepo(tt.url)
+			assert.Equal(t, tt.owner, owner)
+			assert.Equal(t, tt.repo, repo)
+		})
+	}
+}
+
+func TestSummarizer_URLs(t *testing.T) {
+	s := Summarizer{
+		ownerName: "someone",
+		repoName:  "project",
+		config: Config{
+			Host:    "sr.ht",
+			Tracker: "project-tickets",
+		},
+	}
+
+	assert.Equal(t, "https://git.sr.ht/~someone/project/refs/v0.2.0", s.ReferenceURL("v0.2.0"))
+	assert.Equal(t, "https://git.sr.ht/~someone/project/log/v0.2.0", s.ChangesURL("v0.1.0", "v0.2.0"))
+	assert.Equal(t, "https://git.sr.ht/~someone/project/log", s.ChangesURL("v0.1.0", ""))
+	assert.Equal(t, "https://todo.sr.ht/~someone/project-tickets/12", s.ticketURL(12))
+
+	s.config.List = "project-devel"
+	assert.Equal(t, "https://lists.sr.ht/~someone/project-devel/patches/34", s.patchsetURL(34))
+}
+
+func Test_fetchTickets(t *testing.T) {
+	pages := []string{
+		`{"data": {"user": {"tracker": {"tickets": {"cursor": "next", "results": [
+			{"id": 1, "title": "fix the bug", "status": "RESOLVED", "resolution": "FIXED", "updated": "2021-09-16T19:34:00Z", "labels": [{"name": "bug"}]}
+		]}}}}}`,
+		`{"data": {"user": {"tracker": {"tickets": {"cursor": null, "results": [
+			{"id": 2, "title": "add the feature", "status": "REPORTED", "resolution": "UNRESOLVED", "updated": "2021-09-17T19:34:00Z", "labels": []}
+		]}}}}}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "someone", req.Variables["owner"])
+		assert.Equal(t, "project", req.Variables["tracker"])
+		if req.Variables["cursor"] == "next" {
+			fmt.Fprint(w, pages[1])
+			return
+		}
+		fmt.Fprint(w, pages[0])
+	}))
+	defer server.Close()
+
+	tickets, err := fetchTickets(server.Client(), server.URL, "", "someone", "project")
+	require.NoError(t, err)
+
+	assert.Equal(t, []todoTicket{
+		{
+			ID:         1,
+			Title:      "fix the bug",
+			Status:     "RESOLVED",
+			Resolution: "FIXED",
+			Updated:    time.Date(2021, 9, 16, 19, 34, 0, 0, time.UTC),
+			Labels:     []string{"bug"},
+		},
+		{
+			ID:         2,
+			Title:      "add the feature",
+			Status:     "REPORTED",
+			Resolution: "UNRESOLVED",
+			Updated:    time.Date(2021, 9, 17, 19, 34, 0, 0, time.UTC),
+		},
+	}, tickets)
+}
+
+func Test_fetchTickets_missingTracker(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"user": {"tracker": null}}}`)
+	}))
+	defer server.Close()
+
+	_, err := fetchTickets(server.Client(), server.URL, "", "someone", "project")
+	require.Error(t, err)
+}
+
+func Test_ticketFilters(t *testing.T) {
+	since := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2021, 9, 30, 0, 0, 0, 0, time.UTC)
+
+	tickets := []todoTicket{
+		{ID: 1, Status: "RESOLVED", Resolution: "FIXED", Updated: since.Add(time.Hour)},
+		{ID: 2, Status: "RESOLVED", Resolution: "WONT_FIX", Updated: since.Add(time.Hour)},
+		{ID: 3, Status: "IN_PROGRESS", Resolution: "UNRESOLVED", Updated: since.Add(time.Hour)},
+		{ID: 4, Status: "RESOLVED", Resolution: "IMPLEMENTED", Updated: since},
+		{ID: 5, Status: "RESOLVED", Resolution: "IMPLEMENTED", Updated: until},
+		{ID: 6, Status: "RESOLVED", Resolution: "IMPLEMENTED", Updated: until.Add(time.Second)},
+	}
+
+	var ids []int
+	for _, ticket := range filterTickets(tickets, ticketsResolved("fixed", "implemented"), ticketsWithinRange(&since, &until)) {
+		ids = append(ids, ticket.ID)
+	}
+
+	assert.Equal(t, []int{1, 5}, ids)
+}
+
+func Test_ticketsResolved(t *testing.T) {
+	tickets := []todoTicket{
+		{ID: 1, Status: "RESOLVED", Resolution: "FIXED"},
+		{ID: 2, Status: "RESOLVED", Resolution: "WONT_FIX"},
+		{ID: 3, Status: "IN_PROGRESS", Resolution: "UNRESOLVED"},
+		{ID: 4, Status: "RESOLVED", Resolution: "IMPLEMENTED"},
+	}
+
+	tests := []struct {
+		name        string
+		resolutions []string
+		want        []int
+	}{
+		{
+			name:        "resolutions given",
+			resolutions: []string{"fixed", "implemented"},
+			want:        []int{1, 4},
+		},
+		{
+			name:        "resolutions are case insensitive",
+			resolutions: []string{"Wont_Fix"},
+			want:        []int{2},
+		},
+		{
+			name: "no resolutions given considers all resolutions",
+			want: []int{1, 2, 4},
+		},
+		{
+			name:        "empty resolutions considers all resolutions",
+			resolutions: []string{},
+			want:        []int{1, 2, 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []int
+			for _, ticket := range filterTickets(tickets, ticketsResolved(tt.resolutions...)) {
+				ids = append(ids, ticket.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func Test_fetchPatchsets(t *testing.T) {
+	pages := []string{
+		`{"data": {"user": {"list": {"patches": {"cursor": "next", "results": [
+			{"id": 1, "subject": "[PATCH v2] fix the bug", "status": "APPLIED", "updated": "2021-09-16T19:34:00Z", "submitter": {"canonicalName": "~someone"}}
+		]}}}}}`,
+		`{"data": {"user": {"list": {"patches": {"cursor": null, "results": [
+			{"id": 2, "subject": "[PATCH] add the feature", "status": "PROPOSED", "updated": "2021-09-17T19:34:00Z", "submitter": {"canonicalName": "Someone Else <else@example.com>"}}
+		]}}}}}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "someone", req.Variables["owner"])
+		assert.Equal(t, "project-devel", req.Variables["list"])
+		if req.Variables["cursor"] == "next" {
+			fmt.Fprint(w, pages[1])
+			return
+		}
+		fmt.Fprint(w, pages[0])
+	}))
+	defer server.Close()
+
+	patchsets, err := fetchPatchsets(server.Client(), server.URL, "", "someone", "project-devel")
+	require.NoError(t, err)
+
+	assert.Equal(t, []listsPatchset{
+		{
+			ID:        1,
+			Subject:   "[PATCH v2] fix the bug",
+			Status:    "APPLIED",
+			Updated:   time.Date(2021, 9, 16, 19, 34, 0, 0, time.UTC),
+			Submitter: "~someone",
+		},
+		{
+			ID:        2,
+			Subject:   "[PATCH] add the feature",
+			Status:    "PROPOSED",
+			Updated:   time.Date(2021, 9, 17, 19, 34, 0, 0, time.UTC),
+			Submitter: "Someone Else <else@example.com>",
+		},
+	}, patchsets)
+}
+
+func Test_fetchPatchsets_missingList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"user": {"list": null}}}`)
+	}))
+	defer server.Close()
+
+	_, err := fetchPatchsets(server.Client(), server.URL, "", "someone", "project-devel")
+	require.Error(t, err)
+}
+
+func Test_selectPatchsets(t *testing.T) {
+	since := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2021, 9, 30, 0, 0, 0, 0, time.UTC)
+
+	patchsets := []listsPatchset{
+		{ID: 1, Status: "APPLIED", Updated: since.Add(time.Hour)},
+		{ID: 2, Status: "REJECTED", Updated: since.Add(time.Hour)},
+		{ID: 3, Status: "PROPOSED", Updated: since.Add(time.Hour)},
+		{ID: 4, Status: "APPLIED", Updated: since},
+		{ID: 5, Status: "APPLIED", Updated: until},
+		{ID: 6, Status: "APPLIED", Updated: until.Add(time.Second)},
+	}
+
+	var ids []int
+	for _, p := range selectPatchsets(patchsets, &since, &until) {
+		ids = append(ids, p.ID)
+	}
+
+	assert.Equal(t, []int{1, 5}, ids)
+}
@@ -0,0 +1,99 @@
+package sourcehut
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const ticketsQuery = `query Tickets($owner: String!, $tracker: String!, $cursor: Cursor) {
+  user(username: $owner) {
+    tracker(name: $tracker) {
+      tickets(cursor: $cursor) {
+        results {
+          id
+          title
+          status
+          resolution
+          updated
+          labels { name }
+        }
+        cursor
+      }
+    }
+  }
+}`
+
+type todoTicket struct {
+	ID         int
+	Title      string
+	Status     string
+	Resolution string
+	// Updated is the last time the ticket was changed. Tickets have no resolution timestamp, however, resolving a
+	// ticket is typically the last change made to it.
+	Updated time.Time
+	Labels  []string
+}
+
+// fetchTickets returns all tickets for the given todo.sr.ht tracker.
+func fetchTickets(client *http.Client, apiURL, token, owner, tracker string) ([]todoTicket, error) {
+	var allTickets []todoTicket
+	var cursor *string
+	for {
+		var data struct {
+			User *struct {
+				Tracker *struct {
+					Tickets struct {
+						Results []struct {
+							ID         int       `json:"id"`
+							Title      string    `json:"title"`
+							Status     string    `json:"status"`
+							Resolution string    `json:"resolution"`
+							Updated    time.Time `json:"updated"`
+							Labels     []struct {
+								Name string `json:"name"`
+							} `json:"labels"`
+						} `json:"results"`
+						Cursor *string `json:"cursor"`
+					} `json:"tickets"`
+				} `json:"tracker"`
+			} `json:"user"`
+		}
+
+		err := query(client, apiURL, token, ticketsQuery, map[string]interface{}{
+			"owner":   owner,
+			"tracker": tracker,
+			"cursor":  cursor,
+		}, &data, "tickets")
+		if err != nil {
+			return nil, err
+		}
+
+		if data.User == nil || data.User.Tracker == nil {
+			return nil, fmt.Errorf("unable to find tracker ~%s/%s", owner, tracker)
+		}
+
+		tickets := data.User.Tracker.Tickets
+		for _, t := range tickets.Results {
+			var labels []string
+			for _, l := range t.Labels {
+				labels = append(labels, l.Name)
+			}
+			allTickets = append(allTickets, todoTicket{
+				ID:         t.ID,
+				Title:      t.Title,
+				Status:     t.Status,
+				Resolution: t.Resolution,
+				Updated:    t.Updated,
+				Labels:     labels,
+			})
+		}
+
+		if tickets.Cursor == nil {
+			break
+		}
+		cursor = tickets.Cursor
+	}
+
+	return allTickets, nil
+}
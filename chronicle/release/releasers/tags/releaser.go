@@ -0,0 +1,99 @@
+package tags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// Releaser determines release information from local git tags alone. This is useful for summarizers whose source has
+// no notion of a release (e.g. an issue tracker), where the semver tags in the repo are the only record of a release.
+type Releaser struct {
+	git git.Interface
+}
+
+func NewReleaser(gitter git.Interface) Releaser {
+	return Releaser{
+		git: gitter,
+	}
+}
+
+// LastRelease returns the most recent semver tag that is not pointing at HEAD (a tag at HEAD is considered to be the
+// release being described, not the previous release).
+func (r Releaser) LastRelease() (*release.Release, error) {
+	releases, err := r.Releases()
+	if err != nil {
+		return nil, err
+	}
+	if len(releases) == 0 {
+		return nil, nil
+	}
+	return &releases[len(releases)-1], nil
+}
+
+// Releases returns a release for each semver tag that is not pointing at HEAD (oldest first).
+func (r Releaser) Releases() ([]release.Release, error) {
+	tags, err := r.git.TagsFromLocal()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch local tags: %w", err)
+	}
+
+	headTag, err := r.git.HeadTag()
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine head tag: %w", err)
+	}
+
+	tags = versionTags(tags)
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].Timestamp.Before(tags[j].Timestamp)
+	})
+
+	releases := []release.Release{}
+	for _, t := range tags {
+		if t.Name == headTag {
+			log.Tracef("skipping tag=%q as a last release candidate: tag is at HEAD", headTag)
+			continue
+		}
+		releases = append(releases, release.Release{
+			Version: t.Name,
+			Date:    t.Timestamp,
+		})
+	}
+	return releases, nil
+}
+
+// Release returns the release for the given tag name. If the tag does not exist then nil is returned (without an error).
+func (r Releaser) Release(ref string) (*release.Release, error) {
+	tags, err := r.git.TagsFromLocal()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch local tags: %w", err)
+	}
+
+	for _, t := range tags {
+		if t.Name == ref {
+			return &release.Release{
+				Version: t.Name,
+				Date:    t.Timestamp,
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+func versionTags(tags []git.Tag) (results []git.Tag) {
+	for _, t := range tags {
+		if _, err := semver.NewVersion(strings.TrimPrefix(t.Name, "v")); err != nil {
+			log.Tracef("skipping tag=%q as a release candidate: not a semver tag", t.Name)
+			continue
+		}
+		results = append(results, t)
+	}
+	return results
+}
@@ -0,0 +1,118 @@
+package release
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+type ReportConfig struct {
+	Since            time.Time
+	Until            time.Time
+	ChangeTypeTitles []change.TypeTitle
+}
+
+// Report is a digest of all changes made within a window of time, irrespective of any release.
+type Report struct {
+	Since            time.Time          // the start of the window (exclusive)
+	Until            time.Time          // the end of the window (inclusive)
+	Changes          change.Changes     // all changes made within the window, oldest first
+	SupportedChanges []change.TypeTitle // the sections of the report and their display titles
+}
+
+// ReportInfo returns a report of all changes made within the configured window of time. Changes without a timestamp
+// cannot be placed within the window and are not included.
+func ReportInfo(summer Summarizer, config ReportConfig) (*Report, error) {
+	if !config.Until.After(config.Since) {
+		return nil, fmt.Errorf("report window is empty: %s until %s", internal.FormatDateTime(config.Since), internal.FormatDateTime(config.Until))
+	}
+
+	log.WithFields("since", internal.FormatDateTime(config.Since), "until", internal.FormatDateTime(config.Until)).Info("report window")
+
+	// there is no notion of a release for a report, so the changes since the last release before the window are
+	// considered and then narrowed to the window
+	sinceRef, err := reportSinceRef(summer, config.Since)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine the last release before the report window: %w", err)
+	}
+	if sinceRef != "" {
+		log.WithFields("since", sinceRef).Debug("summarizing changes since the last release before the report window")
+	}
+
+	changes, err := summer.Changes(sinceRef, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to summarize changes: %w", err)
+	}
+
+	changes = changesWithinWindow(changes, config.Since, config.Until)
+
+	logChanges(changes)
+
+	return &Report{
+		Since:            config.Since,
+		Until:            config.Until,
+		Changes:          changes,
+		SupportedChanges: config.ChangeTypeTitles,
+	}, nil
+}
+
+// reportSinceRef returns the version of the newest release made at or before the start of the window, so that the
+// whole history does not need to be summarized. An empty ref (all changes) is returned when there is no such release.
+func reportSinceRef(summer Summarizer, since time.Time) (string, error) {
+	var releases []Release
+	if lister, ok := summer.(ReleaseLister); ok {
+		listed, err := lister.Releases()
+		if err != nil {
+			return "", err
+		}
+		releases = listed
+	}
+
+	if releases == nil {
+		last, err := summer.LastRelease()
+		if err != nil {
+			return "", err
+		}
+		if last != nil {
+			releases = []Release{*last}
+		}
+	}
+
+	var ref string
+	var newest time.Time
+	for _, r := range releases {
+		// note: releases without a date cannot be placed before the window
+		if r.Date.IsZero() || r.Date.After(since) {
+			continue
+		}
+		if ref == "" || r.Date.After(newest) {
+			ref, newest = r.Version, r.Date
+		}
+	}
+	return ref, nil
+}
+
+func changesWithinWindow(changes []change.Change, since, until time.Time) change.Changes {
+	var results change.Changes
+	for _, c := range changes {
+		switch {
+		case c.Timestamp.IsZero():
+			log.Tracef("change %q excluded from report: no timestamp", c.Text)
+			continue
+		case !c.Timestamp.After(since), c.Timestamp.After(until):
+			log.Tracef("change %q excluded from report: outside of window (%s)", c.Text, internal.FormatDateTime(c.Timestamp))
+			continue
+		}
+		results = append(results, c)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Timestamp.Before(results[j].Timestamp)
+	})
+
+	return results
+}
@@ -0,0 +1,156 @@
+package release
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func TestReportInfo(t *testing.T) {
+	since := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(7 * 24 * time.Hour)
+
+	bug := change.NewType("bug", change.SemVerPatch)
+
+	summer := MockSummarizer{
+		MockChanges: []change.Change{
+			{
+				Text:        "later fix",
+				ChangeTypes: []change.Type{bug},
+				Timestamp:   since.Add(48 * time.Hour),
+			},
+			{
+				Text:        "earlier fix",
+				ChangeTypes: []change.Type{bug},
+				Timestamp:   since.Add(time.Hour),
+			},
+			{
+				Text:        "before the window",
+				ChangeTypes: []change.Type{bug},
+				Timestamp:   since,
+			},
+			{
+				Text:        "after the window",
+				ChangeTypes: []change.Type{bug},
+				Timestamp:   until.Add(time.Second),
+			},
+			{
+				Text:        "at the end of the window",
+				ChangeTypes: []change.Type{bug},
+				Timestamp:   until,
+			},
+			{
+				Text:        "no timestamp",
+				ChangeTypes: []change.Type{bug},
+			},
+		},
+	}
+
+	titles := []change.TypeTitle{{ChangeType: bug, Title: "Bug Fixes"}}
+
+	report, err := ReportInfo(summer, ReportConfig{
+		Since:            since,
+		Until:            until,
+		ChangeTypeTitles: titles,
+	})
+	require.NoError(t, err)
+
+	var texts []string
+	for _, c := range report.Changes {
+		texts = append(texts, c.Text)
+	}
+
+	assert.Equal(t, []string{"earlier fix", "later fix", "at the end of the window"}, texts)
+	assert.Equal(t, since, report.Since)
+	assert.Equal(t, until, report.Until)
+	assert.Equal(t, titles, report.SupportedChanges)
+}
+
+// sinceRefRecorder records the since ref that changes are summarized from.
+type sinceRefRecorder struct {
+	MockSummarizer
+	sinceRef *string
+}
+
+func (r sinceRefRecorder) Changes(sinceRef, untilRef string) ([]change.Change, error) {
+	*r.sinceRef = sinceRef
+	return r.MockSummarizer.Changes(sinceRef, untilRef)
+}
+
+func TestReportInfo_sinceRef(t *testing.T) {
+	since := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(7 * 24 * time.Hour)
+
+	tests := []struct {
+		name   string
+		summer MockSummarizer
+		want   string
+	}{
+		{
+			name: "no releases",
+		},
+		{
+			name: "newest release at or before the window",
+			summer: MockSummarizer{
+				MockReleases: []Release{
+					{Version: "v0.1.0", Date: since.Add(-30 * 24 * time.Hour)},
+					{Version: "v0.2.0", Date: since},
+					{Version: "v0.3.0", Date: since.Add(time.Hour)},
+				},
+			},
+			want: "v0.2.0",
+		},
+		{
+			name: "releases listed out of order",
+			summer: MockSummarizer{
+				MockReleases: []Release{
+					{Version: "v0.2.0", Date: since.Add(-time.Hour)},
+					{Version: "v0.1.0", Date: since.Add(-30 * 24 * time.Hour)},
+				},
+			},
+			want: "v0.2.0",
+		},
+		{
+			name: "all releases within the window",
+			summer: MockSummarizer{
+				MockReleases: []Release{
+					{Version: "v0.1.0", Date: since.Add(time.Hour)},
+				},
+			},
+		},
+		{
+			name: "releases without a date are ignored",
+			summer: MockSummarizer{
+				MockReleases: []Release{
+					{Version: "v0.1.0", Date: since.Add(-time.Hour)},
+					{Version: "v0.2.0"},
+				},
+			},
+			want: "v0.1.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			_, err := ReportInfo(sinceRefRecorder{MockSummarizer: tt.summer, sinceRef: &got}, ReportConfig{
+				Since: since,
+				Until: until,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReportInfo_emptyWindow(t *testing.T) {
+	now := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+	_, err := ReportInfo(MockSummarizer{}, ReportConfig{
+		Since: now,
+		Until: now,
+	})
+	require.ErrorContains(t, err, "report window is empty")
+}
@@ -0,0 +1,177 @@
+package release
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+type RetroConfig struct {
+	Since            time.Time
+	Until            time.Time
+	ChangeTypeTitles []change.TypeTitle
+	TopContributors  int // the number of contributors to list (all when 0)
+}
+
+// Retro is a retrospective of all releases made within a period of time (e.g. a year), for project retrospectives.
+type Retro struct {
+	Since            time.Time          // the start of the period (exclusive)
+	Until            time.Time          // the end of the period (inclusive)
+	Releases         []RetroRelease     // the releases made within the period, oldest first
+	BiggestRelease   *RetroRelease      `json:",omitempty"` // the release with the most changes (the earliest when tied)
+	Changes          change.Changes     // all changes made within the period, oldest first
+	ChangesByType    []TypeCount        // the number of changes of each change type (in the configured order, omitting types without changes)
+	TopContributors  []ContributorCount // the people who made the most changes within the period, most changes first
+	Unreleased       int                // the number of changes made within the period after the last release
+	SupportedChanges []change.TypeTitle // the change types of the retrospective and their display titles
+}
+
+// RetroRelease is a release made within the period of a retrospective.
+type RetroRelease struct {
+	Release
+	Changes int // the number of changes within the release (that were made within the period)
+}
+
+// TypeCount is the number of changes of a change type.
+type TypeCount struct {
+	change.TypeTitle
+	Count int
+}
+
+// ContributorCount is the number of changes made by a contributor.
+type ContributorCount struct {
+	change.Reference
+	Count int
+}
+
+// RetroInfo returns a retrospective of the releases and changes made within the configured period. Each change is
+// attributed to the first release made at or after it. Changes without a timestamp cannot be placed within the period
+// and are not included. The releases are only known for summarizers that can list them (see ReleaseLister).
+func RetroInfo(summer Summarizer, config RetroConfig) (*Retro, error) {
+	if !config.Until.After(config.Since) {
+		return nil, fmt.Errorf("retrospective period is empty: %s until %s", internal.FormatDateTime(config.Since), internal.FormatDateTime(config.Until))
+	}
+
+	log.WithFields("since", internal.FormatDateTime(config.Since), "until", internal.FormatDateTime(config.Until)).Info("retrospective period")
+
+	releases, err := releasesWithin(summer, config.Since, config.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := summer.Changes("", "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to summarize changes: %w", err)
+	}
+	changes = changesWithinWindow(changes, config.Since, config.Until)
+
+	logChanges(changes)
+
+	retro := Retro{
+		Since:            config.Since,
+		Until:            config.Until,
+		Releases:         releases,
+		Changes:          changes,
+		ChangesByType:    countByType(changes, config.ChangeTypeTitles),
+		TopContributors:  topContributors(changes, config.TopContributors),
+		SupportedChanges: config.ChangeTypeTitles,
+	}
+
+	for _, c := range changes {
+		idx := sort.Search(len(retro.Releases), func(i int) bool {
+			return !retro.Releases[i].Date.Before(c.Timestamp)
+		})
+		if idx == len(retro.Releases) {
+			retro.Unreleased++
+			continue
+		}
+		retro.Releases[idx].Changes++
+	}
+
+	for i := range retro.Releases {
+		if retro.BiggestRelease == nil || retro.Releases[i].Changes > retro.BiggestRelease.Changes {
+			biggest := retro.Releases[i]
+			retro.BiggestRelease = &biggest
+		}
+	}
+
+	return &retro, nil
+}
+
+// releasesWithin returns the releases made within the period (oldest first).
+func releasesWithin(summer Summarizer, since, until time.Time) ([]RetroRelease, error) {
+	lister, ok := summer.(ReleaseLister)
+	if !ok {
+		log.Warn("the configured source cannot list its releases, so releases are not included in the retrospective")
+		return nil, nil
+	}
+
+	releases, err := lister.Releases()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list releases: %w", err)
+	}
+
+	var results []RetroRelease
+	for _, r := range releases {
+		if r.Draft || !r.Date.After(since) || r.Date.After(until) {
+			continue
+		}
+		results = append(results, RetroRelease{Release: r})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Date.Before(results[j].Date)
+	})
+
+	log.Infof("releases within the period: %d", len(results))
+	return results, nil
+}
+
+func countByType(changes change.Changes, titles []change.TypeTitle) []TypeCount {
+	var results []TypeCount
+	for _, t := range titles {
+		if count := len(changes.ByChangeType(t.ChangeType)); count > 0 {
+			results = append(results, TypeCount{TypeTitle: t, Count: count})
+		}
+	}
+	return results
+}
+
+// topContributors returns the authors with the most changes (most first, then by name), limited to the given number
+// of contributors (all when 0).
+func topContributors(changes change.Changes, limit int) []ContributorCount {
+	byName := make(map[string]*ContributorCount)
+	var results []*ContributorCount
+	for _, c := range changes {
+		for _, a := range c.Authors {
+			entry, ok := byName[a.Text]
+			if !ok {
+				entry = &ContributorCount{Reference: a}
+				byName[a.Text] = entry
+				results = append(results, entry)
+			}
+			entry.Count++
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Count != results[j].Count {
+			return results[i].Count > results[j].Count
+		}
+		return results[i].Text < results[j].Text
+	})
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	var contributors []ContributorCount
+	for _, r := range results {
+		contributors = append(contributors, *r)
+	}
+	return contributors
+}
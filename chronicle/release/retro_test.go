@@ -0,0 +1,113 @@
+package release
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+)
+
+func TestRetroInfo(t *testing.T) {
+	since := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	bug := change.NewType("bug", change.SemVerPatch)
+	added := change.NewType("added", change.SemVerMinor)
+	removed := change.NewType("removed", change.SemVerMajor)
+
+	alice := change.Reference{Text: "@alice", URL: "https://github.com/alice"}
+	bob := change.Reference{Text: "@bob", URL: "https://github.com/bob"}
+	carol := change.Reference{Text: "@carol", URL: "https://github.com/carol"}
+
+	march := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	june := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	summer := MockSummarizer{
+		MockReleases: []Release{
+			{Version: "v0.9.0", Date: since.Add(-time.Hour)},
+			{Version: "v1.0.0", Date: march},
+			{Version: "v1.1.0", Date: june},
+			{Version: "v1.2.0", Date: june.Add(time.Hour), Draft: true},
+			{Version: "v2.0.0", Date: until.Add(time.Hour)},
+		},
+		MockChanges: []change.Change{
+			{Text: "before the period", ChangeTypes: []change.Type{bug}, Timestamp: since, Authors: []change.Reference{carol}},
+			{Text: "fix one", ChangeTypes: []change.Type{bug}, Timestamp: since.Add(time.Hour), Authors: []change.Reference{bob}},
+			{Text: "add one", ChangeTypes: []change.Type{added}, Timestamp: march, Authors: []change.Reference{alice}},
+			{Text: "add two", ChangeTypes: []change.Type{added}, Timestamp: march.Add(time.Hour), Authors: []change.Reference{alice, bob}},
+			{Text: "fix two", ChangeTypes: []change.Type{bug}, Timestamp: march.Add(2 * time.Hour), Authors: []change.Reference{alice}},
+			{Text: "fix three", ChangeTypes: []change.Type{bug}, Timestamp: june.Add(time.Hour), Authors: []change.Reference{carol}},
+			{Text: "no timestamp", ChangeTypes: []change.Type{bug}, Authors: []change.Reference{carol}},
+		},
+	}
+
+	titles := []change.TypeTitle{
+		{ChangeType: bug, Title: "Bug Fixes"},
+		{ChangeType: added, Title: "Added Features"},
+		{ChangeType: removed, Title: "Removed Features"},
+	}
+
+	retro, err := RetroInfo(summer, RetroConfig{
+		Since:            since,
+		Until:            until,
+		ChangeTypeTitles: titles,
+		TopContributors:  2,
+	})
+	require.NoError(t, err)
+
+	var texts []string
+	for _, c := range retro.Changes {
+		texts = append(texts, c.Text)
+	}
+	assert.Equal(t, []string{"fix one", "add one", "add two", "fix two", "fix three"}, texts)
+
+	// the draft release and the releases outside of the period are not included
+	assert.Equal(t, []RetroRelease{
+		{Release: Release{Version: "v1.0.0", Date: march}, Changes: 2},
+		{Release: Release{Version: "v1.1.0", Date: june}, Changes: 2},
+	}, retro.Releases)
+	require.NotNil(t, retro.BiggestRelease)
+	assert.Equal(t, "v1.0.0", retro.BiggestRelease.Version)
+	assert.Equal(t, 1, retro.Unreleased)
+
+	assert.Equal(t, []TypeCount{
+		{TypeTitle: titles[0], Count: 3},
+		{TypeTitle: titles[1], Count: 2},
+	}, retro.ChangesByType)
+
+	assert.Equal(t, []ContributorCount{
+		{Reference: alice, Count: 3},
+		{Reference: bob, Count: 2},
+	}, retro.TopContributors)
+
+	assert.Equal(t, titles, retro.SupportedChanges)
+}
+
+func TestRetroInfo_NoReleases(t *testing.T) {
+	since := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	bug := change.NewType("bug", change.SemVerPatch)
+
+	retro, err := RetroInfo(MockSummarizer{
+		MockChanges: []change.Change{
+			{Text: "fix one", ChangeTypes: []change.Type{bug}, Timestamp: since.Add(time.Hour)},
+		},
+	}, RetroConfig{
+		Since: since,
+		Until: since.AddDate(1, 0, 0),
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, retro.Releases)
+	assert.Nil(t, retro.BiggestRelease)
+	assert.Equal(t, 1, retro.Unreleased)
+	assert.Empty(t, retro.TopContributors)
+}
+
+func TestRetroInfo_EmptyPeriod(t *testing.T) {
+	since := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	_, err := RetroInfo(MockSummarizer{}, RetroConfig{Since: since, Until: since})
+	assert.Error(t, err)
+}
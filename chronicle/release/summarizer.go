@@ -21,3 +21,9 @@ type Summarizer interface {
 	// ChangesURL is the URL to find the specific source changes that makeup this release, e.g. https://github.com/anchore/chronicle/compare/v0.3.0...v0.4.1 .
 	ChangesURL(sinceRef, untilRef string) string
 }
+
+// DraftSummarizer is implemented by summarizers for sources with a notion of unpublished (draft) releases.
+type DraftSummarizer interface {
+	// LatestDraftRelease returns the most recently created draft release. If there are no draft releases then nil is returned (without an error).
+	LatestDraftRelease() (*Release, error)
+}
@@ -0,0 +1,52 @@
+package release
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-semver/semver"
+)
+
+// SupportLine is a line of releases that is supported as a whole (e.g. "v1.2" for every v1.2.x release), along with
+// when the line reaches its end of life.
+type SupportLine struct {
+	Version   string    // the version prefix of the releases within the line (e.g. "v1.2" or "v1")
+	EOL       time.Time // when the line stops being supported (zero when not yet scheduled)
+	EndOfLife bool      `json:",omitempty"` // whether the line reached its end of life by the date of the release being described
+	Current   bool      `json:",omitempty"` // whether the release being described is within the line
+}
+
+// NewSupportMatrix describes each of the given support lines relative to the release with the given version and date.
+func NewSupportMatrix(lines []SupportLine, version string, date time.Time) []SupportLine {
+	var results []SupportLine
+	for _, l := range lines {
+		l.EndOfLife = !l.EOL.IsZero() && !date.Before(l.EOL)
+		l.Current = l.Contains(version)
+		results = append(results, l)
+	}
+	return results
+}
+
+// Contains indicates whether the given release version is within the line (e.g. "v1.2.3" is within "v1.2" and "v1",
+// but not "v1.3"). Versions that are not semver are not within any line.
+func (l SupportLine) Contains(version string) bool {
+	v, err := semver.NewVersion(strings.TrimPrefix(version, "v"))
+	if err != nil {
+		return false
+	}
+
+	fields := strings.Split(strings.TrimPrefix(l.Version, "v"), ".")
+	if len(fields) == 0 || len(fields) > 3 {
+		return false
+	}
+
+	actual := []int64{v.Major, v.Minor, v.Patch}
+	for idx, field := range fields {
+		expected, err := strconv.ParseInt(field, 10, 64)
+		if err != nil || expected != actual[idx] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+package release
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupportLine_Contains(t *testing.T) {
+	tests := []struct {
+		line    string
+		version string
+		want    bool
+	}{
+		{line: "v1.2", version: "v1.2.3", want: true},
+		{line: "1.2", version: "v1.2.0", want: true},
+		{line: "v1", version: "1.9.0", want: true},
+		{line: "v1.2", version: "v1.3.0", want: false},
+		{line: "v1.2", version: "v11.2.0", want: false},
+		{line: "v1.2.3", version: "v1.2.3-rc.1", want: true},
+		{line: "v1.2", version: UnreleasedVersion, want: false},
+		{line: "latest", version: "v1.2.3", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line+"/"+tt.version, func(t *testing.T) {
+			assert.Equal(t, tt.want, SupportLine{Version: tt.line}.Contains(tt.version))
+		})
+	}
+}
+
+func TestNewSupportMatrix(t *testing.T) {
+	date := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	lines := []SupportLine{
+		{Version: "v2.0"},
+		{Version: "v1.1", EOL: time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		{Version: "v1.0", EOL: time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	assert.Equal(t, []SupportLine{
+		{Version: "v2.0"},
+		{Version: "v1.1", EOL: time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		{Version: "v1.0", EOL: time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC), EndOfLife: true, Current: true},
+	}, NewSupportMatrix(lines, "v1.0.4", date))
+}
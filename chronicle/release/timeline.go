@@ -0,0 +1,94 @@
+package release
+
+import (
+	"sync"
+	"time"
+)
+
+// Timeline places a release within the sequence of releases of the project, for teams that track the release cadence
+// within their notes (e.g. "release #57, 21 days since v1.4.0").
+type Timeline struct {
+	Number            int       `json:",omitempty"` // the position of the release among all releases (including pre-releases), starting at 1 (0 when the releases cannot be listed)
+	PreviousVersion   string    // the version of the release the changelog starts from
+	PreviousDate      time.Time // when the previous release was made
+	DaysSincePrevious int       // the number of whole days between the previous release and this release
+}
+
+// newTimeline describes where the release made at the given time falls relative to the previous release (the start of
+// the changelog). When the release number cannot be determined a warning is raised and the number is left unset.
+func newTimeline(summer Summarizer, previous Release, untilVersion string, date time.Time, warnings *Warnings) *Timeline {
+	timeline := Timeline{
+		PreviousVersion: previous.Version,
+		PreviousDate:    previous.Date,
+	}
+
+	if !previous.Date.IsZero() && date.After(previous.Date) {
+		timeline.DaysSincePrevious = int(date.Sub(previous.Date).Hours() / 24)
+	}
+
+	number, err := releaseNumber(summer, untilVersion, date)
+	if err != nil {
+		warnings.Add(TimelineWarning, "unable to determine the release number: %+v", err)
+	}
+	timeline.Number = number
+
+	return &timeline
+}
+
+// releaseNumber returns the position of the release among all releases: the position of the release itself when it
+// has already been published, otherwise the position after every release made before the given date. This is 0 when
+// the releases cannot be listed.
+func releaseNumber(summer Summarizer, untilVersion string, date time.Time) (int, error) {
+	lister, ok := summer.(ReleaseLister)
+	if !ok {
+		return 0, nil
+	}
+
+	releases, err := lister.Releases()
+	if err != nil || releases == nil {
+		return 0, err
+	}
+
+	// note: the releases are ordered oldest first
+	if untilVersion != "" {
+		for idx, r := range releases {
+			if r.Version == untilVersion {
+				return idx + 1, nil
+			}
+		}
+	}
+
+	number := 1
+	for _, r := range releases {
+		if r.Date.Before(date) {
+			number++
+		}
+	}
+	return number, nil
+}
+
+// onceLister lists the releases of a summarizer at most once, since both the start of the changelog and the timeline
+// are derived from them.
+type onceLister struct {
+	Summarizer
+	lister   ReleaseLister
+	once     sync.Once
+	releases []Release
+	err      error
+}
+
+// listOnce returns the summarizer with its releases listed at most once (when the summarizer can list its releases).
+func listOnce(summer Summarizer) Summarizer {
+	lister, ok := summer.(ReleaseLister)
+	if !ok {
+		return summer
+	}
+	return &onceLister{Summarizer: summer, lister: lister}
+}
+
+func (l *onceLister) Releases() ([]Release, error) {
+	l.once.Do(func() {
+		l.releases, l.err = l.lister.Releases()
+	})
+	return l.releases, l.err
+}
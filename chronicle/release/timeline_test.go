@@ -0,0 +1,155 @@
+package release
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingLister struct {
+	MockSummarizer
+}
+
+func (failingLister) Releases() ([]Release, error) {
+	return nil, fmt.Errorf("bad things")
+}
+
+type countingLister struct {
+	MockSummarizer
+	calls int
+}
+
+func (c *countingLister) Releases() ([]Release, error) {
+	c.calls++
+	return c.MockSummarizer.Releases()
+}
+
+func Test_newTimeline(t *testing.T) {
+	previousDate := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	previous := Release{Version: "v1.4.0", Date: previousDate}
+
+	tests := []struct {
+		name         string
+		summer       Summarizer
+		untilVersion string
+		date         time.Time
+		want         Timeline
+		wantWarnings int
+	}{
+		{
+			name: "listed releases",
+			summer: MockSummarizer{
+				MockReleases: []Release{
+					{Version: "v1.3.0"},
+					{Version: "v1.4.0-rc.1"},
+					{Version: "v1.4.0"},
+				},
+			},
+			date: previousDate.Add(21*24*time.Hour + time.Hour),
+			want: Timeline{
+				Number:            4,
+				PreviousVersion:   "v1.4.0",
+				PreviousDate:      previousDate,
+				DaysSincePrevious: 21,
+			},
+		},
+		{
+			name:   "releases cannot be listed",
+			summer: MockSummarizer{},
+			date:   previousDate.Add(23 * time.Hour),
+			want: Timeline{
+				PreviousVersion: "v1.4.0",
+				PreviousDate:    previousDate,
+			},
+		},
+		{
+			name: "previous release is not listed",
+			summer: MockSummarizer{
+				MockReleases: []Release{{Version: "v1.3.0"}},
+			},
+			date: previousDate.Add(48 * time.Hour),
+			want: Timeline{
+				Number:            2,
+				PreviousVersion:   "v1.4.0",
+				PreviousDate:      previousDate,
+				DaysSincePrevious: 2,
+			},
+		},
+		{
+			name: "pre-releases after the previous stable release",
+			summer: MockSummarizer{
+				MockReleases: []Release{
+					{Version: "v1.3.0", Date: previousDate.Add(-30 * 24 * time.Hour)},
+					{Version: "v1.4.0", Date: previousDate},
+					{Version: "v1.5.0-rc.1", Date: previousDate.Add(24 * time.Hour)},
+					{Version: "v1.5.0-rc.2", Date: previousDate.Add(48 * time.Hour)},
+				},
+			},
+			date: previousDate.Add(72 * time.Hour),
+			want: Timeline{
+				Number:            5,
+				PreviousVersion:   "v1.4.0",
+				PreviousDate:      previousDate,
+				DaysSincePrevious: 3,
+			},
+		},
+		{
+			name: "release already published",
+			summer: MockSummarizer{
+				MockReleases: []Release{
+					{Version: "v1.3.0", Date: previousDate.Add(-30 * 24 * time.Hour)},
+					{Version: "v1.4.0", Date: previousDate},
+					{Version: "v1.5.0-rc.1", Date: previousDate.Add(24 * time.Hour)},
+					{Version: "v1.5.0", Date: previousDate.Add(48 * time.Hour)},
+					{Version: "v1.6.0", Date: previousDate.Add(96 * time.Hour)},
+				},
+			},
+			untilVersion: "v1.5.0",
+			date:         previousDate.Add(120 * time.Hour),
+			want: Timeline{
+				Number:            4,
+				PreviousVersion:   "v1.4.0",
+				PreviousDate:      previousDate,
+				DaysSincePrevious: 5,
+			},
+		},
+		{
+			name:   "releases fail to be listed",
+			summer: failingLister{},
+			date:   previousDate.Add(24 * time.Hour),
+			want: Timeline{
+				PreviousVersion:   "v1.4.0",
+				PreviousDate:      previousDate,
+				DaysSincePrevious: 1,
+			},
+			wantWarnings: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings := &Warnings{}
+			got := newTimeline(tt.summer, previous, tt.untilVersion, tt.date, warnings)
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want, *got)
+			assert.Len(t, warnings.List(), tt.wantWarnings)
+		})
+	}
+}
+
+func Test_listOnce(t *testing.T) {
+	summer := &countingLister{
+		MockSummarizer: MockSummarizer{
+			MockLastRelease: "v1.4.0",
+			MockReleases:    []Release{{Version: "v1.4.0"}},
+		},
+	}
+
+	_, description, err := ChangelogInfo(summer, ChangelogInfoConfig{UntilVersion: "v1.5.0"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, description.Timeline.Number)
+	assert.Equal(t, 1, summer.calls)
+}
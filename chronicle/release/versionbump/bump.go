@@ -0,0 +1,159 @@
+package versionbump
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Kind is the kind of manifest that holds the version of the project.
+type Kind string
+
+const (
+	PackageJSONManifest Kind = "package.json" // the top-level "version" field of an npm package.json
+	HelmManifest        Kind = "helm"         // the "version" field of a Helm Chart.yaml
+	PatternManifest     Kind = "pattern"      // any file, where the version is matched by the "version" capture group of a regex
+)
+
+var kinds = []Kind{PackageJSONManifest, HelmManifest, PatternManifest}
+
+// patterns match the version for each kind of manifest (via the "version" capture group). Only the first match within
+// a manifest is replaced.
+var patterns = map[Kind]*regexp.Regexp{
+	// note: the top-level version is the first "version" field within a package.json by convention
+	PackageJSONManifest: regexp.MustCompile(`"version"\s*:\s*"(?P<version>[^"]*)"`),
+	HelmManifest:        regexp.MustCompile(`(?m)^version:[ \t]*["']?(?P<version>[^"'\s#]+)`),
+}
+
+// defaultPaths are the manifests edited for each kind when no path is configured.
+var defaultPaths = map[Kind]string{
+	PackageJSONManifest: "package.json",
+	HelmManifest:        "Chart.yaml",
+}
+
+func ParseKind(kind string) (Kind, error) {
+	kind = strings.ToLower(strings.TrimSpace(kind))
+	for _, k := range kinds {
+		if string(k) == kind {
+			return k, nil
+		}
+	}
+	return "", fmt.Errorf("unknown manifest type %q (allowable: %+v)", kind, kinds)
+}
+
+// Manifest is a file that holds the version of the project.
+type Manifest struct {
+	Kind    Kind
+	Path    string         // the file to edit (defaults per kind, relative to the directory given to Plan)
+	Pattern *regexp.Regexp // the regex that matches the version via the "version" capture group (for PatternManifest)
+}
+
+// Edit is the change to make to a single manifest.
+type Edit struct {
+	Path     string // the path to the manifest
+	Line     int    // the line that holds the version (starting at 1)
+	Before   string // the line before the edit
+	After    string // the line after the edit
+	contents []byte // the full contents after the edit
+}
+
+// Plan determines the edits that set the version within each of the manifests (relative to the given directory),
+// without changing any files. Manifests that already hold the version are skipped.
+func Plan(manifests []Manifest, dir, version string) ([]Edit, error) {
+	var edits []Edit
+	for _, m := range manifests {
+		edit, err := plan(m, dir, version)
+		if err != nil {
+			return nil, err
+		}
+		if edit != nil {
+			edits = append(edits, *edit)
+		}
+	}
+	return edits, nil
+}
+
+func plan(m Manifest, dir, version string) (*Edit, error) {
+	path := m.Path
+	if path == "" {
+		path = defaultPaths[m.Kind]
+	}
+	if path == "" {
+		return nil, fmt.Errorf("no path given for the %q manifest", m.Kind)
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+
+	pattern := m.Pattern
+	if pattern == nil {
+		pattern = patterns[m.Kind]
+	}
+	if pattern == nil {
+		return nil, fmt.Errorf("no pattern given for the %q manifest %q", m.Kind, path)
+	}
+	group := pattern.SubexpIndex("version")
+	if group < 0 {
+		return nil, fmt.Errorf("the pattern for manifest %q has no \"version\" capture group", path)
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read manifest: %w", err)
+	}
+
+	match := pattern.FindSubmatchIndex(contents)
+	if match == nil || match[2*group] < 0 {
+		return nil, fmt.Errorf("no version found within manifest %q", path)
+	}
+	start, end := match[2*group], match[2*group+1]
+	if string(contents[start:end]) == version {
+		return nil, nil
+	}
+
+	updated := make([]byte, 0, len(contents)+len(version))
+	updated = append(updated, contents[:start]...)
+	updated = append(updated, version...)
+	updated = append(updated, contents[end:]...)
+
+	lineStart := strings.LastIndexByte(string(contents[:start]), '\n') + 1
+	before := lineAt(contents, lineStart)
+	after := lineAt(updated, lineStart)
+
+	return &Edit{
+		Path:     path,
+		Line:     strings.Count(string(contents[:start]), "\n") + 1,
+		Before:   before,
+		After:    after,
+		contents: updated,
+	}, nil
+}
+
+func lineAt(contents []byte, start int) string {
+	line := string(contents[start:])
+	if idx := strings.IndexByte(line, '\n'); idx >= 0 {
+		line = line[:idx]
+	}
+	return line
+}
+
+// Diff describes the edit in the unified diff format.
+func (e Edit) Diff() string {
+	return fmt.Sprintf("--- %s\n+++ %s\n@@ -%d +%d @@\n-%s\n+%s\n", e.Path, e.Path, e.Line, e.Line, e.Before, e.After)
+}
+
+// Apply writes each of the edited manifests (keeping their file modes).
+func Apply(edits []Edit) error {
+	for _, e := range edits {
+		info, err := os.Stat(e.Path)
+		if err != nil {
+			return fmt.Errorf("unable to write manifest: %w", err)
+		}
+		if err := os.WriteFile(e.Path, e.contents, info.Mode().Perm()); err != nil {
+			return fmt.Errorf("unable to write manifest: %w", err)
+		}
+	}
+	return nil
+}
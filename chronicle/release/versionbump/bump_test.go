@@ -0,0 +1,151 @@
+package versionbump
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlan(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest Manifest
+		file     string
+		content  string
+		want     string
+		wantDiff string
+		wantNone bool
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "package.json",
+			manifest: Manifest{Kind: PackageJSONManifest},
+			file:     "package.json",
+			content: `{
+  "name": "thing",
+  "version": "1.2.3",
+  "dependencies": {
+    "other": {"version": "9.9.9"}
+  }
+}
+`,
+			want: `{
+  "name": "thing",
+  "version": "1.3.0",
+  "dependencies": {
+    "other": {"version": "9.9.9"}
+  }
+}
+`,
+			wantDiff: `@@ -3 +3 @@
+-  "version": "1.2.3",
++  "version": "1.3.0",
+`,
+		},
+		{
+			name:     "helm chart",
+			manifest: Manifest{Kind: HelmManifest},
+			file:     "Chart.yaml",
+			content: `apiVersion: v2
+name: thing
+version: "1.2.3" # the chart version
+appVersion: "1.2.3"
+`,
+			want: `apiVersion: v2
+name: thing
+version: "1.3.0" # the chart version
+appVersion: "1.2.3"
+`,
+		},
+		{
+			name: "go constant by pattern",
+			manifest: Manifest{
+				Kind:    PatternManifest,
+				Path:    "version.go",
+				Pattern: regexp.MustCompile(`const Version = "v?(?P<version>[^"]+)"`),
+			},
+			file:    "version.go",
+			content: "package internal\n\nconst Version = \"v1.2.3\"\n",
+			want:    "package internal\n\nconst Version = \"v1.3.0\"\n",
+		},
+		{
+			name:     "already the version",
+			manifest: Manifest{Kind: PackageJSONManifest},
+			file:     "package.json",
+			content:  `{"version": "1.3.0"}`,
+			wantNone: true,
+		},
+		{
+			name:     "no version",
+			manifest: Manifest{Kind: PackageJSONManifest},
+			file:     "package.json",
+			content:  `{"name": "thing"}`,
+			wantErr:  require.Error,
+		},
+		{
+			name: "pattern without a version group",
+			manifest: Manifest{
+				Kind:    PatternManifest,
+				Path:    "version.go",
+				Pattern: regexp.MustCompile(`const Version = "([^"]+)"`),
+			},
+			file:    "version.go",
+			content: "const Version = \"1.2.3\"\n",
+			wantErr: require.Error,
+		},
+		{
+			name:     "pattern without a path",
+			manifest: Manifest{Kind: PatternManifest, Pattern: regexp.MustCompile(`(?P<version>.+)`)},
+			wantErr:  require.Error,
+		},
+		{
+			name:     "missing manifest",
+			manifest: Manifest{Kind: HelmManifest},
+			wantErr:  require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+
+			dir := t.TempDir()
+			if tt.file != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, tt.file), []byte(tt.content), 0600))
+			}
+
+			edits, err := Plan([]Manifest{tt.manifest}, dir, "1.3.0")
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+
+			if tt.wantNone {
+				assert.Empty(t, edits)
+				return
+			}
+			require.Len(t, edits, 1)
+
+			if tt.wantDiff != "" {
+				path := filepath.Join(dir, tt.file)
+				assert.Equal(t, "--- "+path+"\n+++ "+path+"\n"+tt.wantDiff, edits[0].Diff())
+			}
+
+			// nothing is written until the edits are applied
+			contents, err := os.ReadFile(filepath.Join(dir, tt.file))
+			require.NoError(t, err)
+			assert.Equal(t, tt.content, string(contents))
+
+			require.NoError(t, Apply(edits))
+
+			contents, err = os.ReadFile(filepath.Join(dir, tt.file))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(contents))
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package versionsource
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v2"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// Kind is where the current version of the project is stored.
+type Kind string
+
+const (
+	NoSource          Kind = ""
+	FileSource        Kind = "file"         // the first line of a plain text file (e.g. VERSION)
+	PackageJSONSource Kind = "package.json" // the "version" field of an npm package.json
+	CargoSource       Kind = "cargo"        // the "package.version" field of a Cargo.toml
+	PyProjectSource   Kind = "pyproject"    // the "project.version" (or "tool.poetry.version") field of a pyproject.toml
+	HelmSource        Kind = "helm"         // the "version" field of a Helm Chart.yaml
+	CommandSource     Kind = "command"      // the output of a shell command
+)
+
+var kinds = []Kind{FileSource, PackageJSONSource, CargoSource, PyProjectSource, HelmSource, CommandSource}
+
+// defaultPaths are the files read for each kind when no path is configured.
+var defaultPaths = map[Kind]string{
+	FileSource:        "VERSION",
+	PackageJSONSource: "package.json",
+	CargoSource:       "Cargo.toml",
+	PyProjectSource:   "pyproject.toml",
+	HelmSource:        "Chart.yaml",
+}
+
+func ParseKind(kind string) (Kind, error) {
+	kind = strings.ToLower(strings.TrimSpace(kind))
+	if kind == "" {
+		return NoSource, nil
+	}
+	for _, k := range kinds {
+		if string(k) == kind {
+			return k, nil
+		}
+	}
+	return NoSource, fmt.Errorf("unknown version source %q (allowable: %+v)", kind, kinds)
+}
+
+type Config struct {
+	Kind    Kind
+	Path    string // the file to read the version from (defaults per kind, relative to Dir)
+	Command string // the shell command that prints the version (for CommandSource)
+	Prefix  string // prepended to the version when not already present (e.g. "v", to match the release tags)
+	Dir     string // the directory that paths are relative to and commands are run from (the repo root)
+}
+
+// Read returns the current version of the project from the configured source, or an empty version when no source is
+// configured.
+func Read(config Config) (string, error) {
+	var version string
+	var err error
+	switch config.Kind {
+	case NoSource:
+		return "", nil
+	case CommandSource:
+		version, err = readCommand(config.Command, config.Dir)
+	default:
+		version, err = readFile(config)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if version == "" {
+		return "", fmt.Errorf("no version found from the %q version source", config.Kind)
+	}
+
+	if !strings.HasPrefix(version, config.Prefix) {
+		version = config.Prefix + version
+	}
+
+	log.WithFields("source", config.Kind, "version", version).Debug("read the current version")
+	return version, nil
+}
+
+func readFile(config Config) (string, error) {
+	path := config.Path
+	if path == "" {
+		path = defaultPaths[config.Kind]
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(config.Dir, path)
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read version from %q: %w", path, err)
+	}
+
+	var version string
+	switch config.Kind {
+	case FileSource:
+		version = firstLine(contents)
+	case PackageJSONSource:
+		version, err = packageJSONVersion(contents)
+	case CargoSource:
+		version, err = cargoVersion(contents)
+	case PyProjectSource:
+		version, err = pyProjectVersion(contents)
+	case HelmSource:
+		version, err = helmVersion(contents)
+	default:
+		return "", fmt.Errorf("unsupported version source %q", config.Kind)
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to read version from %q: %w", path, err)
+	}
+	return version, nil
+}
+
+func firstLine(contents []byte) string {
+	lines := strings.SplitN(strings.TrimSpace(string(contents)), "\n", 2)
+	return strings.TrimSpace(lines[0])
+}
+
+func packageJSONVersion(contents []byte) (string, error) {
+	var doc struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(contents, &doc); err != nil {
+		return "", err
+	}
+	return doc.Version, nil
+}
+
+func cargoVersion(contents []byte) (string, error) {
+	var doc struct {
+		Package struct {
+			Version interface{} `toml:"version"`
+		} `toml:"package"`
+	}
+	if err := toml.Unmarshal(contents, &doc); err != nil {
+		return "", err
+	}
+	// note: the version may be inherited from the workspace (version.workspace = true), which is not supported
+	version, ok := doc.Package.Version.(string)
+	if !ok && doc.Package.Version != nil {
+		return "", fmt.Errorf("the package version is not a string (workspace inherited versions are not supported)")
+	}
+	return version, nil
+}
+
+func pyProjectVersion(contents []byte) (string, error) {
+	var doc struct {
+		Project struct {
+			Version string `toml:"version"`
+		} `toml:"project"`
+		Tool struct {
+			Poetry struct {
+				Version string `toml:"version"`
+			} `toml:"poetry"`
+		} `toml:"tool"`
+	}
+	if err := toml.Unmarshal(contents, &doc); err != nil {
+		return "", err
+	}
+	if doc.Project.Version != "" {
+		return doc.Project.Version, nil
+	}
+	return doc.Tool.Poetry.Version, nil
+}
+
+func helmVersion(contents []byte) (string, error) {
+	var doc struct {
+		Version string `yaml:"version"`
+	}
+	if err := yaml.Unmarshal(contents, &doc); err != nil {
+		return "", err
+	}
+	return doc.Version, nil
+}
+
+func readCommand(command, dir string) (string, error) {
+	if command == "" {
+		return "", fmt.Errorf("no command configured for the %q version source", CommandSource)
+	}
+
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Dir = dir
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("version command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return firstLine(stdout.Bytes()), nil
+}
@@ -0,0 +1,169 @@
+package versionsource
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		file    string
+		content string
+		want    string
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:   "no source",
+			config: Config{},
+			want:   "",
+		},
+		{
+			name:    "plain file",
+			config:  Config{Kind: FileSource},
+			file:    "VERSION",
+			content: "1.2.3\nignored\n",
+			want:    "1.2.3",
+		},
+		{
+			name:    "plain file with a custom path",
+			config:  Config{Kind: FileSource, Path: "version.txt"},
+			file:    "version.txt",
+			content: "  v1.2.3  ",
+			want:    "v1.2.3",
+		},
+		{
+			name:    "package.json",
+			config:  Config{Kind: PackageJSONSource, Prefix: "v"},
+			file:    "package.json",
+			content: `{"name": "thing", "version": "1.2.3"}`,
+			want:    "v1.2.3",
+		},
+		{
+			name:    "prefix already present",
+			config:  Config{Kind: PackageJSONSource, Prefix: "v"},
+			file:    "package.json",
+			content: `{"version": "v1.2.3"}`,
+			want:    "v1.2.3",
+		},
+		{
+			name:   "cargo",
+			config: Config{Kind: CargoSource},
+			file:   "Cargo.toml",
+			content: `[package]
+name = "thing"
+version = "0.4.1"
+
+[dependencies]
+serde = "1.0"
+`,
+			want: "0.4.1",
+		},
+		{
+			name:   "cargo workspace inherited version",
+			config: Config{Kind: CargoSource},
+			file:   "Cargo.toml",
+			content: `[package]
+name = "thing"
+version.workspace = true
+`,
+			wantErr: require.Error,
+		},
+		{
+			name:   "pyproject",
+			config: Config{Kind: PyProjectSource},
+			file:   "pyproject.toml",
+			content: `[project]
+name = "thing"
+version = "2.0.0"
+`,
+			want: "2.0.0",
+		},
+		{
+			name:   "pyproject poetry",
+			config: Config{Kind: PyProjectSource},
+			file:   "pyproject.toml",
+			content: `[tool.poetry]
+name = "thing"
+version = "2.1.0"
+`,
+			want: "2.1.0",
+		},
+		{
+			name:   "helm chart",
+			config: Config{Kind: HelmSource},
+			file:   "Chart.yaml",
+			content: `apiVersion: v2
+name: thing
+version: 0.3.0
+appVersion: "1.16.0"
+`,
+			want: "0.3.0",
+		},
+		{
+			name:    "no version field",
+			config:  Config{Kind: PackageJSONSource},
+			file:    "package.json",
+			content: `{"name": "thing"}`,
+			wantErr: require.Error,
+		},
+		{
+			name:    "missing file",
+			config:  Config{Kind: HelmSource},
+			wantErr: require.Error,
+		},
+		{
+			name:   "command",
+			config: Config{Kind: CommandSource, Command: "echo 1.2.3"},
+			want:   "1.2.3",
+		},
+		{
+			name:    "failing command",
+			config:  Config{Kind: CommandSource, Command: "exit 1"},
+			wantErr: require.Error,
+		},
+		{
+			name:    "command not configured",
+			config:  Config{Kind: CommandSource},
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+
+			dir := t.TempDir()
+			if tt.file != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, tt.file), []byte(tt.content), 0600))
+			}
+			tt.config.Dir = dir
+
+			got, err := Read(tt.config)
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseKind(t *testing.T) {
+	kind, err := ParseKind("Package.json")
+	require.NoError(t, err)
+	assert.Equal(t, PackageJSONSource, kind)
+
+	kind, err = ParseKind("")
+	require.NoError(t, err)
+	assert.Equal(t, NoSource, kind)
+
+	_, err = ParseKind("gradle")
+	assert.Error(t, err)
+}
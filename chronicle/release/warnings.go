@@ -0,0 +1,63 @@
+package release
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// WarningKind classifies the problems found while describing a release.
+type WarningKind string
+
+const (
+	SkippedChangeWarning WarningKind = "skipped-change" // a change within the release was left out of the changelog (e.g. a PR without a change type label)
+	UnresolvedTagWarning WarningKind = "unresolved-tag" // a release tag could not be resolved, so the release range or version may not be what was intended
+	TruncatedWarning     WarningKind = "truncated"      // results were cut short (e.g. by a page limit), so changes may be missing from the changelog
+	EndOfLifeWarning     WarningKind = "end-of-life"    // the release is within a line of releases that has reached its end of life
+	TimelineWarning      WarningKind = "timeline"       // the position of the release among all releases could not be determined
+)
+
+// Warning is a problem found while describing a release that did not prevent the changelog from being created, but
+// likely needs attention from whoever is cutting the release.
+type Warning struct {
+	Kind    WarningKind
+	Message string
+}
+
+// Warnings collects the warnings raised while describing a release, so they can be reported together (instead of
+// being scattered across the log). It is safe for concurrent use. A nil collection is valid, in which case each
+// warning is logged as it is raised.
+type Warnings struct {
+	lock  sync.Mutex
+	items []Warning
+}
+
+// Add records a warning of the given kind.
+func (w *Warnings) Add(kind WarningKind, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	if w == nil {
+		log.Warn(message)
+		return
+	}
+
+	log.WithFields("kind", kind).Debugf("warning: %s", message)
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.items = append(w.items, Warning{
+		Kind:    kind,
+		Message: message,
+	})
+}
+
+// List returns the warnings recorded so far, in the order they were raised.
+func (w *Warnings) List() []Warning {
+	if w == nil {
+		return nil
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return append([]Warning(nil), w.items...)
+}
@@ -0,0 +1,22 @@
+package release
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWarnings(t *testing.T) {
+	var nilWarnings *Warnings
+	nilWarnings.Add(TruncatedWarning, "dropped %d", 1)
+	assert.Empty(t, nilWarnings.List())
+
+	w := &Warnings{}
+	w.Add(SkippedChangeWarning, "skipped %s", "#1")
+	w.Add(TruncatedWarning, "truncated")
+
+	assert.Equal(t, []Warning{
+		{Kind: SkippedChangeWarning, Message: "skipped #1"},
+		{Kind: TruncatedWarning, Message: "truncated"},
+	}, w.List())
+}
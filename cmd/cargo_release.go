@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/cargorelease"
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/log"
+	"github.com/anchore/chronicle/internal/vcs"
+)
+
+var cargoReleaseCmd = &cobra.Command{
+	Use:   "cargo-release [PATH]",
+	Short: "Write the release notes for the crate being released (as a cargo-release pre-release-hook)",
+	Long: `Write the release notes for the crate being released by cargo-release, for use as its pre-release-hook. The
+version being released (and the previous version) are taken from the environment of the hook, and the notes are
+written to the configured path with the cargo-release tokens replaced (e.g. {{version}}). On a dry run the notes are
+written to stdout instead. The output format and title are the same as for "chronicle create".
+
+Configure the hook (within release.toml or the [package.metadata.release] table of Cargo.toml)
+	pre-release-hook = ["chronicle", "cargo-release"]
+
+Write the notes for each crate of a workspace to its own file (with per-crate tags)
+	pre-release-hook = ["chronicle", "cargo-release", "--path", "notes/{{crate_name}}-{{version}}.md", "--tag-name", "{{crate_name}}-v{{version}}"]
+
+`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runCargoRelease,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		// note: the hook is run from the root of the crate, which is not the root of the repo for workspace members
+		var repo = "./"
+		switch {
+		case len(args) == 1:
+			if !vcs.IsRepository(args[0]) {
+				return fmt.Errorf("given path is not a git or mercurial repository: %s", args[0])
+			}
+			repo = args[0]
+		case os.Getenv("WORKSPACE_ROOT") != "":
+			repo = os.Getenv("WORKSPACE_ROOT")
+		default:
+			log.Infof("no repository path given, assuming %q", repo)
+		}
+		appConfig.CliOptions.RepoPath = repo
+		return nil
+	},
+}
+
+func init() {
+	setCargoReleaseFlags(cargoReleaseCmd.Flags())
+	if err := bindCargoReleaseConfigOptions(cargoReleaseCmd.Flags()); err != nil {
+		panic(err)
+	}
+
+	rootCmd.AddCommand(cargoReleaseCmd)
+}
+
+func setCargoReleaseFlags(flags *pflag.FlagSet) {
+	flags.StringP(
+		"path", "p", "release-notes/{{version}}.md",
+		"where to write the release notes, with the cargo-release tokens replaced ({{version}}, {{prev_version}}, {{crate_name}}, and {{date}})",
+	)
+
+	flags.StringP(
+		"tag-name", "", "v{{version}}",
+		"the release tag, with the cargo-release tokens replaced (should match the tag-name of cargo-release)",
+	)
+}
+
+func bindCargoReleaseConfigOptions(flags *pflag.FlagSet) error {
+	for _, flag := range []string{
+		"path",
+		"tag-name",
+	} {
+		if err := viper.BindPFlag("cargo-release."+flag, flags.Lookup(flag)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runCargoRelease(cmd *cobra.Command, args []string) error {
+	hook, err := cargorelease.HookFromEnv(os.Getenv, runClock().Now())
+	if err != nil {
+		return err
+	}
+
+	// the tag does not exist yet, so the release is described up to the current revision
+	appConfig.CliOptions.UntilVersion = hook.Render(appConfig.CargoRelease.TagName)
+
+	if appConfig.SinceTag == "" && hook.PrevVersion != "" {
+		// for workspaces with per-crate tags the last release of the repo may be of another crate, so start from the
+		// tag of the previous version of this crate (when it was released)
+		prevTag := hook.RenderPrevious(appConfig.CargoRelease.TagName)
+		if _, err := git.SearchForTag(appConfig.CliOptions.RepoPath, prevTag); err == nil {
+			appConfig.SinceTag = prevTag
+		} else {
+			log.WithFields("tag", prevTag).Debug("no tag for the previous version (starting from the last release)")
+		}
+	}
+
+	worker, err := selectWorker(appConfig.CliOptions.RepoPath)
+	if err != nil {
+		return err
+	}
+
+	_, description, _, err := worker()
+	if err != nil {
+		return err
+	}
+
+	defer printWarnings(description.Warnings)
+
+	outputs, err := parseOutputs(appConfig.Output)
+	if err != nil {
+		return err
+	}
+	if len(outputs) > 1 || outputs[0].Path != "" {
+		return fmt.Errorf("the release notes are written to --path, so only a single output format (without a file) can be given")
+	}
+
+	// the release notes are written to the path (so never colorized), except for a dry run
+	path := hook.Render(appConfig.CargoRelease.Path)
+	o := outputs[0]
+	if !hook.DryRun {
+		o.Path = path
+	}
+
+	rendered, err := renderDescription(o, *description)
+	if err != nil {
+		return err
+	}
+
+	if hook.DryRun {
+		log.WithFields("path", path).Info("dry run (not writing the release notes)")
+		_, err = os.Stdout.Write(rendered)
+		return err
+	}
+
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("unable to create release notes directory %q: %w", dir, err)
+		}
+	}
+
+	if err := os.WriteFile(path, rendered, 0644); err != nil {
+		return fmt.Errorf("unable to write release notes to %q: %w", path, err)
+	}
+
+	log.WithFields("path", path, "version", description.Version).Info("wrote release notes")
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// runClock returns the source of the current time for the dates derived from it (e.g. the date of an untagged
+// release). The system clock is used unless SOURCE_DATE_EPOCH (see https://reproducible-builds.org/specs/source-date-epoch/)
+// is set, which fixes the current time for reproducible output.
+func runClock() release.Clock {
+	epoch := os.Getenv("SOURCE_DATE_EPOCH")
+	if epoch == "" {
+		return nil
+	}
+	seconds, err := strconv.ParseInt(epoch, 10, 64)
+	if err != nil {
+		log.Warnf("ignoring invalid SOURCE_DATE_EPOCH=%q: %+v", epoch, err)
+		return nil
+	}
+	return release.FixedClock(time.Unix(seconds, 0).UTC())
+}
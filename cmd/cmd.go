@@ -87,6 +87,12 @@ func initLogging() {
 		panic(err)
 	}
 
+	if appConfig.DebugBundle != "" {
+		logRecorder = log.NewRecorder(lgr)
+		chronicle.SetLogger(logRecorder)
+		return
+	}
+
 	chronicle.SetLogger(lgr)
 }
 
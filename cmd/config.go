@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+
+	"github.com/anchore/chronicle/internal/config"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Work with the application config",
+}
+
+var configMigrateCmd = &cobra.Command{
+	Use:   "migrate [PATH]",
+	Short: "Upgrade a config file written for an older version of chronicle to the current schema",
+	Long: `Upgrade a config file written for an older version of chronicle to the current schema.
+
+Renamed keys are updated in place (keeping the formatting and comments of the config), with each change explained
+alongside the lines changed. Problems that cannot be migrated automatically (e.g. unknown keys) are reported as notes.
+When no path is given the config that chronicle would otherwise use is migrated.
+
+Show how the config would be migrated
+	chronicle config migrate
+
+Migrate the given config in place
+	chronicle config migrate --write .chronicle.yaml
+`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runConfigMigrate,
+}
+
+func init() {
+	configMigrateCmd.Flags().BoolP(
+		"write", "w", false,
+		"write the migrated config back to the file (otherwise only the changes are shown)",
+	)
+
+	configCmd.AddCommand(configMigrateCmd)
+	rootCmd.AddCommand(configCmd)
+}
+
+func runConfigMigrate(cmd *cobra.Command, args []string) error {
+	path := appConfig.ConfigPath
+	if len(args) == 1 {
+		path = args[0]
+	}
+	if path == "" {
+		return fmt.Errorf("no config found to migrate")
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to read config %q: %w", path, err)
+	}
+
+	m, err := config.Migrate(contents)
+	if err != nil {
+		return fmt.Errorf("unable to migrate config %q: %w", path, err)
+	}
+
+	for _, c := range m.Changes {
+		fmt.Printf("%s:%d: %s\n", path, c.Line, c.Message)
+		fmt.Println(color.Red.Sprintf("  - %s", c.Before))
+		fmt.Println(color.Green.Sprintf("  + %s", c.After))
+	}
+
+	for _, note := range m.Notes {
+		fmt.Println(color.Yellow.Sprintf("%s: note: %s", path, note))
+	}
+
+	if len(m.Changes) == 0 {
+		fmt.Printf("%s is up to date\n", path)
+		return nil
+	}
+
+	write, err := cmd.Flags().GetBool("write")
+	if err != nil {
+		return err
+	}
+	if !write {
+		fmt.Println("(run with --write to update the config)")
+		return nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, m.Contents, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("unable to write config %q: %w", path, err)
+	}
+	fmt.Printf("migrated %s\n", path)
+	return nil
+}
@@ -3,15 +3,23 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/gookit/color"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
 	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
 	"github.com/anchore/chronicle/chronicle/release/format"
+	"github.com/anchore/chronicle/chronicle/release/publish"
+	"github.com/anchore/chronicle/chronicle/release/releasers/github"
+	"github.com/anchore/chronicle/chronicle/release/versionsource"
 	"github.com/anchore/chronicle/internal/git"
 	"github.com/anchore/chronicle/internal/log"
+	"github.com/anchore/chronicle/internal/vcs"
 )
 
 var createCmd = &cobra.Command{
@@ -33,8 +41,8 @@ Create a changelog representing the changes from tag v0.14.0 until v0.18.0 (for
 	PreRunE: func(cmd *cobra.Command, args []string) error {
 		var repo = "./"
 		if len(args) == 1 {
-			if !git.IsRepository(args[0]) {
-				return fmt.Errorf("given path is not a git repository: %s", args[0])
+			if !vcs.IsRepository(args[0]) {
+				return fmt.Errorf("given path is not a git or mercurial repository: %s", args[0])
 			}
 			repo = args[0]
 		} else {
@@ -52,9 +60,14 @@ func init() {
 }
 
 func setCreateFlags(flags *pflag.FlagSet) {
+	flags.StringArrayP(
+		"output", "o", []string{string(format.Default())},
+		fmt.Sprintf("output format to use, optionally with a file to write it to (e.g. json=release.json); can be given multiple times to render several outputs in one run: %+v", format.All()),
+	)
+
 	flags.StringP(
-		"output", "o", string(format.Default()),
-		fmt.Sprintf("output format to use: %+v", format.All()),
+		"template", "", "",
+		"the go text/template to render the changelog with (for -o template, see \"chronicle template lint\")",
 	)
 
 	flags.StringP(
@@ -77,36 +90,140 @@ func setCreateFlags(flags *pflag.FlagSet) {
 		"guess the next release version based off of issues and PRs in cases where there is no semver tag after --since-tag (cannot use with --until-tag)",
 	)
 
+	flags.StringP(
+		"milestone", "", "",
+		"select changes by the given github milestone title instead of the tag range (see github.milestone-mode)",
+	)
+
 	flags.StringP(
 		"title", "t", "Changelog",
 		"The title of the changelog output",
 	)
+
+	flags.IntP(
+		"summary-lines", "", 0,
+		"write only the top N changes (one per line, without markup) instead of the changelog, e.g. for a release PR title or commit message",
+	)
+
+	flags.StringSliceP(
+		"publish", "", nil,
+		"publish the changelog to the given destinations once written (options: gist, s3, gcs, mastodon, x, npm)",
+	)
+
+	flags.BoolP(
+		"expose-raw", "", false,
+		"attach the raw API payload of each issue and PR to the changes (github only; for use by templates and JSON output, the shape may change without notice)",
+	)
+
+	flags.BoolP(
+		"new-contributors", "", false,
+		"add a section crediting the people whose first contribution is in the release (github only)",
+	)
+
+	flags.BoolP(
+		"no-color", "", false,
+		"do not colorize the terminal output format (color is otherwise used when writing to a terminal)",
+	)
+
+	flags.StringP(
+		"prepend", "", "",
+		"insert the release into the given existing changelog file (e.g. CHANGELOG.md), replacing the section of the same release when re-run (md and keepachangelog output only)",
+	)
+
+	flags.BoolP(
+		"validate", "", false,
+		"check the json output against the published JSON schema of the document before writing it",
+	)
+
+	flags.StringP(
+		"export-bundle", "", "",
+		"write the API responses fetched while creating the changelog to the given tarball, for use with --import-bundle (github only)",
+	)
+
+	flags.StringP(
+		"import-bundle", "", "",
+		"create the changelog from the API responses within the given tarball (from --export-bundle) without network access (github only)",
+	)
+
+	flags.StringP(
+		"debug-bundle", "", "",
+		"write a zip archive with the resolved config, API requests, why each issue and PR was included or excluded, and timing (for troubleshooting)",
+	)
+
+	flags.BoolP(
+		"publish-only", "", false,
+		"publish the previously generated changelog to the --publish destinations it was not yet published to (does not generate a new changelog)",
+	)
 }
 
 func bindCreateConfigOptions(flags *pflag.FlagSet) error {
 	for _, flag := range []string{
 		"output",
+		"template",
 		"since-tag",
 		"until-tag",
 		"title",
 		"speculate-next-version",
 		"version-file",
+		"publish",
+		"publish-only",
+		"expose-raw",
+		"new-contributors",
+		"no-color",
+		"prepend",
+		"validate",
+		"export-bundle",
+		"import-bundle",
+		"debug-bundle",
 	} {
 		if err := viper.BindPFlag(flag, flags.Lookup(flag)); err != nil {
 			return err
 		}
 	}
-	return nil
+	if err := viper.BindPFlag("summary.lines", flags.Lookup("summary-lines")); err != nil {
+		return err
+	}
+	return viper.BindPFlag("github.milestone", flags.Lookup("milestone"))
 }
 
-func runCreate(cmd *cobra.Command, args []string) error {
-	worker := selectWorker(appConfig.CliOptions.RepoPath)
+func runCreate(cmd *cobra.Command, args []string) (err error) {
+	if appConfig.DebugBundle != "" {
+		started := time.Now()
+		defer func() {
+			// note: the bundle is most useful when the run fails, so it is always written
+			if bundleErr := writeDebugBundle(appConfig.DebugBundle, started, err); bundleErr != nil {
+				log.Warnf("unable to write debug bundle: %+v", bundleErr)
+			}
+		}()
+	}
 
-	_, description, err := worker()
+	if appConfig.PublishOnly {
+		return runPublishOnly()
+	}
+
+	if err := openBundle(); err != nil {
+		return err
+	}
+
+	worker, err := selectWorker(appConfig.CliOptions.RepoPath)
+	if err != nil {
+		return err
+	}
+
+	startRelease, description, consume, err := worker()
 	if err != nil {
 		return err
 	}
 
+	// note: the bundle is exported as soon as everything has been fetched, since rendering and publishing are meant to
+	// happen where the bundle is imported
+	if err := exportBundle(); err != nil {
+		return err
+	}
+
+	// note: warnings are reported last (even when publishing fails) so they are not lost among the log lines
+	defer func() { printWarnings(description.Warnings) }()
+
 	if appConfig.VersionFile != "" {
 		f, err := os.OpenFile(appConfig.VersionFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
 		if err != nil {
@@ -117,25 +234,300 @@ func runCreate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	f := format.FromString(appConfig.Output)
-	if f == nil {
-		return fmt.Errorf("unable to parse output format: %q", appConfig.Output)
+	// the actions are run only once the changelog has been written successfully
+	postCreateActions := []postCreateAction{consume}
+
+	bumpVersion, err := planVersionBump(*description)
+	if err != nil {
+		return err
+	}
+	postCreateActions = append(postCreateActions, bumpVersion)
+
+	setHelmChartChanges, err := planHelmChartChanges(*description)
+	if err != nil {
+		return err
+	}
+	postCreateActions = append(postCreateActions, setHelmChartChanges)
+
+	if err := describeDeprecations(description); err != nil {
+		return err
+	}
+
+	describeSupport(description)
+
+	if err := describeFeatureFlags(startRelease, description); err != nil {
+		return err
 	}
 
-	presenterTask, err := selectPresenter(*f)
+	outputs, err := parseOutputs(appConfig.Output)
 	if err != nil {
 		return err
 	}
 
-	p, err := presenterTask(*description)
+	// note: publishing, --prepend, and the release index are based on the first output
+	f := outputs[0].Format
+
+	targets, err := selectPublishers(f, *description)
 	if err != nil {
 		return err
 	}
 
-	return p.Present(os.Stdout)
+	// the changelog is described once and rendered through the presenter of each output
+	rendered := make([][]byte, len(outputs))
+	for i, o := range outputs {
+		rendered[i], err = renderDescription(o, *description)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := validateOutputs(outputs, rendered); err != nil {
+		return err
+	}
+
+	for i, o := range outputs {
+		if err := writeOutput(o, *description, rendered[i]); err != nil {
+			return err
+		}
+	}
+
+	if err := prependChangelog(f, *description, string(rendered[0])); err != nil {
+		return err
+	}
+
+	// the published changelog is never colorized, even when the first output is colorized for the terminal
+	published := rendered[0]
+	if useColor(outputs[0]) {
+		published, err = renderDescription(publishedOutput(f), *description)
+		if err != nil {
+			return err
+		}
+	}
+
+	// note: the state is always recorded so that a later --publish-only run can publish this changelog
+	results := publishChangelog(targets, &publish.State{
+		Project:     projectIdentity(appConfig.CliOptions.RepoPath),
+		Format:      string(f),
+		Description: *description,
+		Content:     string(published),
+	})
+
+	if err := updateReleaseIndex(f, *description, results.URLs()); err != nil {
+		return err
+	}
+
+	if err := recordDeprecations(*description); err != nil {
+		return err
+	}
+
+	for _, action := range postCreateActions {
+		if action == nil {
+			continue
+		}
+		if err := action(); err != nil {
+			return err
+		}
+	}
+
+	// partial publishing failures are reported last, since the changelog itself was still written successfully
+	return results.Err()
+}
+
+// runWarnings collects the warnings raised while describing the release (shared by the summarizer and the changelog).
+var runWarnings = &release.Warnings{}
+
+// printWarnings writes the warnings raised during the run to stderr as a single block (stdout is reserved for the
+// changelog itself).
+func printWarnings(warnings []release.Warning) {
+	if len(warnings) == 0 || appConfig.Quiet {
+		return
+	}
+
+	fmt.Fprintln(os.Stderr, color.Yellow.Sprintf("%d warning(s):", len(warnings)))
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "  - [%s] %s\n", w.Kind, w.Message)
+	}
+}
+
+// postCreateAction is run only once the changelog has been written successfully (e.g. to consume changelog fragments).
+type postCreateAction func() error
+
+// worker describes the release, along with the action to run once the changelog has been written (nil when there is
+// nothing to do).
+type worker func() (*release.Release, *release.Description, postCreateAction, error)
+
+// withoutPostCreateAction adapts a worker that has nothing to do once the changelog has been written.
+func withoutPostCreateAction(describe func() (*release.Release, *release.Description, error)) worker {
+	return func() (*release.Release, *release.Description, postCreateAction, error) {
+		startRelease, description, err := describe()
+		return startRelease, description, nil, err
+	}
+}
+
+func selectWorker(_ string) (worker, error) {
+	if len(appConfig.MultiRepo.Repos) > 0 {
+		return createChangelogFromRepos, nil
+	}
+	return selectSourceWorker()
+}
+
+// selectSourceWorker selects the worker for the configured source (or the source detected for the repo).
+func selectSourceWorker() (worker, error) {
+	// TODO: this is the spot to add support for other providers such as GitLab or Bitbucket or other VCSs altogether, such as subversion.
+	source := strings.ToLower(appConfig.Source)
+	if source == "" {
+		source = detectSource()
+	}
+
+	switch source {
+	case "github":
+		return withoutPostCreateAction(createChangelogFromGithub), nil
+	case "jira":
+		return withoutPostCreateAction(createChangelogFromJira), nil
+	case "linear":
+		return withoutPostCreateAction(createChangelogFromLinear), nil
+	case "gerrit":
+		return withoutPostCreateAction(createChangelogFromGerrit), nil
+	case "sourcehut":
+		return withoutPostCreateAction(createChangelogFromSourcehut), nil
+	case "plugin":
+		return withoutPostCreateAction(createChangelogFromPlugin), nil
+	case "commits":
+		return withoutPostCreateAction(createChangelogFromCommits), nil
+	case "fragments":
+		return createChangelogFromFragments, nil
+	case "keepachangelog":
+		return withoutPostCreateAction(createChangelogFromKeepAChangelog), nil
+	case "composite":
+		return withoutPostCreateAction(createChangelogFromComposite), nil
+	default:
+		return nil, fmt.Errorf("unsupported source: %q", source)
+	}
+}
+
+// createChangelogFromReleases creates a changelog for summarizers that track releases separately from tags (such as
+// github releases), where a tag at HEAD is only the release being described if it has not already been released.
+func createChangelogFromReleases(gitter git.Interface, summer release.Summarizer, changeTypeTitles []change.TypeTitle) (*release.Release, *release.Description, error) {
+	var err error
+	var untilTag = appConfig.UntilTag
+	var untilDraft bool
+	if untilTag == "" {
+		endRelease, err := github.FindChangelogEndRelease(summer, gitter)
+		if err != nil {
+			return nil, nil, err
+		}
+		if endRelease != nil {
+			untilTag, untilDraft = endRelease.Version, endRelease.Draft
+		}
+	}
+
+	var untilVersion string
+	if untilTag == "" && appConfig.Github.DraftReleaseAsUntil {
+		var draft *release.Release
+		draft, untilTag, err = github.FindDraftEndRelease(summer, gitter)
+		if err != nil {
+			return nil, nil, err
+		}
+		if draft != nil {
+			// note: when the draft is not tagged yet, the changelog ends at the current revision
+			untilDraft, untilVersion = true, draft.Version
+		}
+	}
+
+	if untilTag == "" && untilVersion == "" {
+		untilVersion, err = untilVersionFromSource()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if untilTag != "" {
+		log.WithFields("tag", untilTag).Infof("until")
+	} else {
+		log.Infof("until the current revision")
+	}
+
+	changelogConfig := release.ChangelogInfoConfig{
+		RepoPath:          appConfig.CliOptions.RepoPath,
+		SinceTag:          appConfig.SinceTag,
+		UntilTag:          untilTag,
+		VersionSpeculator: newVersionSpeculator(gitter),
+		ChangeTypeTitles:  changeTypeTitles,
+		NewContributors:   appConfig.NewContributors,
+		PrereleaseMode:    appConfig.PrereleaseMode,
+		Warnings:          runWarnings,
+		UntilDraft:        untilDraft,
+		UntilVersion:      untilVersion,
+		Clock:             runClock(),
+	}
+
+	return release.ChangelogInfo(summer, changelogConfig)
+}
+
+// createChangelogFromTagReleases creates a changelog for summarizers that have no notion of a release (such as issue
+// trackers), where the semver tags in the local repo are the only record of a release.
+func createChangelogFromTagReleases(gitter git.Interface, summer release.Summarizer, changeTypeTitles []change.TypeTitle) (*release.Release, *release.Description, error) {
+	// since there is no notion of a release, a tag at HEAD is always the release being described
+	var err error
+	var untilTag = appConfig.UntilTag
+	if untilTag == "" {
+		untilTag, err = gitter.HeadTag()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var untilVersion string
+	if untilTag == "" {
+		untilVersion, err = untilVersionFromSource()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if untilTag != "" {
+		log.WithFields("tag", untilTag).Infof("until")
+	} else {
+		log.Infof("until the current revision")
+	}
+
+	changelogConfig := release.ChangelogInfoConfig{
+		RepoPath:          appConfig.CliOptions.RepoPath,
+		SinceTag:          appConfig.SinceTag,
+		UntilTag:          untilTag,
+		VersionSpeculator: newVersionSpeculator(gitter),
+		ChangeTypeTitles:  changeTypeTitles,
+		NewContributors:   appConfig.NewContributors,
+		PrereleaseMode:    appConfig.PrereleaseMode,
+		Warnings:          runWarnings,
+		UntilVersion:      untilVersion,
+		Clock:             runClock(),
+	}
+
+	return release.ChangelogInfo(summer, changelogConfig)
+}
+
+// untilVersionFromSource returns the version of the (untagged) release being described from the configured version
+// source, such as the version field of a package.json (empty when no version source is configured). A version given by
+// the caller (such as the cargo-release hook) takes precedence.
+func untilVersionFromSource() (string, error) {
+	if appConfig.CliOptions.UntilVersion != "" {
+		return appConfig.CliOptions.UntilVersion, nil
+	}
+
+	version, err := versionsource.Read(appConfig.VersionSource.ToVersionSourceConfig(appConfig.CliOptions.RepoPath))
+	if err != nil {
+		return "", fmt.Errorf("unable to read the current version: %w", err)
+	}
+	return version, nil
 }
 
-func selectWorker(repo string) func() (*release.Release, *release.Description, error) {
-	// TODO: we only support github, but this is the spot to add support for other providers such as GitLab or Bitbucket or other VCSs altogether, such as subversion.
-	return createChangelogFromGithub
+func newVersionSpeculator(gitter git.Interface) release.VersionSpeculator {
+	if !appConfig.SpeculateNextVersion {
+		return nil
+	}
+	return github.NewVersionSpeculator(gitter, release.SpeculationBehavior{
+		EnforceV0:           appConfig.EnforceV0,
+		NoChangesBumpsPatch: true,
+	})
 }
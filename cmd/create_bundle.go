@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/anchore/chronicle/chronicle/release/releasers/github"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// runBundle holds the API responses that are recorded with --export-bundle, or replayed with --import-bundle.
+var runBundle *github.Bundle
+
+// openBundle prepares the bundle of API responses before the changelog is described: an empty bundle to record into
+// with --export-bundle, or the bundle to replay with --import-bundle.
+func openBundle() error {
+	switch {
+	case appConfig.ExportBundle != "" && appConfig.ImportBundle != "":
+		return fmt.Errorf("--export-bundle and --import-bundle cannot be used together")
+	case appConfig.ExportBundle != "":
+		runBundle = github.NewBundle()
+	case appConfig.ImportBundle != "":
+		f, err := os.Open(appConfig.ImportBundle)
+		if err != nil {
+			return fmt.Errorf("unable to open bundle %q: %w", appConfig.ImportBundle, err)
+		}
+		defer f.Close()
+
+		runBundle, err = github.ReadBundle(f)
+		if err != nil {
+			return fmt.Errorf("unable to import bundle %q: %w", appConfig.ImportBundle, err)
+		}
+		log.WithFields("path", appConfig.ImportBundle, "responses", runBundle.Len()).Info("replaying API responses from bundle")
+	}
+	return nil
+}
+
+// exportBundle writes the API responses recorded while describing the changelog with --export-bundle.
+func exportBundle() error {
+	if appConfig.ExportBundle == "" || runBundle == nil {
+		return nil
+	}
+
+	if runBundle.Len() == 0 {
+		log.Warn("no API responses were recorded for the bundle (only the github source is supported)")
+	}
+
+	f, err := os.Create(appConfig.ExportBundle)
+	if err != nil {
+		return fmt.Errorf("unable to create bundle %q: %w", appConfig.ExportBundle, err)
+	}
+	defer f.Close()
+
+	log.WithFields("path", appConfig.ExportBundle, "responses", runBundle.Len()).Info("exporting API responses to bundle")
+
+	return runBundle.Write(f)
+}
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/commits"
+	"github.com/anchore/chronicle/internal/vcs"
+)
+
+func createChangelogFromCommits() (*release.Release, *release.Description, error) {
+	gitter, err := vcs.New(appConfig.CliOptions.RepoPath, appConfig.Remotes...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summer, err := commits.NewSummarizer(gitter, appConfig.Commits.ToCommitsConfig())
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create summarizer: %w", err)
+	}
+
+	return createChangelogFromTagReleases(gitter, summer, getCommitsSupportedChanges())
+}
+
+func getCommitsSupportedChanges() []change.TypeTitle {
+	var supportedChanges []change.TypeTitle
+	for _, c := range appConfig.Commits.Changes {
+		k := change.ParseSemVerKind(c.SemVerKind)
+		t := change.NewType(c.Type, k)
+		supportedChanges = append(supportedChanges, change.TypeTitle{
+			ChangeType: t,
+			Title:      c.Title,
+		})
+	}
+	return supportedChanges
+}
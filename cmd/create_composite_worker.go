@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/commits"
+	"github.com/anchore/chronicle/chronicle/release/releasers/composite"
+	"github.com/anchore/chronicle/chronicle/release/releasers/fragments"
+	"github.com/anchore/chronicle/chronicle/release/releasers/gerrit"
+	"github.com/anchore/chronicle/chronicle/release/releasers/github"
+	"github.com/anchore/chronicle/chronicle/release/releasers/jira"
+	"github.com/anchore/chronicle/chronicle/release/releasers/keepachangelog"
+	"github.com/anchore/chronicle/chronicle/release/releasers/linear"
+	"github.com/anchore/chronicle/chronicle/release/releasers/plugin"
+	"github.com/anchore/chronicle/chronicle/release/releasers/sourcehut"
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/vcs"
+)
+
+func createChangelogFromComposite() (*release.Release, *release.Description, error) {
+	gitter, err := vcs.New(appConfig.CliOptions.RepoPath, appConfig.Remotes...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summer, changeTypeTitles, err := newCompositeSummarizer(gitter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// releases are determined by the primary (first) source
+	switch strings.ToLower(appConfig.Composite.Sources[0]) {
+	case "github", "plugin", "keepachangelog":
+		return createChangelogFromReleases(gitter, summer, changeTypeTitles)
+	default:
+		return createChangelogFromTagReleases(gitter, summer, changeTypeTitles)
+	}
+}
+
+func newCompositeSummarizer(gitter git.Interface) (*composite.Summarizer, []change.TypeTitle, error) {
+	var sources []composite.Source
+	var changeTypeTitles []change.TypeTitle
+	for _, name := range appConfig.Composite.Sources {
+		name = strings.ToLower(name)
+		summer, titles, err := newSourceSummarizer(gitter, name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to create summarizer for source %q: %w", name, err)
+		}
+		sources = append(sources, composite.Source{
+			Name:       name,
+			Summarizer: summer,
+		})
+		changeTypeTitles = mergeChangeTypeTitles(changeTypeTitles, titles)
+	}
+
+	summer, err := composite.NewSummarizer(appConfig.Composite.ToCompositeConfig(), sources...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create summarizer: %w", err)
+	}
+	return summer, changeTypeTitles, nil
+}
+
+// newSourceSummarizer creates the summarizer (and the change sections it supports) for a single, non-composite source.
+func newSourceSummarizer(gitter git.Interface, name string) (release.Summarizer, []change.TypeTitle, error) {
+	var summer release.Summarizer
+	var titles []change.TypeTitle
+	var err error
+	switch name {
+	case "github":
+		summer, err = github.NewSummarizer(gitter, newGithubConfig())
+		titles = getGithubSupportedChanges()
+	case "jira":
+		summer, err = jira.NewSummarizer(gitter, appConfig.Jira.ToJiraConfig())
+		titles = getJiraSupportedChanges()
+	case "linear":
+		summer, err = linear.NewSummarizer(gitter, appConfig.Linear.ToLinearConfig())
+		titles = getLinearSupportedChanges()
+	case "gerrit":
+		summer, err = gerrit.NewSummarizer(gitter, appConfig.Gerrit.ToGerritConfig())
+		titles = getGerritSupportedChanges()
+	case "sourcehut":
+		summer, err = sourcehut.NewSummarizer(gitter, appConfig.Sourcehut.ToSourcehutConfig())
+		titles = getSourcehutSupportedChanges()
+	case "commits":
+		summer, err = commits.NewSummarizer(gitter, appConfig.Commits.ToCommitsConfig())
+		titles = getCommitsSupportedChanges()
+	case "fragments":
+		summer, err = fragments.NewSummarizer(gitter, appConfig.Fragments.ToFragmentsConfig(appConfig.CliOptions.RepoPath))
+		titles = getFragmentsSupportedChanges()
+	case "keepachangelog":
+		summer, err = keepachangelog.NewSummarizer(appConfig.KeepAChangelog.ToKeepAChangelogConfig(appConfig.CliOptions.RepoPath))
+		titles = getKeepAChangelogSupportedChanges()
+	case "plugin":
+		summer, err = plugin.NewSummarizer(appConfig.Plugin.ToPluginConfig(appConfig.CliOptions.RepoPath))
+		titles = getPluginSupportedChanges()
+	default:
+		return nil, nil, fmt.Errorf("unsupported source")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return summer, titles, nil
+}
+
+// mergeChangeTypeTitles appends the titles for any change types that are not already present (so the section titles
+// from higher priority sources are kept).
+func mergeChangeTypeTitles(existing, titles []change.TypeTitle) []change.TypeTitle {
+	results := existing
+	for _, t := range titles {
+		var found bool
+		for _, e := range existing {
+			if e.ChangeType.Name == t.ChangeType.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			results = append(results, t)
+		}
+	}
+	return results
+}
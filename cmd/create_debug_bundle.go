@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// logRecorder keeps every log message of the run (regardless of the log level) for the debug bundle.
+var logRecorder *log.Recorder
+
+// bundleDecision is why an issue or PR was included in or excluded from the changelog.
+type bundleDecision struct {
+	Time     time.Time   `json:"time"`
+	Subject  interface{} `json:"subject"`
+	Decision interface{} `json:"decision"`
+	Reason   string      `json:"reason"`
+}
+
+// bundleRequest is a single API request made while creating the changelog.
+type bundleRequest struct {
+	Time      time.Time   `json:"time"`
+	Method    interface{} `json:"method"`
+	URL       interface{} `json:"url"`
+	Variables interface{} `json:"variables,omitempty"`
+	Status    interface{} `json:"status,omitempty"`
+	Error     interface{} `json:"error,omitempty"`
+	Duration  interface{} `json:"duration"`
+}
+
+type bundleTiming struct {
+	Started  time.Time `json:"started"`
+	Finished time.Time `json:"finished"`
+	Duration string    `json:"duration"`
+	Requests int       `json:"requests"`
+}
+
+// writeDebugBundle writes a zip archive describing how the changelog was created (or why it failed): the resolved
+// configuration, the API requests made, why each issue and PR was included or excluded, and timing, along with the
+// full log. Credentials are never included.
+func writeDebugBundle(path string, started time.Time, runErr error) error {
+	if logRecorder == nil {
+		return fmt.Errorf("no log was recorded")
+	}
+
+	entries := logRecorder.Entries()
+
+	decisions := []bundleDecision{}
+	requests := []bundleRequest{}
+	for _, e := range entries {
+		switch {
+		case e.Fields["decision"] != nil:
+			decisions = append(decisions, bundleDecision{
+				Time:     e.Time,
+				Subject:  e.Fields["subject"],
+				Decision: e.Fields["decision"],
+				Reason:   e.Message,
+			})
+		case e.Fields["method"] != nil && e.Fields["url"] != nil:
+			requests = append(requests, bundleRequest{
+				Time:      e.Time,
+				Method:    e.Fields["method"],
+				URL:       e.Fields["url"],
+				Variables: e.Fields["variables"],
+				Status:    e.Fields["status"],
+				Error:     e.Fields["error"],
+				Duration:  e.Fields["duration"],
+			})
+		}
+	}
+
+	finished := time.Now()
+	timing := bundleTiming{
+		Started:  started,
+		Finished: finished,
+		Duration: finished.Sub(started).String(),
+		Requests: len(requests),
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create debug bundle %q: %w", path, err)
+	}
+	defer f.Close()
+
+	archive := zip.NewWriter(f)
+
+	if err := writeBundleFile(archive, "config.yaml", []byte(appConfig.String())); err != nil {
+		return err
+	}
+
+	for _, file := range []struct {
+		name  string
+		value interface{}
+	}{
+		{name: "decisions.json", value: decisions},
+		{name: "requests.json", value: requests},
+		{name: "timing.json", value: timing},
+	} {
+		contents, err := json.MarshalIndent(file.value, "", "  ")
+		if err != nil {
+			return fmt.Errorf("unable to encode %q: %w", file.name, err)
+		}
+		if err := writeBundleFile(archive, file.name, contents); err != nil {
+			return err
+		}
+	}
+
+	var logLines []byte
+	for _, e := range entries {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("unable to encode log entry: %w", err)
+		}
+		logLines = append(append(logLines, line...), '\n')
+	}
+	if err := writeBundleFile(archive, "log.jsonl", logLines); err != nil {
+		return err
+	}
+
+	if runErr != nil {
+		if err := writeBundleFile(archive, "error.txt", []byte(fmt.Sprintf("%+v\n", runErr))); err != nil {
+			return err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("unable to write debug bundle %q: %w", path, err)
+	}
+
+	log.WithFields("path", path).Info("wrote debug bundle")
+	return nil
+}
+
+func writeBundleFile(archive *zip.Writer, name string, contents []byte) error {
+	w, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("unable to add %q to debug bundle: %w", name, err)
+	}
+	if _, err := w.Write(contents); err != nil {
+		return fmt.Errorf("unable to add %q to debug bundle: %w", name, err)
+	}
+	return nil
+}
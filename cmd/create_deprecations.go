@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/deprecation"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// describeDeprecations adds the deprecations made by previous releases (from the configured deprecations ledger) to the
+// release being described, so the presenters can note how far away each removal is.
+func describeDeprecations(description *release.Description) error {
+	if appConfig.Deprecations.Ledger == "" {
+		return nil
+	}
+
+	ledger, err := deprecation.Load(appConfig.Deprecations.Ledger)
+	if err != nil {
+		return err
+	}
+
+	description.Deprecations = ledger.Pending(description.Version)
+	return nil
+}
+
+// recordDeprecations appends the deprecations shipped by the release being described to the configured deprecations
+// ledger (if any).
+func recordDeprecations(description release.Description) error {
+	if appConfig.Deprecations.Ledger == "" {
+		return nil
+	}
+
+	if description.Version == release.UnreleasedVersion {
+		log.Info("not updating the deprecations ledger for an unreleased changelog")
+		return nil
+	}
+
+	ledger, err := deprecation.Load(appConfig.Deprecations.Ledger)
+	if err != nil {
+		return err
+	}
+
+	added := ledger.Record(description, deprecation.Bump(appConfig.Deprecations.Removal))
+	if len(added) == 0 {
+		return nil
+	}
+
+	log.WithFields("path", appConfig.Deprecations.Ledger, "version", description.Version, "deprecations", len(added)).Info("updating deprecations ledger")
+
+	return ledger.Save(appConfig.Deprecations.Ledger)
+}
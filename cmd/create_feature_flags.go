@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/featureflag"
+	"github.com/anchore/chronicle/internal/log"
+	"github.com/anchore/chronicle/internal/vcs"
+)
+
+// describeFeatureFlags adds the feature flags that changed since the previous release (by diffing the configured flag
+// definition file) to the release being described. When there is no until tag the flags are read from the working
+// tree (that is, the flags that will ship with the next release).
+func describeFeatureFlags(previous *release.Release, description *release.Description) error {
+	cfg := appConfig.FeatureFlags.ToFeatureFlagConfig()
+	if cfg.Path == "" {
+		return nil
+	}
+
+	if len(appConfig.MultiRepo.Repos) > 0 {
+		log.Warn("feature flag changes are not supported when combining multiple repos (ignoring)")
+		return nil
+	}
+
+	repo, err := vcs.New(appConfig.CliOptions.RepoPath, appConfig.Remotes...)
+	if err != nil {
+		return err
+	}
+
+	var before string
+	if previous != nil && previous.Version != "" {
+		before, err = fileAt(repo.FilesAt, previous.Version, cfg.Path)
+		if err != nil {
+			return err
+		}
+	}
+
+	var after string
+	if appConfig.UntilTag != "" {
+		after, err = fileAt(repo.FilesAt, appConfig.UntilTag, cfg.Path)
+	} else {
+		after, err = workingTreeFile(filepath.Join(appConfig.CliOptions.RepoPath, cfg.Path))
+	}
+	if err != nil {
+		return err
+	}
+
+	previousFlags, err := featureflag.Parse(before, cfg)
+	if err != nil {
+		return err
+	}
+
+	currentFlags, err := featureflag.Parse(after, cfg)
+	if err != nil {
+		return err
+	}
+
+	changes := featureflag.Diff(previousFlags, currentFlags)
+	log.WithFields("path", cfg.Path, "changes", len(changes)).Debug("feature flag changes")
+	if len(changes) == 0 {
+		return nil
+	}
+
+	description.FeatureFlags = &release.FeatureFlags{
+		Title:   appConfig.FeatureFlags.Title,
+		Changes: changes,
+	}
+	return nil
+}
+
+// fileAt returns the contents of the given file at the given ref (empty when the file does not exist at the ref).
+func fileAt(filesAt func(ref, dir string) (map[string]string, error), ref, filePath string) (string, error) {
+	files, err := filesAt(ref, path.Dir(filePath))
+	if err != nil {
+		return "", fmt.Errorf("unable to read feature flags at %q: %w", ref, err)
+	}
+	return files[path.Base(filePath)], nil
+}
+
+// workingTreeFile returns the contents of the given file (empty when the file does not exist).
+func workingTreeFile(filePath string) (string, error) {
+	contents, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		return "", fmt.Errorf("unable to read feature flags: %w", err)
+	}
+	return string(contents), nil
+}
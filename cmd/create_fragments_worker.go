@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/fragments"
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/log"
+	"github.com/anchore/chronicle/internal/vcs"
+)
+
+// createChangelogFromFragments creates a changelog from the news fragment files, returning the removal of the fragments
+// that were used as the action to run once the changelog has been written (when configured).
+func createChangelogFromFragments() (*release.Release, *release.Description, postCreateAction, error) {
+	gitter, err := vcs.New(appConfig.CliOptions.RepoPath, appConfig.Remotes...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	summer, err := fragments.NewSummarizer(gitter, appConfig.Fragments.ToFragmentsConfig(appConfig.CliOptions.RepoPath))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to create summarizer: %w", err)
+	}
+
+	startRelease, description, err := createChangelogFromTagReleases(gitter, summer, getFragmentsSupportedChanges())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if !appConfig.Fragments.Delete {
+		return startRelease, description, nil, nil
+	}
+
+	// the fragments are only consumed by the next release (or the release at HEAD), never by a past release
+	atHead, err := describesHead(gitter)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !atHead {
+		log.WithFields("tag", appConfig.UntilTag).Info("not removing the fragments: the changelog is for a past release")
+		return startRelease, description, nil, nil
+	}
+
+	return startRelease, description, summer.RemoveConsumed, nil
+}
+
+// describesHead indicates whether the changelog is for the current revision, that is either the release tagged at HEAD
+// or the release that has not been tagged yet.
+func describesHead(gitter git.Interface) (bool, error) {
+	if appConfig.UntilTag == "" {
+		return true, nil
+	}
+	headTag, err := gitter.HeadTag()
+	if err != nil {
+		return false, err
+	}
+	return headTag == appConfig.UntilTag, nil
+}
+
+func getFragmentsSupportedChanges() []change.TypeTitle {
+	var supportedChanges []change.TypeTitle
+	for _, c := range appConfig.Fragments.Changes {
+		k := change.ParseSemVerKind(c.SemVerKind)
+		t := change.NewType(c.Type, k)
+		supportedChanges = append(supportedChanges, change.TypeTitle{
+			ChangeType: t,
+			Title:      c.Title,
+		})
+	}
+	return supportedChanges
+}
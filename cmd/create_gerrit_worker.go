@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/gerrit"
+	"github.com/anchore/chronicle/internal/vcs"
+)
+
+func createChangelogFromGerrit() (*release.Release, *release.Description, error) {
+	gitter, err := vcs.New(appConfig.CliOptions.RepoPath, appConfig.Remotes...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summer, err := gerrit.NewSummarizer(gitter, appConfig.Gerrit.ToGerritConfig())
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create summarizer: %w", err)
+	}
+
+	return createChangelogFromTagReleases(gitter, summer, getGerritSupportedChanges())
+}
+
+func getGerritSupportedChanges() []change.TypeTitle {
+	var supportedChanges []change.TypeTitle
+	for _, c := range appConfig.Gerrit.Changes {
+		k := change.ParseSemVerKind(c.SemVerKind)
+		t := change.NewType(c.Type, k)
+		supportedChanges = append(supportedChanges, change.TypeTitle{
+			ChangeType: t,
+			Title:      c.Title,
+		})
+	}
+	return supportedChanges
+}
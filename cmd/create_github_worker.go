@@ -6,14 +6,13 @@ import (
 	"github.com/anchore/chronicle/chronicle/release"
 	"github.com/anchore/chronicle/chronicle/release/change"
 	"github.com/anchore/chronicle/chronicle/release/releasers/github"
-	"github.com/anchore/chronicle/internal/git"
-	"github.com/anchore/chronicle/internal/log"
+	"github.com/anchore/chronicle/internal/vcs"
 )
 
 func createChangelogFromGithub() (*release.Release, *release.Description, error) {
-	ghConfig := appConfig.Github.ToGithubConfig()
+	ghConfig := newGithubConfig()
 
-	gitter, err := git.New(appConfig.CliOptions.RepoPath)
+	gitter, err := vcs.New(appConfig.CliOptions.RepoPath, appConfig.Remotes...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -23,43 +22,39 @@ func createChangelogFromGithub() (*release.Release, *release.Description, error)
 		return nil, nil, fmt.Errorf("unable to create summarizer: %w", err)
 	}
 
-	changeTypeTitles := getGithubSupportedChanges()
+	return createChangelogFromReleases(gitter, summer, getGithubSupportedChanges())
+}
 
-	var untilTag = appConfig.UntilTag
-	if untilTag == "" {
-		untilTag, err = github.FindChangelogEndTag(summer, gitter)
-		if err != nil {
-			return nil, nil, err
+// newGithubConfig returns the github summarizer config, including the options that are not specific to github.
+func newGithubConfig() github.Config {
+	ghConfig := appConfig.Github.ToGithubConfig()
+	ghConfig.ExposeRaw = appConfig.ExposeRaw
+	ghConfig.Warnings = runWarnings
+	ghConfig.Clock = runClock()
+	if runBundle != nil {
+		if appConfig.ImportBundle != "" {
+			ghConfig.Replay = runBundle
+		} else {
+			ghConfig.Record = runBundle
 		}
 	}
-
-	if untilTag != "" {
-		log.WithFields("tag", untilTag).Infof("until")
-	} else {
-		log.Infof("until the current revision")
-	}
-
-	var speculator release.VersionSpeculator
-	if appConfig.SpeculateNextVersion {
-		speculator = github.NewVersionSpeculator(gitter, release.SpeculationBehavior{
-			EnforceV0:           appConfig.EnforceV0,
-			NoChangesBumpsPatch: true,
-		})
-	}
-
-	changelogConfig := release.ChangelogInfoConfig{
-		RepoPath:          appConfig.CliOptions.RepoPath,
-		SinceTag:          appConfig.SinceTag,
-		UntilTag:          untilTag,
-		VersionSpeculator: speculator,
-		ChangeTypeTitles:  changeTypeTitles,
+	if appConfig.NoCache {
+		ghConfig.CacheDir = ""
 	}
-
-	return release.ChangelogInfo(summer, changelogConfig)
+	return ghConfig
 }
 
 func getGithubSupportedChanges() []change.TypeTitle {
+	ghConfig := appConfig.Github.ToGithubConfig()
+
 	var supportedChanges []change.TypeTitle
+	if ghConfig.Advisories.Include {
+		// listed first so that security fixes are never buried beneath other changes
+		supportedChanges = append(supportedChanges, change.TypeTitle{
+			ChangeType: ghConfig.Advisories.ChangeType,
+			Title:      appConfig.Github.Advisories.Title,
+		})
+	}
 	for _, c := range appConfig.Github.Changes {
 		// TODO: this could be one source of truth upstream
 		k := change.ParseSemVerKind(c.SemVerKind)
@@ -69,5 +64,11 @@ func getGithubSupportedChanges() []change.TypeTitle {
 			Title:      c.Title,
 		})
 	}
+	if ghConfig.Discussions.Enabled() {
+		supportedChanges = append(supportedChanges, change.TypeTitle{
+			ChangeType: ghConfig.Discussions.ChangeType,
+			Title:      appConfig.Github.Discussions.Title,
+		})
+	}
 	return supportedChanges
 }
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/format/pkgmanager"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// planHelmChartChanges returns the action that sets the "artifacthub.io/changes" annotation of each configured Helm
+// chart to the changes of the release, once the changelog has been written successfully.
+func planHelmChartChanges(description release.Description) (postCreateAction, error) {
+	if len(appConfig.Helm.Charts) == 0 {
+		return nil, nil
+	}
+
+	changes, err := pkgmanager.ArtifactHubChanges(description)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() error {
+		for _, chart := range appConfig.Helm.Charts {
+			if !filepath.IsAbs(chart) {
+				chart = filepath.Join(appConfig.CliOptions.RepoPath, chart)
+			}
+			if err := writeHelmChartChanges(chart, changes); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+func writeHelmChartChanges(path, changes string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("unable to read helm chart: %w", err)
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to read helm chart: %w", err)
+	}
+
+	updated, err := pkgmanager.InjectArtifactHubChanges(contents, changes)
+	if err != nil {
+		return fmt.Errorf("unable to update helm chart %q: %w", path, err)
+	}
+
+	log.WithFields("chart", path).Infof("setting the %s annotation", pkgmanager.ArtifactHubChangesAnnotation)
+	return os.WriteFile(path, updated, info.Mode().Perm())
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/format"
+	"github.com/anchore/chronicle/chronicle/release/format/feed"
+	"github.com/anchore/chronicle/chronicle/release/index"
+	"github.com/anchore/chronicle/chronicle/release/publish"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// updateReleaseIndex records the release being described within the configured release index (if any). The link for
+// the release is taken from the configured link template, otherwise the first published URL is used.
+func updateReleaseIndex(f format.Format, description release.Description, publishedURLs []string) error {
+	if appConfig.Index.Path == "" {
+		return nil
+	}
+
+	if description.Version == release.UnreleasedVersion {
+		log.Info("not updating the release index for an unreleased changelog")
+		return nil
+	}
+
+	var link string
+	if appConfig.Index.Link != "" {
+		var err error
+		link, err = publish.RenderTemplate(appConfig.Index.Link, description, string(f))
+		if err != nil {
+			return err
+		}
+	} else if len(publishedURLs) > 0 {
+		link = publishedURLs[0]
+	}
+
+	var content string
+	if index.IsFeed(appConfig.Index.Path) {
+		var err error
+		content, err = feed.ContentHTML(description)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.WithFields("path", appConfig.Index.Path, "version", description.Version).Info("updating release index")
+
+	return index.Update(appConfig.Index.Path, appConfig.Index.Title, index.Entry{
+		Version: description.Version,
+		Date:    description.Date,
+		URL:     link,
+		Content: content,
+	})
+}
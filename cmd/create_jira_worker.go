@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/jira"
+	"github.com/anchore/chronicle/internal/vcs"
+)
+
+func createChangelogFromJira() (*release.Release, *release.Description, error) {
+	gitter, err := vcs.New(appConfig.CliOptions.RepoPath, appConfig.Remotes...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summer, err := jira.NewSummarizer(gitter, appConfig.Jira.ToJiraConfig())
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create summarizer: %w", err)
+	}
+
+	return createChangelogFromTagReleases(gitter, summer, getJiraSupportedChanges())
+}
+
+func getJiraSupportedChanges() []change.TypeTitle {
+	var supportedChanges []change.TypeTitle
+	for _, c := range appConfig.Jira.Changes {
+		k := change.ParseSemVerKind(c.SemVerKind)
+		t := change.NewType(c.Type, k)
+		supportedChanges = append(supportedChanges, change.TypeTitle{
+			ChangeType: t,
+			Title:      c.Title,
+		})
+	}
+	return supportedChanges
+}
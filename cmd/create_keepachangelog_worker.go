@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/keepachangelog"
+	"github.com/anchore/chronicle/internal/vcs"
+)
+
+func createChangelogFromKeepAChangelog() (*release.Release, *release.Description, error) {
+	gitter, err := vcs.New(appConfig.CliOptions.RepoPath, appConfig.Remotes...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summer, err := keepachangelog.NewSummarizer(appConfig.KeepAChangelog.ToKeepAChangelogConfig(appConfig.CliOptions.RepoPath))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create summarizer: %w", err)
+	}
+
+	// the changelog file is the record of releases, so a tag at HEAD that is already within the file is not the
+	// release being described
+	return createChangelogFromReleases(gitter, summer, getKeepAChangelogSupportedChanges())
+}
+
+func getKeepAChangelogSupportedChanges() []change.TypeTitle {
+	var supportedChanges []change.TypeTitle
+	for _, c := range appConfig.KeepAChangelog.Changes {
+		k := change.ParseSemVerKind(c.SemVerKind)
+		t := change.NewType(c.Type, k)
+		supportedChanges = append(supportedChanges, change.TypeTitle{
+			ChangeType: t,
+			Title:      c.Title,
+		})
+	}
+	return supportedChanges
+}
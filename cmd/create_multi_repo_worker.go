@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// createChangelogFromRepos creates a single changelog for a release that spans several repos, where the changelog of
+// each repo is created as usual (from the configured source, or the source detected for the repo) and then combined.
+// The actions of each repo are run once the combined changelog has been written.
+func createChangelogFromRepos() (*release.Release, *release.Description, postCreateAction, error) {
+	var repos []release.RepoDescription
+	var actions []postCreateAction
+	for _, r := range appConfig.MultiRepo.Repos {
+		log.WithFields("repo", r.Name, "path", r.Path).Info("describing repo")
+
+		description, action, err := describeRepo(r.Path, r.SinceTag, r.UntilTag)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("unable to describe repo %q: %w", r.Name, err)
+		}
+		repos = append(repos, release.RepoDescription{
+			Name:        r.Name,
+			Description: *description,
+		})
+		if action != nil {
+			actions = append(actions, action)
+		}
+	}
+
+	combined := release.CombineDescriptions(appConfig.MultiRepo.Version, repos)
+	if len(actions) == 0 {
+		return &combined.Release, &combined, nil, nil
+	}
+	return &combined.Release, &combined, func() error {
+		for _, action := range actions {
+			if err := action(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+// describeRepo describes the release of a single repo, by running the worker for the repo as if chronicle were invoked
+// for it directly.
+func describeRepo(path, sinceTag, untilTag string) (*release.Description, postCreateAction, error) {
+	originalPath, originalSince, originalUntil := appConfig.CliOptions.RepoPath, appConfig.SinceTag, appConfig.UntilTag
+	defer func() {
+		appConfig.CliOptions.RepoPath, appConfig.SinceTag, appConfig.UntilTag = originalPath, originalSince, originalUntil
+	}()
+	appConfig.CliOptions.RepoPath, appConfig.SinceTag, appConfig.UntilTag = path, sinceTag, untilTag
+
+	worker, err := selectSourceWorker()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, description, action, err := worker()
+	return description, action, err
+}
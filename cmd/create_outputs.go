@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/format"
+	"github.com/anchore/chronicle/chronicle/release/format/json"
+	"github.com/anchore/chronicle/chronicle/release/format/summary"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// output is a format to render the changelog with, along with where to write it (stdout when there is no path).
+type output struct {
+	Format    format.Format
+	Path      string
+	published bool // the changelog is rendered for the publishers rather than written
+}
+
+// publishedOutput is the output that the changelog is rendered with for the publishers.
+func publishedOutput(f format.Format) output {
+	return output{Format: f, published: true}
+}
+
+// toStdout indicates whether the output is written to stdout.
+func (o output) toStdout() bool {
+	return o.Path == "" && !o.published
+}
+
+// parseOutputs parses each -o value, a format optionally followed by the file to write it to (e.g.
+// "json=release.json"). At most one output can be written to stdout.
+func parseOutputs(values []string) ([]output, error) {
+	if len(values) == 0 {
+		values = []string{string(format.Default())}
+	}
+
+	var outputs []output
+	toStdout := false
+	for _, value := range values {
+		name, path := value, ""
+		if i := strings.Index(value, "="); i >= 0 {
+			name, path = value[:i], strings.TrimSpace(value[i+1:])
+		}
+
+		f := format.FromString(strings.TrimSpace(name))
+		if f == nil {
+			return nil, fmt.Errorf("unable to parse output format: %q", name)
+		}
+
+		if path == "" {
+			if toStdout {
+				return nil, fmt.Errorf("only one output can be written to stdout (give the others a path, e.g. -o json=release.json)")
+			}
+			toStdout = true
+		}
+
+		outputs = append(outputs, output{Format: *f, Path: path})
+	}
+	return outputs, nil
+}
+
+// validateOutputs checks each rendered json output against the published JSON schema with --validate, so that an
+// invalid document is never written or published.
+func validateOutputs(outputs []output, rendered [][]byte) error {
+	if !appConfig.Validate {
+		return nil
+	}
+
+	validated := false
+	for i, o := range outputs {
+		if o.Format != format.JSONFormat {
+			continue
+		}
+		if err := json.Validate(rendered[i]); err != nil {
+			return err
+		}
+		validated = true
+	}
+
+	if !validated {
+		return fmt.Errorf("--validate is only supported with the %q output format", format.JSONFormat)
+	}
+	return nil
+}
+
+// writeOutput writes the rendered changelog to the path of the output, otherwise to stdout (or only the highlights of
+// the release with --summary-lines, while the full changelog is still what gets published).
+func writeOutput(o output, description release.Description, rendered []byte) error {
+	if o.Path != "" {
+		if dir := filepath.Dir(o.Path); dir != "" {
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return fmt.Errorf("unable to create output directory %q: %w", dir, err)
+			}
+		}
+		if err := os.WriteFile(o.Path, rendered, 0644); err != nil {
+			return fmt.Errorf("unable to write %s output to %q: %w", o.Format, o.Path, err)
+		}
+		log.WithFields("path", o.Path, "format", o.Format).Info("wrote changelog")
+		return nil
+	}
+
+	if appConfig.Summary.Lines <= 0 {
+		_, err := os.Stdout.Write(rendered)
+		return err
+	}
+
+	p, err := summary.NewSummaryPresenter(summary.Config{
+		Description: description,
+		Lines:       appConfig.Summary.Lines,
+		Select:      appConfig.Summary.Select,
+		MaxLength:   appConfig.Summary.MaxLength,
+	})
+	if err != nil {
+		return err
+	}
+	return p.Present(os.Stdout)
+}
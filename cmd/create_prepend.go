@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/changelogfile"
+	"github.com/anchore/chronicle/chronicle/release/format"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// prependChangelog inserts the rendered release into the existing changelog file with --prepend (replacing the section
+// of the same release when re-run).
+func prependChangelog(f format.Format, description release.Description, rendered string) error {
+	if appConfig.Prepend == "" {
+		return nil
+	}
+
+	if f != format.MarkdownFormat && f != format.KeepAChangelogFormat {
+		return fmt.Errorf("--prepend is only supported with the %q and %q output formats (got %q)", format.MarkdownFormat, format.KeepAChangelogFormat, f)
+	}
+
+	log.WithFields("path", appConfig.Prepend, "version", description.Version).Info("updating changelog")
+
+	return changelogfile.Update(appConfig.Prepend, rendered, description.Version)
+}
@@ -2,25 +2,74 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/wagoodman/go-presenter"
+	"golang.org/x/term"
 
 	"github.com/anchore/chronicle/chronicle/release"
 	"github.com/anchore/chronicle/chronicle/release/format"
+	"github.com/anchore/chronicle/chronicle/release/format/blockkit"
+	"github.com/anchore/chronicle/chronicle/release/format/discord"
+	"github.com/anchore/chronicle/chronicle/release/format/feed"
+	"github.com/anchore/chronicle/chronicle/release/format/html"
 	"github.com/anchore/chronicle/chronicle/release/format/json"
+	"github.com/anchore/chronicle/chronicle/release/format/keepachangelog"
 	"github.com/anchore/chronicle/chronicle/release/format/markdown"
+	"github.com/anchore/chronicle/chronicle/release/format/pkgmanager"
+	"github.com/anchore/chronicle/chronicle/release/format/tabular"
+	"github.com/anchore/chronicle/chronicle/release/format/template"
+	"github.com/anchore/chronicle/chronicle/release/format/terminal"
+	"github.com/anchore/chronicle/chronicle/release/format/terraform"
 )
 
 type presentationTask func(description release.Description) (presenter.Presenter, error)
 
-func selectPresenter(f format.Format) (presentationTask, error) {
-	switch f {
+func selectPresenter(o output) (presentationTask, error) {
+	switch o.Format {
 	case format.MarkdownFormat:
 		return presentMarkdown, nil
 	case format.JSONFormat:
 		return presentJSON, nil
+	case format.HomebrewFormat:
+		return presentHomebrew, nil
+	case format.ScoopFormat:
+		return presentScoop, nil
+	case format.TemplateFormat:
+		return presentTemplate, nil
+	case format.HTMLFormat:
+		return presentHTML, nil
+	case format.ArtifactHubFormat:
+		return presentArtifactHub, nil
+	case format.KeepAChangelogFormat:
+		return presentKeepAChangelog, nil
+	case format.TerraformFormat:
+		return presentTerraform, nil
+	case format.AtomFormat:
+		return presentAtom, nil
+	case format.RSSFormat:
+		return presentRSS, nil
+	case format.SlackFormat:
+		return presentSlack, nil
+	case format.DiscordFormat:
+		return presentDiscord, nil
+	case format.TerminalFormat:
+		return presentTerminal(useColor(o)), nil
+	case format.CSVFormat:
+		return presentTable(tabular.CSV), nil
+	case format.TSVFormat:
+		return presentTable(tabular.TSV), nil
+	case format.DebianFormat:
+		return presentDebian, nil
+	case format.RPMFormat:
+		return presentRPM, nil
+	case format.KrewFormat:
+		return presentKrew, nil
+	case format.OperatorHubFormat:
+		return presentOperatorHub, nil
 	default:
-		return nil, fmt.Errorf("unsupported output format: %+v", f)
+		return nil, fmt.Errorf("unsupported output format: %+v", o.Format)
 	}
 }
 
@@ -28,9 +77,193 @@ func presentMarkdown(description release.Description) (presenter.Presenter, erro
 	return markdown.NewMarkdownPresenter(markdown.Config{
 		Description: description,
 		Title:       appConfig.Title,
+		Footer:      appConfig.Markdown.Footer,
+		Details:     appConfig.Markdown.Details,
+		Wrap:        appConfig.Markdown.Wrap,
+	})
+}
+
+func presentKeepAChangelog(description release.Description) (presenter.Presenter, error) {
+	return keepachangelog.NewKeepAChangelogPresenter(keepachangelog.Config{
+		Description: description,
+		Title:       appConfig.Title,
+	})
+}
+
+func presentTerraform(description release.Description) (presenter.Presenter, error) {
+	return terraform.NewTerraformPresenter(description)
+}
+
+func presentAtom(description release.Description) (presenter.Presenter, error) {
+	return feed.NewFeedPresenter(feed.Atom, description)
+}
+
+func presentRSS(description release.Description) (presenter.Presenter, error) {
+	return feed.NewFeedPresenter(feed.RSS, description)
+}
+
+func presentSlack(description release.Description) (presenter.Presenter, error) {
+	return blockkit.NewBlockKitPresenter(blockkit.Config{
+		Description: description,
+		Title:       appConfig.Title,
+	})
+}
+
+func presentDiscord(description release.Description) (presenter.Presenter, error) {
+	return discord.NewDiscordPresenter(discord.Config{
+		Description: description,
+		Title:       appConfig.Title,
+	})
+}
+
+func presentTerminal(color bool) presentationTask {
+	return func(description release.Description) (presenter.Presenter, error) {
+		return terminal.NewTerminalPresenter(terminal.Config{
+			Description: description,
+			Title:       appConfig.Title,
+			Color:       color,
+		})
+	}
+}
+
+func presentTable(delimiter rune) presentationTask {
+	return func(description release.Description) (presenter.Presenter, error) {
+		return tabular.NewTabularPresenter(tabular.Config{
+			Description: description,
+			Delimiter:   delimiter,
+		})
+	}
+}
+
+// isTerminal indicates whether the given file is a terminal.
+var isTerminal = func(f *os.File) bool {
+	return term.IsTerminal(int(f.Fd()))
+}
+
+// useColor indicates whether the terminal output should be colorized: only when written to stdout while it is a
+// terminal (never when written to a file or published), unless disabled with --no-color (or the NO_COLOR env var, see
+// https://no-color.org).
+func useColor(o output) bool {
+	if !o.toStdout() || appConfig.NoColor || os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	return isTerminal(os.Stdout)
+}
+
+func presentHTML(description release.Description) (presenter.Presenter, error) {
+	return html.NewHTMLPresenter(html.Config{
+		Description: description,
+		Title:       appConfig.Title,
+		EmbedCSS:    appConfig.HTML.EmbedCSS,
 	})
 }
 
 func presentJSON(description release.Description) (presenter.Presenter, error) {
-	return json.NewJSONPresenter(description)
+	if !appConfig.Provenance.Enabled {
+		return json.NewJSONPresenter(description)
+	}
+
+	provenance, err := newProvenance(description)
+	if err != nil {
+		return nil, err
+	}
+	return json.NewJSONPresenterWithProvenance(description, provenance)
+}
+
+func presentHomebrew(description release.Description) (presenter.Presenter, error) {
+	return pkgmanager.NewHomebrewPresenter(description)
+}
+
+func presentArtifactHub(description release.Description) (presenter.Presenter, error) {
+	return pkgmanager.NewArtifactHubPresenter(description)
+}
+
+func presentDebian(description release.Description) (presenter.Presenter, error) {
+	cfg := appConfig.Debian
+
+	if cfg.Package == "" {
+		name, err := repoDirName()
+		if err != nil {
+			return nil, err
+		}
+		cfg.Package = name
+	}
+
+	// note: these are the same environment variables used by dch (from devscripts)
+	if cfg.Maintainer == "" && os.Getenv("DEBFULLNAME") != "" && os.Getenv("DEBEMAIL") != "" {
+		cfg.Maintainer = fmt.Sprintf("%s <%s>", os.Getenv("DEBFULLNAME"), os.Getenv("DEBEMAIL"))
+	}
+
+	return pkgmanager.NewDebianPresenter(pkgmanager.DebianConfig{
+		Description:  description,
+		Package:      cfg.Package,
+		Revision:     cfg.Revision,
+		Distribution: cfg.Distribution,
+		Urgency:      cfg.Urgency,
+		Maintainer:   cfg.Maintainer,
+	})
+}
+
+func presentRPM(description release.Description) (presenter.Presenter, error) {
+	packager := appConfig.RPM.Packager
+
+	// note: this is the same environment variable used by rpmdev-packager (from rpmdevtools)
+	if packager == "" {
+		packager = os.Getenv("RPM_PACKAGER")
+	}
+
+	return pkgmanager.NewRPMPresenter(pkgmanager.RPMConfig{
+		Description: description,
+		Release:     appConfig.RPM.Release,
+		Packager:    packager,
+	})
+}
+
+func presentKrew(description release.Description) (presenter.Presenter, error) {
+	return pkgmanager.NewKrewPresenter(description)
+}
+
+func presentOperatorHub(description release.Description) (presenter.Presenter, error) {
+	operator := appConfig.OperatorHub.Operator
+	if operator == "" {
+		name, err := repoDirName()
+		if err != nil {
+			return nil, err
+		}
+		operator = name
+	}
+
+	return pkgmanager.NewOperatorHubPresenter(pkgmanager.OperatorHubConfig{
+		Description: description,
+		Operator:    operator,
+	})
+}
+
+// repoDirName is the name of the directory of the repo, used as the default name of the package being released.
+func repoDirName() (string, error) {
+	repoPath, err := filepath.Abs(appConfig.CliOptions.RepoPath)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Base(repoPath), nil
+}
+
+func presentScoop(description release.Description) (presenter.Presenter, error) {
+	return pkgmanager.NewScoopPresenter(description)
+}
+
+func presentTemplate(description release.Description) (presenter.Presenter, error) {
+	if appConfig.Template == "" {
+		return nil, fmt.Errorf("no changelog template given (see --template)")
+	}
+
+	contents, err := os.ReadFile(appConfig.Template)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read changelog template %q: %w", appConfig.Template, err)
+	}
+
+	return template.NewTemplatePresenter(appConfig.Template, string(contents), template.Data{
+		Description: description,
+		Title:       appConfig.Title,
+	})
 }
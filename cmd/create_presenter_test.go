@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/format"
+	"github.com/anchore/chronicle/internal/config"
+)
+
+func Test_useColor(t *testing.T) {
+	originalConfig, originalIsTerminal := appConfig, isTerminal
+	t.Cleanup(func() { appConfig, isTerminal = originalConfig, originalIsTerminal })
+	t.Setenv("NO_COLOR", "")
+
+	appConfig = &config.Application{}
+	isTerminal = func(*os.File) bool { return true }
+
+	tests := []struct {
+		name    string
+		output  output
+		noColor bool
+		want    bool
+	}{
+		{
+			name:   "stdout",
+			output: output{Format: format.TerminalFormat},
+			want:   true,
+		},
+		{
+			name:   "file",
+			output: output{Format: format.TerminalFormat, Path: "preview.txt"},
+		},
+		{
+			name:   "published",
+			output: publishedOutput(format.TerminalFormat),
+		},
+		{
+			name:    "disabled",
+			output:  output{Format: format.TerminalFormat},
+			noColor: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			appConfig.NoColor = tt.noColor
+			assert.Equal(t, tt.want, useColor(tt.output))
+		})
+	}
+}
+
+func Test_writeOutput_fileIsNeverColorized(t *testing.T) {
+	originalConfig, originalIsTerminal := appConfig, isTerminal
+	t.Cleanup(func() { appConfig, isTerminal = originalConfig, originalIsTerminal })
+	t.Setenv("NO_COLOR", "")
+
+	appConfig = &config.Application{}
+	isTerminal = func(*os.File) bool { return true }
+
+	description := release.Description{
+		SupportedChanges: []change.TypeTitle{{ChangeType: change.NewType("bug", change.SemVerPatch), Title: "Bug Fixes"}},
+		Release:          release.Release{Version: "v0.1.0"},
+		Changes: []change.Change{
+			{
+				Text:        "fix the thing",
+				ChangeTypes: []change.Type{change.NewType("bug", change.SemVerPatch)},
+				References:  []change.Reference{{Text: "PR #1", URL: "https://github.com/anchore/chronicle/pull/1"}},
+			},
+		},
+	}
+
+	o := output{Format: format.TerminalFormat, Path: filepath.Join(t.TempDir(), "preview.txt")}
+	rendered, err := renderDescription(o, description)
+	require.NoError(t, err)
+	require.NoError(t, writeOutput(o, description, rendered))
+
+	contents, err := os.ReadFile(o.Path)
+	require.NoError(t, err)
+	assert.Contains(t, string(contents), "fix the thing")
+	assert.NotContains(t, string(contents), "\x1b")
+
+	// whereas the same output written to a terminal is colorized
+	colorized, err := renderDescription(output{Format: format.TerminalFormat}, description)
+	require.NoError(t, err)
+	assert.Contains(t, string(colorized), "\x1b")
+}
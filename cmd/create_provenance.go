@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/format/json"
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/version"
+)
+
+// invokedAt is when chronicle was started, recorded as the start of the build within the provenance.
+var invokedAt = time.Now()
+
+// newProvenance describes how the release notes for the given release were produced: by which builder (e.g. the CI
+// workflow), from which source revision, and with which inputs.
+func newProvenance(description release.Description) (json.Provenance, error) {
+	repoPath := appConfig.CliOptions.RepoPath
+
+	source, err := sourceDescriptor(repoPath, description.Version)
+	if err != nil {
+		return json.Provenance{}, fmt.Errorf("unable to determine source revision: %w", err)
+	}
+
+	params := map[string]string{}
+	for k, v := range map[string]string{
+		"sinceTag": appConfig.SinceTag,
+		"untilTag": appConfig.UntilTag,
+		"source":   appConfig.Source,
+	} {
+		if v != "" {
+			params[k] = v
+		}
+	}
+
+	builderID, invocationID := ciBuilder(os.Getenv)
+	if appConfig.Provenance.BuilderID != "" {
+		builderID = appConfig.Provenance.BuilderID
+	}
+
+	started := invokedAt.UTC()
+	finished := time.Now().UTC()
+
+	return json.Provenance{
+		BuildDefinition: json.BuildDefinition{
+			BuildType:            json.BuildType,
+			ExternalParameters:   params,
+			ResolvedDependencies: []json.ResourceDescriptor{source},
+		},
+		RunDetails: json.RunDetails{
+			Builder: json.Builder{
+				ID: builderID,
+				Version: map[string]string{
+					"chronicle": version.FromBuild().Version,
+				},
+			},
+			Metadata: json.BuildMetadata{
+				InvocationID: invocationID,
+				StartedOn:    &started,
+				FinishedOn:   &finished,
+			},
+		},
+	}, nil
+}
+
+// sourceDescriptor returns the source repo and revision of the release, e.g.
+// "git+https://github.com/anchore/chronicle@refs/tags/v0.4.1" with the commit digest of the tag.
+func sourceDescriptor(repoPath, releaseVersion string) (json.ResourceDescriptor, error) {
+	ref := ""
+	var commit string
+	if tag, err := git.SearchForTag(repoPath, releaseVersion); err == nil {
+		ref = "refs/tags/" + tag.Name
+		commit = tag.Commit
+	} else {
+		// an unreleased (or speculated) version has no tag yet, so is described by the commit at HEAD
+		head, err := git.HeadCommit(repoPath)
+		if err != nil {
+			return json.ResourceDescriptor{}, err
+		}
+		ref = head
+		commit = head
+	}
+
+	repoURI := projectIdentity(repoPath)
+	if remote, err := git.RemoteURL(repoPath, appConfig.Remotes...); err == nil && remote != "" {
+		repoURI = normalizeRemoteURL(remote)
+	}
+
+	return json.ResourceDescriptor{
+		URI: fmt.Sprintf("git+%s@%s", repoURI, ref),
+		Digest: map[string]string{
+			"gitCommit": commit,
+		},
+	}, nil
+}
+
+// normalizeRemoteURL converts SCP-like remotes (e.g. git@github.com:anchore/chronicle.git) to https URLs and removes
+// any credentials and ".git" suffix, so that the source URI is stable across clone methods.
+func normalizeRemoteURL(remote string) string {
+	if strings.HasPrefix(remote, "git@") && !strings.Contains(remote, "://") {
+		hostPath := strings.SplitN(strings.TrimPrefix(remote, "git@"), ":", 2)
+		if len(hostPath) == 2 {
+			remote = "https://" + hostPath[0] + "/" + hostPath[1]
+		}
+	}
+	if u, err := url.Parse(remote); err == nil && u.Host != "" {
+		u.User = nil
+		if u.Scheme == "ssh" {
+			u.Scheme = "https"
+			u.Host = u.Hostname()
+		}
+		remote = u.String()
+	}
+	return strings.TrimSuffix(remote, ".git")
+}
+
+// ciBuilder returns the builder identity and invocation ID from the CI environment (currently GitHub Actions),
+// falling back to identifying chronicle itself as the builder.
+func ciBuilder(getenv func(string) string) (string, string) {
+	if getenv("GITHUB_ACTIONS") == "true" {
+		server := getenv("GITHUB_SERVER_URL")
+		if server == "" {
+			server = "https://github.com"
+		}
+
+		builderID := server + "/actions/runner"
+		if workflowRef := getenv("GITHUB_WORKFLOW_REF"); workflowRef != "" {
+			// e.g. https://github.com/anchore/chronicle/.github/workflows/release.yaml@refs/tags/v0.4.1
+			builderID = server + "/" + workflowRef
+		}
+
+		var invocationID string
+		if repo, runID := getenv("GITHUB_REPOSITORY"), getenv("GITHUB_RUN_ID"); repo != "" && runID != "" {
+			invocationID = fmt.Sprintf("%s/%s/actions/runs/%s", server, repo, runID)
+			if attempt := getenv("GITHUB_RUN_ATTEMPT"); attempt != "" {
+				invocationID += "/attempts/" + attempt
+			}
+		}
+		return builderID, invocationID
+	}
+	return "https://github.com/anchore/chronicle", ""
+}
@@ -0,0 +1,420 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/format"
+	"github.com/anchore/chronicle/chronicle/release/format/summary"
+	"github.com/anchore/chronicle/chronicle/release/publish"
+	"github.com/anchore/chronicle/chronicle/release/publish/email"
+	"github.com/anchore/chronicle/chronicle/release/publish/gcs"
+	"github.com/anchore/chronicle/chronicle/release/publish/gist"
+	"github.com/anchore/chronicle/chronicle/release/publish/mastodon"
+	"github.com/anchore/chronicle/chronicle/release/publish/npm"
+	"github.com/anchore/chronicle/chronicle/release/publish/s3"
+	"github.com/anchore/chronicle/chronicle/release/publish/slack"
+	"github.com/anchore/chronicle/chronicle/release/publish/x"
+	"github.com/anchore/chronicle/chronicle/release/releasers/github"
+	"github.com/anchore/chronicle/internal"
+	"github.com/anchore/chronicle/internal/log"
+	"github.com/anchore/chronicle/internal/vcs"
+)
+
+func selectPublishers(f format.Format, description release.Description) ([]publish.Target, error) {
+	var targets []publish.Target
+	for _, name := range appConfig.Publish {
+		var pub publish.Publisher
+		var content []byte
+		var err error
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "":
+			continue
+		case "gist":
+			pub, err = newGistPublisher(f)
+		case "s3":
+			pub, err = newS3Publisher(f, description)
+		case "gcs":
+			pub, err = newGCSPublisher(f, description)
+		case "mastodon":
+			pub, content, err = newMastodonPublisher(description)
+		case "x":
+			pub, content, err = newXPublisher(description)
+		case "npm":
+			pub, err = newNPMPublisher()
+		case "pypi":
+			err = fmt.Errorf("the description of a release cannot be changed once it has been uploaded to pypi (render the changelog into the long description before building the package instead)")
+		default:
+			return nil, fmt.Errorf("unsupported publisher: %q", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to create %q publisher: %w", name, err)
+		}
+		targets = append(targets, publish.Target{
+			Name:      name,
+			Publisher: pub,
+			Content:   content,
+		})
+	}
+
+	routed, err := selectRoutedPublishers(f, description)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(targets, routed...), nil
+}
+
+// selectRoutedPublishers returns a target for each destination of each route, with the content being the changelog
+// rendered with only the changes of the components owned by that route. Routes without any changes are skipped, so
+// teams are not notified of releases that do not concern them.
+func selectRoutedPublishers(f format.Format, description release.Description) ([]publish.Target, error) {
+	var targets []publish.Target
+	for _, route := range appConfig.Routing.ParsedRoutes {
+		slice := route.Slice(description, appConfig.Routing.ComponentRegex)
+		if len(slice.Changes) == 0 {
+			log.WithFields("components", strings.Join(route.Components, ", ")).Debug("no changes to route")
+			continue
+		}
+
+		content, err := renderDescription(publishedOutput(f), slice)
+		if err != nil {
+			return nil, fmt.Errorf("unable to render release notes for components %q: %w", strings.Join(route.Components, ", "), err)
+		}
+
+		for _, d := range route.Destinations {
+			var pub publish.Publisher
+			switch d.Kind {
+			case "slack":
+				pub, err = newSlackPublisher(d.Address)
+			case "email":
+				pub, err = newEmailPublisher(f, description, d.Address)
+			default:
+				err = fmt.Errorf("unsupported destination kind: %q", d.Kind)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("unable to create %q publisher: %w", d, err)
+			}
+			targets = append(targets, publish.Target{
+				Name:      d.String(),
+				Publisher: pub,
+				Content:   content,
+			})
+		}
+	}
+	return targets, nil
+}
+
+// renderDescription renders the given release description with the presenter for the format of the given output.
+func renderDescription(o output, description release.Description) ([]byte, error) {
+	presenterTask, err := selectPresenter(o)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := presenterTask(description)
+	if err != nil {
+		return nil, err
+	}
+
+	var rendered bytes.Buffer
+	if err := p.Present(&rendered); err != nil {
+		return nil, err
+	}
+	return rendered.Bytes(), nil
+}
+
+// publishChangelog publishes the rendered changelog (from the given state) to each target, recording the destinations
+// that were published to within the state file so that a later --publish-only run can resume with the failed targets.
+// Destinations that this release was already published to (by a previous run) are skipped.
+func publishChangelog(targets []publish.Target, state *publish.State) publish.Results {
+	ledger := loadPublishLedger(state.Description)
+
+	var pending []publish.Target
+	var skipped publish.Results
+	for _, t := range targets {
+		if ledger != nil {
+			if entry, ok := ledger.Find(t.Name, state.Project, state.Description.Version); ok {
+				log.Warnf("release %q was already published to %q at %s (skipping)", entry.Version, t.Name, internal.FormatDateTime(entry.PublishedAt))
+				skipped = append(skipped, publish.Result{Name: t.Name, URL: entry.URL})
+				continue
+			}
+		}
+		pending = append(pending, t)
+	}
+
+	results := publish.PublishAll(pending, []byte(state.Content), publish.RetryConfig{
+		Attempts: appConfig.PublishRetries + 1,
+		Backoff:  appConfig.PublishRetryBackoff,
+	})
+
+	for _, result := range results {
+		switch {
+		case result.Err != nil:
+			log.Errorf("unable to publish changelog to %q: %+v", result.Name, result.Err)
+		case result.URL != "":
+			// note: stdout is reserved for the changelog itself
+			fmt.Fprintln(os.Stderr, result.URL)
+		}
+	}
+
+	if ledger != nil && len(results.Succeeded()) > 0 {
+		ledger.Record(state.Project, state.Description.Version, results, runClock().Now())
+		if err := ledger.Save(appConfig.PublishLedger); err != nil {
+			log.Warnf("unable to record published releases: %+v", err)
+		}
+	}
+
+	results = append(skipped, results...)
+
+	state.Record(results)
+
+	if err := state.Save(appConfig.PublishState); err != nil {
+		// this only affects the ability to resume publishing later, so should not fail the run
+		log.Warnf("unable to record publish state: %+v", err)
+	}
+
+	return results
+}
+
+// loadPublishLedger returns the record of previously published releases, or nil if releases should not be deduplicated
+// (unreleased changelogs are previews, so are always published).
+func loadPublishLedger(description release.Description) *publish.Ledger {
+	if !appConfig.PublishDedupe || description.Version == release.UnreleasedVersion {
+		return nil
+	}
+
+	ledger, err := publish.LoadLedger(appConfig.PublishLedger)
+	if err != nil {
+		log.Warnf("unable to load published releases (not deduplicating): %+v", err)
+		return nil
+	}
+	return ledger
+}
+
+// projectIdentity returns a stable identity for the repo at the given path, preferring the remote URL since the local
+// path may differ between CI runs.
+func projectIdentity(repoPath string) string {
+	if remote, err := vcs.RemoteURL(repoPath, appConfig.Remotes...); err == nil && remote != "" {
+		return remote
+	}
+	if abs, err := filepath.Abs(repoPath); err == nil {
+		return abs
+	}
+	return repoPath
+}
+
+// runPublishOnly publishes the previously generated changelog to the configured destinations, skipping any
+// destinations that it has already been published to.
+func runPublishOnly() error {
+	state, err := publish.LoadState(appConfig.PublishState)
+	if err != nil {
+		return err
+	}
+
+	f := format.FromString(state.Format)
+	if f == nil {
+		return fmt.Errorf("unable to parse output format of previously generated changelog: %q", state.Format)
+	}
+
+	log.WithFields("version", state.Description.Version, "format", state.Format).Info("publishing previously generated changelog")
+
+	targets, err := selectPublishers(*f, state.Description)
+	if err != nil {
+		return err
+	}
+
+	var pending []publish.Target
+	for _, t := range targets {
+		if state.IsPublished(t.Name) {
+			log.Infof("changelog already published to %q (skipping)", t.Name)
+			continue
+		}
+		pending = append(pending, t)
+	}
+
+	results := publishChangelog(pending, state)
+
+	if err := updateReleaseIndex(*f, state.Description, state.Published.URLs()); err != nil {
+		return err
+	}
+
+	return results.Err()
+}
+
+func newGistPublisher(f format.Format) (publish.Publisher, error) {
+	filename := appConfig.Gist.Filename
+	if filename == "" {
+		filename = "CHANGELOG." + string(f)
+	}
+
+	ghConfig := appConfig.Github.ToGithubConfig()
+	token, err := github.ResolveToken(ghConfig.Host, ghConfig.Token, ghConfig.TokenFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return gist.NewPublisher(gist.Config{
+		APIURL:      ghConfig.APIURL,
+		Token:       token,
+		Public:      appConfig.Gist.Public,
+		Filename:    filename,
+		Description: appConfig.Gist.Description,
+	})
+}
+
+func newSlackPublisher(channel string) (publish.Publisher, error) {
+	token := appConfig.Slack.Token
+	if token == "" {
+		token = os.Getenv("SLACK_TOKEN")
+	}
+
+	return slack.NewPublisher(slack.Config{
+		APIURL:  appConfig.Slack.APIURL,
+		Token:   token,
+		Channel: channel,
+	})
+}
+
+func newEmailPublisher(f format.Format, description release.Description, to string) (publish.Publisher, error) {
+	subject, err := publish.RenderTemplate(appConfig.Email.Subject, description, string(f))
+	if err != nil {
+		return nil, err
+	}
+
+	return email.NewPublisher(email.Config{
+		Addr:        appConfig.Email.SMTPAddr,
+		Username:    appConfig.Email.Username,
+		Password:    appConfig.Email.Password,
+		From:        appConfig.Email.From,
+		To:          []string{to},
+		Subject:     subject,
+		ContentType: publish.ContentType(string(f)),
+	})
+}
+
+func newS3Publisher(f format.Format, description release.Description) (publish.Publisher, error) {
+	key, err := publish.RenderObjectKey(appConfig.S3.Key, description, string(f))
+	if err != nil {
+		return nil, err
+	}
+
+	return s3.NewPublisher(s3.Config{
+		Endpoint:        appConfig.S3.Endpoint,
+		Region:          appConfig.S3.Region,
+		Bucket:          appConfig.S3.Bucket,
+		Key:             key,
+		ContentType:     publish.ContentType(string(f)),
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	})
+}
+
+func newGCSPublisher(f format.Format, description release.Description) (publish.Publisher, error) {
+	key, err := publish.RenderObjectKey(appConfig.GCS.Key, description, string(f))
+	if err != nil {
+		return nil, err
+	}
+
+	return gcs.NewPublisher(gcs.Config{
+		Bucket:      appConfig.GCS.Bucket,
+		Key:         key,
+		ContentType: publish.ContentType(string(f)),
+		Token:       os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN"),
+	})
+}
+
+// renderAnnouncement renders the short post announcing the release (instead of the changelog), with the top change of
+// the release as the highlight.
+func renderAnnouncement(description release.Description, limit int) ([]byte, error) {
+	var highlight string
+	if top := summary.Highlights(description, 1, appConfig.Summary.Select); len(top) > 0 {
+		highlight = top[0].Text
+	}
+
+	post, err := publish.RenderAnnouncement(appConfig.Announcement.Template, description, highlight, limit)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(post), nil
+}
+
+func newMastodonPublisher(description release.Description) (publish.Publisher, []byte, error) {
+	content, err := renderAnnouncement(description, appConfig.Mastodon.MaxCharacters)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	token := appConfig.Mastodon.Token
+	if token == "" {
+		token = os.Getenv("MASTODON_TOKEN")
+	}
+
+	pub, err := mastodon.NewPublisher(mastodon.Config{
+		URL:        appConfig.Mastodon.URL,
+		Token:      token,
+		Visibility: appConfig.Mastodon.Visibility,
+	})
+	return pub, content, err
+}
+
+func newXPublisher(description release.Description) (publish.Publisher, []byte, error) {
+	content, err := renderAnnouncement(description, appConfig.X.MaxCharacters)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pub, err := x.NewPublisher(x.Config{
+		APIURL:            appConfig.X.APIURL,
+		APIKey:            os.Getenv("X_API_KEY"),
+		APISecret:         os.Getenv("X_API_SECRET"),
+		AccessToken:       os.Getenv("X_ACCESS_TOKEN"),
+		AccessTokenSecret: os.Getenv("X_ACCESS_TOKEN_SECRET"),
+	})
+	return pub, content, err
+}
+
+func newNPMPublisher() (publish.Publisher, error) {
+	token := appConfig.NPM.Token
+	if token == "" {
+		token = os.Getenv("NPM_TOKEN")
+	}
+
+	pkg := appConfig.NPM.Package
+	if pkg == "" {
+		name, err := packageJSONName(appConfig.CliOptions.RepoPath)
+		if err != nil {
+			return nil, err
+		}
+		pkg = name
+	}
+
+	return npm.NewPublisher(npm.Config{
+		RegistryURL: appConfig.NPM.Registry,
+		Token:       token,
+		Package:     pkg,
+	})
+}
+
+// packageJSONName returns the name of the npm package described by the package.json at the root of the repo.
+func packageJSONName(repoPath string) (string, error) {
+	contents, err := os.ReadFile(filepath.Join(repoPath, "package.json"))
+	if err != nil {
+		return "", fmt.Errorf("no npm package configured and unable to read package.json: %w", err)
+	}
+
+	var doc struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(contents, &doc); err != nil {
+		return "", fmt.Errorf("unable to parse package.json: %w", err)
+	}
+	return doc.Name, nil
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/log"
+	"github.com/anchore/chronicle/internal/vcs"
+)
+
+// detectSource returns the source for the host of the git remote when no source is configured: the first matching
+// entry in the source-hosts config, then the configured github and sourcehut hosts, otherwise github.
+func detectSource() string {
+	remoteURL, err := vcs.RemoteURL(appConfig.CliOptions.RepoPath, appConfig.Remotes...)
+	if err != nil {
+		log.WithFields("error", err).Debug("unable to read the git remote, defaulting to the github source")
+		return "github"
+	}
+
+	host := git.RemoteHost(remoteURL)
+	source := sourceForHost(host)
+	log.WithFields("host", host, "source", source).Debug("detected source from the git remote")
+	return source
+}
+
+func sourceForHost(host string) string {
+	for _, entry := range appConfig.SourceHosts {
+		if strings.EqualFold(entry.Host, host) {
+			return strings.ToLower(entry.Source)
+		}
+	}
+
+	switch {
+	case strings.EqualFold(host, appConfig.Github.Host):
+		return "github"
+	case appConfig.Sourcehut.Host != "" && strings.EqualFold(host, "git."+appConfig.Sourcehut.Host):
+		return "sourcehut"
+	}
+	return "github"
+}
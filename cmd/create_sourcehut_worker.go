@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/sourcehut"
+	"github.com/anchore/chronicle/internal/vcs"
+)
+
+func createChangelogFromSourcehut() (*release.Release, *release.Description, error) {
+	gitter, err := vcs.New(appConfig.CliOptions.RepoPath, appConfig.Remotes...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summer, err := sourcehut.NewSummarizer(gitter, appConfig.Sourcehut.ToSourcehutConfig())
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create summarizer: %w", err)
+	}
+
+	return createChangelogFromTagReleases(gitter, summer, getSourcehutSupportedChanges())
+}
+
+func getSourcehutSupportedChanges() []change.TypeTitle {
+	var supportedChanges []change.TypeTitle
+	for _, c := range appConfig.Sourcehut.Changes {
+		k := change.ParseSemVerKind(c.SemVerKind)
+		t := change.NewType(c.Type, k)
+		supportedChanges = append(supportedChanges, change.TypeTitle{
+			ChangeType: t,
+			Title:      c.Title,
+		})
+	}
+	if appConfig.Sourcehut.List != "" {
+		supportedChanges = append(supportedChanges, change.TypeTitle{
+			ChangeType: appConfig.Sourcehut.ToSourcehutConfig().PatchChangeType,
+			Title:      appConfig.Sourcehut.Patches.Title,
+		})
+	}
+	return supportedChanges
+}
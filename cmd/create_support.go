@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+// describeSupport adds the configured support matrix to the release being described, warning when the release is
+// within a line that has reached its end of life.
+func describeSupport(description *release.Description) {
+	if len(appConfig.Support.Lines) == 0 {
+		return
+	}
+
+	description.Support = release.NewSupportMatrix(appConfig.Support.ToSupportLines(), description.Version, description.Date)
+
+	for _, l := range description.Support {
+		if l.Current && l.EndOfLife {
+			description.Warnings = append(description.Warnings, release.Warning{
+				Kind:    release.EndOfLifeWarning,
+				Message: fmt.Sprintf("release %s is within the %s line, which reached its end of life on %s", description.Version, l.Version, l.EOL.Format("2006-01-02")),
+			})
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/versionbump"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+// planVersionBump determines how to set the version of the release within the configured manifests, returning the
+// action that makes the edits once the changelog has been written successfully (the edits are shown as a diff on
+// stderr for a dry run instead).
+func planVersionBump(description release.Description) (postCreateAction, error) {
+	manifests := appConfig.VersionBump.ToManifests()
+	if len(manifests) == 0 {
+		return nil, nil
+	}
+
+	if description.Version == "" || description.Version == release.UnreleasedVersion {
+		log.Warn("not setting the version within the manifests: the release version is not known (see --speculate-next-version)")
+		return nil, nil
+	}
+
+	version := strings.TrimPrefix(description.Version, appConfig.VersionBump.StripPrefix)
+
+	edits, err := versionbump.Plan(manifests, appConfig.CliOptions.RepoPath, version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to set the version within the manifests: %w", err)
+	}
+
+	if len(edits) == 0 {
+		log.WithFields("version", version).Info("the manifests already have the release version")
+		return nil, nil
+	}
+
+	if appConfig.VersionBump.DryRun {
+		for _, e := range edits {
+			fmt.Fprint(os.Stderr, e.Diff())
+		}
+		return nil, nil
+	}
+
+	return func() error {
+		for _, e := range edits {
+			log.WithFields("path", e.Path, "version", version).Info("setting the version within the manifest")
+		}
+		return versionbump.Apply(edits)
+	}, nil
+}
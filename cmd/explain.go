@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/anchore/chronicle/chronicle"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/internal"
+	"github.com/anchore/chronicle/internal/log"
+	"github.com/anchore/chronicle/internal/vcs"
+)
+
+var explainCmd = &cobra.Command{
+	Use:   "explain NUMBER [PATH]",
+	Short: "Explain why an issue or PR is (or is not) in the changelog",
+	Long: `Explain why an issue or PR is (or is not) in the changelog, by creating the changelog exactly as the create
+command would and reporting every decision made about the given issue or PR (the github source only).
+
+Explain why PR #1234 is not in the changelog since tag v0.14.0 (for ./)
+	chronicle explain 1234 --since-tag v0.14.0
+
+`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runExplain,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		var repo = "./"
+		if len(args) == 2 {
+			if !vcs.IsRepository(args[1]) {
+				return fmt.Errorf("given path is not a git or mercurial repository: %s", args[1])
+			}
+			repo = args[1]
+		} else {
+			log.Infof("no repository path given, assuming %q", repo)
+		}
+		appConfig.CliOptions.RepoPath = repo
+		return nil
+	},
+}
+
+func init() {
+	setExplainFlags(explainCmd.Flags())
+
+	rootCmd.AddCommand(explainCmd)
+}
+
+func setExplainFlags(flags *pflag.FlagSet) {
+	// note: these are not bound to the config (which is bound to the create command flags), see runExplain
+	flags.StringP(
+		"since-tag", "s", "",
+		"tag to start changelog processing from (inclusive)",
+	)
+
+	flags.StringP(
+		"until-tag", "u", "",
+		"tag to end changelog processing at (inclusive)",
+	)
+}
+
+func runExplain(cmd *cobra.Command, args []string) error {
+	number, err := strconv.Atoi(strings.TrimPrefix(args[0], "#"))
+	if err != nil {
+		return fmt.Errorf("invalid issue or PR number: %q", args[0])
+	}
+
+	for flag, value := range map[string]*string{
+		"since-tag": &appConfig.SinceTag,
+		"until-tag": &appConfig.UntilTag,
+	} {
+		if cmd.Flags().Changed(flag) {
+			*value, _ = cmd.Flags().GetString(flag)
+		}
+	}
+
+	// the decisions made about each issue and PR are traced as they are made, so are collected from the log
+	if logRecorder == nil {
+		logRecorder = log.NewRecorder(log.Log)
+		chronicle.SetLogger(logRecorder)
+	}
+
+	worker, err := selectWorker(appConfig.CliOptions.RepoPath)
+	if err != nil {
+		return err
+	}
+
+	_, description, _, err := worker()
+	if err != nil {
+		return err
+	}
+
+	return writeExplanation(os.Stdout, number, logRecorder.Entries(), description.Changes)
+}
+
+func writeExplanation(w io.Writer, number int, entries []log.Entry, changes change.Changes) error {
+	subjects := map[string]bool{
+		fmt.Sprintf("PR #%d", number):    true,
+		fmt.Sprintf("issue #%d", number): true,
+	}
+
+	var decisions []string
+	for _, e := range entries {
+		if e.Fields["decision"] == nil || !subjects[fmt.Sprint(e.Fields["subject"])] {
+			continue
+		}
+		decisions = append(decisions, fmt.Sprintf("  %s %s", internal.FormatDateTime(e.Time), e.Message))
+	}
+
+	included := changesWithIdentity(changes, fmt.Sprintf("github-pr:%d", number), fmt.Sprintf("github-issue:%d", number))
+
+	var sb strings.Builder
+	switch {
+	case len(included) > 0:
+		fmt.Fprintf(&sb, "#%d is in the changelog as:\n", number)
+		for _, c := range included {
+			var types []string
+			for _, t := range c.ChangeTypes {
+				types = append(types, t.Name)
+			}
+			fmt.Fprintf(&sb, "  - %s (%s)\n", c.Text, strings.Join(types, ", "))
+		}
+	case len(decisions) > 0:
+		fmt.Fprintf(&sb, "#%d is not in the changelog\n", number)
+	default:
+		fmt.Fprintf(&sb, "#%d is not in the changelog: it is not a merged PR or closed issue that was considered for the release (it may be outside of what was fetched, see github.max-pages and github.incremental-fetch)\n", number)
+	}
+
+	if len(decisions) > 0 {
+		fmt.Fprintf(&sb, "\ndecisions (in the order they were made):\n%s\n", strings.Join(decisions, "\n"))
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// changesWithIdentity returns the changes (including clustered changes) derived from any of the given entities.
+func changesWithIdentity(changes change.Changes, ids ...string) (results change.Changes) {
+	for _, c := range changes {
+		if c.SharesIdentity(change.Change{Identities: ids}) {
+			results = append(results, c)
+		}
+		results = append(results, changesWithIdentity(c.Children, ids...)...)
+	}
+	return results
+}
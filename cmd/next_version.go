@@ -8,8 +8,8 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
-	"github.com/anchore/chronicle/internal/git"
 	"github.com/anchore/chronicle/internal/log"
+	"github.com/anchore/chronicle/internal/vcs"
 )
 
 var nextVersionCmd = &cobra.Command{
@@ -20,8 +20,8 @@ var nextVersionCmd = &cobra.Command{
 	PreRunE: func(cmd *cobra.Command, args []string) error {
 		var repo = "./"
 		if len(args) == 1 {
-			if !git.IsRepository(args[0]) {
-				return fmt.Errorf("given path is not a git repository: %s", args[0])
+			if !vcs.IsRepository(args[0]) {
+				return fmt.Errorf("given path is not a git or mercurial repository: %s", args[0])
 			}
 			repo = args[0]
 		} else {
@@ -57,9 +57,12 @@ func bindNextVersionConfigOptions(flags *pflag.FlagSet) error {
 
 func runNextVersion(cmd *cobra.Command, args []string) error {
 	appConfig.SpeculateNextVersion = true
-	worker := selectWorker(appConfig.CliOptions.RepoPath)
+	worker, err := selectWorker(appConfig.CliOptions.RepoPath)
+	if err != nil {
+		return err
+	}
 
-	_, description, err := worker()
+	_, description, _, err := worker()
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/format"
+	"github.com/anchore/chronicle/chronicle/release/format/json"
+	"github.com/anchore/chronicle/chronicle/release/format/report"
+	"github.com/anchore/chronicle/internal"
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/log"
+	"github.com/anchore/chronicle/internal/vcs"
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report [PATH]",
+	Short: "Summarize the changes made within a window of time (irrespective of releases)",
+	Long: `Summarize the changes made within a window of time, grouped by change type and component. Unlike a changelog
+there is no notion of a release or version, which makes this suitable for periodic updates (e.g. a weekly digest).
+
+Summarize the changes made within the last week (for ./)
+	chronicle report --since 7d
+
+Summarize the changes made during March 2023 (for ../path/to/repo)
+	chronicle report --since 2023-03-01 --until 2023-04-01 ../path/to/repo
+
+`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runReport,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		var repo = "./"
+		if len(args) == 1 {
+			if !vcs.IsRepository(args[0]) {
+				return fmt.Errorf("given path is not a git or mercurial repository: %s", args[0])
+			}
+			repo = args[0]
+		} else {
+			log.Infof("no repository path given, assuming %q", repo)
+		}
+		appConfig.CliOptions.RepoPath = repo
+		return nil
+	},
+}
+
+func init() {
+	setReportFlags(reportCmd.Flags())
+	if err := bindReportConfigOptions(reportCmd.Flags()); err != nil {
+		panic(err)
+	}
+
+	rootCmd.AddCommand(reportCmd)
+}
+
+func setReportFlags(flags *pflag.FlagSet) {
+	flags.StringP(
+		"output", "o", string(format.Default()),
+		fmt.Sprintf("output format to use: %+v", format.All()),
+	)
+
+	flags.StringP(
+		"since", "s", "7d",
+		"the start of the report, either relative to now (e.g. 36h, 7d, 2w) or a date (e.g. 2023-03-01)",
+	)
+
+	flags.StringP(
+		"until", "u", "",
+		"the end of the report, either relative to now (e.g. 1d) or a date (e.g. 2023-03-08) (default is now)",
+	)
+
+	flags.StringP(
+		"title", "t", "What Changed",
+		"The title of the report output",
+	)
+
+	flags.StringP(
+		"group-by", "", "",
+		"group the changes by the period they were made in: week (ISO), month, or quarter (default is no grouping)",
+	)
+}
+
+func bindReportConfigOptions(flags *pflag.FlagSet) error {
+	for _, flag := range []string{
+		"output",
+		"since",
+		"until",
+		"title",
+		"group-by",
+	} {
+		if err := viper.BindPFlag("report."+flag, flags.Lookup(flag)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runReport(cmd *cobra.Command, args []string) error {
+	now := runClock().Now()
+	since, err := internal.ParseRelativeTime(appConfig.Report.Since, now)
+	if err != nil {
+		return fmt.Errorf("invalid --since value: %w", err)
+	}
+
+	until := now
+	if appConfig.Report.Until != "" {
+		until, err = internal.ParseRelativeTime(appConfig.Report.Until, now)
+		if err != nil {
+			return fmt.Errorf("invalid --until value: %w", err)
+		}
+	}
+
+	gitter, err := vcs.New(appConfig.CliOptions.RepoPath, appConfig.Remotes...)
+	if err != nil {
+		return err
+	}
+
+	summer, changeTypeTitles, err := newReportSummarizer(gitter)
+	if err != nil {
+		return fmt.Errorf("unable to create summarizer: %w", err)
+	}
+	defer func() {
+		printWarnings(runWarnings.List())
+	}()
+
+	r, err := release.ReportInfo(summer, release.ReportConfig{
+		Since:            since,
+		Until:            until,
+		ChangeTypeTitles: changeTypeTitles,
+	})
+	if err != nil {
+		return err
+	}
+
+	p, err := selectReportPresenter(*r)
+	if err != nil {
+		return err
+	}
+
+	return p.Present(os.Stdout)
+}
+
+// newReportSummarizer creates the summarizer for the configured source. Since a report has no notion of a release,
+// only the changes from the summarizer are used.
+func newReportSummarizer(gitter git.Interface) (release.Summarizer, []change.TypeTitle, error) {
+	source := strings.ToLower(appConfig.Source)
+	if source == "" {
+		source = detectSource()
+	}
+
+	switch source {
+	case "composite":
+		return newCompositeSummarizer(gitter)
+	default:
+		return newSourceSummarizer(gitter, source)
+	}
+}
+
+func selectReportPresenter(r release.Report) (presenter.Presenter, error) {
+	f := format.FromString(appConfig.Report.Output)
+	if f == nil {
+		return nil, fmt.Errorf("unable to parse output format: %q", appConfig.Report.Output)
+	}
+
+	switch *f {
+	case format.MarkdownFormat:
+		return report.NewReportPresenter(report.Config{
+			Report:           r,
+			Title:            appConfig.Report.Title,
+			ComponentPattern: appConfig.Report.ComponentRegex,
+			GroupBy:          appConfig.Report.Period,
+			FiscalYearStart:  appConfig.Report.FiscalYearMonth,
+		})
+	case format.JSONFormat:
+		return json.NewJSONReportPresenter(r)
+	default:
+		return nil, fmt.Errorf("unsupported output format: %+v", f)
+	}
+}
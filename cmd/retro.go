@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/wagoodman/go-presenter"
+
+	"github.com/anchore/chronicle/chronicle/release"
+	"github.com/anchore/chronicle/chronicle/release/format"
+	"github.com/anchore/chronicle/chronicle/release/format/json"
+	"github.com/anchore/chronicle/chronicle/release/format/report"
+	"github.com/anchore/chronicle/internal/log"
+	"github.com/anchore/chronicle/internal/vcs"
+)
+
+var retroCmd = &cobra.Command{
+	Use:   "retro [PATH]",
+	Short: "Summarize all releases made within a year (for project retrospectives)",
+	Long: `Summarize all releases made within a calendar year: the number of changes of each change type, the top
+contributors, and the biggest release. Each change is attributed to the first release made after it.
+
+Summarize the releases made during 2024 (for ./)
+	chronicle retro --year 2024
+
+Summarize the releases made so far this year as JSON (for ../path/to/repo)
+	chronicle retro -o json ../path/to/repo
+
+`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runRetro,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		var repo = "./"
+		if len(args) == 1 {
+			if !vcs.IsRepository(args[0]) {
+				return fmt.Errorf("given path is not a git or mercurial repository: %s", args[0])
+			}
+			repo = args[0]
+		} else {
+			log.Infof("no repository path given, assuming %q", repo)
+		}
+		appConfig.CliOptions.RepoPath = repo
+		return nil
+	},
+}
+
+func init() {
+	setRetroFlags(retroCmd.Flags())
+	if err := bindRetroConfigOptions(retroCmd.Flags()); err != nil {
+		panic(err)
+	}
+
+	rootCmd.AddCommand(retroCmd)
+}
+
+func setRetroFlags(flags *pflag.FlagSet) {
+	flags.StringP(
+		"output", "o", string(format.Default()),
+		fmt.Sprintf("output format to use: %+v", format.All()),
+	)
+
+	flags.IntP(
+		"year", "y", 0,
+		"the calendar year to summarize (default is the current year)",
+	)
+
+	flags.StringP(
+		"title", "t", "Year in Review",
+		"The title of the retrospective output",
+	)
+}
+
+func bindRetroConfigOptions(flags *pflag.FlagSet) error {
+	for _, flag := range []string{
+		"output",
+		"year",
+		"title",
+	} {
+		if err := viper.BindPFlag("retro."+flag, flags.Lookup(flag)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runRetro(cmd *cobra.Command, args []string) error {
+	year := appConfig.Retro.Year
+	if year == 0 {
+		year = runClock().Now().Year()
+	}
+	since := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	until := since.AddDate(1, 0, 0)
+
+	gitter, err := vcs.New(appConfig.CliOptions.RepoPath, appConfig.Remotes...)
+	if err != nil {
+		return err
+	}
+
+	summer, changeTypeTitles, err := newReportSummarizer(gitter)
+	if err != nil {
+		return fmt.Errorf("unable to create summarizer: %w", err)
+	}
+	defer func() {
+		printWarnings(runWarnings.List())
+	}()
+
+	r, err := release.RetroInfo(summer, release.RetroConfig{
+		Since:            since,
+		Until:            until,
+		ChangeTypeTitles: changeTypeTitles,
+		TopContributors:  appConfig.Retro.TopContributors,
+	})
+	if err != nil {
+		return err
+	}
+
+	p, err := selectRetroPresenter(*r)
+	if err != nil {
+		return err
+	}
+
+	return p.Present(os.Stdout)
+}
+
+func selectRetroPresenter(r release.Retro) (presenter.Presenter, error) {
+	f := format.FromString(appConfig.Retro.Output)
+	if f == nil {
+		return nil, fmt.Errorf("unable to parse output format: %q", appConfig.Retro.Output)
+	}
+
+	switch *f {
+	case format.MarkdownFormat:
+		return report.NewRetroPresenter(report.RetroConfig{
+			Retro: r,
+			Title: appConfig.Retro.Title,
+		})
+	case format.JSONFormat:
+		return json.NewJSONRetroPresenter(r)
+	default:
+		return nil, fmt.Errorf("unsupported output format: %+v", f)
+	}
+}
@@ -44,6 +44,15 @@ func setGlobalFlags(flags *pflag.FlagSet) error {
 		return err
 	}
 
+	flag = "no-cache"
+	flags.BoolP(
+		flag, "", false,
+		"do not read or write the on-disk cache of API responses",
+	)
+	if err := viper.BindPFlag(flag, flags.Lookup(flag)); err != nil {
+		return err
+	}
+
 	flags.CountVarP(&persistentOpts.Verbosity, "verbose", "v", "increase verbosity (-v = info, -vv = debug)")
 
 	return nil
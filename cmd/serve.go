@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/badge"
+	"github.com/anchore/chronicle/chronicle/release/feedback"
+	"github.com/anchore/chronicle/chronicle/release/releasers/github"
+	"github.com/anchore/chronicle/internal/log"
+	"github.com/anchore/chronicle/internal/vcs"
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve [PATH]",
+	Short: "Serve release information for a repo over HTTP",
+	Long: `Serve release information for a repo over HTTP.
+
+Endpoints:
+	GET /feedback                  the reactions on the latest GitHub release (as JSON)
+	GET /feedback/<version>        the reactions on the GitHub release for the given version (as JSON)
+	GET /badge/latest-release      a shields.io endpoint badge with the version of the latest release
+	GET /badge/unreleased-changes  a shields.io endpoint badge with the number of changes since the latest release
+
+Serve on port 9000
+	chronicle serve --listen :9000
+`,
+	Args:    cobra.MaximumNArgs(1),
+	RunE:    runServe,
+	PreRunE: createCmd.PreRunE,
+}
+
+func init() {
+	setServeFlags(serveCmd.Flags())
+	if err := bindServeConfigOptions(serveCmd.Flags()); err != nil {
+		panic(err)
+	}
+
+	rootCmd.AddCommand(serveCmd)
+}
+
+func setServeFlags(flags *pflag.FlagSet) {
+	flags.StringP(
+		"listen", "l", ":8080",
+		"the address to serve on",
+	)
+}
+
+func bindServeConfigOptions(flags *pflag.FlagSet) error {
+	return viper.BindPFlag("serve.listen", flags.Lookup("listen"))
+}
+
+func runServe(_ *cobra.Command, _ []string) error {
+	gitter, err := vcs.New(appConfig.CliOptions.RepoPath, appConfig.Remotes...)
+	if err != nil {
+		return err
+	}
+
+	ghConfig := newGithubConfig()
+	// feedback and badges are cached in memory (per serve.feedback-cache-ttl and serve.badge-cache-ttl), so the on-disk cache would only serve stale reactions
+	ghConfig.CacheDir = ""
+
+	summer, err := github.NewSummarizer(gitter, ghConfig)
+	if err != nil {
+		return fmt.Errorf("unable to create summarizer: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	feedbackHandler := feedback.NewHandler(summer, "/feedback", appConfig.Serve.FeedbackCacheTTL)
+	mux.Handle("/feedback", feedbackHandler)
+	mux.Handle("/feedback/", feedbackHandler)
+	mux.Handle("/badge/", badge.NewHandler(summer, "/badge", appConfig.Serve.BadgeCacheTTL))
+
+	server := &http.Server{
+		Addr:              appConfig.Serve.Listen,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	log.WithFields("address", appConfig.Serve.Listen).Info("serving")
+
+	return server.ListenAndServe()
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/anchore/chronicle/chronicle/release/format/template"
+)
+
+var templateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "Work with changelog templates",
+}
+
+var templateLintCmd = &cobra.Command{
+	Use:   "lint PATH...",
+	Short: "Check changelog templates for syntax errors and unknown fields, functions, and templates",
+	Long: `Check changelog templates for syntax errors and unknown fields, functions, and templates.
+
+Each template is checked against the release model available when rendering a changelog, with any problems reported
+as "<path>:<line>:<column>: <problem>".
+
+Lint a template
+	chronicle template lint changelog.tmpl
+`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runTemplateLint,
+}
+
+func init() {
+	templateCmd.AddCommand(templateLintCmd)
+	rootCmd.AddCommand(templateCmd)
+}
+
+func runTemplateLint(_ *cobra.Command, args []string) error {
+	var count int
+	for _, path := range args {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("unable to read template %q: %w", path, err)
+		}
+
+		for _, problem := range template.Lint(path, string(contents)) {
+			fmt.Printf("%s:%s\n", path, problem)
+			count++
+		}
+	}
+
+	if count > 0 {
+		return fmt.Errorf("found %d problem(s)", count)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/anchore/chronicle/chronicle/release/releasers/github"
+	"github.com/anchore/chronicle/internal/selfupdate"
+	"github.com/anchore/chronicle/internal/version"
+	hashiVersion "github.com/anchore/go-version"
+)
+
+var updateSelfOpts struct {
+	Version string
+	Check   bool
+	Force   bool
+}
+
+var updateSelfCmd = &cobra.Command{
+	Use:   "update-self",
+	Short: "Replace this chronicle binary with the latest release",
+	Long: `Replace this chronicle binary with the latest release (or the given --version) from the chronicle GitHub releases.
+
+The release archive for this platform is verified against the checksums published with the release before the binary
+is replaced. This checks the integrity of the download only: the checksums come from the same release, and no
+signature is checked (releases are not signed). This is meant for installations made with the install script (or by downloading a release directly);
+installations from a package manager should be updated with that package manager instead.
+
+Check for a newer release without installing it
+	chronicle update-self --check
+
+Install a specific release
+	chronicle update-self --version v0.5.0
+`,
+	Args: cobra.NoArgs,
+	RunE: runUpdateSelf,
+}
+
+func init() {
+	flags := updateSelfCmd.Flags()
+	flags.StringVar(&updateSelfOpts.Version, "version", "", "the release tag to install (default is the latest release)")
+	flags.BoolVar(&updateSelfOpts.Check, "check", false, "only report if a newer release is available")
+	flags.BoolVar(&updateSelfOpts.Force, "force", false, "install the release even if it is not newer (or this is not a release build)")
+
+	rootCmd.AddCommand(updateSelfCmd)
+}
+
+func runUpdateSelf(_ *cobra.Command, _ []string) error {
+	// a token is only used to avoid the lower rate limits of unauthenticated requests
+	configToken, tokenFile := selfUpdateToken(appConfig.Github.ToGithubConfig())
+	token, _ := github.ResolveToken(selfUpdateHost, configToken, tokenFile)
+	updater := selfupdate.NewUpdater(selfupdate.DefaultAPIURL, token)
+
+	release, err := updater.FetchRelease(updateSelfOpts.Version)
+	if err != nil {
+		return err
+	}
+
+	current := version.FromBuild()
+	newer, err := isNewerRelease(current, release.Version())
+	if err != nil {
+		return err
+	}
+
+	if updateSelfOpts.Check {
+		switch {
+		case !current.IsProductionBuild():
+			fmt.Printf("chronicle %s is the latest release (this is not a release build)\n", release.Tag)
+		case newer:
+			fmt.Printf("chronicle %s is available (installed: %s)\n", release.Tag, current.Version)
+		default:
+			fmt.Printf("chronicle %s is up to date\n", current.Version)
+		}
+		return nil
+	}
+
+	if !newer && !updateSelfOpts.Force {
+		if !current.IsProductionBuild() {
+			return fmt.Errorf("this is not a release build of chronicle (version %q), use --force to replace it anyway", current.Version)
+		}
+		fmt.Printf("chronicle %s is up to date\n", current.Version)
+		return nil
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("unable to find the chronicle binary: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(exe); err == nil {
+		exe = resolved
+	}
+
+	binary, err := updater.Download(*release)
+	if err != nil {
+		return err
+	}
+
+	if err := selfupdate.ReplaceBinary(exe, binary); err != nil {
+		return err
+	}
+
+	fmt.Printf("updated %s to %s\n", exe, release.Tag)
+	return nil
+}
+
+// selfUpdateHost is where chronicle is released, regardless of the configured github host.
+const selfUpdateHost = "github.com"
+
+// selfUpdateToken returns the configured token and token file, which are only used for the releases of chronicle when
+// they are for github.com (a token for a github enterprise host must never be sent elsewhere).
+func selfUpdateToken(ghConfig github.Config) (token, tokenFile string) {
+	if ghConfig.Host != "" && ghConfig.Host != selfUpdateHost {
+		return "", ""
+	}
+	return ghConfig.Token, ghConfig.TokenFile
+}
+
+// isNewerRelease indicates if the given release version is newer than the running build (never true for non-release
+// builds, which cannot be compared).
+func isNewerRelease(current version.Version, releaseVersion string) (bool, error) {
+	if !current.IsProductionBuild() {
+		return false, nil
+	}
+	currentVersion, err := hashiVersion.NewVersion(current.Version)
+	if err != nil {
+		return false, fmt.Errorf("unable to parse current version: %w", err)
+	}
+	latest, err := hashiVersion.NewVersion(releaseVersion)
+	if err != nil {
+		return false, fmt.Errorf("unable to parse release version %q: %w", releaseVersion, err)
+	}
+	return latest.GreaterThan(currentVersion), nil
+}
@@ -6,12 +6,14 @@ import (
 	"path"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/adrg/xdg"
 	"github.com/mitchellh/go-homedir"
 	"github.com/spf13/viper"
 	"gopkg.in/yaml.v2"
 
+	"github.com/anchore/chronicle/chronicle/release"
 	"github.com/anchore/chronicle/internal"
 	"github.com/anchore/go-logger"
 )
@@ -27,18 +29,77 @@ type parser interface {
 }
 
 type Application struct {
-	ConfigPath           string           `yaml:",omitempty" json:"configPath"`                                                               // the location where the application config was read from (either from -c or discovered while loading)
-	Output               string           `yaml:"output" json:"output" mapstructure:"output"`                                                 // -o, the Presenter hint string to use for report formatting
-	Quiet                bool             `yaml:"quiet" json:"quiet" mapstructure:"quiet"`                                                    // -q, indicates to not show any status output to stderr (ETUI or logging UI)
-	Log                  logging          `yaml:"log" json:"log" mapstructure:"log"`                                                          // all logging-related options
-	CliOptions           CliOnlyOptions   `yaml:"-" json:"-"`                                                                                 // all options only available through the CLI (not via env vars or config)
-	SpeculateNextVersion bool             `yaml:"speculate-next-version" json:"speculate-next-version" mapstructure:"speculate-next-version"` // -n, guess the next version based on issues and PRs
-	VersionFile          string           `yaml:"version-file" json:"version-file" mapstructure:"version-file"`                               // --version-file, the path to a file containing the version to use for the changelog
-	SinceTag             string           `yaml:"since-tag" json:"since-tag" mapstructure:"since-tag"`                                        // -s, the tag to start the changelog from
-	UntilTag             string           `yaml:"until-tag" json:"until-tag" mapstructure:"until-tag"`                                        // -u, the tag to end the changelog at
-	EnforceV0            bool             `yaml:"enforce-v0" json:"enforce-v0" mapstructure:"enforce-v0"`
-	Title                string           `yaml:"title" json:"title" mapstructure:"title"`
-	Github               githubSummarizer `yaml:"github" json:"github" mapstructure:"github"`
+	ConfigPath           string                   `yaml:",omitempty" json:"configPath"`                                                               // the location where the application config was read from (either from -c or discovered while loading)
+	Output               []string                 `yaml:"output" json:"output" mapstructure:"output"`                                                 // -o, the formats to render the changelog with, each optionally with a file to write it to (e.g. "json=release.json")
+	Template             string                   `yaml:"template" json:"template" mapstructure:"template"`                                           // --template, the path to the changelog template used with the "template" output format
+	Quiet                bool                     `yaml:"quiet" json:"quiet" mapstructure:"quiet"`                                                    // -q, indicates to not show any status output to stderr (ETUI or logging UI)
+	Log                  logging                  `yaml:"log" json:"log" mapstructure:"log"`                                                          // all logging-related options
+	CliOptions           CliOnlyOptions           `yaml:"-" json:"-"`                                                                                 // all options only available through the CLI (not via env vars or config)
+	SpeculateNextVersion bool                     `yaml:"speculate-next-version" json:"speculate-next-version" mapstructure:"speculate-next-version"` // -n, guess the next version based on issues and PRs
+	VersionFile          string                   `yaml:"version-file" json:"version-file" mapstructure:"version-file"`                               // --version-file, the path to a file containing the version to use for the changelog
+	VersionSource        versionSource            `yaml:"version-source" json:"version-source" mapstructure:"version-source"`                         // where to read the version of an untagged release from (e.g. package.json)
+	CargoRelease         cargoRelease             `yaml:"cargo-release" json:"cargo-release" mapstructure:"cargo-release"`
+	VersionBump          versionBump              `yaml:"version-bump" json:"version-bump" mapstructure:"version-bump"`          // set the version of the release within the project manifests (e.g. package.json)
+	SinceTag             string                   `yaml:"since-tag" json:"since-tag" mapstructure:"since-tag"`                   // -s, the tag to start the changelog from
+	UntilTag             string                   `yaml:"until-tag" json:"until-tag" mapstructure:"until-tag"`                   // -u, the tag to end the changelog at
+	PrereleaseMode       string                   `yaml:"prerelease-mode" json:"prerelease-mode" mapstructure:"prerelease-mode"` // whether pre-releases are considered as the last release (stable, include, or channel)
+	EnforceV0            bool                     `yaml:"enforce-v0" json:"enforce-v0" mapstructure:"enforce-v0"`
+	Title                string                   `yaml:"title" json:"title" mapstructure:"title"`
+	ExposeRaw            bool                     `yaml:"expose-raw" json:"expose-raw" mapstructure:"expose-raw"`                   // --expose-raw, attach the raw API payloads of issues and PRs to each change
+	NewContributors      bool                     `yaml:"new-contributors" json:"new-contributors" mapstructure:"new-contributors"` // --new-contributors, add a section crediting the people who contributed for the first time in the release
+	NoColor              bool                     `yaml:"no-color" json:"no-color" mapstructure:"no-color"`                         // --no-color, do not colorize the terminal output format (even when writing to a terminal)
+	Prepend              string                   `yaml:"prepend" json:"prepend" mapstructure:"prepend"`                            // --prepend, insert the release into the given existing changelog file (e.g. CHANGELOG.md)
+	Validate             bool                     `yaml:"validate" json:"validate" mapstructure:"validate"`                         // --validate, check the json output against the published JSON schema before writing it
+	NoCache              bool                     `yaml:"no-cache" json:"no-cache" mapstructure:"no-cache"`                         // --no-cache, do not read or write the on-disk cache of API responses
+	ExportBundle         string                   `yaml:"export-bundle" json:"export-bundle" mapstructure:"export-bundle"`          // --export-bundle, write the fetched API responses to a tarball (for creating the changelog on an air-gapped machine)
+	ImportBundle         string                   `yaml:"import-bundle" json:"import-bundle" mapstructure:"import-bundle"`          // --import-bundle, create the changelog from the API responses within a tarball instead of making requests
+	DebugBundle          string                   `yaml:"debug-bundle" json:"debug-bundle" mapstructure:"debug-bundle"`             // --debug-bundle, write a zip archive describing how the changelog was created (for troubleshooting)
+	Source               string                   `yaml:"source" json:"source" mapstructure:"source"`                               // the summarizer to source changes from (e.g. github, jira, linear, gerrit, sourcehut, commits, fragments, keepachangelog, plugin, composite)
+	Remotes              []string                 `yaml:"remotes" json:"remotes" mapstructure:"remotes"`                            // the git remotes (or mercurial paths) to take the remote URL from, in order of preference
+	SourceHosts          []sourceHost             `yaml:"source-hosts" json:"source-hosts" mapstructure:"source-hosts"`             // the source for each git remote host, used when no source is configured (e.g. for self-hosted instances on custom domains)
+	Github               githubSummarizer         `yaml:"github" json:"github" mapstructure:"github"`
+	Jira                 jiraSummarizer           `yaml:"jira" json:"jira" mapstructure:"jira"`
+	Linear               linearSummarizer         `yaml:"linear" json:"linear" mapstructure:"linear"`
+	Gerrit               gerritSummarizer         `yaml:"gerrit" json:"gerrit" mapstructure:"gerrit"`
+	Commits              commitsSummarizer        `yaml:"commits" json:"commits" mapstructure:"commits"`
+	Fragments            fragmentsSummarizer      `yaml:"fragments" json:"fragments" mapstructure:"fragments"`
+	KeepAChangelog       keepAChangelogSummarizer `yaml:"keepachangelog" json:"keepachangelog" mapstructure:"keepachangelog"`
+	Plugin               pluginSummarizer         `yaml:"plugin" json:"plugin" mapstructure:"plugin"`
+	Composite            compositeSummarizer      `yaml:"composite" json:"composite" mapstructure:"composite"`
+	MultiRepo            multiRepo                `yaml:"multi-repo" json:"multi-repo" mapstructure:"multi-repo"`
+	Sourcehut            sourcehutSummarizer      `yaml:"sourcehut" json:"sourcehut" mapstructure:"sourcehut"`
+	Report               report                   `yaml:"report" json:"report" mapstructure:"report"`
+	Retro                retro                    `yaml:"retro" json:"retro" mapstructure:"retro"`
+	Serve                serve                    `yaml:"serve" json:"serve" mapstructure:"serve"`
+	Publish              []string                 `yaml:"publish" json:"publish" mapstructure:"publish"`                                           // --publish, the destinations to publish the changelog to after it has been written (e.g. gist, s3, gcs, mastodon, x, npm)
+	PublishOnly          bool                     `yaml:"publish-only" json:"publish-only" mapstructure:"publish-only"`                            // --publish-only, publish the previously generated changelog instead of generating a new one
+	PublishRetries       int                      `yaml:"publish-retries" json:"publish-retries" mapstructure:"publish-retries"`                   // the number of times to retry each publish destination before giving up
+	PublishRetryBackoff  time.Duration            `yaml:"publish-retry-backoff" json:"publish-retry-backoff" mapstructure:"publish-retry-backoff"` // the delay before the first retry (doubled for each retry after)
+	PublishState         string                   `yaml:"publish-state" json:"publish-state" mapstructure:"publish-state"`                         // the path to record the last published changelog (defaults to the user cache dir)
+	PublishDedupe        bool                     `yaml:"publish-dedupe" json:"publish-dedupe" mapstructure:"publish-dedupe"`                      // skip destinations that a release was already published to (by a previous run)
+	PublishLedger        string                   `yaml:"publish-ledger" json:"publish-ledger" mapstructure:"publish-ledger"`                      // the path to record every published release (defaults to the user cache dir)
+	Gist                 gistPublisher            `yaml:"gist" json:"gist" mapstructure:"gist"`
+	S3                   s3Publisher              `yaml:"s3" json:"s3" mapstructure:"s3"`
+	GCS                  gcsPublisher             `yaml:"gcs" json:"gcs" mapstructure:"gcs"`
+	Slack                slackPublisher           `yaml:"slack" json:"slack" mapstructure:"slack"`
+	Email                emailPublisher           `yaml:"email" json:"email" mapstructure:"email"`
+	Mastodon             mastodonPublisher        `yaml:"mastodon" json:"mastodon" mapstructure:"mastodon"`
+	NPM                  npmPublisher             `yaml:"npm" json:"npm" mapstructure:"npm"`
+	X                    xPublisher               `yaml:"x" json:"x" mapstructure:"x"`
+	Announcement         announcement             `yaml:"announcement" json:"announcement" mapstructure:"announcement"`
+	Routing              routing                  `yaml:"routing" json:"routing" mapstructure:"routing"`
+	Index                releaseIndex             `yaml:"index" json:"index" mapstructure:"index"`
+	Deprecations         deprecations             `yaml:"deprecations" json:"deprecations" mapstructure:"deprecations"`
+	Support              support                  `yaml:"support" json:"support" mapstructure:"support"`
+	FeatureFlags         featureFlags             `yaml:"feature-flags" json:"feature-flags" mapstructure:"feature-flags"`
+	Provenance           provenance               `yaml:"provenance" json:"provenance" mapstructure:"provenance"`
+	Summary              summary                  `yaml:"summary" json:"summary" mapstructure:"summary"`
+	Markdown             markdown                 `yaml:"markdown" json:"markdown" mapstructure:"markdown"`
+	RPM                  rpm                      `yaml:"rpm" json:"rpm" mapstructure:"rpm"`
+	Debian               debian                   `yaml:"debian" json:"debian" mapstructure:"debian"`
+	OperatorHub          operatorHub              `yaml:"operatorhub" json:"operatorhub" mapstructure:"operatorhub"`
+	Helm                 helm                     `yaml:"helm" json:"helm" mapstructure:"helm"`
+	HTML                 html                     `yaml:"html" json:"html" mapstructure:"html"`
 }
 
 func newApplicationConfig(v *viper.Viper, cliOpts CliOnlyOptions) *Application {
@@ -73,7 +134,15 @@ func LoadApplicationConfig(v *viper.Viper, cliOpts CliOnlyOptions) (*Application
 // init loads the default configuration values into the viper instance (before the config values are read and parsed).
 func (cfg Application) loadDefaultValues(v *viper.Viper) {
 	// set the default values for primitive fields in this struct
-	// TODO...
+	v.SetDefault("source", "")
+	v.SetDefault("prerelease-mode", release.StableReleasesOnly)
+	v.SetDefault("source-hosts", []sourceHost{})
+	v.SetDefault("remotes", []string{"upstream", "origin"})
+	v.SetDefault("publish-retries", 2)
+	v.SetDefault("publish-retry-backoff", 2*time.Second)
+	v.SetDefault("publish-state", "")
+	v.SetDefault("publish-dedupe", true)
+	v.SetDefault("publish-ledger", "")
 
 	// for each field in the configuration struct, see if the field implements the defaultValueLoader interface and invoke it if it does
 	value := reflect.ValueOf(cfg)
@@ -92,6 +161,20 @@ func (cfg *Application) parseConfigValues() error {
 		return errors.New("cannot specify both --speculate-next-version and --until-tag")
 	}
 
+	mode, err := release.ParsePrereleaseMode(cfg.PrereleaseMode)
+	if err != nil {
+		return fmt.Errorf("invalid prerelease-mode: %w", err)
+	}
+	cfg.PrereleaseMode = mode
+
+	if cfg.PublishState == "" {
+		cfg.PublishState = path.Join(xdg.CacheHome, internal.ApplicationName, "publish-state.json")
+	}
+
+	if cfg.PublishLedger == "" {
+		cfg.PublishLedger = path.Join(xdg.CacheHome, internal.ApplicationName, "publish-ledger.json")
+	}
+
 	if cfg.Quiet {
 		cfg.Log.LevelOpt = logger.DisabledLevel
 	} else {
@@ -0,0 +1,13 @@
+package config
+
+import "github.com/spf13/viper"
+
+type cargoRelease struct {
+	Path    string `yaml:"path" json:"path" mapstructure:"path"`             // where the release notes are written, with the cargo-release tokens replaced (e.g. {{version}})
+	TagName string `yaml:"tag-name" json:"tag-name" mapstructure:"tag-name"` // the release tag, with the cargo-release tokens replaced (the same as the tag-name of cargo-release)
+}
+
+func (cfg cargoRelease) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("cargo-release.path", "release-notes/{{version}}.md")
+	v.SetDefault("cargo-release.tag-name", "v{{version}}")
+}
@@ -2,7 +2,8 @@ package config
 
 // CliOnlyOptions are options that are in the application config in memory, but are only exposed via CLI switches (not from unmarshaling a config file)
 type CliOnlyOptions struct {
-	RepoPath   string
-	ConfigPath string // -c. where the read config is on disk
-	Verbosity  int    // -v or -vv , controlling which UI (ETUI vs logging) and what the log level should be
+	RepoPath     string
+	ConfigPath   string // -c. where the read config is on disk
+	Verbosity    int    // -v or -vv , controlling which UI (ETUI vs logging) and what the log level should be
+	UntilVersion string // the version of the (untagged) release being described, when given by the caller (e.g. the cargo-release hook)
 }
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/commits"
+)
+
+type commitsSummarizer struct {
+	GerritURL      string         `yaml:"gerrit-url" json:"gerrit-url" mapstructure:"gerrit-url"`
+	PhabricatorURL string         `yaml:"phabricator-url" json:"phabricator-url" mapstructure:"phabricator-url"`
+	Changes        []commitChange `yaml:"changes" json:"changes" mapstructure:"changes"`
+}
+
+type commitChange struct {
+	Type       string   `yaml:"name" json:"name" mapstructure:"name"`
+	Title      string   `yaml:"title" json:"title" mapstructure:"title"`
+	SemVerKind string   `yaml:"semver-field" json:"semver-field" mapstructure:"semver-field"`
+	Types      []string `yaml:"types" json:"types" mapstructure:"types"`
+}
+
+func (cfg commitsSummarizer) ToCommitsConfig() commits.Config {
+	typeSet := make(change.TypeSet)
+	for _, c := range cfg.Changes {
+		k := change.ParseSemVerKind(c.SemVerKind)
+		t := change.NewType(c.Type, k)
+		for _, ty := range c.Types {
+			typeSet[ty] = t
+		}
+	}
+	return commits.Config{
+		ChangeTypesByType: typeSet,
+		GerritURL:         cfg.GerritURL,
+		PhabricatorURL:    cfg.PhabricatorURL,
+	}
+}
+
+func (cfg commitsSummarizer) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("commits.gerrit-url", "")
+	v.SetDefault("commits.phabricator-url", "")
+	v.SetDefault("commits.changes", []commitChange{
+		{
+			Type:       "security-fixes",
+			Title:      "Security Fixes",
+			Types:      []string{"security"},
+			SemVerKind: change.SemVerPatch.String(),
+		},
+		{
+			Type:       "added-feature",
+			Title:      "Added Features",
+			Types:      []string{"feat", "feature"},
+			SemVerKind: change.SemVerMinor.String(),
+		},
+		{
+			Type:       "bug-fix",
+			Title:      "Bug Fixes",
+			Types:      []string{"fix"},
+			SemVerKind: change.SemVerPatch.String(),
+		},
+		{
+			Type:       "breaking-feature",
+			Title:      "Breaking Changes",
+			Types:      []string{"breaking"},
+			SemVerKind: change.SemVerMajor.String(),
+		},
+	})
+}
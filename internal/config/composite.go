@@ -0,0 +1,32 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/releasers/composite"
+)
+
+type compositeSummarizer struct {
+	Sources         []string `yaml:"sources" json:"sources" mapstructure:"sources"`
+	TitleSimilarity float64  `yaml:"title-similarity" json:"title-similarity" mapstructure:"title-similarity"` // how alike (0 to 1) the titles of changes from different sources must be to merge them (disabled when 0)
+}
+
+func (cfg compositeSummarizer) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("composite.sources", []string{})
+	v.SetDefault("composite.title-similarity", 0)
+}
+
+func (cfg *compositeSummarizer) parseConfigValues() error {
+	if cfg.TitleSimilarity < 0 || cfg.TitleSimilarity > 1 {
+		return fmt.Errorf("composite title-similarity must be between 0 and 1 (got %v)", cfg.TitleSimilarity)
+	}
+	return nil
+}
+
+func (cfg compositeSummarizer) ToCompositeConfig() composite.Config {
+	return composite.Config{
+		TitleSimilarity: cfg.TitleSimilarity,
+	}
+}
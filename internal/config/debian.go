@@ -0,0 +1,36 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+var debianUrgencies = []string{"low", "medium", "high", "emergency", "critical"}
+
+type debian struct {
+	Package      string `yaml:"package" json:"package" mapstructure:"package"`                // the source package name (defaults to the name of the repo directory)
+	Revision     string `yaml:"revision" json:"revision" mapstructure:"revision"`             // the Debian revision appended to the upstream version (e.g. "1" for "1.2.3-1")
+	Distribution string `yaml:"distribution" json:"distribution" mapstructure:"distribution"` // the distribution the package is uploaded to
+	Urgency      string `yaml:"urgency" json:"urgency" mapstructure:"urgency"`                // the urgency of the upload: low, medium, high, emergency, or critical
+	Maintainer   string `yaml:"maintainer" json:"maintainer" mapstructure:"maintainer"`       // the name and email of the maintainer (defaults to the DEBFULLNAME and DEBEMAIL env vars)
+}
+
+func (cfg debian) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("debian.package", "")
+	v.SetDefault("debian.revision", "1")
+	v.SetDefault("debian.distribution", "unstable")
+	v.SetDefault("debian.urgency", "medium")
+	v.SetDefault("debian.maintainer", "")
+}
+
+func (cfg *debian) parseConfigValues() error {
+	cfg.Urgency = strings.ToLower(cfg.Urgency)
+	for _, u := range debianUrgencies {
+		if cfg.Urgency == u {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid debian urgency %q (allowable: %s)", cfg.Urgency, strings.Join(debianUrgencies, ", "))
+}
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/deprecation"
+)
+
+type deprecations struct {
+	Ledger  string `yaml:"ledger" json:"ledger" mapstructure:"ledger"`    // the path of the deprecations ledger to maintain (disabled when empty)
+	Removal string `yaml:"removal" json:"removal" mapstructure:"removal"` // the version field bumped from the deprecating release to schedule the removal (major, minor, or patch)
+}
+
+func (cfg *deprecations) parseConfigValues() error {
+	bump, err := deprecation.ParseBump(cfg.Removal)
+	if err != nil {
+		return fmt.Errorf("invalid deprecations.removal: %w", err)
+	}
+	cfg.Removal = string(bump)
+	return nil
+}
+
+func (cfg deprecations) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("deprecations.ledger", "")
+	v.SetDefault("deprecations.removal", deprecation.MajorBump)
+}
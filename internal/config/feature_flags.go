@@ -0,0 +1,32 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/featureflag"
+)
+
+type featureFlags struct {
+	Path       string `yaml:"path" json:"path" mapstructure:"path"`                      // the flag definition file within the repo to diff between releases (disabled when empty)
+	Key        string `yaml:"key" json:"key" mapstructure:"key"`                         // the dot-separated path to the flag definitions within the file (the root of the document when empty)
+	NameKey    string `yaml:"name-key" json:"name-key" mapstructure:"name-key"`          // for flags defined as a list: the field of each flag holding its name
+	DefaultKey string `yaml:"default-key" json:"default-key" mapstructure:"default-key"` // the field of each flag holding its default value
+	Title      string `yaml:"title" json:"title" mapstructure:"title"`                   // the title of the section listing the flag changes
+}
+
+func (cfg featureFlags) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("feature-flags.path", "")
+	v.SetDefault("feature-flags.key", "")
+	v.SetDefault("feature-flags.name-key", "name")
+	v.SetDefault("feature-flags.default-key", "default")
+	v.SetDefault("feature-flags.title", "Feature Flags")
+}
+
+func (cfg featureFlags) ToFeatureFlagConfig() featureflag.Config {
+	return featureflag.Config{
+		Path:       cfg.Path,
+		Key:        cfg.Key,
+		NameKey:    cfg.NameKey,
+		DefaultKey: cfg.DefaultKey,
+	}
+}
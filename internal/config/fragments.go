@@ -0,0 +1,89 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/fragments"
+)
+
+type fragmentsSummarizer struct {
+	Dir      string           `yaml:"dir" json:"dir" mapstructure:"dir"`
+	IssueURL string           `yaml:"issue-url" json:"issue-url" mapstructure:"issue-url"`
+	Delete   bool             `yaml:"delete" json:"delete" mapstructure:"delete"`
+	Changes  []fragmentChange `yaml:"changes" json:"changes" mapstructure:"changes"`
+}
+
+type fragmentChange struct {
+	Type       string   `yaml:"name" json:"name" mapstructure:"name"`
+	Title      string   `yaml:"title" json:"title" mapstructure:"title"`
+	SemVerKind string   `yaml:"semver-field" json:"semver-field" mapstructure:"semver-field"`
+	Types      []string `yaml:"types" json:"types" mapstructure:"types"`
+}
+
+func (cfg fragmentsSummarizer) ToFragmentsConfig(repoPath string) fragments.Config {
+	typeSet := make(change.TypeSet)
+	for _, c := range cfg.Changes {
+		k := change.ParseSemVerKind(c.SemVerKind)
+		t := change.NewType(c.Type, k)
+		for _, ty := range c.Types {
+			typeSet[ty] = t
+		}
+	}
+	return fragments.Config{
+		RepoPath:          repoPath,
+		Dir:               cfg.Dir,
+		IssueURLTemplate:  cfg.IssueURL,
+		ChangeTypesByType: typeSet,
+	}
+}
+
+func (cfg fragmentsSummarizer) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("fragments.dir", "changelog.d")
+	v.SetDefault("fragments.issue-url", "")
+	v.SetDefault("fragments.delete", false)
+	v.SetDefault("fragments.changes", []fragmentChange{
+		{
+			Type:       "security-fixes",
+			Title:      "Security Fixes",
+			Types:      []string{"security"},
+			SemVerKind: change.SemVerPatch.String(),
+		},
+		{
+			Type:       "added-feature",
+			Title:      "Added Features",
+			Types:      []string{"feature"},
+			SemVerKind: change.SemVerMinor.String(),
+		},
+		{
+			Type:       "bug-fix",
+			Title:      "Bug Fixes",
+			Types:      []string{"fix", "bugfix"},
+			SemVerKind: change.SemVerPatch.String(),
+		},
+		{
+			Type:       "breaking-feature",
+			Title:      "Breaking Changes",
+			Types:      []string{"breaking"},
+			SemVerKind: change.SemVerMajor.String(),
+		},
+		{
+			Type:       "removed-feature",
+			Title:      "Removed Features",
+			Types:      []string{"removal"},
+			SemVerKind: change.SemVerMajor.String(),
+		},
+		{
+			Type:       "deprecated-feature",
+			Title:      "Deprecated Features",
+			Types:      []string{"deprecation"},
+			SemVerKind: change.SemVerMinor.String(),
+		},
+		{
+			Type:       change.UnknownType.Name,
+			Title:      "Additional Changes",
+			Types:      []string{"misc", "doc"},
+			SemVerKind: change.UnknownType.Kind.String(),
+		},
+	})
+}
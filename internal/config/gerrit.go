@@ -0,0 +1,75 @@
+package config
+
+import (
+	"os"
+
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/gerrit"
+)
+
+type gerritSummarizer struct {
+	Host    string         `yaml:"host" json:"host" mapstructure:"host"`
+	Project string         `yaml:"project" json:"project" mapstructure:"project"`
+	Branch  string         `yaml:"branch" json:"branch" mapstructure:"branch"`
+	Changes []gerritChange `yaml:"changes" json:"changes" mapstructure:"changes"`
+}
+
+type gerritChange struct {
+	Type       string   `yaml:"name" json:"name" mapstructure:"name"`
+	Title      string   `yaml:"title" json:"title" mapstructure:"title"`
+	SemVerKind string   `yaml:"semver-field" json:"semver-field" mapstructure:"semver-field"`
+	Hashtags   []string `yaml:"hashtags" json:"hashtags" mapstructure:"hashtags"`
+	Topics     []string `yaml:"topics" json:"topics" mapstructure:"topics"`
+}
+
+func (cfg gerritSummarizer) ToGerritConfig() gerrit.Config {
+	byHashtag := make(change.TypeSet)
+	byTopic := make(change.TypeSet)
+	for _, c := range cfg.Changes {
+		k := change.ParseSemVerKind(c.SemVerKind)
+		t := change.NewType(c.Type, k)
+		for _, h := range c.Hashtags {
+			byHashtag[h] = t
+		}
+		for _, p := range c.Topics {
+			byTopic[p] = t
+		}
+	}
+	return gerrit.Config{
+		Host:                 cfg.Host,
+		Project:              cfg.Project,
+		Branch:               cfg.Branch,
+		ChangeTypesByHashtag: byHashtag,
+		ChangeTypesByTopic:   byTopic,
+		User:                 os.Getenv("GERRIT_USER"),
+		Password:             os.Getenv("GERRIT_PASSWORD"),
+	}
+}
+
+func (cfg gerritSummarizer) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("gerrit.host", "")
+	v.SetDefault("gerrit.project", "")
+	v.SetDefault("gerrit.branch", "")
+	v.SetDefault("gerrit.changes", []gerritChange{
+		{
+			Type:       "security-fixes",
+			Title:      "Security Fixes",
+			Hashtags:   []string{"security"},
+			SemVerKind: change.SemVerPatch.String(),
+		},
+		{
+			Type:       "added-feature",
+			Title:      "Added Features",
+			Hashtags:   []string{"feature", "enhancement"},
+			SemVerKind: change.SemVerMinor.String(),
+		},
+		{
+			Type:       "bug-fix",
+			Title:      "Bug Fixes",
+			Hashtags:   []string{"bug", "bugfix"},
+			SemVerKind: change.SemVerPatch.String(),
+		},
+	})
+}
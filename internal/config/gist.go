@@ -0,0 +1,17 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+)
+
+type gistPublisher struct {
+	Public      bool   `yaml:"public" json:"public" mapstructure:"public"`
+	Filename    string `yaml:"filename" json:"filename" mapstructure:"filename"`
+	Description string `yaml:"description" json:"description" mapstructure:"description"`
+}
+
+func (cfg gistPublisher) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("gist.public", false)
+	v.SetDefault("gist.filename", "")
+	v.SetDefault("gist.description", "")
+}
@@ -1,25 +1,99 @@
 package config
 
 import (
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/adrg/xdg"
 	"github.com/spf13/viper"
 
 	"github.com/anchore/chronicle/chronicle/release/change"
 	"github.com/anchore/chronicle/chronicle/release/releasers/github"
+	"github.com/anchore/chronicle/internal"
 )
 
 type githubSummarizer struct {
-	Host                            string         `yaml:"host" json:"host" mapstructure:"host"`
-	ExcludeLabels                   []string       `yaml:"exclude-labels" json:"exclude-labels" mapstructure:"exclude-labels"`
-	IncludeIssuePRAuthors           bool           `yaml:"include-issue-pr-authors" json:"include-issue-pr-authors" mapstructure:"include-issue-pr-authors"`
-	IncludeIssuePRs                 bool           `yaml:"include-issue-prs" json:"include-issue-prs" mapstructure:"include-issue-prs"`
-	IncludeIssuesClosedAsNotPlanned bool           `yaml:"include-issues-not-planned" json:"include-issues-not-planned" mapstructure:"include-issues-not-planned"`
-	IncludePRs                      bool           `yaml:"include-prs" json:"include-prs" mapstructure:"include-prs"`
-	IncludeIssues                   bool           `yaml:"include-issues" json:"include-issues" mapstructure:"include-issues"`
-	IncludeUnlabeledIssues          bool           `yaml:"include-unlabeled-issues" json:"include-unlabeled-issues" mapstructure:"include-unlabeled-issues"`
-	IncludeUnlabeledPRs             bool           `yaml:"include-unlabeled-prs" json:"include-unlabeled-prs" mapstructure:"include-unlabeled-prs"`
-	IssuesRequireLinkedPR           bool           `yaml:"issues-require-linked-prs" json:"issues-require-linked-prs" mapstructure:"issues-require-linked-prs"`
-	ConsiderPRMergeCommits          bool           `yaml:"consider-pr-merge-commits" json:"consider-pr-merge-commits" mapstructure:"consider-pr-merge-commits"`
-	Changes                         []githubChange `yaml:"changes" json:"changes" mapstructure:"changes"`
+	Host                            string                  `yaml:"host" json:"host" mapstructure:"host"`
+	APIURL                          string                  `yaml:"api-url" json:"api-url" mapstructure:"api-url"`
+	ExcludeLabels                   []string                `yaml:"exclude-labels" json:"exclude-labels" mapstructure:"exclude-labels"`
+	ExcludeAuthors                  []string                `yaml:"exclude-authors" json:"exclude-authors" mapstructure:"exclude-authors"`
+	IncludeAuthors                  []string                `yaml:"include-authors" json:"include-authors" mapstructure:"include-authors"`
+	TargetBranch                    string                  `yaml:"target-branch" json:"target-branch" mapstructure:"target-branch"` // only keep the PRs merged into this branch (e.g. "main" or "release/*")
+	Paths                           []string                `yaml:"paths" json:"paths" mapstructure:"paths"`                         // only keep the PRs that changed files matching any of these globs (e.g. "services/api/**")
+	IncludeIssuePRAuthors           bool                    `yaml:"include-issue-pr-authors" json:"include-issue-pr-authors" mapstructure:"include-issue-pr-authors"`
+	IncludePRAuthors                bool                    `yaml:"include-pr-authors" json:"include-pr-authors" mapstructure:"include-pr-authors"`
+	IncludeIssueAssignees           bool                    `yaml:"include-issue-assignees" json:"include-issue-assignees" mapstructure:"include-issue-assignees"`
+	IncludeIssuePRs                 bool                    `yaml:"include-issue-prs" json:"include-issue-prs" mapstructure:"include-issue-prs"`
+	IncludeIssuesClosedAsNotPlanned bool                    `yaml:"include-issues-not-planned" json:"include-issues-not-planned" mapstructure:"include-issues-not-planned"`
+	IncludePRs                      bool                    `yaml:"include-prs" json:"include-prs" mapstructure:"include-prs"`
+	IncludeIssues                   bool                    `yaml:"include-issues" json:"include-issues" mapstructure:"include-issues"`
+	IncludeUnlabeledIssues          bool                    `yaml:"include-unlabeled-issues" json:"include-unlabeled-issues" mapstructure:"include-unlabeled-issues"`
+	IncludeUnlabeledPRs             bool                    `yaml:"include-unlabeled-prs" json:"include-unlabeled-prs" mapstructure:"include-unlabeled-prs"`
+	IssuesRequireLinkedPR           bool                    `yaml:"issues-require-linked-prs" json:"issues-require-linked-prs" mapstructure:"issues-require-linked-prs"`
+	ConsiderPRMergeCommits          bool                    `yaml:"consider-pr-merge-commits" json:"consider-pr-merge-commits" mapstructure:"consider-pr-merge-commits"`
+	AssociateCommits                bool                    `yaml:"associate-commits" json:"associate-commits" mapstructure:"associate-commits"`
+	CancelReverts                   bool                    `yaml:"cancel-reverts" json:"cancel-reverts" mapstructure:"cancel-reverts"`
+	LinkIssuesByTimeline            bool                    `yaml:"link-issues-by-timeline" json:"link-issues-by-timeline" mapstructure:"link-issues-by-timeline"`
+	ClusterIssuePRs                 bool                    `yaml:"cluster-issue-prs" json:"cluster-issue-prs" mapstructure:"cluster-issue-prs"`
+	RollupDepth                     int                     `yaml:"rollup-depth" json:"rollup-depth" mapstructure:"rollup-depth"`
+	IncrementalFetch                bool                    `yaml:"incremental-fetch" json:"incremental-fetch" mapstructure:"incremental-fetch"`
+	ReleaseNoteBlock                string                  `yaml:"release-note-block" json:"release-note-block" mapstructure:"release-note-block"`
+	ReleaseNoteHeading              string                  `yaml:"release-note-heading" json:"release-note-heading" mapstructure:"release-note-heading"`
+	PageSize                        int                     `yaml:"page-size" json:"page-size" mapstructure:"page-size"`
+	MaxPages                        int                     `yaml:"max-pages" json:"max-pages" mapstructure:"max-pages"`
+	MaxRateLimitWait                time.Duration           `yaml:"max-rate-limit-wait" json:"max-rate-limit-wait" mapstructure:"max-rate-limit-wait"`
+	Token                           string                  `yaml:"-" json:"-" mapstructure:"token"` // never shown when displaying the config
+	TokenFile                       string                  `yaml:"token-file" json:"token-file" mapstructure:"token-file"`
+	PreferTitleFrom                 string                  `yaml:"prefer-title-from" json:"prefer-title-from" mapstructure:"prefer-title-from"`
+	CacheDir                        string                  `yaml:"cache-dir" json:"cache-dir" mapstructure:"cache-dir"`
+	CacheTTL                        time.Duration           `yaml:"cache-ttl" json:"cache-ttl" mapstructure:"cache-ttl"`
+	Milestone                       string                  `yaml:"milestone" json:"milestone" mapstructure:"milestone"`
+	MilestoneMode                   string                  `yaml:"milestone-mode" json:"milestone-mode" mapstructure:"milestone-mode"`
+	ChangeWindow                    string                  `yaml:"change-window" json:"change-window" mapstructure:"change-window"`
+	DraftReleaseAsUntil             bool                    `yaml:"draft-release-as-until" json:"draft-release-as-until" mapstructure:"draft-release-as-until"`
+	App                             githubApp               `yaml:"app" json:"app" mapstructure:"app"`
+	Discussions                     githubDiscussions       `yaml:"discussions" json:"discussions" mapstructure:"discussions"`
+	Advisories                      githubAdvisories        `yaml:"security-advisories" json:"security-advisories" mapstructure:"security-advisories"`
+	DependencyUpdates               githubDependencyUpdates `yaml:"dependency-updates" json:"dependency-updates" mapstructure:"dependency-updates"`
+	Changes                         []githubChange          `yaml:"changes" json:"changes" mapstructure:"changes"`
+}
+
+type githubApp struct {
+	ID             int64  `yaml:"id" json:"id" mapstructure:"id"`
+	InstallationID int64  `yaml:"installation-id" json:"installation-id" mapstructure:"installation-id"`
+	PrivateKey     string `yaml:"-" json:"-" mapstructure:"private-key"` // never shown when displaying the config
+	PrivateKeyFile string `yaml:"private-key-file" json:"private-key-file" mapstructure:"private-key-file"`
+}
+
+type githubDiscussions struct {
+	Categories []string `yaml:"categories" json:"categories" mapstructure:"categories"`
+	Pinned     bool     `yaml:"pinned" json:"pinned" mapstructure:"pinned"`
+	Type       string   `yaml:"name" json:"name" mapstructure:"name"`
+	Title      string   `yaml:"title" json:"title" mapstructure:"title"`
+}
+
+// changeType is the change type (changelog section) that the selected discussions are listed under.
+func (d githubDiscussions) changeType() change.Type {
+	return change.NewType(d.Type, change.SemVerUnknown)
+}
+
+type githubAdvisories struct {
+	Include    bool   `yaml:"include" json:"include" mapstructure:"include"`
+	Type       string `yaml:"name" json:"name" mapstructure:"name"`
+	Title      string `yaml:"title" json:"title" mapstructure:"title"`
+	SemVerKind string `yaml:"semver-field" json:"semver-field" mapstructure:"semver-field"`
+}
+
+// changeType is the change type (changelog section) that the selected advisories are listed under.
+func (a githubAdvisories) changeType() change.Type {
+	return change.NewType(a.Type, change.ParseSemVerKind(a.SemVerKind))
+}
+
+type githubDependencyUpdates struct {
+	Group   bool     `yaml:"group" json:"group" mapstructure:"group"`
+	Authors []string `yaml:"authors" json:"authors" mapstructure:"authors"`
+	Title   string   `yaml:"title" json:"title" mapstructure:"title"`
 }
 
 type githubChange struct {
@@ -27,6 +101,28 @@ type githubChange struct {
 	Title      string   `yaml:"title" json:"title" mapstructure:"title"`
 	SemVerKind string   `yaml:"semver-field" json:"semver-field" mapstructure:"semver-field"`
 	Labels     []string `yaml:"labels" json:"labels" mapstructure:"labels"`
+	// aliases for "name" and "semver-field" (folded into the fields above when the config is parsed)
+	TypeAlias       string `yaml:"-" json:"-" mapstructure:"type"`
+	SemVerBumpAlias string `yaml:"-" json:"-" mapstructure:"semver-bump"`
+}
+
+// normalize folds any aliased fields into the canonical fields and ensures the entry is usable.
+func (c *githubChange) normalize() error {
+	if c.Type == "" {
+		c.Type = c.TypeAlias
+	}
+	if c.SemVerKind == "" {
+		c.SemVerKind = c.SemVerBumpAlias
+	}
+	c.TypeAlias, c.SemVerBumpAlias = "", ""
+
+	if c.Type == "" {
+		return fmt.Errorf("change entry %q has no name", c.Title)
+	}
+	if c.SemVerKind != "" && change.ParseSemVerKind(c.SemVerKind) == change.SemVerUnknown {
+		return fmt.Errorf("change entry %q has an invalid semver field %q (must be one of: major, minor, patch)", c.Type, c.SemVerKind)
+	}
+	return nil
 }
 
 func (cfg githubSummarizer) ToGithubConfig() github.Config {
@@ -38,9 +134,16 @@ func (cfg githubSummarizer) ToGithubConfig() github.Config {
 			typeSet[l] = t
 		}
 	}
+	apiURL := cfg.APIURL
+	if apiURL == "" {
+		apiURL = github.DefaultAPIURL(cfg.Host)
+	}
 	return github.Config{
 		Host:                            cfg.Host,
+		APIURL:                          apiURL,
 		IncludeIssuePRAuthors:           cfg.IncludeIssuePRAuthors,
+		OmitPRAuthors:                   !cfg.IncludePRAuthors,
+		IncludeIssueAssignees:           cfg.IncludeIssueAssignees,
 		IncludeIssuePRs:                 cfg.IncludeIssuePRs,
 		IncludeIssues:                   cfg.IncludeIssues,
 		IncludeIssuesClosedAsNotPlanned: cfg.IncludeIssuesClosedAsNotPlanned,
@@ -48,24 +151,160 @@ func (cfg githubSummarizer) ToGithubConfig() github.Config {
 		IncludeUnlabeledIssues:          cfg.IncludeUnlabeledIssues,
 		IncludeUnlabeledPRs:             cfg.IncludeUnlabeledPRs,
 		ExcludeLabels:                   cfg.ExcludeLabels,
+		ExcludeAuthors:                  cfg.ExcludeAuthors,
+		IncludeAuthors:                  cfg.IncludeAuthors,
+		Paths:                           cfg.Paths,
+		TargetBranch:                    cfg.TargetBranch,
 		IssuesRequireLinkedPR:           cfg.IssuesRequireLinkedPR,
 		ConsiderPRMergeCommits:          cfg.ConsiderPRMergeCommits,
-		ChangeTypesByLabel:              typeSet,
+		AssociateCommits:                cfg.AssociateCommits,
+		CancelReverts:                   cfg.CancelReverts,
+		LinkIssuesByTimeline:            cfg.LinkIssuesByTimeline,
+		ClusterIssuePRs:                 cfg.ClusterIssuePRs,
+		RollupDepth:                     cfg.RollupDepth,
+		IncrementalFetch:                cfg.IncrementalFetch,
+		ReleaseNoteBlock:                cfg.ReleaseNoteBlock,
+		ReleaseNoteHeading:              cfg.ReleaseNoteHeading,
+		PageSize:                        cfg.PageSize,
+		MaxPages:                        cfg.MaxPages,
+		MaxRateLimitWait:                cfg.MaxRateLimitWait,
+		Token:                           cfg.Token,
+		TokenFile:                       cfg.TokenFile,
+		PreferTitleFrom:                 cfg.PreferTitleFrom,
+		CacheDir:                        cfg.CacheDir,
+		CacheTTL:                        cfg.CacheTTL,
+		Milestone:                       cfg.Milestone,
+		MilestoneMode:                   cfg.MilestoneMode,
+		ChangeWindow:                    cfg.ChangeWindow,
+		Discussions: github.Discussions{
+			Categories: cfg.Discussions.Categories,
+			Pinned:     cfg.Discussions.Pinned,
+			ChangeType: cfg.Discussions.changeType(),
+		},
+		DependencyUpdates: github.DependencyUpdates{
+			Group:   cfg.DependencyUpdates.Group,
+			Authors: cfg.DependencyUpdates.Authors,
+			Title:   cfg.DependencyUpdates.Title,
+		},
+		Advisories: github.Advisories{
+			Include:    cfg.Advisories.Include,
+			ChangeType: cfg.Advisories.changeType(),
+		},
+		App: github.AppAuth{
+			ID:             cfg.App.ID,
+			InstallationID: cfg.App.InstallationID,
+			PrivateKey:     cfg.App.PrivateKey,
+			PrivateKeyFile: cfg.App.PrivateKeyFile,
+		},
+		ChangeTypesByLabel: typeSet,
+	}
+}
+
+func (cfg *githubSummarizer) parseConfigValues() error {
+	preference, err := github.ParseTitlePreference(cfg.PreferTitleFrom)
+	if err != nil {
+		return fmt.Errorf("invalid github.prefer-title-from: %w", err)
+	}
+	cfg.PreferTitleFrom = preference
+
+	for _, pattern := range append(append(append([]string{}, cfg.ExcludeAuthors...), cfg.IncludeAuthors...), cfg.DependencyUpdates.Authors...) {
+		if err := github.ValidateAuthorPattern(pattern); err != nil {
+			return fmt.Errorf("invalid github author pattern %q: %w", pattern, err)
+		}
+	}
+
+	if err := github.ValidateBranchPattern(cfg.TargetBranch); err != nil {
+		return fmt.Errorf("invalid github.target-branch %q: %w", cfg.TargetBranch, err)
+	}
+
+	for _, pattern := range cfg.Paths {
+		if err := github.ValidatePathPattern(pattern); err != nil {
+			return fmt.Errorf("invalid github path pattern %q: %w", pattern, err)
+		}
+	}
+
+	mode, err := github.ParseMilestoneMode(cfg.MilestoneMode)
+	if err != nil {
+		return fmt.Errorf("invalid github.milestone-mode: %w", err)
+	}
+	cfg.MilestoneMode = mode
+
+	window, err := github.ParseChangeWindow(cfg.ChangeWindow)
+	if err != nil {
+		return fmt.Errorf("invalid github.change-window: %w", err)
+	}
+	cfg.ChangeWindow = window
+
+	if kind := cfg.Advisories.SemVerKind; kind != "" && change.ParseSemVerKind(kind) == change.SemVerUnknown {
+		return fmt.Errorf("invalid github.security-advisories.semver-field %q (must be one of: major, minor, patch)", kind)
+	}
+
+	for i := range cfg.Changes {
+		if err := cfg.Changes[i].normalize(); err != nil {
+			return fmt.Errorf("invalid github.changes: %w", err)
+		}
+	}
+
+	if cfg.CacheDir == "" {
+		cfg.CacheDir = path.Join(xdg.CacheHome, internal.ApplicationName, "github")
 	}
+	return nil
 }
 
 func (cfg githubSummarizer) loadDefaultValues(v *viper.Viper) {
 	v.SetDefault("github.host", "github.com")
+	v.SetDefault("github.api-url", "")
 	v.SetDefault("github.issues-require-linked-prs", false)
 	v.SetDefault("github.consider-pr-merge-commits", true)
+	v.SetDefault("github.associate-commits", false)
+	v.SetDefault("github.cancel-reverts", true)
+	v.SetDefault("github.link-issues-by-timeline", false)
+	v.SetDefault("github.cluster-issue-prs", false)
+	v.SetDefault("github.rollup-depth", 0)
+	v.SetDefault("github.incremental-fetch", true)
+	v.SetDefault("github.release-note-block", "release-note")
+	v.SetDefault("github.release-note-heading", "")
+	v.SetDefault("github.page-size", 100)
+	v.SetDefault("github.max-pages", 0)
+	v.SetDefault("github.max-rate-limit-wait", 10*time.Minute)
+	v.SetDefault("github.token", "")
+	v.SetDefault("github.token-file", "")
+	v.SetDefault("github.prefer-title-from", github.PreferIssueTitle)
+	v.SetDefault("github.cache-dir", "")
+	v.SetDefault("github.cache-ttl", time.Duration(0))
+	v.SetDefault("github.milestone", "")
+	v.SetDefault("github.milestone-mode", github.MilestoneReplacesRange)
+	v.SetDefault("github.change-window", github.TimestampWindow)
+	v.SetDefault("github.draft-release-as-until", false)
+	v.SetDefault("github.discussions.categories", []string{})
+	v.SetDefault("github.discussions.pinned", false)
+	v.SetDefault("github.discussions.name", "announcements")
+	v.SetDefault("github.discussions.title", "Announcements")
+	v.SetDefault("github.security-advisories.include", false)
+	v.SetDefault("github.security-advisories.name", "security-advisories")
+	v.SetDefault("github.security-advisories.title", "Security")
+	v.SetDefault("github.security-advisories.semver-field", change.SemVerPatch.String())
+	v.SetDefault("github.dependency-updates.group", false)
+	v.SetDefault("github.dependency-updates.authors", []string{"dependabot[bot]", "renovate[bot]"})
+	v.SetDefault("github.dependency-updates.title", "Dependency updates")
+	v.SetDefault("github.app.id", 0)
+	v.SetDefault("github.app.installation-id", 0)
+	v.SetDefault("github.app.private-key", "")
+	v.SetDefault("github.app.private-key-file", "")
 	v.SetDefault("github.include-prs", true)
 	v.SetDefault("github.include-issue-pr-authors", true)
+	v.SetDefault("github.include-pr-authors", true)
+	v.SetDefault("github.include-issue-assignees", false)
 	v.SetDefault("github.include-issue-prs", true)
 	v.SetDefault("github.include-issues", true)
 	v.SetDefault("github.include-issues-not-planned", false)
 	v.SetDefault("github.include-unlabeled-issues", true)
 	v.SetDefault("github.include-unlabeled-prs", true)
-	v.SetDefault("github.exclude-labels", []string{"duplicate", "question", "invalid", "wontfix", "wont-fix", "release-ignore", "changelog-ignore", "ignore"})
+	v.SetDefault("github.exclude-labels", []string{"duplicate", "question", "invalid", "wontfix", "wont-fix", "release-ignore", "changelog-ignore", "changelog/ignore", "ignore"})
+	v.SetDefault("github.exclude-authors", []string{})
+	v.SetDefault("github.include-authors", []string{})
+	v.SetDefault("github.paths", []string{})
+	v.SetDefault("github.target-branch", "")
 	v.SetDefault("github.changes", []githubChange{
 		{
 			Type:       "security-fixes",
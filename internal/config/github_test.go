@@ -0,0 +1,62 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_githubChange_normalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  githubChange
+		want    githubChange
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:   "canonical fields",
+			change: githubChange{Type: "bug", SemVerKind: "patch", Labels: []string{"bug"}},
+			want:   githubChange{Type: "bug", SemVerKind: "patch", Labels: []string{"bug"}},
+		},
+		{
+			name:   "aliased fields",
+			change: githubChange{TypeAlias: "bug", SemVerBumpAlias: "patch", Labels: []string{"bug"}},
+			want:   githubChange{Type: "bug", SemVerKind: "patch", Labels: []string{"bug"}},
+		},
+		{
+			name:   "canonical fields take precedence over aliases",
+			change: githubChange{Type: "bug", TypeAlias: "fix", SemVerKind: "minor", SemVerBumpAlias: "patch"},
+			want:   githubChange{Type: "bug", SemVerKind: "minor"},
+		},
+		{
+			name:   "no semver field",
+			change: githubChange{Type: "docs"},
+			want:   githubChange{Type: "docs"},
+		},
+		{
+			name:    "no name",
+			change:  githubChange{Title: "Bug Fixes", SemVerKind: "patch"},
+			wantErr: require.Error,
+		},
+		{
+			name:    "invalid semver field",
+			change:  githubChange{TypeAlias: "bug", SemVerBumpAlias: "micro"},
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			c := tt.change
+			err := c.normalize()
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, c)
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package config
+
+import "github.com/spf13/viper"
+
+type helm struct {
+	Charts []string `yaml:"charts" json:"charts" mapstructure:"charts"` // the Chart.yaml files to set the artifacthub.io/changes annotation within (relative to the repo)
+}
+
+func (cfg helm) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("helm.charts", []string{})
+}
@@ -0,0 +1,11 @@
+package config
+
+import "github.com/spf13/viper"
+
+type html struct {
+	EmbedCSS bool `yaml:"embed-css" json:"embed-css" mapstructure:"embed-css"` // include a minimal stylesheet (for when the changelog is not styled by the page it is included in)
+}
+
+func (cfg html) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("html.embed-css", false)
+}
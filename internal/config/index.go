@@ -0,0 +1,17 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+)
+
+type releaseIndex struct {
+	Path  string `yaml:"path" json:"path" mapstructure:"path"`
+	Title string `yaml:"title" json:"title" mapstructure:"title"`
+	Link  string `yaml:"link" json:"link" mapstructure:"link"`
+}
+
+func (cfg releaseIndex) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("index.path", "")
+	v.SetDefault("index.title", "Releases")
+	v.SetDefault("index.link", "")
+}
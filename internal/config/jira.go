@@ -0,0 +1,69 @@
+package config
+
+import (
+	"os"
+
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/jira"
+)
+
+type jiraSummarizer struct {
+	Host               string       `yaml:"host" json:"host" mapstructure:"host"`
+	Project            string       `yaml:"project" json:"project" mapstructure:"project"`
+	ExcludeResolutions []string     `yaml:"exclude-resolutions" json:"exclude-resolutions" mapstructure:"exclude-resolutions"`
+	Changes            []jiraChange `yaml:"changes" json:"changes" mapstructure:"changes"`
+}
+
+type jiraChange struct {
+	Type       string   `yaml:"name" json:"name" mapstructure:"name"`
+	Title      string   `yaml:"title" json:"title" mapstructure:"title"`
+	SemVerKind string   `yaml:"semver-field" json:"semver-field" mapstructure:"semver-field"`
+	IssueTypes []string `yaml:"issue-types" json:"issue-types" mapstructure:"issue-types"`
+}
+
+func (cfg jiraSummarizer) ToJiraConfig() jira.Config {
+	typeSet := make(change.TypeSet)
+	for _, c := range cfg.Changes {
+		k := change.ParseSemVerKind(c.SemVerKind)
+		t := change.NewType(c.Type, k)
+		for _, it := range c.IssueTypes {
+			typeSet[it] = t
+		}
+	}
+	return jira.Config{
+		Host:                   cfg.Host,
+		Project:                cfg.Project,
+		ExcludeResolutions:     cfg.ExcludeResolutions,
+		ChangeTypesByIssueType: typeSet,
+		User:                   os.Getenv("JIRA_USER"),
+		Token:                  os.Getenv("JIRA_TOKEN"),
+	}
+}
+
+func (cfg jiraSummarizer) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("jira.host", "")
+	v.SetDefault("jira.project", "")
+	v.SetDefault("jira.exclude-resolutions", []string{"Won't Do", "Won't Fix", "Duplicate", "Cannot Reproduce", "Incomplete"})
+	v.SetDefault("jira.changes", []jiraChange{
+		{
+			Type:       "security-fixes",
+			Title:      "Security Fixes",
+			IssueTypes: []string{"Security", "Vulnerability"},
+			SemVerKind: change.SemVerPatch.String(),
+		},
+		{
+			Type:       "added-feature",
+			Title:      "Added Features",
+			IssueTypes: []string{"Story", "New Feature", "Improvement"},
+			SemVerKind: change.SemVerMinor.String(),
+		},
+		{
+			Type:       "bug-fix",
+			Title:      "Bug Fixes",
+			IssueTypes: []string{"Bug"},
+			SemVerKind: change.SemVerPatch.String(),
+		},
+	})
+}
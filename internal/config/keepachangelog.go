@@ -0,0 +1,86 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/keepachangelog"
+)
+
+type keepAChangelogSummarizer struct {
+	Path    string                 `yaml:"path" json:"path" mapstructure:"path"`
+	Changes []keepAChangelogChange `yaml:"changes" json:"changes" mapstructure:"changes"`
+}
+
+type keepAChangelogChange struct {
+	Type       string   `yaml:"name" json:"name" mapstructure:"name"`
+	Title      string   `yaml:"title" json:"title" mapstructure:"title"`
+	SemVerKind string   `yaml:"semver-field" json:"semver-field" mapstructure:"semver-field"`
+	Sections   []string `yaml:"sections" json:"sections" mapstructure:"sections"`
+}
+
+func (cfg keepAChangelogSummarizer) ToKeepAChangelogConfig(repoPath string) keepachangelog.Config {
+	typeSet := make(change.TypeSet)
+	for _, c := range cfg.Changes {
+		k := change.ParseSemVerKind(c.SemVerKind)
+		t := change.NewType(c.Type, k)
+		for _, section := range c.Sections {
+			typeSet[strings.ToLower(section)] = t
+		}
+	}
+	return keepachangelog.Config{
+		RepoPath:             repoPath,
+		Path:                 cfg.Path,
+		ChangeTypesBySection: typeSet,
+	}
+}
+
+func (cfg keepAChangelogSummarizer) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("keepachangelog.path", "CHANGELOG.md")
+	v.SetDefault("keepachangelog.changes", []keepAChangelogChange{
+		{
+			Type:       "security-fixes",
+			Title:      "Security Fixes",
+			Sections:   []string{"Security", "Security Fixes"},
+			SemVerKind: change.SemVerPatch.String(),
+		},
+		{
+			Type:       "added-feature",
+			Title:      "Added Features",
+			Sections:   []string{"Added", "Added Features"},
+			SemVerKind: change.SemVerMinor.String(),
+		},
+		{
+			Type:       "bug-fix",
+			Title:      "Bug Fixes",
+			Sections:   []string{"Fixed", "Bug Fixes"},
+			SemVerKind: change.SemVerPatch.String(),
+		},
+		{
+			Type:       "breaking-feature",
+			Title:      "Breaking Changes",
+			Sections:   []string{"Breaking", "Breaking Changes"},
+			SemVerKind: change.SemVerMajor.String(),
+		},
+		{
+			Type:       "removed-feature",
+			Title:      "Removed Features",
+			Sections:   []string{"Removed", "Removed Features"},
+			SemVerKind: change.SemVerMajor.String(),
+		},
+		{
+			Type:       "deprecated-feature",
+			Title:      "Deprecated Features",
+			Sections:   []string{"Deprecated", "Deprecated Features"},
+			SemVerKind: change.SemVerMinor.String(),
+		},
+		{
+			Type:       change.UnknownType.Name,
+			Title:      "Additional Changes",
+			Sections:   []string{"Changed", "Additional Changes"},
+			SemVerKind: change.UnknownType.Kind.String(),
+		},
+	})
+}
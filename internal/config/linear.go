@@ -0,0 +1,71 @@
+package config
+
+import (
+	"os"
+
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/linear"
+)
+
+type linearSummarizer struct {
+	APIURL  string         `yaml:"api-url" json:"api-url" mapstructure:"api-url"`
+	Teams   []string       `yaml:"teams" json:"teams" mapstructure:"teams"`
+	Changes []linearChange `yaml:"changes" json:"changes" mapstructure:"changes"`
+}
+
+type linearChange struct {
+	Type       string   `yaml:"name" json:"name" mapstructure:"name"`
+	Title      string   `yaml:"title" json:"title" mapstructure:"title"`
+	SemVerKind string   `yaml:"semver-field" json:"semver-field" mapstructure:"semver-field"`
+	Labels     []string `yaml:"labels" json:"labels" mapstructure:"labels"`
+	Projects   []string `yaml:"projects" json:"projects" mapstructure:"projects"`
+}
+
+func (cfg linearSummarizer) ToLinearConfig() linear.Config {
+	byLabel := make(change.TypeSet)
+	byProject := make(change.TypeSet)
+	for _, c := range cfg.Changes {
+		k := change.ParseSemVerKind(c.SemVerKind)
+		t := change.NewType(c.Type, k)
+		for _, l := range c.Labels {
+			byLabel[l] = t
+		}
+		for _, p := range c.Projects {
+			byProject[p] = t
+		}
+	}
+	return linear.Config{
+		APIURL:               cfg.APIURL,
+		Teams:                cfg.Teams,
+		ChangeTypesByLabel:   byLabel,
+		ChangeTypesByProject: byProject,
+		APIKey:               os.Getenv("LINEAR_API_KEY"),
+	}
+}
+
+func (cfg linearSummarizer) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("linear.api-url", linear.DefaultAPIURL)
+	v.SetDefault("linear.teams", []string{})
+	v.SetDefault("linear.changes", []linearChange{
+		{
+			Type:       "security-fixes",
+			Title:      "Security Fixes",
+			Labels:     []string{"Security"},
+			SemVerKind: change.SemVerPatch.String(),
+		},
+		{
+			Type:       "added-feature",
+			Title:      "Added Features",
+			Labels:     []string{"Feature", "Improvement"},
+			SemVerKind: change.SemVerMinor.String(),
+		},
+		{
+			Type:       "bug-fix",
+			Title:      "Bug Fixes",
+			Labels:     []string{"Bug"},
+			SemVerKind: change.SemVerPatch.String(),
+		},
+	})
+}
@@ -0,0 +1,15 @@
+package config
+
+import "github.com/spf13/viper"
+
+type markdown struct {
+	Footer  bool `yaml:"footer" json:"footer" mapstructure:"footer"`    // end with the contributors and the full changelog link (as github generated release notes do)
+	Details bool `yaml:"details" json:"details" mapstructure:"details"` // list the changes grouped beneath an entry within a collapsed <details> block
+	Wrap    int  `yaml:"wrap" json:"wrap" mapstructure:"wrap"`          // hard-wrap the entries at this column (disabled when 0)
+}
+
+func (cfg markdown) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("markdown.footer", false)
+	v.SetDefault("markdown.details", false)
+	v.SetDefault("markdown.wrap", 0)
+}
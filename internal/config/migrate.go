@@ -0,0 +1,239 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// keyRename is a config key that was renamed between chronicle versions.
+type keyRename struct {
+	path string // the dot-separated path of the section holding the key, where a "[]" suffix is every entry of a list (e.g. "github.changes[]")
+	from string
+	to   string
+}
+
+// keyRenames are all config keys that have been renamed between released versions of chronicle, oldest first. Keys
+// that are documented aliases (such as "type" for the "name" of a github change) are valid config and never listed.
+var keyRenames []keyRename
+
+// Migration is the result of upgrading a config file to the current schema.
+type Migration struct {
+	Contents []byte            // the upgraded config (the formatting and comments of the original are kept)
+	Changes  []MigrationChange // the changes made to the config, in the order they appear
+	Notes    []string          // problems found that could not be migrated automatically (e.g. unknown keys)
+}
+
+// MigrationChange is a single line of the config that was changed by the migration.
+type MigrationChange struct {
+	Line    int    // the line number within the config (starting at 1)
+	Before  string // the original line
+	After   string // the migrated line
+	Message string // why the line was changed
+}
+
+// Migrate upgrades the given config file contents written for an older version of chronicle to the current schema.
+// Keys are renamed in place, so the formatting and comments of the config are kept.
+func Migrate(contents []byte) (*Migration, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(contents, &root); err != nil {
+		return nil, fmt.Errorf("unable to parse config: %w", err)
+	}
+
+	m := &Migration{}
+
+	type edit struct {
+		key     *yaml.Node
+		rename  keyRename
+		message string
+	}
+	var edits []edit
+	// the old keys that are set alongside the new keys (which are noted rather than reported as unknown)
+	conflicts := make(map[string]bool)
+	for _, r := range keyRenames {
+		for _, section := range findSections(&root, r.path) {
+			key := mappingKey(section.node, r.from)
+			if key == nil {
+				continue
+			}
+			if mappingKey(section.node, r.to) != nil {
+				m.Notes = append(m.Notes, fmt.Sprintf("%s: both %q and %q are set, remove %q (it is ignored)", section.path, r.from, r.to, r.from))
+				conflicts[section.path+"."+r.from] = true
+				continue
+			}
+			edits = append(edits, edit{key: key, rename: r, message: fmt.Sprintf("%s.%s: renamed to %q", section.path, r.from, r.to)})
+		}
+	}
+
+	// note: keys are renamed from the end of each line, so the columns of the keys before are not shifted
+	sort.SliceStable(edits, func(i, j int) bool {
+		if edits[i].key.Line != edits[j].key.Line {
+			return edits[i].key.Line < edits[j].key.Line
+		}
+		return edits[i].key.Column > edits[j].key.Column
+	})
+
+	lines := strings.Split(string(contents), "\n")
+	original := append([]string(nil), lines...)
+	for _, e := range edits {
+		after, err := renameKey(lines[e.key.Line-1], e.key.Column, e.rename.from, e.rename.to)
+		if err != nil {
+			return nil, fmt.Errorf("unable to rename %q on line %d: %w", e.rename.from, e.key.Line, err)
+		}
+		lines[e.key.Line-1] = after
+	}
+
+	for _, e := range edits {
+		m.Changes = append(m.Changes, MigrationChange{
+			Line:    e.key.Line,
+			Before:  original[e.key.Line-1],
+			After:   lines[e.key.Line-1],
+			Message: e.message,
+		})
+	}
+	m.Contents = []byte(strings.Join(lines, "\n"))
+
+	// note: unknown keys are found within the migrated config, since the old keys of a rename are no longer read
+	var migrated yaml.Node
+	if err := yaml.Unmarshal(m.Contents, &migrated); err != nil {
+		return nil, fmt.Errorf("unable to parse migrated config: %w", err)
+	}
+	for _, path := range unknownKeys(&migrated, reflect.TypeOf(Application{}), "") {
+		if conflicts[path] {
+			continue
+		}
+		m.Notes = append(m.Notes, fmt.Sprintf("%s: unknown key (not used by chronicle)", path))
+	}
+
+	return m, nil
+}
+
+type section struct {
+	path string
+	node *yaml.Node
+}
+
+// findSections returns every mapping at the given dot-separated path (see keyRename.path).
+func findSections(root *yaml.Node, path string) []section {
+	current := []section{{node: documentNode(root)}}
+	for _, field := range strings.Split(path, ".") {
+		name := strings.TrimSuffix(field, "[]")
+		var next []section
+		for _, s := range current {
+			value := mappingValue(s.node, name)
+			if value == nil {
+				continue
+			}
+			p := strings.TrimPrefix(s.path+"."+name, ".")
+			if !strings.HasSuffix(field, "[]") {
+				next = append(next, section{path: p, node: value})
+				continue
+			}
+			if value.Kind != yaml.SequenceNode {
+				continue
+			}
+			for idx, entry := range value.Content {
+				next = append(next, section{path: fmt.Sprintf("%s[%d]", p, idx), node: entry})
+			}
+		}
+		current = next
+	}
+
+	var results []section
+	for _, s := range current {
+		if s.node.Kind == yaml.MappingNode {
+			results = append(results, s)
+		}
+	}
+	return results
+}
+
+// unknownKeys returns the path of every key within the config that does not map onto a field of the given config type.
+func unknownKeys(node *yaml.Node, t reflect.Type, path string) []string {
+	node = documentNode(node)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var results []string
+	switch {
+	case node.Kind == yaml.MappingNode && t.Kind() == reflect.Struct:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			p := strings.TrimPrefix(path+"."+key.Value, ".")
+			field, ok := fieldByKey(t, key.Value)
+			if !ok {
+				results = append(results, p)
+				continue
+			}
+			results = append(results, unknownKeys(value, field.Type, p)...)
+		}
+	case node.Kind == yaml.SequenceNode && t.Kind() == reflect.Slice:
+		for idx, entry := range node.Content {
+			results = append(results, unknownKeys(entry, t.Elem(), fmt.Sprintf("%s[%d]", path, idx))...)
+		}
+	}
+	return results
+}
+
+// fieldByKey returns the field of the config type that the given key is read into (by the mapstructure tag).
+func fieldByKey(t reflect.Type, key string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("mapstructure"), ",")[0]
+		if name != "" && name != "-" && name == key {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// renameKey replaces the key starting at the given column (starting at 1) of the line, keeping any quoting.
+func renameKey(line string, column int, from, to string) (string, error) {
+	start := column - 1
+	if start < 0 || start >= len(line) {
+		return "", fmt.Errorf("column %d is out of range", column)
+	}
+
+	rest := line[start:]
+	for _, quote := range []string{"", `"`, "'"} {
+		if strings.HasPrefix(rest, quote+from+quote) {
+			return line[:start] + quote + to + quote + rest[len(quote+from+quote):], nil
+		}
+	}
+	return "", fmt.Errorf("key %q not found", from)
+}
+
+func documentNode(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		return node.Content[0]
+	}
+	return node
+}
+
+func mappingKey(node *yaml.Node, name string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == name {
+			return node.Content[i]
+		}
+	}
+	return nil
+}
+
+func mappingValue(node *yaml.Node, name string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == name {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
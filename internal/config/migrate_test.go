@@ -0,0 +1,101 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withKeyRenames replaces the config keys that have been renamed for the duration of the test.
+func withKeyRenames(t *testing.T, renames []keyRename) {
+	t.Helper()
+	original := keyRenames
+	keyRenames = renames
+	t.Cleanup(func() { keyRenames = original })
+}
+
+func TestMigrate(t *testing.T) {
+	withKeyRenames(t, []keyRename{
+		{path: "github.changes[]", from: "kind", to: "name"},
+		{path: "github.changes[]", from: "bump", to: "semver-field"},
+	})
+
+	contents := `# the changelog config
+output: md
+github:
+  changes:
+    # bugs
+    - kind: bug
+      title: Bug Fixes
+      bump: patch
+      labels: ["bug"]
+    - {kind: "added", bump: 'minor', labels: [enhancement]}
+    - name: breaking
+      kind: breaking-change
+      semver-field: major
+  unknown-option: true
+`
+
+	m, err := Migrate([]byte(contents))
+	require.NoError(t, err)
+
+	assert.Equal(t, `# the changelog config
+output: md
+github:
+  changes:
+    # bugs
+    - name: bug
+      title: Bug Fixes
+      semver-field: patch
+      labels: ["bug"]
+    - {name: "added", semver-field: 'minor', labels: [enhancement]}
+    - name: breaking
+      kind: breaking-change
+      semver-field: major
+  unknown-option: true
+`, string(m.Contents))
+
+	assert.Equal(t, []MigrationChange{
+		{Line: 6, Before: "    - kind: bug", After: "    - name: bug", Message: `github.changes[0].kind: renamed to "name"`},
+		{Line: 8, Before: "      bump: patch", After: "      semver-field: patch", Message: `github.changes[0].bump: renamed to "semver-field"`},
+		{
+			Line:    10,
+			Before:  `    - {kind: "added", bump: 'minor', labels: [enhancement]}`,
+			After:   `    - {name: "added", semver-field: 'minor', labels: [enhancement]}`,
+			Message: `github.changes[1].bump: renamed to "semver-field"`,
+		},
+		{
+			Line:    10,
+			Before:  `    - {kind: "added", bump: 'minor', labels: [enhancement]}`,
+			After:   `    - {name: "added", semver-field: 'minor', labels: [enhancement]}`,
+			Message: `github.changes[1].kind: renamed to "name"`,
+		},
+	}, m.Changes)
+
+	assert.Equal(t, []string{
+		`github.changes[2]: both "kind" and "name" are set, remove "kind" (it is ignored)`,
+		"github.unknown-option: unknown key (not used by chronicle)",
+	}, m.Notes)
+}
+
+func TestMigrate_upToDate(t *testing.T) {
+	contents := "github:\n  changes:\n    - name: bug\n      semver-field: patch\n"
+
+	m, err := Migrate([]byte(contents))
+	require.NoError(t, err)
+	assert.Equal(t, contents, string(m.Contents))
+	assert.Empty(t, m.Changes)
+	assert.Empty(t, m.Notes)
+}
+
+func TestMigrate_keepsAliases(t *testing.T) {
+	// the documented aliases of the github change fields are valid config, so are left as-is
+	contents := "github:\n  changes:\n    - type: bug\n      semver-bump: patch\n"
+
+	m, err := Migrate([]byte(contents))
+	require.NoError(t, err)
+	assert.Equal(t, contents, string(m.Contents))
+	assert.Empty(t, m.Changes)
+	assert.Empty(t, m.Notes)
+}
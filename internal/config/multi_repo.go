@@ -0,0 +1,36 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+)
+
+type multiRepo struct {
+	Version string     `yaml:"version" json:"version" mapstructure:"version"` // the version of the combined release (e.g. "2023.03")
+	Repos   []repoSpec `yaml:"repos" json:"repos" mapstructure:"repos"`       // the repos that together make up the release, in the order they are listed
+}
+
+type repoSpec struct {
+	Name     string `yaml:"name" json:"name" mapstructure:"name"`                // the display name of the repo, used as the component of each of its changes
+	Path     string `yaml:"path" json:"path" mapstructure:"path"`                // the path to the local clone of the repo
+	SinceTag string `yaml:"since-tag" json:"since-tag" mapstructure:"since-tag"` // the tag to start the changelog of the repo from (defaults to the last release)
+	UntilTag string `yaml:"until-tag" json:"until-tag" mapstructure:"until-tag"` // the tag to end the changelog of the repo at (defaults to the current revision)
+}
+
+func (cfg multiRepo) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("multi-repo.version", "")
+	v.SetDefault("multi-repo.repos", []repoSpec{})
+}
+
+func (cfg *multiRepo) parseConfigValues() error {
+	for idx, r := range cfg.Repos {
+		if r.Name == "" {
+			return fmt.Errorf("multi-repo repo %d has no name", idx+1)
+		}
+		if r.Path == "" {
+			return fmt.Errorf("multi-repo repo %q has no path", r.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/publish/mastodon"
+	"github.com/anchore/chronicle/chronicle/release/publish/npm"
+	"github.com/anchore/chronicle/chronicle/release/publish/x"
+)
+
+type slackPublisher struct {
+	APIURL string `yaml:"api-url" json:"api-url" mapstructure:"api-url"`
+	Token  string `yaml:"-" json:"-" mapstructure:"token"` // never shown when displaying the config
+}
+
+func (cfg slackPublisher) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("slack.api-url", "")
+	v.SetDefault("slack.token", "")
+}
+
+type emailPublisher struct {
+	SMTPAddr string `yaml:"smtp-addr" json:"smtp-addr" mapstructure:"smtp-addr"` // the SMTP server address (host:port)
+	Username string `yaml:"username" json:"username" mapstructure:"username"`
+	Password string `yaml:"-" json:"-" mapstructure:"password"` // never shown when displaying the config
+	From     string `yaml:"from" json:"from" mapstructure:"from"`
+	Subject  string `yaml:"subject" json:"subject" mapstructure:"subject"` // a template rendered with the release version and date
+}
+
+func (cfg emailPublisher) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("email.smtp-addr", "")
+	v.SetDefault("email.username", "")
+	v.SetDefault("email.password", "")
+	v.SetDefault("email.from", "")
+	v.SetDefault("email.subject", "Release notes for {{.Version}}")
+}
+
+type announcement struct {
+	Template string `yaml:"template" json:"template" mapstructure:"template"` // the post rendered for the release (see publish.AnnouncementData)
+}
+
+func (cfg announcement) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("announcement.template", "{{.Version}} is out{{if .Highlight}}: {{.Highlight}}{{end}} {{.ChangesURL}}")
+}
+
+type mastodonPublisher struct {
+	URL           string `yaml:"url" json:"url" mapstructure:"url"`                                  // the base URL of the mastodon instance
+	Token         string `yaml:"-" json:"-" mapstructure:"token"`                                    // never shown when displaying the config
+	Visibility    string `yaml:"visibility" json:"visibility" mapstructure:"visibility"`             // public, unlisted, private, or direct (default is the account default)
+	MaxCharacters int    `yaml:"max-characters" json:"max-characters" mapstructure:"max-characters"` // the post length limit of the instance
+}
+
+func (cfg mastodonPublisher) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("mastodon.url", "")
+	v.SetDefault("mastodon.token", "")
+	v.SetDefault("mastodon.visibility", "")
+	v.SetDefault("mastodon.max-characters", mastodon.DefaultMaxCharacters)
+}
+
+type xPublisher struct {
+	APIURL        string `yaml:"api-url" json:"api-url" mapstructure:"api-url"`
+	MaxCharacters int    `yaml:"max-characters" json:"max-characters" mapstructure:"max-characters"` // the post length limit of the account
+}
+
+func (cfg xPublisher) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("x.api-url", "")
+	v.SetDefault("x.max-characters", x.MaxCharacters)
+}
+
+type npmPublisher struct {
+	Registry string `yaml:"registry" json:"registry" mapstructure:"registry"` // the base URL of the npm registry
+	Package  string `yaml:"package" json:"package" mapstructure:"package"`    // the name of the package (defaults to the name within the package.json of the repo)
+	Token    string `yaml:"-" json:"-" mapstructure:"token"`                  // never shown when displaying the config
+}
+
+func (cfg npmPublisher) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("npm.registry", npm.DefaultRegistryURL)
+	v.SetDefault("npm.package", "")
+	v.SetDefault("npm.token", "")
+}
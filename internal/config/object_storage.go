@@ -0,0 +1,31 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+)
+
+const defaultObjectKey = "{{.Version}}/CHANGELOG.{{.Format}}"
+
+type s3Publisher struct {
+	Endpoint string `yaml:"endpoint" json:"endpoint" mapstructure:"endpoint"`
+	Region   string `yaml:"region" json:"region" mapstructure:"region"`
+	Bucket   string `yaml:"bucket" json:"bucket" mapstructure:"bucket"`
+	Key      string `yaml:"key" json:"key" mapstructure:"key"`
+}
+
+func (cfg s3Publisher) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("s3.endpoint", "")
+	v.SetDefault("s3.region", "us-east-1")
+	v.SetDefault("s3.bucket", "")
+	v.SetDefault("s3.key", defaultObjectKey)
+}
+
+type gcsPublisher struct {
+	Bucket string `yaml:"bucket" json:"bucket" mapstructure:"bucket"`
+	Key    string `yaml:"key" json:"key" mapstructure:"key"`
+}
+
+func (cfg gcsPublisher) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("gcs.bucket", "")
+	v.SetDefault("gcs.key", defaultObjectKey)
+}
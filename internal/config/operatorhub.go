@@ -0,0 +1,11 @@
+package config
+
+import "github.com/spf13/viper"
+
+type operatorHub struct {
+	Operator string `yaml:"operator" json:"operator" mapstructure:"operator"` // the name of the operator, used for the CSV names (defaults to the name of the repo directory)
+}
+
+func (cfg operatorHub) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("operatorhub.operator", "")
+}
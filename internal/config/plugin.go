@@ -0,0 +1,66 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/plugin"
+)
+
+type pluginSummarizer struct {
+	Command string         `yaml:"command" json:"command" mapstructure:"command"`
+	Args    []string       `yaml:"args" json:"args" mapstructure:"args"`
+	Changes []pluginChange `yaml:"changes" json:"changes" mapstructure:"changes"`
+}
+
+type pluginChange struct {
+	Type       string `yaml:"name" json:"name" mapstructure:"name"`
+	Title      string `yaml:"title" json:"title" mapstructure:"title"`
+	SemVerKind string `yaml:"semver-field" json:"semver-field" mapstructure:"semver-field"`
+}
+
+func (cfg pluginSummarizer) ToPluginConfig(repoPath string) plugin.Config {
+	typeSet := make(change.TypeSet)
+	for _, c := range cfg.Changes {
+		k := change.ParseSemVerKind(c.SemVerKind)
+		typeSet[c.Type] = change.NewType(c.Type, k)
+	}
+	return plugin.Config{
+		Command:     cfg.Command,
+		Args:        cfg.Args,
+		Dir:         repoPath,
+		ChangeTypes: typeSet,
+	}
+}
+
+func (cfg pluginSummarizer) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("plugin.command", "")
+	v.SetDefault("plugin.args", []string{})
+	v.SetDefault("plugin.changes", []pluginChange{
+		{
+			Type:       "security-fixes",
+			Title:      "Security Fixes",
+			SemVerKind: change.SemVerPatch.String(),
+		},
+		{
+			Type:       "added-feature",
+			Title:      "Added Features",
+			SemVerKind: change.SemVerMinor.String(),
+		},
+		{
+			Type:       "bug-fix",
+			Title:      "Bug Fixes",
+			SemVerKind: change.SemVerPatch.String(),
+		},
+		{
+			Type:       "breaking-feature",
+			Title:      "Breaking Changes",
+			SemVerKind: change.SemVerMajor.String(),
+		},
+		{
+			Type:       change.UnknownType.Name,
+			Title:      "Additional Changes",
+			SemVerKind: change.UnknownType.Kind.String(),
+		},
+	})
+}
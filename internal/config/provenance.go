@@ -0,0 +1,15 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+)
+
+type provenance struct {
+	Enabled   bool   `yaml:"enabled" json:"enabled" mapstructure:"enabled"`          // include SLSA-style provenance within the JSON output
+	BuilderID string `yaml:"builder-id" json:"builder-id" mapstructure:"builder-id"` // the identity of the build platform (default is derived from the CI environment)
+}
+
+func (cfg provenance) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("provenance.enabled", false)
+	v.SetDefault("provenance.builder-id", "")
+}
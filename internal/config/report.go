@@ -0,0 +1,59 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/spf13/viper"
+
+	reportFormat "github.com/anchore/chronicle/chronicle/release/format/report"
+)
+
+type report struct {
+	Since            string              `yaml:"since" json:"since" mapstructure:"since"`                                     // --since, the start of the report window (e.g. "7d" or "2023-03-01")
+	Until            string              `yaml:"until" json:"until" mapstructure:"until"`                                     // --until, the end of the report window (defaults to now)
+	Title            string              `yaml:"title" json:"title" mapstructure:"title"`                                     // --title, the title of the report
+	Output           string              `yaml:"output" json:"output" mapstructure:"output"`                                  // --output, the format of the report
+	ComponentPattern string              `yaml:"component-pattern" json:"component-pattern" mapstructure:"component-pattern"` // a regex with a "component" capture group that extracts the component from the change text
+	ComponentRegex   *regexp.Regexp      `yaml:"-" json:"-" mapstructure:"-"`
+	GroupBy          string              `yaml:"group-by" json:"group-by" mapstructure:"group-by"`                            // --group-by, group the changes by period: week, month, or quarter (default is no grouping)
+	FiscalYearStart  string              `yaml:"fiscal-year-start" json:"fiscal-year-start" mapstructure:"fiscal-year-start"` // the first month of the fiscal year (by name or number), used when grouping by quarter
+	Period           reportFormat.Period `yaml:"-" json:"-" mapstructure:"-"`
+	FiscalYearMonth  time.Month          `yaml:"-" json:"-" mapstructure:"-"`
+}
+
+func (cfg report) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("report.component-pattern", `^(?P<component>[\w./-]+):\s+`)
+	v.SetDefault("report.fiscal-year-start", "january")
+}
+
+func (cfg *report) parseConfigValues() error {
+	period, err := reportFormat.ParsePeriod(cfg.GroupBy)
+	if err != nil {
+		return err
+	}
+	cfg.Period = period
+
+	cfg.FiscalYearMonth = time.January
+	if cfg.FiscalYearStart != "" {
+		month, err := reportFormat.ParseMonth(cfg.FiscalYearStart)
+		if err != nil {
+			return fmt.Errorf("invalid report fiscal year start: %w", err)
+		}
+		cfg.FiscalYearMonth = month
+	}
+
+	if cfg.ComponentPattern == "" {
+		return nil
+	}
+	r, err := regexp.Compile(cfg.ComponentPattern)
+	if err != nil {
+		return fmt.Errorf("invalid report component pattern %q: %w", cfg.ComponentPattern, err)
+	}
+	if r.SubexpIndex("component") < 0 {
+		return fmt.Errorf("report component pattern %q has no \"component\" capture group", cfg.ComponentPattern)
+	}
+	cfg.ComponentRegex = r
+	return nil
+}
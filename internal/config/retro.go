@@ -0,0 +1,28 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+)
+
+type retro struct {
+	Year            int    `yaml:"year" json:"year" mapstructure:"year"`                                     // --year, the calendar year of the retrospective (defaults to the current year)
+	Title           string `yaml:"title" json:"title" mapstructure:"title"`                                  // --title, the title of the retrospective
+	Output          string `yaml:"output" json:"output" mapstructure:"output"`                               // --output, the format of the retrospective
+	TopContributors int    `yaml:"top-contributors" json:"top-contributors" mapstructure:"top-contributors"` // the number of contributors to list (0 lists all)
+}
+
+func (cfg retro) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("retro.top-contributors", 10)
+}
+
+func (cfg *retro) parseConfigValues() error {
+	if cfg.Year < 0 {
+		return fmt.Errorf("invalid retro year: %d", cfg.Year)
+	}
+	if cfg.TopContributors < 0 {
+		return fmt.Errorf("invalid retro top contributors: %d (must be 0 or more)", cfg.TopContributors)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/publish"
+)
+
+type routing struct {
+	ComponentPattern string          `yaml:"component-pattern" json:"component-pattern" mapstructure:"component-pattern"` // a regex with a "component" capture group that extracts the component from the change text
+	Routes           []route         `yaml:"routes" json:"routes" mapstructure:"routes"`                                  // the components owned by each team and where to send their release notes
+	ComponentRegex   *regexp.Regexp  `yaml:"-" json:"-" mapstructure:"-"`
+	ParsedRoutes     []publish.Route `yaml:"-" json:"-" mapstructure:"-"`
+}
+
+type route struct {
+	Components   []string `yaml:"components" json:"components" mapstructure:"components"`
+	Destinations []string `yaml:"destinations" json:"destinations" mapstructure:"destinations"` // e.g. "slack:#team-api" or "email:api-team@example.com"
+}
+
+func (cfg routing) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("routing.component-pattern", `^(?P<component>[\w./-]+):\s+`)
+	v.SetDefault("routing.routes", []route{})
+}
+
+func (cfg *routing) parseConfigValues() error {
+	if len(cfg.Routes) == 0 {
+		return nil
+	}
+
+	r, err := regexp.Compile(cfg.ComponentPattern)
+	if err != nil {
+		return fmt.Errorf("invalid routing component pattern %q: %w", cfg.ComponentPattern, err)
+	}
+	if r.SubexpIndex("component") < 0 {
+		return fmt.Errorf("routing component pattern %q has no \"component\" capture group", cfg.ComponentPattern)
+	}
+	cfg.ComponentRegex = r
+
+	cfg.ParsedRoutes = nil
+	for idx, rt := range cfg.Routes {
+		if len(rt.Components) == 0 {
+			return fmt.Errorf("route %d has no components", idx+1)
+		}
+		parsed := publish.Route{Components: rt.Components}
+		for _, value := range rt.Destinations {
+			d, err := publish.ParseDestination(value)
+			if err != nil {
+				return fmt.Errorf("invalid route %d: %w", idx+1, err)
+			}
+			parsed.Destinations = append(parsed.Destinations, d)
+		}
+		if len(parsed.Destinations) == 0 {
+			return fmt.Errorf("route %d has no destinations", idx+1)
+		}
+		cfg.ParsedRoutes = append(cfg.ParsedRoutes, parsed)
+	}
+	return nil
+}
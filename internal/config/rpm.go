@@ -0,0 +1,13 @@
+package config
+
+import "github.com/spf13/viper"
+
+type rpm struct {
+	Release  string `yaml:"release" json:"release" mapstructure:"release"`    // the RPM release appended to the version (e.g. "1" for "1.2.3-1")
+	Packager string `yaml:"packager" json:"packager" mapstructure:"packager"` // the name and email of the packager (defaults to the RPM_PACKAGER env var)
+}
+
+func (cfg rpm) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("rpm.release", "1")
+	v.SetDefault("rpm.packager", "")
+}
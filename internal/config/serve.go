@@ -0,0 +1,19 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+type serve struct {
+	Listen           string        `yaml:"listen" json:"listen" mapstructure:"listen"`                                     // --listen, the address to serve on (e.g. ":8080")
+	FeedbackCacheTTL time.Duration `yaml:"feedback-cache-ttl" json:"feedback-cache-ttl" mapstructure:"feedback-cache-ttl"` // how long release feedback is cached before querying github again
+	BadgeCacheTTL    time.Duration `yaml:"badge-cache-ttl" json:"badge-cache-ttl" mapstructure:"badge-cache-ttl"`          // how long the stats shown by badges are cached before querying github again
+}
+
+func (cfg serve) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("serve.listen", ":8080")
+	v.SetDefault("serve.feedback-cache-ttl", 5*time.Minute)
+	v.SetDefault("serve.badge-cache-ttl", 5*time.Minute)
+}
@@ -0,0 +1,7 @@
+package config
+
+// sourceHost selects the source for repos with a git remote on the given host (when no source is configured).
+type sourceHost struct {
+	Host   string `yaml:"host" json:"host" mapstructure:"host"`
+	Source string `yaml:"source" json:"source" mapstructure:"source"`
+}
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"os"
+
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/change"
+	"github.com/anchore/chronicle/chronicle/release/releasers/sourcehut"
+)
+
+type sourcehutSummarizer struct {
+	Host               string            `yaml:"host" json:"host" mapstructure:"host"`
+	Tracker            string            `yaml:"tracker" json:"tracker" mapstructure:"tracker"`
+	IncludeResolutions []string          `yaml:"include-resolutions" json:"include-resolutions" mapstructure:"include-resolutions"`
+	List               string            `yaml:"list" json:"list" mapstructure:"list"`
+	Patches            sourcehutPatches  `yaml:"patches" json:"patches" mapstructure:"patches"`
+	Changes            []sourcehutChange `yaml:"changes" json:"changes" mapstructure:"changes"`
+}
+
+type sourcehutChange struct {
+	Type       string   `yaml:"name" json:"name" mapstructure:"name"`
+	Title      string   `yaml:"title" json:"title" mapstructure:"title"`
+	SemVerKind string   `yaml:"semver-field" json:"semver-field" mapstructure:"semver-field"`
+	Labels     []string `yaml:"labels" json:"labels" mapstructure:"labels"`
+}
+
+type sourcehutPatches struct {
+	Type       string `yaml:"name" json:"name" mapstructure:"name"`
+	Title      string `yaml:"title" json:"title" mapstructure:"title"`
+	SemVerKind string `yaml:"semver-field" json:"semver-field" mapstructure:"semver-field"`
+}
+
+// changeType is the change type (changelog section) that the applied patchsets are listed under.
+func (p sourcehutPatches) changeType() change.Type {
+	return change.NewType(p.Type, change.ParseSemVerKind(p.SemVerKind))
+}
+
+func (cfg sourcehutSummarizer) ToSourcehutConfig() sourcehut.Config {
+	typeSet := make(change.TypeSet)
+	for _, c := range cfg.Changes {
+		k := change.ParseSemVerKind(c.SemVerKind)
+		t := change.NewType(c.Type, k)
+		for _, l := range c.Labels {
+			typeSet[l] = t
+		}
+	}
+	return sourcehut.Config{
+		Host:               cfg.Host,
+		Tracker:            cfg.Tracker,
+		IncludeResolutions: cfg.IncludeResolutions,
+		ChangeTypesByLabel: typeSet,
+		List:               cfg.List,
+		PatchChangeType:    cfg.Patches.changeType(),
+		Token:              os.Getenv("SRHT_TOKEN"),
+	}
+}
+
+func (cfg sourcehutSummarizer) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("sourcehut.host", "sr.ht")
+	v.SetDefault("sourcehut.tracker", "")
+	v.SetDefault("sourcehut.include-resolutions", []string{"fixed", "implemented"})
+	v.SetDefault("sourcehut.list", "")
+	v.SetDefault("sourcehut.patches.name", "merged-patches")
+	v.SetDefault("sourcehut.patches.title", "Merged Patches")
+	v.SetDefault("sourcehut.patches.semver-field", change.SemVerPatch.String())
+	v.SetDefault("sourcehut.changes", []sourcehutChange{
+		{
+			Type:       "security-fixes",
+			Title:      "Security Fixes",
+			Labels:     []string{"security"},
+			SemVerKind: change.SemVerPatch.String(),
+		},
+		{
+			Type:       "added-feature",
+			Title:      "Added Features",
+			Labels:     []string{"enhancement", "feature"},
+			SemVerKind: change.SemVerMinor.String(),
+		},
+		{
+			Type:       "bug-fix",
+			Title:      "Bug Fixes",
+			Labels:     []string{"bug"},
+			SemVerKind: change.SemVerPatch.String(),
+		},
+	})
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	summaryFormat "github.com/anchore/chronicle/chronicle/release/format/summary"
+)
+
+type summary struct {
+	Lines     int    `yaml:"lines" json:"lines" mapstructure:"lines"`                // --summary-lines, write only the top N changes instead of the changelog (0 to write the changelog)
+	Select    string `yaml:"select" json:"select" mapstructure:"select"`             // how the top changes are picked: "significance" or "sections"
+	MaxLength int    `yaml:"max-length" json:"max-length" mapstructure:"max-length"` // truncate each line to this many characters (0 for no limit)
+}
+
+func (cfg *summary) parseConfigValues() error {
+	selection, err := summaryFormat.ParseSelection(cfg.Select)
+	if err != nil {
+		return fmt.Errorf("invalid summary.select: %w", err)
+	}
+	cfg.Select = selection
+	return nil
+}
+
+func (cfg summary) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("summary.lines", 0)
+	v.SetDefault("summary.select", summaryFormat.SignificanceSelection)
+	v.SetDefault("summary.max-length", 0)
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release"
+)
+
+type support struct {
+	Lines []supportLine `yaml:"lines" json:"lines" mapstructure:"lines"` // the supported lines of releases, rendered as a support matrix within the notes of each release
+}
+
+// supportLine is a line of releases (e.g. "v1.2" for every v1.2.x release) with when it reaches its end of life.
+type supportLine struct {
+	Version string `yaml:"version" json:"version" mapstructure:"version"`
+	EOL     string `yaml:"eol" json:"eol" mapstructure:"eol"` // the end of life date (YYYY-MM-DD), or empty when not yet scheduled
+}
+
+func (cfg *support) parseConfigValues() error {
+	for _, l := range cfg.Lines {
+		if l.Version == "" {
+			return fmt.Errorf("invalid support.lines: a version is required for each line")
+		}
+		if _, err := l.eol(); err != nil {
+			return fmt.Errorf("invalid support.lines eol for %q: %w", l.Version, err)
+		}
+	}
+	return nil
+}
+
+func (cfg support) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("support.lines", []supportLine{})
+}
+
+func (l supportLine) eol() (time.Time, error) {
+	if l.EOL == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse("2006-01-02", l.EOL)
+}
+
+// ToSupportLines converts the configured lines to the lines of the support matrix (the lines are expected to have been
+// validated already).
+func (cfg support) ToSupportLines() []release.SupportLine {
+	var lines []release.SupportLine
+	for _, l := range cfg.Lines {
+		eol, _ := l.eol()
+		lines = append(lines, release.SupportLine{
+			Version: l.Version,
+			EOL:     eol,
+		})
+	}
+	return lines
+}
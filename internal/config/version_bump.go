@@ -0,0 +1,73 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/versionbump"
+)
+
+type versionBump struct {
+	Manifests   []manifestSpec `yaml:"manifests" json:"manifests" mapstructure:"manifests"`          // the manifests to set the version of the release within (disabled when empty)
+	DryRun      bool           `yaml:"dry-run" json:"dry-run" mapstructure:"dry-run"`                // show the edits as a diff (on stderr) instead of writing them
+	StripPrefix string         `yaml:"strip-prefix" json:"strip-prefix" mapstructure:"strip-prefix"` // removed from the start of the release version before it is written (e.g. "v")
+}
+
+type manifestSpec struct {
+	Type    string                `yaml:"type" json:"type" mapstructure:"type"`          // package.json, helm, or pattern
+	Path    string                `yaml:"path" json:"path" mapstructure:"path"`          // the file to edit (defaults per type, relative to the repo)
+	Pattern string                `yaml:"pattern" json:"pattern" mapstructure:"pattern"` // a regex matching the version via the "version" capture group (for the pattern type)
+	Parsed  *versionbump.Manifest `yaml:"-" json:"-" mapstructure:"-"`
+}
+
+func (cfg versionBump) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("version-bump.manifests", []manifestSpec{})
+	v.SetDefault("version-bump.dry-run", false)
+	v.SetDefault("version-bump.strip-prefix", "v")
+}
+
+func (cfg *versionBump) parseConfigValues() error {
+	for idx := range cfg.Manifests {
+		m := &cfg.Manifests[idx]
+		kind, err := versionbump.ParseKind(m.Type)
+		if err != nil {
+			return fmt.Errorf("invalid version-bump manifest %d: %w", idx+1, err)
+		}
+
+		manifest := versionbump.Manifest{
+			Kind: kind,
+			Path: m.Path,
+		}
+
+		if kind == versionbump.PatternManifest {
+			if m.Path == "" || m.Pattern == "" {
+				return fmt.Errorf("version-bump manifest %d needs both a path and a pattern", idx+1)
+			}
+		}
+		if m.Pattern != "" {
+			r, err := regexp.Compile(m.Pattern)
+			if err != nil {
+				return fmt.Errorf("invalid version-bump pattern %q: %w", m.Pattern, err)
+			}
+			if r.SubexpIndex("version") < 0 {
+				return fmt.Errorf("version-bump pattern %q has no \"version\" capture group", m.Pattern)
+			}
+			manifest.Pattern = r
+		}
+		m.Parsed = &manifest
+	}
+	return nil
+}
+
+// ToManifests returns the configured manifests (only valid once the config values have been parsed).
+func (cfg versionBump) ToManifests() []versionbump.Manifest {
+	var manifests []versionbump.Manifest
+	for _, m := range cfg.Manifests {
+		if m.Parsed != nil {
+			manifests = append(manifests, *m.Parsed)
+		}
+	}
+	return manifests
+}
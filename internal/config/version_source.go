@@ -0,0 +1,44 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	"github.com/anchore/chronicle/chronicle/release/versionsource"
+)
+
+type versionSource struct {
+	Type    string             `yaml:"type" json:"type" mapstructure:"type"`          // where the current version is stored: file, package.json, cargo, pyproject, helm, or command (disabled when empty)
+	Path    string             `yaml:"path" json:"path" mapstructure:"path"`          // the file to read the version from (defaults per type, relative to the repo)
+	Command string             `yaml:"command" json:"command" mapstructure:"command"` // the shell command that prints the version (for the command type)
+	Prefix  string             `yaml:"prefix" json:"prefix" mapstructure:"prefix"`    // prepended to the version when not already present (e.g. "v")
+	Kind    versionsource.Kind `yaml:"-" json:"-" mapstructure:"-"`
+}
+
+func (cfg versionSource) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("version-source.type", "")
+	v.SetDefault("version-source.prefix", "")
+}
+
+func (cfg *versionSource) parseConfigValues() error {
+	kind, err := versionsource.ParseKind(cfg.Type)
+	if err != nil {
+		return err
+	}
+	if kind == versionsource.CommandSource && cfg.Command == "" {
+		return fmt.Errorf("version-source command is required for the %q type", kind)
+	}
+	cfg.Kind = kind
+	return nil
+}
+
+func (cfg versionSource) ToVersionSourceConfig(repoPath string) versionsource.Config {
+	return versionsource.Config{
+		Kind:    cfg.Kind,
+		Path:    cfg.Path,
+		Command: cfg.Command,
+		Prefix:  cfg.Prefix,
+		Dir:     repoPath,
+	}
+}
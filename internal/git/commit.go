@@ -0,0 +1,32 @@
+package git
+
+import (
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// Commit is a single entry from the git log.
+type Commit struct {
+	Hash        string
+	Message     string
+	AuthorName  string
+	AuthorEmail string
+	Timestamp   time.Time
+}
+
+// Subject returns the first line of the commit message.
+func (c Commit) Subject() string {
+	return strings.TrimSpace(strings.SplitN(c.Message, "\n", 2)[0])
+}
+
+func newCommit(c *object.Commit) Commit {
+	return Commit{
+		Hash:        c.Hash.String(),
+		Message:     c.Message,
+		AuthorName:  c.Author.Name,
+		AuthorEmail: c.Author.Email,
+		Timestamp:   c.Committer.When,
+	}
+}
@@ -0,0 +1,63 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// FilesAt returns the contents of all files directly within the given directory at the given git ref (keyed by file
+// name). A directory that does not exist at the ref yields no files.
+func FilesAt(repoPath, ref, dir string) (map[string]string, error) {
+	r, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := r.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		return nil, fmt.Errorf("unable to find git ref=%q: %w", ref, err)
+	}
+
+	commit, err := r.CommitObject(*hash)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	// note: the root of the repo is the commit tree itself
+	dirTree := tree
+	if dir != "" && dir != "." {
+		dirTree, err = tree.Tree(dir)
+	}
+	if err != nil {
+		if errors.Is(err, object.ErrDirectoryNotFound) {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("unable to find directory=%q at git ref=%q: %w", dir, ref, err)
+	}
+
+	files := make(map[string]string)
+	for _, entry := range dirTree.Entries {
+		if !entry.Mode.IsFile() {
+			continue
+		}
+		f, err := dirTree.TreeEntryFile(&entry)
+		if err != nil {
+			return nil, err
+		}
+		contents, err := f.Contents()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read file=%q at git ref=%q: %w", entry.Name, ref, err)
+		}
+		files[entry.Name] = contents
+	}
+	return files, nil
+}
@@ -0,0 +1,61 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilesAt(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		ref  string
+		dir  string
+		want map[string]string
+	}{
+		{
+			name: "files at first tag",
+			path: "test-fixtures/repos/fragment-repo",
+			ref:  "v0.1.0",
+			dir:  "changelog.d",
+			want: map[string]string{
+				"1.fix.md": "Fix the first thing\n",
+			},
+		},
+		{
+			name: "nested directories are not included",
+			path: "test-fixtures/repos/fragment-repo",
+			ref:  "v0.2.0",
+			dir:  "changelog.d",
+			want: map[string]string{
+				"1.fix.md":     "Fix the first thing\n",
+				"2.feature.md": "Add the feature\n",
+			},
+		},
+		{
+			name: "root directory",
+			path: "test-fixtures/repos/fragment-repo",
+			ref:  "v0.2.0",
+			dir:  ".",
+			want: map[string]string{
+				"flags.yaml": "dark-mode: false\n",
+			},
+		},
+		{
+			name: "missing directory",
+			path: "test-fixtures/repos/fragment-repo",
+			ref:  "HEAD",
+			dir:  "does-not-exist",
+			want: map[string]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := FilesAt(test.path, test.ref, test.dir)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, actual)
+		})
+	}
+}
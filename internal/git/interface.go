@@ -4,6 +4,8 @@ import "fmt"
 
 var _ Interface = (*gitter)(nil)
 
+// Interface provides the git operations chronicle needs from a repo. The implementation returned by New is safe for
+// concurrent use, since it holds no state beyond the repo path (each operation opens the repo independently).
 type Interface interface {
 	HeadTagOrCommit() (string, error)
 	HeadTag() (string, error)
@@ -11,18 +13,24 @@ type Interface interface {
 	SearchForTag(tagRef string) (*Tag, error)
 	TagsFromLocal() ([]Tag, error)
 	CommitsBetween(Range) ([]string, error)
+	CommitLogBetween(Range) ([]Commit, error)
+	FilesAt(ref, dir string) (map[string]string, error)
 }
 
 type gitter struct {
 	repoPath string
+	remotes  []string
 }
 
-func New(repoPath string) (Interface, error) {
+// New returns the operations for the git repo at the given path, where the remote URL is taken from the first of the
+// preferred remotes that the repo has (see RemoteURL).
+func New(repoPath string, preferredRemotes ...string) (Interface, error) {
 	if !IsRepository(repoPath) {
 		return nil, fmt.Errorf("not a git repository: %q", repoPath)
 	}
 	return gitter{
 		repoPath: repoPath,
+		remotes:  preferredRemotes,
 	}, nil
 }
 
@@ -30,6 +38,14 @@ func (g gitter) CommitsBetween(cfg Range) ([]string, error) {
 	return CommitsBetween(g.repoPath, cfg)
 }
 
+func (g gitter) CommitLogBetween(cfg Range) ([]Commit, error) {
+	return CommitLogBetween(g.repoPath, cfg)
+}
+
+func (g gitter) FilesAt(ref, dir string) (map[string]string, error) {
+	return FilesAt(g.repoPath, ref, dir)
+}
+
 func (g gitter) HeadTagOrCommit() (string, error) {
 	return HeadTagOrCommit(g.repoPath)
 }
@@ -39,7 +55,7 @@ func (g gitter) HeadTag() (string, error) {
 }
 
 func (g gitter) RemoteURL() (string, error) {
-	return RemoteURL(g.repoPath)
+	return RemoteURL(g.repoPath, g.remotes...)
 }
 
 func (g gitter) SearchForTag(tagRef string) (*Tag, error) {
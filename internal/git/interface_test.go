@@ -0,0 +1,36 @@
+package git
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitter_concurrent(t *testing.T) {
+	g, err := New("test-fixtures/repos/tag-range-repo")
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			tag, err := g.SearchForTag("v0.2.0")
+			if assert.NoError(t, err) {
+				assert.Equal(t, "v0.2.0", tag.Name)
+			}
+
+			tags, err := g.TagsFromLocal()
+			assert.NoError(t, err)
+			assert.Len(t, tags, 3)
+
+			commits, err := g.CommitsBetween(Range{SinceRef: "v0.1.0", UntilRef: "v0.2.0"})
+			assert.NoError(t, err)
+			assert.NotEmpty(t, commits)
+		}()
+	}
+	wg.Wait()
+}
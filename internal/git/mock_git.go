@@ -7,12 +7,22 @@ type MockInterface struct {
 	MockRemoteURL       string
 	MockSearchTag       string
 	MockCommitsBetween  []string
+	MockCommitLog       []Commit
+	MockFiles           map[string]map[string]string // files by ref
 }
 
 func (m MockInterface) CommitsBetween(r Range) ([]string, error) {
 	return m.MockCommitsBetween, nil
 }
 
+func (m MockInterface) CommitLogBetween(_ Range) ([]Commit, error) {
+	return m.MockCommitLog, nil
+}
+
+func (m MockInterface) FilesAt(ref, _ string) (map[string]string, error) {
+	return m.MockFiles[ref], nil
+}
+
 func (m MockInterface) HeadTagOrCommit() (string, error) {
 	return m.MockHeadOrTagCommit, nil
 }
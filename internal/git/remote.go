@@ -3,28 +3,137 @@ package git
 import (
 	"fmt"
 	"io"
+	"net/url"
 	"os"
 	"path"
 	"regexp"
+	"strings"
 
-	"github.com/anchore/chronicle/internal"
+	"github.com/anchore/chronicle/internal/log"
 )
 
-var remotePattern = regexp.MustCompile(`\[remote\s*"origin"]\s*\n\s*url\s*=\s*(?P<url>[^\s]+)\s+`)
+// DefaultRemote is the remote used when no remotes are preferred.
+const DefaultRemote = "origin"
 
-// TODO: can't use r.Config for same validation reasons
-func RemoteURL(p string) (string, error) {
+// remote is a named remote within the git config of a repo.
+type remote struct {
+	Name string
+	URL  string
+}
+
+var remoteSectionPattern = regexp.MustCompile(`^\[remote\s+"(?P<name>[^"]+)"\s*]$`)
+
+// RemoteURL returns the URL of the first of the preferred remotes that the repo has (the "origin" remote when none
+// are given). When the repo has none of the preferred remotes, the first remote in the git config is used, so a repo
+// with a single remote (whatever it is named) is always supported. An empty string is returned when there are no
+// remotes.
+func RemoteURL(p string, preferred ...string) (string, error) {
 	f, err := os.Open(path.Join(p, ".git", "config"))
 	if err != nil {
 		return "", fmt.Errorf("unable to open git config: %w", err)
 	}
+	defer f.Close()
+
 	contents, err := io.ReadAll(f)
 	if err != nil {
 		return "", fmt.Errorf("unable to read git config: %w", err)
 	}
-	matches := internal.MatchNamedCaptureGroups(remotePattern, string(contents))
 
-	return matches["url"], nil
+	return selectRemote(parseRemotes(string(contents)), preferred), nil
+}
+
+func selectRemote(remotes []remote, preferred []string) string {
+	if len(preferred) == 0 {
+		preferred = []string{DefaultRemote}
+	}
+	for _, name := range preferred {
+		for _, r := range remotes {
+			if r.Name == name {
+				return r.URL
+			}
+		}
+	}
+	if len(remotes) > 0 {
+		log.WithFields("remote", remotes[0].Name, "preferred", preferred).Debug("none of the preferred remotes found, using the first remote")
+		return remotes[0].URL
+	}
+	return ""
+}
+
+// parseRemotes returns the remotes (that have a URL) in the order they appear within the given git config.
+func parseRemotes(config string) []remote {
+	var remotes []remote
+	var current *remote
+	for _, line := range strings.Split(config, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "[") {
+			current = nil
+			if match := remoteSectionPattern.FindStringSubmatch(line); match != nil {
+				remotes = append(remotes, remote{Name: match[remoteSectionPattern.SubexpIndex("name")]})
+				current = &remotes[len(remotes)-1]
+			}
+			continue
+		}
+		if current == nil || current.URL != "" {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) == 2 && strings.TrimSpace(parts[0]) == "url" {
+			current.URL = strings.TrimSpace(parts[1])
+		}
+	}
+
+	var results []remote
+	for _, r := range remotes {
+		if r.URL != "" {
+			results = append(results, r)
+		}
+	}
+	return results
+}
+
+// RemoteHost returns the host of the given git remote URL, for both URLs (e.g. https://github.com/anchore/chronicle.git
+// or ssh://git@github.com/anchore/chronicle.git) and scp-like addresses (e.g. git@github.com:anchore/chronicle.git).
+// An empty string is returned when there is no host (e.g. for a local path).
+func RemoteHost(remoteURL string) string {
+	if strings.Contains(remoteURL, "://") {
+		u, err := url.Parse(remoteURL)
+		if err != nil {
+			return ""
+		}
+		return strings.ToLower(u.Hostname())
+	}
+
+	// scp-like addresses are [user@]host:path, where the host has no slashes
+	idx := strings.Index(remoteURL, ":")
+	if idx < 0 || strings.Contains(remoteURL[:idx], "/") {
+		return ""
+	}
+	host := remoteURL[:idx]
+	if at := strings.LastIndex(host, "@"); at >= 0 {
+		host = host[at+1:]
+	}
+	return strings.ToLower(host)
+}
+
+// RemotePath returns the path of the repo within the given git remote URL (e.g. "anchore/chronicle.git"), for both URLs
+// (including any port, e.g. ssh://git@github.com:22/anchore/chronicle.git) and scp-like addresses (e.g.
+// git@github.com:anchore/chronicle.git), without any leading or trailing slashes. An empty string is returned when
+// there is no host (e.g. for a local path).
+func RemotePath(remoteURL string) string {
+	if strings.Contains(remoteURL, "://") {
+		u, err := url.Parse(remoteURL)
+		if err != nil || u.Host == "" {
+			return ""
+		}
+		return strings.Trim(u.Path, "/")
+	}
+
+	idx := strings.Index(remoteURL, ":")
+	if idx < 0 || strings.Contains(remoteURL[:idx], "/") {
+		return ""
+	}
+	return strings.Trim(remoteURL[idx+1:], "/")
 }
 
 // TODO: can't use r.Config for same validation reasons
@@ -27,3 +27,86 @@ func TestRemoteUrl(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoteUrl_preferred(t *testing.T) {
+	actual, err := RemoteURL("test-fixtures/repos/remote-repo", "upstream", "origin")
+	require.NoError(t, err)
+	assert.Equal(t, "git@github.com:upstream/count-goober.git", actual)
+
+	// when none of the preferred remotes exist the first remote is used
+	actual, err = RemoteURL("test-fixtures/repos/remote-repo", "fork")
+	require.NoError(t, err)
+	assert.Equal(t, "git@github.com:wagoodman/count-goober.git", actual)
+}
+
+func Test_parseRemotes(t *testing.T) {
+	config := `[core]
+	bare = false
+[remote "upstream"]
+	url = https://github.com/anchore/chronicle.git
+	fetch = +refs/heads/*:refs/remotes/upstream/*
+[branch "main"]
+	remote = upstream
+	url = not-a-remote
+[remote "no-url"]
+	fetch = +refs/heads/*:refs/remotes/no-url/*
+[remote "origin"]
+	url = git@github.com:someone/chronicle.git
+`
+	assert.Equal(t, []remote{
+		{Name: "upstream", URL: "https://github.com/anchore/chronicle.git"},
+		{Name: "origin", URL: "git@github.com:someone/chronicle.git"},
+	}, parseRemotes(config))
+}
+
+func Test_selectRemote(t *testing.T) {
+	remotes := []remote{
+		{Name: "upstream", URL: "upstream-url"},
+		{Name: "origin", URL: "origin-url"},
+	}
+	assert.Equal(t, "origin-url", selectRemote(remotes, nil))
+	assert.Equal(t, "upstream-url", selectRemote(remotes, []string{"upstream", "origin"}))
+	assert.Equal(t, "upstream-url", selectRemote(remotes, []string{"fork"}))
+	assert.Empty(t, selectRemote(nil, []string{"origin"}))
+}
+
+func TestRemotePath(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "git@github.com:anchore/chronicle.git", want: "anchore/chronicle.git"},
+		{url: "https://github.com/anchore/chronicle/", want: "anchore/chronicle"},
+		{url: "ssh://git@git.company.com:2222/team/project.git", want: "team/project.git"},
+		{url: "git@git.sr.ht:~someone/project", want: "~someone/project"},
+		{url: "/srv/git/project.git", want: ""},
+		{url: "file:///srv/git/project.git", want: ""},
+		{url: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			assert.Equal(t, tt.want, RemotePath(tt.url))
+		})
+	}
+}
+
+func TestRemoteHost(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "git@github.com:anchore/chronicle.git", want: "github.com"},
+		{url: "https://github.com/anchore/chronicle.git", want: "github.com"},
+		{url: "ssh://git@git.company.com:2222/team/project.git", want: "git.company.com"},
+		{url: "git@git.sr.ht:~someone/project", want: "git.sr.ht"},
+		{url: "Codeberg.org:someone/project.git", want: "codeberg.org"},
+		{url: "/srv/git/project.git", want: ""},
+		{url: "../project", want: ""},
+		{url: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			assert.Equal(t, tt.want, RemoteHost(tt.url))
+		})
+	}
+}
@@ -28,6 +28,20 @@ type Range struct {
 
 // TODO: put under test
 func CommitsBetween(repoPath string, cfg Range) ([]string, error) {
+	log, err := CommitLogBetween(repoPath, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []string
+	for _, c := range log {
+		commits = append(commits, c.Hash)
+	}
+	return commits, nil
+}
+
+// CommitLogBetween returns the full commit details (message, author, etc) for all commits within the given range (in reverse chronological order).
+func CommitLogBetween(repoPath string, cfg Range) ([]Commit, error) {
 	r, err := git.PlainOpen(repoPath)
 	if err != nil {
 		return nil, err
@@ -53,22 +67,20 @@ func CommitsBetween(repoPath string, cfg Range) ([]string, error) {
 
 	log.WithFields("since", sinceHash, "until", untilHash).Trace("searching commit range")
 
-	var commits []string
+	var commits []Commit
 	err = iter.ForEach(func(c *object.Commit) (retErr error) {
-		hash := c.Hash.String()
-
 		switch {
 		case untilHash != nil && c.Hash == *untilHash:
 			if cfg.IncludeEnd {
-				commits = append(commits, hash)
+				commits = append(commits, newCommit(c))
 			}
 		case sinceHash != nil && c.Hash == *sinceHash:
 			retErr = storer.ErrStop
 			if cfg.IncludeStart {
-				commits = append(commits, hash)
+				commits = append(commits, newCommit(c))
 			}
 		default:
-			commits = append(commits, hash)
+			commits = append(commits, newCommit(c))
 		}
 
 		return
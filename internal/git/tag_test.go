@@ -224,3 +224,27 @@ func popBack(items []string) []string {
 	}
 	return items[:len(items)-1]
 }
+
+func TestCommitLogBetween(t *testing.T) {
+	actual, err := CommitLogBetween("test-fixtures/repos/tag-range-repo", Range{
+		SinceRef:     "v0.1.1",
+		UntilRef:     "v0.2.0",
+		IncludeStart: false,
+		IncludeEnd:   true,
+	})
+	require.NoError(t, err)
+
+	var subjects []string
+	for _, c := range actual {
+		subjects = append(subjects, c.Subject())
+		assert.Equal(t, "nope", c.AuthorName)
+		assert.Equal(t, "nope@nope.com", c.AuthorEmail)
+	}
+
+	// remember: git log is in reverse chronological order
+	assert.Equal(t, []string{
+		"fix: missed something of everything",
+		"feat: implement everything that wasnt there",
+		"fix: bad release of 0.1.1",
+	}, subjects)
+}
@@ -0,0 +1,25 @@
+#!/usr/bin/env bash
+set -eux -o pipefail
+
+git init repos/fragment-repo
+
+pushd repos/fragment-repo
+
+git config --local user.email "nope@nope.com"
+git config --local user.name "nope"
+
+trap 'popd' EXIT
+
+mkdir changelog.d
+echo "Fix the first thing" > changelog.d/1.fix.md
+git add changelog.d
+git commit -m 'add first fragment'
+git tag v0.1.0
+
+echo "Add the feature" > changelog.d/2.feature.md
+mkdir -p changelog.d/nested
+echo "ignored" > changelog.d/nested/3.fix.md
+echo "dark-mode: false" > flags.yaml
+git add changelog.d flags.yaml
+git commit -m 'add second fragment'
+git tag v0.2.0
@@ -0,0 +1,34 @@
+package hg
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// FilesAt returns the contents of all files directly within the given directory at the given ref (keyed by file
+// name). A directory that does not exist at the ref yields no files.
+func (r repo) FilesAt(ref, dir string) (map[string]string, error) {
+	// note: the glob is relative to the repo root and "*" does not match across directories
+	pattern := "rootglob:" + path.Join(dir, "*")
+	out, err := r.run("files", "-r", ref, pattern)
+	if err != nil {
+		if isNoMatch(err) {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("unable to find directory=%q at hg ref=%q: %w", dir, ref, err)
+	}
+
+	files := make(map[string]string)
+	for _, name := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if name == "" {
+			continue
+		}
+		contents, err := r.run("cat", "-r", ref, "path:"+name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read file=%q at hg ref=%q: %w", name, ref, err)
+		}
+		files[path.Base(name)] = string(contents)
+	}
+	return files, nil
+}
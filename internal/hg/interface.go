@@ -0,0 +1,83 @@
+package hg
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/anchore/chronicle/internal/git"
+)
+
+var _ git.Interface = (*repo)(nil)
+
+// runner runs an hg command within the repo, returning stdout.
+type runner func(args ...string) ([]byte, error)
+
+// repo provides the operations chronicle needs from a mercurial repo (the same as for a git repo), by running the hg
+// executable. Like the git implementation, it holds no state beyond the repo path, so is safe for concurrent use.
+type repo struct {
+	repoPath string
+	paths    []string
+	run      runner
+}
+
+// New returns the operations for the mercurial repo at the given path, where the remote URL is taken from the first
+// of the preferred paths that the repo has (see RemoteURL).
+func New(repoPath string, preferredPaths ...string) (git.Interface, error) {
+	if !IsRepository(repoPath) {
+		return nil, fmt.Errorf("not a mercurial repository: %q", repoPath)
+	}
+	return repo{
+		repoPath: repoPath,
+		paths:    preferredPaths,
+		run:      commandRunner(repoPath),
+	}, nil
+}
+
+// IsRepository indicates if the given path is the root of a mercurial repo.
+func IsRepository(path string) bool {
+	info, err := os.Stat(filepath.Join(path, ".hg"))
+	return err == nil && info.IsDir()
+}
+
+func commandRunner(repoPath string) runner {
+	return func(args ...string) ([]byte, error) {
+		cmd := exec.Command("hg", args...)
+		cmd.Dir = repoPath
+		// ignore user configuration (e.g. aliases and localization) that would change the output
+		cmd.Env = append(os.Environ(), "HGPLAIN=1")
+
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+
+		out, err := cmd.Output()
+		if err != nil {
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				return out, &commandError{args: args, exitCode: exitErr.ExitCode(), stderr: string(bytes.TrimSpace(stderr.Bytes()))}
+			}
+			return nil, fmt.Errorf("unable to run hg: %w", err)
+		}
+		return out, nil
+	}
+}
+
+type commandError struct {
+	args     []string
+	exitCode int
+	stderr   string
+}
+
+func (e *commandError) Error() string {
+	return fmt.Sprintf("hg %s failed (exit code %d): %s", e.args[0], e.exitCode, e.stderr)
+}
+
+// isNoMatch indicates if the command failed only because nothing matched (e.g. no files for a pattern), which hg
+// reports with an exit code of 1.
+func isNoMatch(err error) bool {
+	var cmdErr *commandError
+	return errors.As(err, &cmdErr) && cmdErr.exitCode == 1
+}
@@ -0,0 +1,182 @@
+package hg
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/chronicle/internal/git"
+)
+
+// fakeRunner returns the output for each hg command (keyed by the space-joined arguments).
+func fakeRunner(t *testing.T, outputs map[string]string) runner {
+	return func(args ...string) ([]byte, error) {
+		key := strings.Join(args, " ")
+		out, ok := outputs[key]
+		if !ok {
+			t.Fatalf("unexpected hg command: %q", key)
+		}
+		return []byte(out), nil
+	}
+}
+
+func TestRepo_HeadTag(t *testing.T) {
+	tests := []struct {
+		name        string
+		output      string
+		wantTag     string
+		wantTagOrID string
+	}{
+		{
+			name:        "tagged head",
+			output:      "abc123\x1f2023-03-01T10:00:00+01:00\x1fv0.2.0\x1ftip\x1e",
+			wantTag:     "v0.2.0",
+			wantTagOrID: "v0.2.0",
+		},
+		{
+			name:        "untagged head",
+			output:      "abc123\x1f2023-03-01T10:00:00+01:00\x1ftip\x1e",
+			wantTagOrID: "abc123",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := repo{run: fakeRunner(t, map[string]string{
+				"log -r . --template " + tagsTemplate: tt.output,
+			})}
+
+			tag, err := r.HeadTag()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTag, tag)
+
+			tagOrID, err := r.HeadTagOrCommit()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTagOrID, tagOrID)
+		})
+	}
+}
+
+func TestRepo_TagsFromLocal(t *testing.T) {
+	r := repo{run: fakeRunner(t, map[string]string{
+		"log -r tag() --template " + tagsTemplate: "aaa\x1f2023-03-01T10:00:00Z\x1fv0.1.0\x1e" +
+			"bbb\x1f2023-04-01T10:00:00Z\x1fv0.2.0\x1fstable\x1ftip\x1e",
+	})}
+
+	tags, err := r.TagsFromLocal()
+	require.NoError(t, err)
+	assert.Equal(t, []git.Tag{
+		{Name: "v0.1.0", Timestamp: time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC), Commit: "aaa"},
+		{Name: "v0.2.0", Timestamp: time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC), Commit: "bbb"},
+		{Name: "stable", Timestamp: time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC), Commit: "bbb"},
+	}, tags)
+}
+
+func TestRepo_SearchForTag(t *testing.T) {
+	r := repo{run: fakeRunner(t, map[string]string{
+		`log -r tag("literal:v0.2.0") --template ` + tagsTemplate: "bbb\x1f2023-04-01T10:00:00Z\x1fv0.2.0\x1fstable\x1e",
+	})}
+
+	tag, err := r.SearchForTag("v0.2.0")
+	require.NoError(t, err)
+	assert.Equal(t, &git.Tag{Name: "v0.2.0", Timestamp: time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC), Commit: "bbb"}, tag)
+}
+
+func TestRepo_CommitLogBetween(t *testing.T) {
+	r := repo{run: fakeRunner(t, map[string]string{
+		`log -r sort(only("tip", "v0.1.0"), -rev) --template ` + commitTemplate: "ccc\x1fSomeone\x1fsomeone@example.com\x1f2023-04-02T10:00:00Z\x1ffix: the thing\n\nwith a body\x1e\n" +
+			"bbb\x1fSomeone Else\x1felse@example.com\x1f2023-04-01T10:00:00Z\x1ffeat: a thing\x1e",
+	})}
+
+	commits, err := r.CommitLogBetween(git.Range{SinceRef: "v0.1.0", UntilRef: "tip", IncludeEnd: true})
+	require.NoError(t, err)
+	assert.Equal(t, []git.Commit{
+		{
+			Hash:        "ccc",
+			Message:     "fix: the thing\n\nwith a body",
+			AuthorName:  "Someone",
+			AuthorEmail: "someone@example.com",
+			Timestamp:   time.Date(2023, 4, 2, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			Hash:        "bbb",
+			Message:     "feat: a thing",
+			AuthorName:  "Someone Else",
+			AuthorEmail: "else@example.com",
+			Timestamp:   time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}, commits)
+}
+
+func Test_rangeRevset(t *testing.T) {
+	tests := []struct {
+		name string
+		r    git.Range
+		want string
+	}{
+		{
+			name: "from the beginning of history",
+			r:    git.Range{UntilRef: "v0.2.0", IncludeStart: true, IncludeEnd: true},
+			want: `sort(::"v0.2.0", -rev)`,
+		},
+		{
+			name: "between tags",
+			r:    git.Range{SinceRef: "v0.1.0", UntilRef: "v0.2.0"},
+			want: `sort((only("v0.2.0", "v0.1.0")) - "v0.2.0", -rev)`,
+		},
+		{
+			name: "including the start",
+			r:    git.Range{SinceRef: "v0.1.0", UntilRef: "v0.2.0", IncludeStart: true, IncludeEnd: true},
+			want: `sort((only("v0.2.0", "v0.1.0")) + "v0.1.0", -rev)`,
+		},
+		{
+			name: "quoted refs",
+			r:    git.Range{UntilRef: `we"ird`, IncludeEnd: true},
+			want: `sort(::"we\"ird", -rev)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, rangeRevset(tt.r))
+		})
+	}
+}
+
+func TestRepo_RemoteURL(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, ".hg"), 0755))
+
+	r, err := New(dir)
+	require.NoError(t, err)
+
+	url, err := r.RemoteURL()
+	require.NoError(t, err)
+	assert.Empty(t, url)
+
+	hgrc := "# a comment\n[ui]\nusername = Someone\n\n[paths]\ndefault-push = ssh://hg@example.com/push\ndefault = https://hg.example.com/project\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".hg", "hgrc"), []byte(hgrc), 0600))
+
+	url, err = r.RemoteURL()
+	require.NoError(t, err)
+	assert.Equal(t, "https://hg.example.com/project", url)
+
+	// the preferred paths are used over the default path
+	r, err = New(dir, "upstream", "default-push")
+	require.NoError(t, err)
+
+	url, err = r.RemoteURL()
+	require.NoError(t, err)
+	assert.Equal(t, "ssh://hg@example.com/push", url)
+}
+
+func TestIsRepository(t *testing.T) {
+	dir := t.TempDir()
+	assert.False(t, IsRepository(dir))
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, ".hg"), 0755))
+	assert.True(t, IsRepository(dir))
+}
@@ -0,0 +1,212 @@
+package hg
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/anchore/chronicle/internal/git"
+)
+
+const (
+	fieldSeparator  = "\x1f"
+	recordSeparator = "\x1e"
+
+	// the automatic tag for the most recent changeset, which is never a release
+	tipTag = "tip"
+)
+
+// e.g. "<node>\x1f<date>\x1f<tag>\x1f<tag>...\x1e"
+const tagsTemplate = `{node}\x1f{date|rfc3339date}\x1f{join(tags, "\x1f")}\x1e`
+
+// e.g. "<node>\x1f<author name>\x1f<author email>\x1f<date>\x1f<description>\x1e"
+const commitTemplate = `{node}\x1f{author|person}\x1f{author|email}\x1f{date|rfc3339date}\x1f{desc}\x1e`
+
+func (r repo) HeadTagOrCommit() (string, error) {
+	node, tags, err := r.head()
+	if err != nil {
+		return "", err
+	}
+	if len(tags) > 0 {
+		return tags[0], nil
+	}
+	return node, nil
+}
+
+func (r repo) HeadTag() (string, error) {
+	_, tags, err := r.head()
+	if err != nil {
+		return "", err
+	}
+	// note: if there is no tag, then an empty value is returned
+	if len(tags) > 0 {
+		return tags[0], nil
+	}
+	return "", nil
+}
+
+// head returns the changeset of the working directory parent (the equivalent of HEAD) and its tags.
+func (r repo) head() (string, []string, error) {
+	out, err := r.run("log", "-r", ".", "--template", tagsTemplate)
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to fetch head: %w", err)
+	}
+	tags, err := parseTags(string(out))
+	if err != nil {
+		return "", nil, err
+	}
+	if len(tags) == 0 {
+		return "", nil, fmt.Errorf("unable to fetch head: no changesets")
+	}
+
+	node := tags[0].Commit
+	var names []string
+	for _, t := range tags {
+		if t.Name != "" {
+			names = append(names, t.Name)
+		}
+	}
+	return node, names, nil
+}
+
+func (r repo) SearchForTag(tagRef string) (*git.Tag, error) {
+	out, err := r.run("log", "-r", fmt.Sprintf("tag(%s)", revsetString("literal:"+tagRef)), "--template", tagsTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find hg tag=%q: %w", tagRef, err)
+	}
+	tags, err := parseTags(string(out))
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tags {
+		if t.Name == tagRef {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("unable to find hg tag=%q", tagRef)
+}
+
+func (r repo) TagsFromLocal() ([]git.Tag, error) {
+	out, err := r.run("log", "-r", "tag()", "--template", tagsTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch tags: %w", err)
+	}
+	tags, err := parseTags(string(out))
+	if err != nil {
+		return nil, err
+	}
+
+	var results []git.Tag
+	for _, t := range tags {
+		if t.Name != "" {
+			results = append(results, t)
+		}
+	}
+	return results, nil
+}
+
+func (r repo) CommitsBetween(cfg git.Range) ([]string, error) {
+	commits, err := r.CommitLogBetween(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var hashes []string
+	for _, c := range commits {
+		hashes = append(hashes, c.Hash)
+	}
+	return hashes, nil
+}
+
+// CommitLogBetween returns the full commit details for all changesets within the given range (in reverse
+// chronological order). Since is treated as the changesets that are ancestors of the until ref but not of the since
+// ref (that is, as with "git log since..until").
+func (r repo) CommitLogBetween(cfg git.Range) ([]git.Commit, error) {
+	out, err := r.run("log", "-r", rangeRevset(cfg), "--template", commitTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch commit range: %w", err)
+	}
+	return parseCommits(string(out))
+}
+
+func rangeRevset(cfg git.Range) string {
+	until := revsetString(cfg.UntilRef)
+	set := "::" + until
+	if cfg.SinceRef != "" {
+		since := revsetString(cfg.SinceRef)
+		set = fmt.Sprintf("only(%s, %s)", until, since)
+		if cfg.IncludeStart {
+			set = fmt.Sprintf("(%s) + %s", set, since)
+		}
+	}
+	if !cfg.IncludeEnd {
+		set = fmt.Sprintf("(%s) - %s", set, until)
+	}
+	return fmt.Sprintf("sort(%s, -rev)", set)
+}
+
+// revsetString quotes the given ref (e.g. a tag name or changeset ID) for use within a revset.
+func revsetString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// parseTags returns a tag for each tag name of each changeset (or a tag without a name for a changeset without tags).
+func parseTags(out string) ([]git.Tag, error) {
+	var tags []git.Tag
+	for _, record := range records(out) {
+		fields := strings.Split(record, fieldSeparator)
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("unable to parse hg changeset: %q", record)
+		}
+		timestamp, err := time.Parse(time.RFC3339, fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse hg changeset date: %w", err)
+		}
+
+		var named bool
+		for _, name := range fields[2:] {
+			if name == "" || name == tipTag {
+				continue
+			}
+			named = true
+			tags = append(tags, git.Tag{Name: name, Timestamp: timestamp, Commit: fields[0]})
+		}
+		if !named {
+			tags = append(tags, git.Tag{Timestamp: timestamp, Commit: fields[0]})
+		}
+	}
+	return tags, nil
+}
+
+func parseCommits(out string) ([]git.Commit, error) {
+	var commits []git.Commit
+	for _, record := range records(out) {
+		fields := strings.SplitN(record, fieldSeparator, 5)
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("unable to parse hg changeset: %q", record)
+		}
+		timestamp, err := time.Parse(time.RFC3339, fields[3])
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse hg changeset date: %w", err)
+		}
+		commits = append(commits, git.Commit{
+			Hash:        fields[0],
+			AuthorName:  fields[1],
+			AuthorEmail: fields[2],
+			Timestamp:   timestamp,
+			Message:     fields[4],
+		})
+	}
+	return commits, nil
+}
+
+func records(out string) []string {
+	var results []string
+	for _, record := range strings.Split(out, recordSeparator) {
+		record = strings.TrimLeft(record, "\n")
+		if record != "" {
+			results = append(results, record)
+		}
+	}
+	return results
+}
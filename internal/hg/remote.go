@@ -0,0 +1,53 @@
+package hg
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// defaultPath is the path used when none of the preferred paths are configured (the equivalent of the git "origin" remote)
+const defaultPath = "default"
+
+// RemoteURL returns the first of the preferred paths configured in the [paths] section of .hg/hgrc, otherwise the
+// "default" path. An empty string is returned when there is no such path.
+func (r repo) RemoteURL() (string, error) {
+	f, err := os.Open(filepath.Join(r.repoPath, ".hg", "hgrc"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("unable to open hg config: %w", err)
+	}
+	defer f.Close()
+
+	paths := make(map[string]string)
+	var section string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSpace(line[1 : len(line)-1])
+		case section == "paths":
+			parts := strings.SplitN(line, "=", 2)
+			if len(parts) == 2 {
+				paths[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("unable to read hg config: %w", err)
+	}
+
+	for _, name := range append(append([]string{}, r.paths...), defaultPath) {
+		if u, ok := paths[name]; ok {
+			return u, nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,142 @@
+package log
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/anchore/go-logger"
+)
+
+var _ logger.Logger = (*Recorder)(nil)
+
+// Entry is a single message captured by a Recorder.
+type Entry struct {
+	Time    time.Time              `json:"time"`
+	Level   logger.Level           `json:"level"`
+	Message string                 `json:"message"`
+	Fields  map[string]interface{} `json:"fields,omitempty"`
+}
+
+// Recorder is a logger that keeps every message (at all levels, including trace) in memory while passing each message
+// through to another logger (which applies its own level), e.g. to attach the full log to a debug bundle.
+type Recorder struct {
+	next    logger.MessageLogger
+	nest    func(fields ...interface{}) logger.Logger
+	fields  map[string]interface{}
+	entries *recording
+}
+
+type recording struct {
+	lock    sync.Mutex
+	entries []Entry
+}
+
+// NewRecorder returns a recorder that passes each message through to the given logger.
+func NewRecorder(next logger.Logger) *Recorder {
+	return &Recorder{
+		next:    next,
+		nest:    next.Nested,
+		entries: &recording{},
+	}
+}
+
+// Entries returns the messages recorded so far, in the order they were logged.
+func (r *Recorder) Entries() []Entry {
+	r.entries.lock.Lock()
+	defer r.entries.lock.Unlock()
+	return append([]Entry(nil), r.entries.entries...)
+}
+
+func (r *Recorder) record(level logger.Level, message string) {
+	r.entries.lock.Lock()
+	defer r.entries.lock.Unlock()
+	r.entries.entries = append(r.entries.entries, Entry{
+		Time:    time.Now(),
+		Level:   level,
+		Message: message,
+		Fields:  r.fields,
+	})
+}
+
+// with returns a recorder that records the given fields along with each message.
+func (r *Recorder) with(next logger.MessageLogger, fields ...interface{}) *Recorder {
+	merged := make(map[string]interface{}, len(r.fields)+len(fields)/2)
+	for k, v := range r.fields {
+		merged[k] = v
+	}
+	for i := 0; i+1 < len(fields); i += 2 {
+		merged[fmt.Sprintf("%s", fields[i])] = fields[i+1]
+	}
+	return &Recorder{
+		next:    next,
+		nest:    r.nest,
+		fields:  merged,
+		entries: r.entries,
+	}
+}
+
+func (r *Recorder) WithFields(fields ...interface{}) logger.MessageLogger {
+	next := r.next
+	if fl, ok := next.(logger.FieldLogger); ok {
+		next = fl.WithFields(fields...)
+	}
+	return r.with(next, fields...)
+}
+
+func (r *Recorder) Nested(fields ...interface{}) logger.Logger {
+	nested := r.nest(fields...)
+	recorder := r.with(nested, fields...)
+	recorder.nest = nested.Nested
+	return recorder
+}
+
+func (r *Recorder) Errorf(format string, args ...interface{}) {
+	r.record(logger.ErrorLevel, fmt.Sprintf(format, args...))
+	r.next.Errorf(format, args...)
+}
+
+func (r *Recorder) Error(args ...interface{}) {
+	r.record(logger.ErrorLevel, fmt.Sprint(args...))
+	r.next.Error(args...)
+}
+
+func (r *Recorder) Warnf(format string, args ...interface{}) {
+	r.record(logger.WarnLevel, fmt.Sprintf(format, args...))
+	r.next.Warnf(format, args...)
+}
+
+func (r *Recorder) Warn(args ...interface{}) {
+	r.record(logger.WarnLevel, fmt.Sprint(args...))
+	r.next.Warn(args...)
+}
+
+func (r *Recorder) Infof(format string, args ...interface{}) {
+	r.record(logger.InfoLevel, fmt.Sprintf(format, args...))
+	r.next.Infof(format, args...)
+}
+
+func (r *Recorder) Info(args ...interface{}) {
+	r.record(logger.InfoLevel, fmt.Sprint(args...))
+	r.next.Info(args...)
+}
+
+func (r *Recorder) Debugf(format string, args ...interface{}) {
+	r.record(logger.DebugLevel, fmt.Sprintf(format, args...))
+	r.next.Debugf(format, args...)
+}
+
+func (r *Recorder) Debug(args ...interface{}) {
+	r.record(logger.DebugLevel, fmt.Sprint(args...))
+	r.next.Debug(args...)
+}
+
+func (r *Recorder) Tracef(format string, args ...interface{}) {
+	r.record(logger.TraceLevel, fmt.Sprintf(format, args...))
+	r.next.Tracef(format, args...)
+}
+
+func (r *Recorder) Trace(args ...interface{}) {
+	r.record(logger.TraceLevel, fmt.Sprint(args...))
+	r.next.Trace(args...)
+}
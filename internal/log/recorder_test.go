@@ -0,0 +1,34 @@
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/go-logger"
+	"github.com/anchore/go-logger/adapter/discard"
+)
+
+func TestRecorder(t *testing.T) {
+	r := NewRecorder(discard.New())
+
+	r.Infof("fetched %d PRs", 3)
+	r.WithFields("subject", "PR #1", "decision", "excluded").Tracef("PR #%d filtered out: has labels", 1)
+	r.Nested("source", "github").WithFields("subject", "issue #2").Debug("issue ", 2)
+
+	entries := r.Entries()
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, logger.InfoLevel, entries[0].Level)
+	assert.Equal(t, "fetched 3 PRs", entries[0].Message)
+	assert.Empty(t, entries[0].Fields)
+
+	assert.Equal(t, logger.TraceLevel, entries[1].Level)
+	assert.Equal(t, "PR #1 filtered out: has labels", entries[1].Message)
+	assert.Equal(t, map[string]interface{}{"subject": "PR #1", "decision": "excluded"}, entries[1].Fields)
+
+	assert.Equal(t, logger.DebugLevel, entries[2].Level)
+	assert.Equal(t, "issue 2", entries[2].Message)
+	assert.Equal(t, map[string]interface{}{"source": "github", "subject": "issue #2"}, entries[2].Fields)
+}
@@ -0,0 +1,296 @@
+/*
+Package selfupdate replaces the running chronicle binary with a release published on GitHub, after verifying the
+downloaded archive against the checksums published with the release.
+*/
+package selfupdate
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/anchore/chronicle/internal"
+	"github.com/anchore/chronicle/internal/log"
+)
+
+const (
+	DefaultAPIURL = "https://api.github.com"
+	owner         = "anchore"
+	// maxDownloadSize bounds the size of any downloaded release asset
+	maxDownloadSize = 200 << 20
+)
+
+// Release is a published chronicle release with the download URLs of its assets (keyed by asset name).
+type Release struct {
+	Tag    string
+	Assets map[string]string
+}
+
+// Version returns the release version without the "v" prefix (as used within the asset names).
+func (r Release) Version() string {
+	return strings.TrimPrefix(r.Tag, "v")
+}
+
+type Updater struct {
+	APIURL string // the base URL of the github REST API
+	Token  string // an optional github token (to avoid the lower unauthenticated rate limits)
+	GOOS   string
+	GOARCH string
+	client *http.Client
+}
+
+func NewUpdater(apiURL, token string) *Updater {
+	if apiURL == "" {
+		apiURL = DefaultAPIURL
+	}
+	return &Updater{
+		APIURL: strings.TrimSuffix(apiURL, "/"),
+		Token:  token,
+		GOOS:   runtime.GOOS,
+		GOARCH: runtime.GOARCH,
+		client: &http.Client{},
+	}
+}
+
+// FetchRelease returns the release with the given tag, or the latest release when no tag is given.
+func (u *Updater) FetchRelease(tag string) (*Release, error) {
+	path := "/releases/latest"
+	if tag != "" {
+		path = "/releases/tags/" + tag
+	}
+
+	body, err := u.get(fmt.Sprintf("%s/repos/%s/%s%s", u.APIURL, owner, internal.ApplicationName, path), "application/vnd.github+json")
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch release: %w", err)
+	}
+
+	var doc struct {
+		TagName string `json:"tag_name"`
+		Assets  []struct {
+			Name string `json:"name"`
+			URL  string `json:"browser_download_url"`
+		} `json:"assets"`
+	}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("unable to parse release: %w", err)
+	}
+
+	release := Release{
+		Tag:    doc.TagName,
+		Assets: make(map[string]string),
+	}
+	for _, a := range doc.Assets {
+		release.Assets[a.Name] = a.URL
+	}
+	return &release, nil
+}
+
+// ArchiveName returns the name of the release archive for the given platform (as named by goreleaser, where macOS
+// releases are universal binaries).
+func ArchiveName(version, goos, goarch string) string {
+	if goos == "darwin" {
+		goarch = "all"
+	}
+	format := "tar.gz"
+	if goos == "windows" {
+		format = "zip"
+	}
+	return fmt.Sprintf("%s_%s_%s_%s.%s", internal.ApplicationName, version, goos, goarch, format)
+}
+
+// ChecksumsName returns the name of the checksums file published with each release.
+func ChecksumsName(version string) string {
+	return fmt.Sprintf("%s_%s_checksums.txt", internal.ApplicationName, version)
+}
+
+// Download fetches the archive for this platform from the given release, verifies it against the release checksums,
+// and returns the chronicle binary from within it.
+func (u *Updater) Download(release Release) ([]byte, error) {
+	archiveName := ArchiveName(release.Version(), u.GOOS, u.GOARCH)
+	archiveURL, ok := release.Assets[archiveName]
+	if !ok {
+		return nil, fmt.Errorf("release %s has no archive for %s/%s (expected %q)", release.Tag, u.GOOS, u.GOARCH, archiveName)
+	}
+
+	checksumsName := ChecksumsName(release.Version())
+	checksumsURL, ok := release.Assets[checksumsName]
+	if !ok {
+		return nil, fmt.Errorf("release %s has no checksums (expected %q), refusing to install an unverified binary", release.Tag, checksumsName)
+	}
+
+	checksums, err := u.get(checksumsURL, "application/octet-stream")
+	if err != nil {
+		return nil, fmt.Errorf("unable to download checksums: %w", err)
+	}
+
+	log.WithFields("asset", archiveName).Info("downloading release archive")
+	archive, err := u.get(archiveURL, "application/octet-stream")
+	if err != nil {
+		return nil, fmt.Errorf("unable to download release archive: %w", err)
+	}
+
+	if err := VerifyChecksum(archiveName, archive, checksums); err != nil {
+		return nil, err
+	}
+
+	return ExtractBinary(archiveName, archive)
+}
+
+func (u *Updater) get(url, accept string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	if u.Token != "" && strings.HasPrefix(url, u.APIURL) {
+		req.Header.Set("Authorization", "Bearer "+u.Token)
+	}
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxDownloadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxDownloadSize {
+		return nil, fmt.Errorf("response from %s is too large", url)
+	}
+	return body, nil
+}
+
+// VerifyChecksum checks the sha256 digest of the named file against the given checksums file (in the "<digest>  <name>"
+// format of sha256sum).
+func VerifyChecksum(name string, content, checksums []byte) error {
+	var want string
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == name {
+			want = strings.ToLower(fields[0])
+			break
+		}
+	}
+	if want == "" {
+		return fmt.Errorf("no checksum found for %q", name)
+	}
+
+	digest := sha256.Sum256(content)
+	got := hex.EncodeToString(digest[:])
+	if got != want {
+		return fmt.Errorf("checksum mismatch for %q: expected %s but got %s", name, want, got)
+	}
+	return nil
+}
+
+// ExtractBinary returns the chronicle binary from the given release archive (a .tar.gz or .zip).
+func ExtractBinary(archiveName string, archive []byte) ([]byte, error) {
+	binaryName := internal.ApplicationName
+	if strings.HasSuffix(archiveName, ".zip") {
+		return extractFromZip(archive, binaryName+".exe")
+	}
+	return extractFromTarGz(archive, binaryName)
+}
+
+func extractFromTarGz(archive []byte, binaryName string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read archive: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read archive: %w", err)
+		}
+		if hdr.Typeflag == tar.TypeReg && filepath.Base(hdr.Name) == binaryName {
+			return io.ReadAll(io.LimitReader(tr, maxDownloadSize))
+		}
+	}
+	return nil, fmt.Errorf("archive does not contain %q", binaryName)
+}
+
+func extractFromZip(archive []byte, binaryName string) ([]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read archive: %w", err)
+	}
+	for _, f := range zr.File {
+		if filepath.Base(f.Name) != binaryName {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return io.ReadAll(io.LimitReader(rc, maxDownloadSize))
+	}
+	return nil, fmt.Errorf("archive does not contain %q", binaryName)
+}
+
+// ReplaceBinary atomically replaces the binary at the given path with the given content, keeping its file mode. The
+// new binary is written alongside the existing one first so that a failure never leaves a partially written binary.
+func ReplaceBinary(path string, binary []byte) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("unable to stat %q: %w", path, err)
+	}
+
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.new")
+	if err != nil {
+		return fmt.Errorf("unable to write new binary (is %q writable?): %w", dir, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(binary); err != nil {
+		tmp.Close()
+		return fmt.Errorf("unable to write new binary: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("unable to write new binary: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), info.Mode().Perm()); err != nil {
+		return fmt.Errorf("unable to set permissions on new binary: %w", err)
+	}
+
+	if runtime.GOOS == "windows" {
+		// a running executable cannot be replaced on windows, but can be renamed out of the way
+		old := path + ".old"
+		_ = os.Remove(old)
+		if err := os.Rename(path, old); err != nil {
+			return fmt.Errorf("unable to move the current binary aside: %w", err)
+		}
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("unable to replace %q: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package selfupdate
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tarGz(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0755, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func sha256sum(content []byte) string {
+	digest := sha256.Sum256(content)
+	return hex.EncodeToString(digest[:])
+}
+
+func TestUpdater(t *testing.T) {
+	archive := tarGz(t, map[string]string{
+		"README.md": "readme",
+		"chronicle": "new binary",
+	})
+	archiveName := "chronicle_0.5.0_linux_amd64.tar.gz"
+
+	checksums := fmt.Sprintf("%s  %s\n%s  chronicle_0.5.0_windows_amd64.zip\n", sha256sum(archive), archiveName, sha256sum([]byte("other")))
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/anchore/chronicle/releases/latest":
+			fmt.Fprintf(w, `{"tag_name": "v0.5.0", "assets": [
+				{"name": %q, "browser_download_url": "%s/download/archive"},
+				{"name": "chronicle_0.5.0_checksums.txt", "browser_download_url": "%s/download/checksums"}
+			]}`, archiveName, server.URL, server.URL)
+		case "/download/archive":
+			_, _ = w.Write(archive)
+		case "/download/checksums":
+			_, _ = w.Write([]byte(checksums))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	u := NewUpdater(server.URL, "")
+	u.GOOS, u.GOARCH = "linux", "amd64"
+
+	release, err := u.FetchRelease("")
+	require.NoError(t, err)
+	assert.Equal(t, "v0.5.0", release.Tag)
+	assert.Equal(t, "0.5.0", release.Version())
+
+	binary, err := u.Download(*release)
+	require.NoError(t, err)
+	assert.Equal(t, "new binary", string(binary))
+
+	// a tampered archive is rejected
+	archive = append(archive, 0)
+	_, err = u.Download(*release)
+	assert.ErrorContains(t, err, "checksum mismatch")
+
+	// a platform without an archive is rejected
+	u.GOOS = "plan9"
+	_, err = u.Download(*release)
+	assert.ErrorContains(t, err, "no archive for plan9/amd64")
+}
+
+func TestArchiveName(t *testing.T) {
+	assert.Equal(t, "chronicle_0.5.0_linux_arm64.tar.gz", ArchiveName("0.5.0", "linux", "arm64"))
+	assert.Equal(t, "chronicle_0.5.0_darwin_all.tar.gz", ArchiveName("0.5.0", "darwin", "arm64"))
+	assert.Equal(t, "chronicle_0.5.0_windows_amd64.zip", ArchiveName("0.5.0", "windows", "amd64"))
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	content := []byte("content")
+	checksums := []byte(sha256sum(content) + " *the-file\n")
+
+	assert.NoError(t, VerifyChecksum("the-file", content, checksums))
+	assert.ErrorContains(t, VerifyChecksum("the-file", []byte("tampered"), checksums), "checksum mismatch")
+	assert.ErrorContains(t, VerifyChecksum("another-file", content, checksums), "no checksum found")
+}
+
+func TestExtractBinary_zip(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("chronicle.exe")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("windows binary"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	binary, err := ExtractBinary("chronicle_0.5.0_windows_amd64.zip", buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, "windows binary", string(binary))
+
+	_, err = ExtractBinary("chronicle_0.5.0_linux_amd64.tar.gz", tarGz(t, map[string]string{"other": "x"}))
+	assert.ErrorContains(t, err, "does not contain")
+}
+
+func TestReplaceBinary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chronicle")
+	require.NoError(t, os.WriteFile(path, []byte("old binary"), 0750))
+
+	require.NoError(t, ReplaceBinary(path, []byte("new binary")))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "new binary", string(content))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0750), info.Mode().Perm())
+
+	// no temp files are left behind
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
@@ -1,7 +1,42 @@
 package internal
 
-import "time"
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
 
 func FormatDateTime(t time.Time) string {
 	return t.UTC().Format("2006-01-02 15:04:05 MST")
 }
+
+var relativeTimePattern = regexp.MustCompile(`^(?P<amount>\d+)(?P<unit>[hdw])$`)
+
+// ParseRelativeTime parses either a relative amount of time before now (e.g. "36h", "7d", or "2w") or a date
+// (e.g. "2023-03-01"), returning the absolute time it represents.
+func ParseRelativeTime(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	if match := relativeTimePattern.FindStringSubmatch(value); match != nil {
+		amount, err := strconv.Atoi(match[1])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid amount of time %q: %w", value, err)
+		}
+		switch match[2] {
+		case "h":
+			return now.Add(-time.Duration(amount) * time.Hour), nil
+		case "d":
+			return now.AddDate(0, 0, -amount), nil
+		case "w":
+			return now.AddDate(0, 0, -7*amount), nil
+		}
+	}
+
+	t, err := time.ParseInLocation("2006-01-02", value, now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q: expected a relative time (e.g. 7d) or a date (e.g. 2023-03-01)", value)
+	}
+	return t, nil
+}
@@ -0,0 +1,56 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRelativeTime(t *testing.T) {
+	now := time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			value: "36h",
+			want:  time.Date(2023, time.March, 14, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			value: "7d",
+			want:  time.Date(2023, time.March, 8, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			value: "2w",
+			want:  time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			value: "2023-03-01",
+			want:  time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			value:   "7 days",
+			wantErr: require.Error,
+		},
+		{
+			value:   "7m",
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			got, err := ParseRelativeTime(tt.value, now)
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package vcs
+
+import (
+	"fmt"
+
+	"github.com/anchore/chronicle/internal/git"
+	"github.com/anchore/chronicle/internal/hg"
+)
+
+// New returns the operations chronicle needs from the repo at the given path, which is either a git or a mercurial
+// repo. The remote URL is taken from the first of the preferred remotes (or mercurial paths) that the repo has.
+func New(repoPath string, preferredRemotes ...string) (git.Interface, error) {
+	switch {
+	case git.IsRepository(repoPath):
+		return git.New(repoPath, preferredRemotes...)
+	case hg.IsRepository(repoPath):
+		return hg.New(repoPath, preferredRemotes...)
+	default:
+		return nil, fmt.Errorf("not a git or mercurial repository: %q", repoPath)
+	}
+}
+
+// IsRepository indicates if the given path is a git or mercurial repo.
+func IsRepository(path string) bool {
+	return git.IsRepository(path) || hg.IsRepository(path)
+}
+
+// RemoteURL returns the URL of the first of the preferred remotes that the repo has, otherwise the default remote of the
+// repo (the "origin" remote for git, or the "default" path for mercurial).
+func RemoteURL(repoPath string, preferredRemotes ...string) (string, error) {
+	repo, err := New(repoPath, preferredRemotes...)
+	if err != nil {
+		return "", err
+	}
+	return repo.RemoteURL()
+}